@@ -9,8 +9,12 @@ import (
 	"Todo-List/internProject/graphQL_service/internal/health"
 	"Todo-List/internProject/graphQL_service/internal/resolvers/access"
 	"Todo-List/internProject/graphQL_service/internal/resolvers/activity"
+	"Todo-List/internProject/graphQL_service/internal/resolvers/invitation"
 	"Todo-List/internProject/graphQL_service/internal/resolvers/list"
+	"Todo-List/internProject/graphQL_service/internal/resolvers/search"
+	"Todo-List/internProject/graphQL_service/internal/resolvers/session"
 	"Todo-List/internProject/graphQL_service/internal/resolvers/todo"
+	"Todo-List/internProject/graphQL_service/internal/resolvers/trash"
 	"Todo-List/internProject/graphQL_service/internal/resolvers/user"
 	"Todo-List/internProject/graphQL_service/internal/url_decorators"
 	_ "Todo-List/internProject/graphQL_service/internal/url_decorators/url_decorators_creators"
@@ -41,8 +45,17 @@ func main() {
 	listConv := gql_converters.NewListConverter()
 	userConv := gql_converters.NewUserConverter(roleConverter)
 	todoConv := gql_converters.NewTodoConverter(pConverter, sConverter)
+	labelConv := gql_converters.NewLabelConverter()
+	commentConv := gql_converters.NewCommentConverter()
+	historyConv := gql_converters.NewHistoryConverter()
+	workLogConv := gql_converters.NewWorkLogConverter()
 	accessConv := gql_converters.NewAccessConverter()
 	activityConverter := gql_converters.NewActivityConverter()
+	searchConv := gql_converters.NewSearchConverter(todoConv, listConv)
+	trashConv := gql_converters.NewTrashConverter(todoConv, listConv)
+	invitationConv := gql_converters.NewInvitationConverter(roleConverter)
+	boardConv := gql_converters.NewBoardConverter(todoConv)
+	sessionConv := gql_converters.NewSessionConverter()
 
 	urlDecoratorFactory := url_decorators.GetUrlDecoratorFactoryInstance()
 	requestDecorator := gql_auth_header_setters.NewRequestAuthHeader()
@@ -51,17 +64,21 @@ func main() {
 	httpService := http_helpers.NewService(httpClient, requestDecorator, httpRequester)
 	jsonMarshaller := http_helpers.NewJsonMarshaller()
 
-	listResolver := list.NewResolver(listConv, userConv, todoConv, restUrl, urlDecoratorFactory, httpService, jsonMarshaller)
-	todoResolver := todo.NewResolver(urlDecoratorFactory, todoConv, userConv, listConv, restUrl, jsonMarshaller, httpService)
-	userResolver := user.NewResolver(userConv, listConv, todoConv, restUrl, urlDecoratorFactory, httpService)
+	listResolver := list.NewResolver(listConv, userConv, todoConv, historyConv, boardConv, restUrl, urlDecoratorFactory, httpService, jsonMarshaller)
+	todoResolver := todo.NewResolver(urlDecoratorFactory, todoConv, userConv, listConv, labelConv, commentConv, historyConv, workLogConv, restUrl, jsonMarshaller, httpService)
+	userResolver := user.NewResolver(userConv, listConv, todoConv, labelConv, restUrl, urlDecoratorFactory, httpService)
 	accessResolver := access.NewResolver(accessConv, jsonMarshaller, httpService, restUrl)
 	activityResolver := activity.NewResolver(restUrl, httpService, activityConverter)
+	searchResolver := search.NewResolver(urlDecoratorFactory, searchConv, restUrl, httpService)
+	trashResolver := trash.NewResolver(trashConv, todoConv, listConv, restUrl, httpService)
+	invitationResolver := invitation.NewResolver(invitationConv, restUrl, jsonMarshaller, httpService)
+	sessionResolver := session.NewResolver(sessionConv, restUrl, httpService)
 	jwtParserHelper := jwt.NewJwtManager()
 	jwtParser := jwt.NewJwtParseService(jwtParserHelper)
 
-	roleDirective := directives.NewRoleDirectiveImplementation(jwtParser)
+	roleDirective := directives.NewRoleDirectiveImplementation(jwtParser, restUrl, httpService)
 
-	root := graph.NewResolver(listResolver, todoResolver, userResolver, accessResolver, activityResolver)
+	root := graph.NewResolver(listResolver, todoResolver, userResolver, accessResolver, activityResolver, searchResolver, trashResolver, invitationResolver, sessionResolver)
 	srv := handler.New(graph.NewExecutableSchema(graph.Config{
 		Resolvers: root,
 		Directives: graph.DirectiveRoot{
@@ -16,36 +16,117 @@ type Pageable interface {
 	GetTotalCount() int32
 }
 
+type SearchResult interface {
+	IsSearchResult()
+}
+
+type TrashRecord interface {
+	IsTrashRecord()
+}
+
 type Access struct {
 	JwtToken     string `json:"jwtToken"`
 	RefreshToken string `json:"refreshToken"`
 }
 
+type BatchTodoOperationInput struct {
+	Op     BatchOperation   `json:"op"`
+	TodoID *string          `json:"todoId,omitempty"`
+	Create *CreateTodoInput `json:"create,omitempty"`
+	Update *UpdateTodoInput `json:"update,omitempty"`
+}
+
+type BatchTodoResult struct {
+	Index  int32          `json:"index"`
+	Op     BatchOperation `json:"op"`
+	Status int32          `json:"status"`
+	Todo   *Todo          `json:"todo,omitempty"`
+	Error  *string        `json:"error,omitempty"`
+}
+
+type BatchTodosInput struct {
+	Mode       *BatchMode                 `json:"mode,omitempty"`
+	Operations []*BatchTodoOperationInput `json:"operations"`
+}
+
+type BatchTodosPayload struct {
+	Committed bool               `json:"committed"`
+	Results   []*BatchTodoResult `json:"results"`
+}
+
+type Board struct {
+	ListID  string         `json:"listId"`
+	Columns []*BoardColumn `json:"columns"`
+}
+
+type BoardColumn struct {
+	ID       *string    `json:"id,omitempty"`
+	Name     string     `json:"name"`
+	Status   TodoStatus `json:"status"`
+	WipLimit *int32     `json:"wipLimit,omitempty"`
+	Todos    []*Todo    `json:"todos"`
+}
+
 type CollaboratorInput struct {
-	ListID    string `json:"listId"`
-	UserEmail string `json:"userEmail"`
+	ListID    string            `json:"listId"`
+	UserEmail string            `json:"userEmail"`
+	Role      *CollaboratorRole `json:"role,omitempty"`
+}
+
+type Comment struct {
+	ID          string    `json:"id"`
+	Content     string    `json:"content"`
+	Author      *User     `json:"author"`
+	CreatedAt   time.Time `json:"createdAt"`
+	LastUpdated time.Time `json:"lastUpdated"`
 }
 
+type CommentPage struct {
+	Data       []*Comment `json:"data"`
+	PageInfo   *PageInfo  `json:"pageInfo,omitempty"`
+	TotalCount int32      `json:"totalCount"`
+}
+
+func (CommentPage) IsPageable()                 {}
+func (this CommentPage) GetPageInfo() *PageInfo { return this.PageInfo }
+func (this CommentPage) GetTotalCount() int32   { return this.TotalCount }
+
 type CreateCollaboratorPayload struct {
 	List    *List `json:"list,omitempty"`
 	User    *User `json:"user,omitempty"`
 	Success bool  `json:"success"`
 }
 
+type CreateListFromTemplateInput struct {
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	StartDate   *time.Time `json:"startDate,omitempty"`
+}
+
 type CreateListInput struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 }
 
-type CreateTodoInput struct {
+type CreateSubtaskInput struct {
 	Name        string     `json:"name"`
 	Description string     `json:"description"`
-	ListID     string   `json:"listId"`
-	Priority   Priority `json:"priority"`
-	AssignedTo *string  `json:"assignedTo,omitempty"`
+	Priority    Priority   `json:"priority"`
+	AssignedTo  *string    `json:"assignedTo,omitempty"`
 	DueDate     *time.Time `json:"dueDate,omitempty"`
 }
 
+type CreateTodoInput struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	ListID      string           `json:"listId"`
+	Priority    Priority         `json:"priority"`
+	AssignedTo  *string          `json:"assignedTo,omitempty"`
+	DueDate     *time.Time       `json:"dueDate,omitempty"`
+	Recurrence  *RecurrenceInput `json:"recurrence,omitempty"`
+	Estimate    *int32           `json:"estimate,omitempty"`
+}
+
 type DeleteCollaboratorPayload struct {
 	ListID    string `json:"listId"`
 	UserEmail string `json:"userEmail"`
@@ -80,21 +161,92 @@ type DeleteUserPayload struct {
 	Role    *UserRole `json:"role,omitempty"`
 }
 
+type DuplicateListInput struct {
+	Name                 string     `json:"name"`
+	Description          *string    `json:"description,omitempty"`
+	IncludeCollaborators bool       `json:"includeCollaborators"`
+	StartDate            *time.Time `json:"startDate,omitempty"`
+}
+
+type HistoryEntry struct {
+	ID        string    `json:"id"`
+	Action    string    `json:"action"`
+	Field     string    `json:"field"`
+	OldValue  *string   `json:"oldValue,omitempty"`
+	NewValue  *string   `json:"newValue,omitempty"`
+	Actor     *User     `json:"actor,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type HistoryPage struct {
+	Data       []*HistoryEntry `json:"data"`
+	PageInfo   *PageInfo       `json:"pageInfo,omitempty"`
+	TotalCount int32           `json:"totalCount"`
+}
+
+func (HistoryPage) IsPageable()                 {}
+func (this HistoryPage) GetPageInfo() *PageInfo { return this.PageInfo }
+func (this HistoryPage) GetTotalCount() int32   { return this.TotalCount }
+
+type Invitation struct {
+	ID        string           `json:"id"`
+	ListID    string           `json:"listId"`
+	Email     string           `json:"email"`
+	Role      CollaboratorRole `json:"role"`
+	Status    InvitationStatus `json:"status"`
+	Token     string           `json:"token"`
+	CreatedAt time.Time        `json:"createdAt"`
+	ExpiresAt time.Time        `json:"expiresAt"`
+}
+
+type InvitationInput struct {
+	ListID string            `json:"listId"`
+	Email  string            `json:"email"`
+	Role   *CollaboratorRole `json:"role,omitempty"`
+}
+
+type Label struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type LabelPage struct {
+	Data       []*Label  `json:"data"`
+	PageInfo   *PageInfo `json:"pageInfo,omitempty"`
+	TotalCount int32     `json:"totalCount"`
+}
+
+func (LabelPage) IsPageable()                 {}
+func (this LabelPage) GetPageInfo() *PageInfo { return this.PageInfo }
+func (this LabelPage) GetTotalCount() int32   { return this.TotalCount }
+
 type List struct {
-	ID            string    `json:"id"`
-	Name          string    `json:"name"`
-	Description   string    `json:"description"`
-	CreatedAt     time.Time `json:"created_at"`
-	LastUpdated   time.Time `json:"last_updated"`
-	Owner         *User     `json:"owner"`
-	Todos         *TodoPage `json:"todos"`
-	Collaborators *UserPage `json:"collaborators"`
+	ID            string       `json:"id"`
+	Name          string       `json:"name"`
+	Description   string       `json:"description"`
+	CreatedAt     time.Time    `json:"created_at"`
+	LastUpdated   time.Time    `json:"last_updated"`
+	Owner         *User        `json:"owner"`
+	Todos         *TodoPage    `json:"todos"`
+	Collaborators *UserPage    `json:"collaborators"`
+	History       *HistoryPage `json:"history"`
+	Board         *Board       `json:"board"`
 }
 
+func (List) IsSearchResult() {}
+
+func (List) IsTrashRecord() {}
+
 type ListFilterInput struct {
 	Name *string `json:"name,omitempty"`
 }
 
+type ListOrder struct {
+	Field     ListSortField  `json:"field"`
+	Direction *SortDirection `json:"direction,omitempty"`
+}
+
 type ListPage struct {
 	Data       []*List   `json:"data"`
 	PageInfo   *PageInfo `json:"pageInfo,omitempty"`
@@ -125,21 +277,79 @@ type RandomActivity struct {
 	KidFriendly  bool   `json:"kidFriendly"`
 }
 
+type Recurrence struct {
+	Frequency RecurrenceFrequency `json:"frequency"`
+	Interval  int32               `json:"interval"`
+	Until     *time.Time          `json:"until,omitempty"`
+	Count     *int32              `json:"count,omitempty"`
+}
+
+type RecurrenceInput struct {
+	Frequency RecurrenceFrequency `json:"frequency"`
+	Interval  *int32              `json:"interval,omitempty"`
+	Until     *time.Time          `json:"until,omitempty"`
+	Count     *int32              `json:"count,omitempty"`
+}
+
 type RefreshTokenInput struct {
 	RefreshToken string `json:"refreshToken"`
 }
 
+type SearchResultPage struct {
+	Data       []SearchResult `json:"data"`
+	PageInfo   *PageInfo      `json:"pageInfo,omitempty"`
+	TotalCount int32          `json:"totalCount"`
+}
+
+func (SearchResultPage) IsPageable()                 {}
+func (this SearchResultPage) GetPageInfo() *PageInfo { return this.PageInfo }
+func (this SearchResultPage) GetTotalCount() int32   { return this.TotalCount }
+
+type Session struct {
+	ID          string    `json:"id"`
+	DeviceLabel string    `json:"deviceLabel"`
+	UserAgent   string    `json:"userAgent"`
+	IPAddress   string    `json:"ipAddress"`
+	CreatedAt   time.Time `json:"createdAt"`
+	LastUsedAt  time.Time `json:"lastUsedAt"`
+	Current     bool      `json:"current"`
+}
+
 type Todo struct {
-	ID          string     `json:"id"`
-	Name        string     `json:"name"`
-	Description string     `json:"description"`
-	List        *List      `json:"list"`
-	Status      TodoStatus `json:"status"`
-	CreatedAt   time.Time  `json:"createdAt"`
-	LastUpdated time.Time  `json:"lastUpdated"`
-	Priority    Priority   `json:"priority"`
-	AssignedTo  *User      `json:"assignedTo,omitempty"`
-	DueDate     *time.Time `json:"dueDate,omitempty"`
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	List        *List        `json:"list"`
+	Status      TodoStatus   `json:"status"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	LastUpdated time.Time    `json:"lastUpdated"`
+	Priority    Priority     `json:"priority"`
+	AssignedTo  *User        `json:"assignedTo,omitempty"`
+	DueDate     *time.Time   `json:"dueDate,omitempty"`
+	Recurrence  *Recurrence  `json:"recurrence,omitempty"`
+	Position    string       `json:"position"`
+	ColumnID    *string      `json:"columnId,omitempty"`
+	Estimate    *int32       `json:"estimate,omitempty"`
+	TimeSpent   int32        `json:"timeSpent"`
+	WorkLogs    []*WorkLog   `json:"workLogs"`
+	Parent      *Todo        `json:"parent,omitempty"`
+	Subtasks    *TodoPage    `json:"subtasks"`
+	BlockedBy   *TodoPage    `json:"blockedBy"`
+	Blocks      *TodoPage    `json:"blocks"`
+	Labels      *LabelPage   `json:"labels"`
+	Assignees   *UserPage    `json:"assignees"`
+	Watchers    *UserPage    `json:"watchers"`
+	Comments    *CommentPage `json:"comments"`
+	History     *HistoryPage `json:"history"`
+}
+
+func (Todo) IsSearchResult() {}
+
+func (Todo) IsTrashRecord() {}
+
+type TodoOrder struct {
+	Field     TodoSortField  `json:"field"`
+	Direction *SortDirection `json:"direction,omitempty"`
 }
 
 type TodoPage struct {
@@ -153,10 +363,22 @@ func (this TodoPage) GetPageInfo() *PageInfo { return this.PageInfo }
 func (this TodoPage) GetTotalCount() int32   { return this.TotalCount }
 
 type TodosFilterInput struct {
-	Status   *TodoStatus `json:"status,omitempty"`
-	Priority *Priority   `json:"priority,omitempty"`
-	Type     *TodoType   `json:"type,omitempty"`
-	Name     *string     `json:"name,omitempty"`
+	Status          *TodoStatus `json:"status,omitempty"`
+	Priority        *Priority   `json:"priority,omitempty"`
+	Type            *TodoType   `json:"type,omitempty"`
+	Name            *string     `json:"name,omitempty"`
+	ExcludeSubtasks *bool       `json:"excludeSubtasks,omitempty"`
+	Label           *string     `json:"label,omitempty"`
+}
+
+type Trash struct {
+	Data       []*TrashItem `json:"data"`
+	TotalCount int32        `json:"totalCount"`
+}
+
+type TrashItem struct {
+	DeletedAt time.Time   `json:"deletedAt"`
+	Record    TrashRecord `json:"record"`
 }
 
 type UpdateListInput struct {
@@ -165,20 +387,25 @@ type UpdateListInput struct {
 }
 
 type UpdateTodoInput struct {
-	Name        *string     `json:"name,omitempty"`
-	Description *string     `json:"description,omitempty"`
-	Status      *TodoStatus `json:"status,omitempty"`
-	Priority    *Priority   `json:"priority,omitempty"`
-	AssignedTo  *string     `json:"assignedTo,omitempty"`
-	DueDate     *time.Time  `json:"dueDate,omitempty"`
+	Name        *string          `json:"name,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Status      *TodoStatus      `json:"status,omitempty"`
+	Priority    *Priority        `json:"priority,omitempty"`
+	AssignedTo  *string          `json:"assignedTo,omitempty"`
+	DueDate     *time.Time       `json:"dueDate,omitempty"`
+	Recurrence  *RecurrenceInput `json:"recurrence,omitempty"`
+	ColumnID    *string          `json:"columnId,omitempty"`
+	Estimate    *int32           `json:"estimate,omitempty"`
 }
 
 type User struct {
-	ID         string    `json:"id"`
-	Email      string    `json:"email"`
-	Role       *UserRole `json:"role,omitempty"`
-	AssignedTo *TodoPage `json:"assignedTo"`
-	Owns       *ListPage `json:"owns"`
+	ID         string            `json:"id"`
+	Email      string            `json:"email"`
+	Role       *UserRole         `json:"role,omitempty"`
+	ListRole   *CollaboratorRole `json:"listRole,omitempty"`
+	AssignedTo *TodoPage         `json:"assignedTo"`
+	Owns       *ListPage         `json:"owns"`
+	Labels     *LabelPage        `json:"labels"`
 }
 
 type UserPage struct {
@@ -195,6 +422,302 @@ type UserRoleFilter struct {
 	Role *UserListRole `json:"role,omitempty"`
 }
 
+type WorkLog struct {
+	ID          string    `json:"id"`
+	TodoID      string    `json:"todoId"`
+	UserID      string    `json:"userId"`
+	Duration    int32     `json:"duration"`
+	Note        string    `json:"note"`
+	LoggedOn    time.Time `json:"loggedOn"`
+	CreatedAt   time.Time `json:"createdAt"`
+	LastUpdated time.Time `json:"lastUpdated"`
+}
+
+type BatchMode string
+
+const (
+	BatchModeAllOrNothing BatchMode = "ALL_OR_NOTHING"
+	BatchModePerItem      BatchMode = "PER_ITEM"
+)
+
+var AllBatchMode = []BatchMode{
+	BatchModeAllOrNothing,
+	BatchModePerItem,
+}
+
+func (e BatchMode) IsValid() bool {
+	switch e {
+	case BatchModeAllOrNothing, BatchModePerItem:
+		return true
+	}
+	return false
+}
+
+func (e BatchMode) String() string {
+	return string(e)
+}
+
+func (e *BatchMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BatchMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BatchMode", str)
+	}
+	return nil
+}
+
+func (e BatchMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *BatchMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e BatchMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type BatchOperation string
+
+const (
+	BatchOperationCreate BatchOperation = "CREATE"
+	BatchOperationUpdate BatchOperation = "UPDATE"
+	BatchOperationDelete BatchOperation = "DELETE"
+)
+
+var AllBatchOperation = []BatchOperation{
+	BatchOperationCreate,
+	BatchOperationUpdate,
+	BatchOperationDelete,
+}
+
+func (e BatchOperation) IsValid() bool {
+	switch e {
+	case BatchOperationCreate, BatchOperationUpdate, BatchOperationDelete:
+		return true
+	}
+	return false
+}
+
+func (e BatchOperation) String() string {
+	return string(e)
+}
+
+func (e *BatchOperation) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BatchOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BatchOperation", str)
+	}
+	return nil
+}
+
+func (e BatchOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *BatchOperation) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e BatchOperation) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CollaboratorRole string
+
+const (
+	CollaboratorRoleViewer  CollaboratorRole = "VIEWER"
+	CollaboratorRoleEditor  CollaboratorRole = "EDITOR"
+	CollaboratorRoleManager CollaboratorRole = "MANAGER"
+)
+
+var AllCollaboratorRole = []CollaboratorRole{
+	CollaboratorRoleViewer,
+	CollaboratorRoleEditor,
+	CollaboratorRoleManager,
+}
+
+func (e CollaboratorRole) IsValid() bool {
+	switch e {
+	case CollaboratorRoleViewer, CollaboratorRoleEditor, CollaboratorRoleManager:
+		return true
+	}
+	return false
+}
+
+func (e CollaboratorRole) String() string {
+	return string(e)
+}
+
+func (e *CollaboratorRole) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CollaboratorRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CollaboratorRole", str)
+	}
+	return nil
+}
+
+func (e CollaboratorRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CollaboratorRole) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CollaboratorRole) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "PENDING"
+	InvitationStatusAccepted InvitationStatus = "ACCEPTED"
+	InvitationStatusDeclined InvitationStatus = "DECLINED"
+	InvitationStatusRevoked  InvitationStatus = "REVOKED"
+)
+
+var AllInvitationStatus = []InvitationStatus{
+	InvitationStatusPending,
+	InvitationStatusAccepted,
+	InvitationStatusDeclined,
+	InvitationStatusRevoked,
+}
+
+func (e InvitationStatus) IsValid() bool {
+	switch e {
+	case InvitationStatusPending, InvitationStatusAccepted, InvitationStatusDeclined, InvitationStatusRevoked:
+		return true
+	}
+	return false
+}
+
+func (e InvitationStatus) String() string {
+	return string(e)
+}
+
+func (e *InvitationStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InvitationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InvitationStatus", str)
+	}
+	return nil
+}
+
+func (e InvitationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *InvitationStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e InvitationStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ListSortField string
+
+const (
+	ListSortFieldName        ListSortField = "NAME"
+	ListSortFieldCreatedAt   ListSortField = "CREATED_AT"
+	ListSortFieldLastUpdated ListSortField = "LAST_UPDATED"
+)
+
+var AllListSortField = []ListSortField{
+	ListSortFieldName,
+	ListSortFieldCreatedAt,
+	ListSortFieldLastUpdated,
+}
+
+func (e ListSortField) IsValid() bool {
+	switch e {
+	case ListSortFieldName, ListSortFieldCreatedAt, ListSortFieldLastUpdated:
+		return true
+	}
+	return false
+}
+
+func (e ListSortField) String() string {
+	return string(e)
+}
+
+func (e *ListSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ListSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ListSortField", str)
+	}
+	return nil
+}
+
+func (e ListSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ListSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ListSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type Priority string
 
 const (
@@ -256,6 +779,181 @@ func (e Priority) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyDaily   RecurrenceFrequency = "DAILY"
+	RecurrenceFrequencyWeekly  RecurrenceFrequency = "WEEKLY"
+	RecurrenceFrequencyMonthly RecurrenceFrequency = "MONTHLY"
+)
+
+var AllRecurrenceFrequency = []RecurrenceFrequency{
+	RecurrenceFrequencyDaily,
+	RecurrenceFrequencyWeekly,
+	RecurrenceFrequencyMonthly,
+}
+
+func (e RecurrenceFrequency) IsValid() bool {
+	switch e {
+	case RecurrenceFrequencyDaily, RecurrenceFrequencyWeekly, RecurrenceFrequencyMonthly:
+		return true
+	}
+	return false
+}
+
+func (e RecurrenceFrequency) String() string {
+	return string(e)
+}
+
+func (e *RecurrenceFrequency) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RecurrenceFrequency(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RecurrenceFrequency", str)
+	}
+	return nil
+}
+
+func (e RecurrenceFrequency) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RecurrenceFrequency) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RecurrenceFrequency) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TodoSortField string
+
+const (
+	TodoSortFieldName        TodoSortField = "NAME"
+	TodoSortFieldCreatedAt   TodoSortField = "CREATED_AT"
+	TodoSortFieldLastUpdated TodoSortField = "LAST_UPDATED"
+	TodoSortFieldDueDate     TodoSortField = "DUE_DATE"
+	TodoSortFieldPriority    TodoSortField = "PRIORITY"
+	TodoSortFieldPosition    TodoSortField = "POSITION"
+)
+
+var AllTodoSortField = []TodoSortField{
+	TodoSortFieldName,
+	TodoSortFieldCreatedAt,
+	TodoSortFieldLastUpdated,
+	TodoSortFieldDueDate,
+	TodoSortFieldPriority,
+	TodoSortFieldPosition,
+}
+
+func (e TodoSortField) IsValid() bool {
+	switch e {
+	case TodoSortFieldName, TodoSortFieldCreatedAt, TodoSortFieldLastUpdated, TodoSortFieldDueDate, TodoSortFieldPriority, TodoSortFieldPosition:
+		return true
+	}
+	return false
+}
+
+func (e TodoSortField) String() string {
+	return string(e)
+}
+
+func (e *TodoSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoSortField", str)
+	}
+	return nil
+}
+
+func (e TodoSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TodoSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TodoSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type TodoStatus string
 
 const (
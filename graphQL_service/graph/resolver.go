@@ -21,7 +21,12 @@ type lResolver interface {
 	AddListCollaborator(ctx context.Context, input gql.CollaboratorInput) (*gql.CreateCollaboratorPayload, error)
 	DeleteListCollaborator(ctx context.Context, id string, userID string) (*gql.DeleteCollaboratorPayload, error)
 	Collaborators(ctx context.Context, obj *gql.List, filters *url_filters.BaseFilters) (*gql.UserPage, error)
+	History(ctx context.Context, obj *gql.List, filters *url_filters.BaseFilters) (*gql.HistoryPage, error)
+	Board(ctx context.Context, obj *gql.List) (*gql.Board, error)
 	CreateList(ctx context.Context, input gql.CreateListInput) (*gql.List, error)
+	TransferListOwnership(ctx context.Context, id string, userID string) (*gql.List, error)
+	DuplicateList(ctx context.Context, id string, input gql.DuplicateListInput) (*gql.List, error)
+	CreateListFromTemplate(ctx context.Context, templateID string, input gql.CreateListFromTemplateInput) (*gql.List, error)
 }
 
 type tResolver interface {
@@ -29,26 +34,74 @@ type tResolver interface {
 	Todo(ctx context.Context, id string) (*gql.Todo, error)
 	DeleteTodosByListID(ctx context.Context, id string) ([]*gql.DeleteTodoPayload, error)
 	DeleteTodos(ctx context.Context) ([]*gql.DeleteTodoPayload, error)
-	DeleteTodo(ctx context.Context, id string) (*gql.DeleteTodoPayload, error)
+	DeleteTodo(ctx context.Context, id string, cascade *bool) (*gql.DeleteTodoPayload, error)
 	CreateTodo(ctx context.Context, input gql.CreateTodoInput) (*gql.Todo, error)
+	CreateSubtask(ctx context.Context, parentID string, input gql.CreateSubtaskInput) (*gql.Todo, error)
 	UpdateTodo(ctx context.Context, id string, input gql.UpdateTodoInput) (*gql.Todo, error)
 	AssignedTo(ctx context.Context, obj *gql.Todo) (*gql.User, error)
 	List(ctx context.Context, obj *gql.Todo) (*gql.List, error)
+	Parent(ctx context.Context, obj *gql.Todo) (*gql.Todo, error)
+	Subtasks(ctx context.Context, obj *gql.Todo, filters *url_filters.TodoFilters) (*gql.TodoPage, error)
+	Labels(ctx context.Context, obj *gql.Todo, filters *url_filters.BaseFilters) (*gql.LabelPage, error)
+	AddLabel(ctx context.Context, todoID string, labelID string) (*gql.Todo, error)
+	RemoveLabel(ctx context.Context, todoID string, labelID string) (*gql.Todo, error)
+	Assignees(ctx context.Context, obj *gql.Todo, filters *url_filters.BaseFilters) (*gql.UserPage, error)
+	Watchers(ctx context.Context, obj *gql.Todo, filters *url_filters.BaseFilters) (*gql.UserPage, error)
+	TimeSpent(ctx context.Context, obj *gql.Todo) (int32, error)
+	WorkLogs(ctx context.Context, obj *gql.Todo) ([]*gql.WorkLog, error)
+	AddTodoAssignee(ctx context.Context, todoID string, userID string) (*gql.Todo, error)
+	RemoveTodoAssignee(ctx context.Context, todoID string, userID string) (*gql.Todo, error)
+	AddTodoWatcher(ctx context.Context, todoID string, userID string) (*gql.Todo, error)
+	RemoveTodoWatcher(ctx context.Context, todoID string, userID string) (*gql.Todo, error)
+	Comments(ctx context.Context, obj *gql.Todo, filters *url_filters.BaseFilters) (*gql.CommentPage, error)
+	History(ctx context.Context, obj *gql.Todo, filters *url_filters.BaseFilters) (*gql.HistoryPage, error)
+	BlockedBy(ctx context.Context, obj *gql.Todo, filters *url_filters.TodoFilters) (*gql.TodoPage, error)
+	Blocks(ctx context.Context, obj *gql.Todo, filters *url_filters.TodoFilters) (*gql.TodoPage, error)
+	AddBlocker(ctx context.Context, todoID string, blockerID string) (*gql.Todo, error)
+	RemoveBlocker(ctx context.Context, todoID string, blockerID string) (*gql.Todo, error)
+	MoveTodos(ctx context.Context, ids []string, listID string) ([]*gql.Todo, error)
+	CopyTodos(ctx context.Context, ids []string, listID string) ([]*gql.Todo, error)
+	ReorderTodo(ctx context.Context, id string, before *string, after *string) (*gql.Todo, error)
+	BatchUpdateTodos(ctx context.Context, input gql.BatchTodosInput) (*gql.BatchTodosPayload, error)
 }
 
 type uResolver interface {
 	Users(ctx context.Context, filters *url_filters.BaseFilters) (*gql.UserPage, error)
 	User(ctx context.Context, id string) (*gql.User, error)
-	DeleteUser(ctx context.Context, id string) (*gql.DeleteUserPayload, error)
+	DeleteUser(ctx context.Context, id string, reassignListsTo *string) (*gql.DeleteUserPayload, error)
 	DeleteUsers(ctx context.Context) ([]*gql.DeleteUserPayload, error)
 	AssignedTo(ctx context.Context, obj *gql.User, baseFilters *url_filters.TodoFilters) (*gql.TodoPage, error)
 	Owns(ctx context.Context, obj *gql.User, filters *url_filters.ListFilters) (*gql.ListPage, error)
+	Labels(ctx context.Context, obj *gql.User, filters *url_filters.BaseFilters) (*gql.LabelPage, error)
 }
 
 type aResolver interface {
 	ExchangeRefreshToken(ctx context.Context, input gql.RefreshTokenInput) (*gql.Access, error)
 }
 
+type sResolver interface {
+	Search(ctx context.Context, filters *url_filters.SearchFilters) (*gql.SearchResultPage, error)
+}
+
+type trResolver interface {
+	Trash(ctx context.Context) (*gql.Trash, error)
+	RestoreTodo(ctx context.Context, id string) (*gql.Todo, error)
+	RestoreList(ctx context.Context, id string) (*gql.List, error)
+}
+
+type iResolver interface {
+	Invitations(ctx context.Context, userID string) ([]*gql.Invitation, error)
+	InviteCollaborator(ctx context.Context, input gql.InvitationInput) (*gql.Invitation, error)
+	AcceptInvitation(ctx context.Context, token string) (*gql.Invitation, error)
+	DeclineInvitation(ctx context.Context, token string) (bool, error)
+}
+
+type sessResolver interface {
+	MySessions(ctx context.Context) ([]*gql.Session, error)
+	RevokeSession(ctx context.Context, id string) (bool, error)
+	RevokeUserSessions(ctx context.Context, userId string) (bool, error)
+}
+
 type activityResolver interface {
 	RandomActivity(ctx context.Context) (*gql.RandomActivity, error)
 }
@@ -59,14 +112,23 @@ type Resolver struct {
 	uResolver        uResolver
 	aResolver        aResolver
 	activityResolver activityResolver
+	sResolver        sResolver
+	trResolver       trResolver
+	iResolver        iResolver
+	sessResolver     sessResolver
 }
 
-func NewResolver(lResolver lResolver, tResolver tResolver, uResolver uResolver, aResolver aResolver, activityResolver activityResolver) *Resolver {
+func NewResolver(lResolver lResolver, tResolver tResolver, uResolver uResolver, aResolver aResolver, activityResolver activityResolver, sResolver sResolver, trResolver trResolver, iResolver iResolver,
+	sessResolver sessResolver) *Resolver {
 	return &Resolver{
 		lResolver:        lResolver,
 		tResolver:        tResolver,
 		uResolver:        uResolver,
 		aResolver:        aResolver,
 		activityResolver: activityResolver,
+		sResolver:        sResolver,
+		trResolver:       trResolver,
+		iResolver:        iResolver,
+		sessResolver:     sessResolver,
 	}
 }
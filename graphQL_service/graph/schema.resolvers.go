@@ -10,14 +10,27 @@ import (
 	"context"
 )
 
+// Author is the resolver for the author field.
+func (r *commentResolver) Author(ctx context.Context, obj *gql.Comment) (*gql.User, error) {
+	return r.uResolver.User(ctx, obj.Author.ID)
+}
+
+// Actor is the resolver for the actor field.
+func (r *historyEntryResolver) Actor(ctx context.Context, obj *gql.HistoryEntry) (*gql.User, error) {
+	if obj.Actor == nil {
+		return nil, nil
+	}
+	return r.uResolver.User(ctx, obj.Actor.ID)
+}
+
 // Owner is the resolver for the owner field.
 func (r *listResolver) Owner(ctx context.Context, obj *gql.List) (*gql.User, error) {
 	return r.lResolver.ListOwner(ctx, obj)
 }
 
 // Todos is the resolver for the todos field.
-func (r *listResolver) Todos(ctx context.Context, obj *gql.List, first *int32, after *string, last *int32, before *string, filter *gql.TodosFilterInput) (*gql.TodoPage, error) {
-	todoFilters := helpers.InitTodoFilters(first, after, last, before, filter)
+func (r *listResolver) Todos(ctx context.Context, obj *gql.List, first *int32, after *string, last *int32, before *string, filter *gql.TodosFilterInput, orderBy *gql.TodoOrder) (*gql.TodoPage, error) {
+	todoFilters := helpers.InitTodoFilters(first, after, last, before, filter, orderBy)
 	return r.lResolver.Todos(ctx, obj, todoFilters)
 }
 
@@ -27,6 +40,17 @@ func (r *listResolver) Collaborators(ctx context.Context, obj *gql.List, first *
 	return r.lResolver.Collaborators(ctx, obj, basedFilters)
 }
 
+// History is the resolver for the history field.
+func (r *listResolver) History(ctx context.Context, obj *gql.List, first *int32, after *string, last *int32, before *string) (*gql.HistoryPage, error) {
+	basedFilters := helpers.InitBaseFilters(first, after, last, before)
+	return r.lResolver.History(ctx, obj, basedFilters)
+}
+
+// Board is the resolver for the board field.
+func (r *listResolver) Board(ctx context.Context, obj *gql.List) (*gql.Board, error) {
+	return r.lResolver.Board(ctx, obj)
+}
+
 // CreateList is the resolver for the createList field.
 func (r *mutationResolver) CreateList(ctx context.Context, input gql.CreateListInput) (*gql.List, error) {
 	return r.lResolver.CreateList(ctx, input)
@@ -57,14 +81,54 @@ func (r *mutationResolver) DeleteLists(ctx context.Context) ([]*gql.DeleteListPa
 	return r.lResolver.DeleteLists(ctx)
 }
 
+// RestoreList is the resolver for the restoreList field.
+func (r *mutationResolver) RestoreList(ctx context.Context, id string) (*gql.List, error) {
+	return r.trResolver.RestoreList(ctx, id)
+}
+
+// TransferListOwnership is the resolver for the transferListOwnership field.
+func (r *mutationResolver) TransferListOwnership(ctx context.Context, id string, userID string) (*gql.List, error) {
+	return r.lResolver.TransferListOwnership(ctx, id, userID)
+}
+
+// DuplicateList is the resolver for the duplicateList field.
+func (r *mutationResolver) DuplicateList(ctx context.Context, id string, input gql.DuplicateListInput) (*gql.List, error) {
+	return r.lResolver.DuplicateList(ctx, id, input)
+}
+
+// CreateListFromTemplate is the resolver for the createListFromTemplate field.
+func (r *mutationResolver) CreateListFromTemplate(ctx context.Context, templateID string, input gql.CreateListFromTemplateInput) (*gql.List, error) {
+	return r.lResolver.CreateListFromTemplate(ctx, templateID, input)
+}
+
+// InviteCollaborator is the resolver for the inviteCollaborator field.
+func (r *mutationResolver) InviteCollaborator(ctx context.Context, input gql.InvitationInput) (*gql.Invitation, error) {
+	return r.iResolver.InviteCollaborator(ctx, input)
+}
+
+// AcceptInvitation is the resolver for the acceptInvitation field.
+func (r *mutationResolver) AcceptInvitation(ctx context.Context, token string) (*gql.Invitation, error) {
+	return r.iResolver.AcceptInvitation(ctx, token)
+}
+
+// DeclineInvitation is the resolver for the declineInvitation field.
+func (r *mutationResolver) DeclineInvitation(ctx context.Context, token string) (bool, error) {
+	return r.iResolver.DeclineInvitation(ctx, token)
+}
+
 // CreateTodo is the resolver for the createTodo field.
 func (r *mutationResolver) CreateTodo(ctx context.Context, input gql.CreateTodoInput) (*gql.Todo, error) {
 	return r.tResolver.CreateTodo(ctx, input)
 }
 
+// CreateSubtask is the resolver for the createSubtask field.
+func (r *mutationResolver) CreateSubtask(ctx context.Context, parentID string, input gql.CreateSubtaskInput) (*gql.Todo, error) {
+	return r.tResolver.CreateSubtask(ctx, parentID, input)
+}
+
 // DeleteTodo is the resolver for the deleteTodo field.
-func (r *mutationResolver) DeleteTodo(ctx context.Context, id string) (*gql.DeleteTodoPayload, error) {
-	return r.tResolver.DeleteTodo(ctx, id)
+func (r *mutationResolver) DeleteTodo(ctx context.Context, id string, cascade *bool) (*gql.DeleteTodoPayload, error) {
+	return r.tResolver.DeleteTodo(ctx, id, cascade)
 }
 
 // DeleteTodos is the resolver for the deleteTodos field.
@@ -82,9 +146,74 @@ func (r *mutationResolver) DeleteTodosByListID(ctx context.Context, id string) (
 	return r.tResolver.DeleteTodosByListID(ctx, id)
 }
 
+// RestoreTodo is the resolver for the restoreTodo field.
+func (r *mutationResolver) RestoreTodo(ctx context.Context, id string) (*gql.Todo, error) {
+	return r.trResolver.RestoreTodo(ctx, id)
+}
+
+// AddLabel is the resolver for the addLabel field.
+func (r *mutationResolver) AddLabel(ctx context.Context, todoID string, labelID string) (*gql.Todo, error) {
+	return r.tResolver.AddLabel(ctx, todoID, labelID)
+}
+
+// RemoveLabel is the resolver for the removeLabel field.
+func (r *mutationResolver) RemoveLabel(ctx context.Context, todoID string, labelID string) (*gql.Todo, error) {
+	return r.tResolver.RemoveLabel(ctx, todoID, labelID)
+}
+
+// AddBlocker is the resolver for the addBlocker field.
+func (r *mutationResolver) AddBlocker(ctx context.Context, todoID string, blockerID string) (*gql.Todo, error) {
+	return r.tResolver.AddBlocker(ctx, todoID, blockerID)
+}
+
+// RemoveBlocker is the resolver for the removeBlocker field.
+func (r *mutationResolver) RemoveBlocker(ctx context.Context, todoID string, blockerID string) (*gql.Todo, error) {
+	return r.tResolver.RemoveBlocker(ctx, todoID, blockerID)
+}
+
+// AddTodoAssignee is the resolver for the addTodoAssignee field.
+func (r *mutationResolver) AddTodoAssignee(ctx context.Context, todoID string, userID string) (*gql.Todo, error) {
+	return r.tResolver.AddTodoAssignee(ctx, todoID, userID)
+}
+
+// RemoveTodoAssignee is the resolver for the removeTodoAssignee field.
+func (r *mutationResolver) RemoveTodoAssignee(ctx context.Context, todoID string, userID string) (*gql.Todo, error) {
+	return r.tResolver.RemoveTodoAssignee(ctx, todoID, userID)
+}
+
+// AddTodoWatcher is the resolver for the addTodoWatcher field.
+func (r *mutationResolver) AddTodoWatcher(ctx context.Context, todoID string, userID string) (*gql.Todo, error) {
+	return r.tResolver.AddTodoWatcher(ctx, todoID, userID)
+}
+
+// RemoveTodoWatcher is the resolver for the removeTodoWatcher field.
+func (r *mutationResolver) RemoveTodoWatcher(ctx context.Context, todoID string, userID string) (*gql.Todo, error) {
+	return r.tResolver.RemoveTodoWatcher(ctx, todoID, userID)
+}
+
+// MoveTodos is the resolver for the moveTodos field.
+func (r *mutationResolver) MoveTodos(ctx context.Context, ids []string, listID string) ([]*gql.Todo, error) {
+	return r.tResolver.MoveTodos(ctx, ids, listID)
+}
+
+// CopyTodos is the resolver for the copyTodos field.
+func (r *mutationResolver) CopyTodos(ctx context.Context, ids []string, listID string) ([]*gql.Todo, error) {
+	return r.tResolver.CopyTodos(ctx, ids, listID)
+}
+
+// ReorderTodo is the resolver for the reorderTodo field.
+func (r *mutationResolver) ReorderTodo(ctx context.Context, id string, before *string, after *string) (*gql.Todo, error) {
+	return r.tResolver.ReorderTodo(ctx, id, before, after)
+}
+
+// BatchUpdateTodos is the resolver for the batchUpdateTodos field.
+func (r *mutationResolver) BatchUpdateTodos(ctx context.Context, input gql.BatchTodosInput) (*gql.BatchTodosPayload, error) {
+	return r.tResolver.BatchUpdateTodos(ctx, input)
+}
+
 // DeleteUser is the resolver for the deleteUser field.
-func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (*gql.DeleteUserPayload, error) {
-	return r.uResolver.DeleteUser(ctx, id)
+func (r *mutationResolver) DeleteUser(ctx context.Context, id string, reassignListsTo *string) (*gql.DeleteUserPayload, error) {
+	return r.uResolver.DeleteUser(ctx, id, reassignListsTo)
 }
 
 // DeleteUsers is the resolver for the deleteUsers field.
@@ -97,9 +226,19 @@ func (r *mutationResolver) ExchangeRefreshToken(ctx context.Context, input gql.R
 	return r.aResolver.ExchangeRefreshToken(ctx, input)
 }
 
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (bool, error) {
+	return r.sessResolver.RevokeSession(ctx, id)
+}
+
+// RevokeUserSessions is the resolver for the revokeUserSessions field.
+func (r *mutationResolver) RevokeUserSessions(ctx context.Context, userID string) (bool, error) {
+	return r.sessResolver.RevokeUserSessions(ctx, userID)
+}
+
 // Lists is the resolver for the lists field.
-func (r *queryResolver) Lists(ctx context.Context, first *int32, after *string, last *int32, before *string, criteria *gql.ListFilterInput) (*gql.ListPage, error) {
-	listFilters := helpers.InitListFilters(first, after, last, before, criteria)
+func (r *queryResolver) Lists(ctx context.Context, first *int32, after *string, last *int32, before *string, criteria *gql.ListFilterInput, orderBy *gql.ListOrder) (*gql.ListPage, error) {
+	listFilters := helpers.InitListFilters(first, after, last, before, criteria, orderBy)
 	return r.lResolver.Lists(ctx, listFilters)
 }
 
@@ -109,8 +248,8 @@ func (r *queryResolver) List(ctx context.Context, id string) (*gql.List, error)
 }
 
 // Todos is the resolver for the todos field.
-func (r *queryResolver) Todos(ctx context.Context, first *int32, after *string, last *int32, before *string, criteria *gql.TodosFilterInput) (*gql.TodoPage, error) {
-	todoFilters := helpers.InitTodoFilters(first, after, last, before, criteria)
+func (r *queryResolver) Todos(ctx context.Context, first *int32, after *string, last *int32, before *string, criteria *gql.TodosFilterInput, orderBy *gql.TodoOrder) (*gql.TodoPage, error) {
+	todoFilters := helpers.InitTodoFilters(first, after, last, before, criteria, orderBy)
 	return r.tResolver.Todos(ctx, todoFilters)
 }
 
@@ -130,6 +269,28 @@ func (r *queryResolver) User(ctx context.Context, id string) (*gql.User, error)
 	return r.uResolver.User(ctx, id)
 }
 
+// Search is the resolver for the search field.
+func (r *queryResolver) Search(ctx context.Context, query string, first *int32, after *string) (*gql.SearchResultPage, error) {
+	filters := helpers.InitSearchFilters(query, first, after)
+
+	return r.sResolver.Search(ctx, filters)
+}
+
+// Trash is the resolver for the trash field.
+func (r *queryResolver) Trash(ctx context.Context) (*gql.Trash, error) {
+	return r.trResolver.Trash(ctx)
+}
+
+// Invitations is the resolver for the invitations field.
+func (r *queryResolver) Invitations(ctx context.Context, userID string) ([]*gql.Invitation, error) {
+	return r.iResolver.Invitations(ctx, userID)
+}
+
+// MySessions is the resolver for the mySessions field.
+func (r *queryResolver) MySessions(ctx context.Context) ([]*gql.Session, error) {
+	return r.sessResolver.MySessions(ctx)
+}
+
 // RandomActivity is the resolver for the randomActivity field.
 func (r *queryResolver) RandomActivity(ctx context.Context) (*gql.RandomActivity, error) {
 	return r.activityResolver.RandomActivity(ctx)
@@ -145,18 +306,93 @@ func (r *todoResolver) AssignedTo(ctx context.Context, obj *gql.Todo) (*gql.User
 	return r.tResolver.AssignedTo(ctx, obj)
 }
 
+// TimeSpent is the resolver for the timeSpent field.
+func (r *todoResolver) TimeSpent(ctx context.Context, obj *gql.Todo) (int32, error) {
+	return r.tResolver.TimeSpent(ctx, obj)
+}
+
+// WorkLogs is the resolver for the workLogs field.
+func (r *todoResolver) WorkLogs(ctx context.Context, obj *gql.Todo) ([]*gql.WorkLog, error) {
+	return r.tResolver.WorkLogs(ctx, obj)
+}
+
+// Parent is the resolver for the parent field.
+func (r *todoResolver) Parent(ctx context.Context, obj *gql.Todo) (*gql.Todo, error) {
+	return r.tResolver.Parent(ctx, obj)
+}
+
+// Subtasks is the resolver for the subtasks field.
+func (r *todoResolver) Subtasks(ctx context.Context, obj *gql.Todo, first *int32, after *string, last *int32, before *string, filter *gql.TodosFilterInput, orderBy *gql.TodoOrder) (*gql.TodoPage, error) {
+	todoFilters := helpers.InitTodoFilters(first, after, last, before, filter, orderBy)
+	return r.tResolver.Subtasks(ctx, obj, todoFilters)
+}
+
+// BlockedBy is the resolver for the blockedBy field.
+func (r *todoResolver) BlockedBy(ctx context.Context, obj *gql.Todo, first *int32, after *string, last *int32, before *string, filter *gql.TodosFilterInput, orderBy *gql.TodoOrder) (*gql.TodoPage, error) {
+	todoFilters := helpers.InitTodoFilters(first, after, last, before, filter, orderBy)
+	return r.tResolver.BlockedBy(ctx, obj, todoFilters)
+}
+
+// Blocks is the resolver for the blocks field.
+func (r *todoResolver) Blocks(ctx context.Context, obj *gql.Todo, first *int32, after *string, last *int32, before *string, filter *gql.TodosFilterInput, orderBy *gql.TodoOrder) (*gql.TodoPage, error) {
+	todoFilters := helpers.InitTodoFilters(first, after, last, before, filter, orderBy)
+	return r.tResolver.Blocks(ctx, obj, todoFilters)
+}
+
+// Labels is the resolver for the labels field.
+func (r *todoResolver) Labels(ctx context.Context, obj *gql.Todo, first *int32, after *string, last *int32, before *string) (*gql.LabelPage, error) {
+	baseFilters := helpers.InitBaseFilters(first, after, last, before)
+	return r.tResolver.Labels(ctx, obj, baseFilters)
+}
+
+// Assignees is the resolver for the assignees field.
+func (r *todoResolver) Assignees(ctx context.Context, obj *gql.Todo, first *int32, after *string, last *int32, before *string) (*gql.UserPage, error) {
+	baseFilters := helpers.InitBaseFilters(first, after, last, before)
+	return r.tResolver.Assignees(ctx, obj, baseFilters)
+}
+
+// Watchers is the resolver for the watchers field.
+func (r *todoResolver) Watchers(ctx context.Context, obj *gql.Todo, first *int32, after *string, last *int32, before *string) (*gql.UserPage, error) {
+	baseFilters := helpers.InitBaseFilters(first, after, last, before)
+	return r.tResolver.Watchers(ctx, obj, baseFilters)
+}
+
+// Comments is the resolver for the comments field.
+func (r *todoResolver) Comments(ctx context.Context, obj *gql.Todo, first *int32, after *string, last *int32, before *string) (*gql.CommentPage, error) {
+	baseFilters := helpers.InitBaseFilters(first, after, last, before)
+	return r.tResolver.Comments(ctx, obj, baseFilters)
+}
+
+// History is the resolver for the history field.
+func (r *todoResolver) History(ctx context.Context, obj *gql.Todo, first *int32, after *string, last *int32, before *string) (*gql.HistoryPage, error) {
+	basedFilters := helpers.InitBaseFilters(first, after, last, before)
+	return r.tResolver.History(ctx, obj, basedFilters)
+}
+
 // AssignedTo is the resolver for the assignedTo field.
-func (r *userResolver) AssignedTo(ctx context.Context, obj *gql.User, first *int32, after *string, last *int32, before *string, filter *gql.TodosFilterInput) (*gql.TodoPage, error) {
-	todoFilters := helpers.InitTodoFilters(first, after, last, before, filter)
+func (r *userResolver) AssignedTo(ctx context.Context, obj *gql.User, first *int32, after *string, last *int32, before *string, filter *gql.TodosFilterInput, orderBy *gql.TodoOrder) (*gql.TodoPage, error) {
+	todoFilters := helpers.InitTodoFilters(first, after, last, before, filter, orderBy)
 	return r.uResolver.AssignedTo(ctx, obj, todoFilters)
 }
 
 // Owns is the resolver for the owns field.
-func (r *userResolver) Owns(ctx context.Context, obj *gql.User, first *int32, after *string, last *int32, before *string, filter *gql.ListFilterInput) (*gql.ListPage, error) {
-	lFilters := helpers.InitListFilters(first, after, last, before, filter)
+func (r *userResolver) Owns(ctx context.Context, obj *gql.User, first *int32, after *string, last *int32, before *string, filter *gql.ListFilterInput, orderBy *gql.ListOrder) (*gql.ListPage, error) {
+	lFilters := helpers.InitListFilters(first, after, last, before, filter, orderBy)
 	return r.uResolver.Owns(ctx, obj, lFilters)
 }
 
+// Labels is the resolver for the labels field.
+func (r *userResolver) Labels(ctx context.Context, obj *gql.User, first *int32, after *string, last *int32, before *string) (*gql.LabelPage, error) {
+	baseFilters := helpers.InitBaseFilters(first, after, last, before)
+	return r.uResolver.Labels(ctx, obj, baseFilters)
+}
+
+// Comment returns CommentResolver implementation.
+func (r *Resolver) Comment() CommentResolver { return &commentResolver{r} }
+
+// HistoryEntry returns HistoryEntryResolver implementation.
+func (r *Resolver) HistoryEntry() HistoryEntryResolver { return &historyEntryResolver{r} }
+
 // List returns ListResolver implementation.
 func (r *Resolver) List() ListResolver { return &listResolver{r} }
 
@@ -172,6 +408,8 @@ func (r *Resolver) Todo() TodoResolver { return &todoResolver{r} }
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
+type commentResolver struct{ *Resolver }
+type historyEntryResolver struct{ *Resolver }
 type listResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
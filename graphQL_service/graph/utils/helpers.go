@@ -30,6 +30,11 @@ func HandleHttpCode(statusCode int) error {
 			Message:    "Unauthorized user",
 			Extensions: map[string]interface{}{"code": "UNAUTHORIZED"},
 		}
+	} else if statusCode == http.StatusConflict {
+		return &gqlerror.Error{
+			Message:    "Request conflicts with the current state of the resource",
+			Extensions: map[string]interface{}{"code": "CONFLICT"},
+		}
 	}
 
 	return nil
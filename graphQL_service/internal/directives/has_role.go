@@ -1,6 +1,8 @@
 package directives
 
 import (
+	"Todo-List/internProject/graphQL_service/graph/utils"
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
 	"Todo-List/internProject/graphQL_service/internal/gql_middlewares"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
 	"Todo-List/internProject/todo_app_service/pkg/constants"
@@ -8,17 +10,30 @@ import (
 	"context"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/vektah/gqlparser/v2/gqlerror"
+	"io"
+	"net/http"
 )
 
 type jwtParser interface {
 	ParseJWT(ctx context.Context, tokenString string) (*jwt.Claims, error)
 }
+
+type httpService interface {
+	GetHttpResponseWithAuthHeader(ctx context.Context, httpMethod string, url string, body io.Reader) (*http.Response, error)
+}
+
 type roleDirective struct {
-	parser jwtParser
+	parser      jwtParser
+	restUrl     string
+	httpService httpService
 }
 
-func NewRoleDirectiveImplementation(parser jwtParser) *roleDirective {
-	return &roleDirective{parser: parser}
+func NewRoleDirectiveImplementation(parser jwtParser, restUrl string, httpService httpService) *roleDirective {
+	return &roleDirective{
+		parser:      parser,
+		restUrl:     restUrl,
+		httpService: httpService,
+	}
 }
 
 func (i *roleDirective) HasRole(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
@@ -44,6 +59,47 @@ func (i *roleDirective) HasRole(ctx context.Context, obj interface{}, next graph
 		log.C(ctx).Debugf("only admins can see user's roles, actual role %s", claims.Role)
 		return nil, nil
 	}
+
+	// the signature and the expiry are checked locally, only the rest api knows whether the token has been revoked
+	if err = i.verifyToken(ctx); err != nil {
+		log.C(ctx).Errorf("failed to check whether user has rights to see the role, error %s when trying to verify jwt", err.Error())
+		return nil, err
+	}
+
 	log.C(ctx).Info("sq tochno li e")
 	return next(ctx)
 }
+
+func (i *roleDirective) verifyToken(ctx context.Context) error {
+	verification, ok := ctx.Value(gql_middlewares.TokenVerification).(*gql_middlewares.TokenVerificationResult)
+	if !ok {
+		return i.requestTokenVerification(ctx)
+	}
+
+	return verification.Get(func() error {
+		return i.requestTokenVerification(ctx)
+	})
+}
+
+func (i *roleDirective) requestTokenVerification(ctx context.Context) error {
+	url := i.restUrl + gql_constants.TOKENS_PATH + gql_constants.VERIFY_PATH
+
+	resp, err := i.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in role directive, error %s", err.Error())
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		return err
+	}
+
+	return &gqlerror.Error{
+		Message: "failed to verify jwt token",
+	}
+}
@@ -11,6 +11,41 @@ const (
 	TOKENS_PATH       = "/tokens"
 	RANDOM_PATH       = "/random"
 	ACTIVITIES_PATH   = "/activities"
+	SUBTASKS_PATH     = "/subtasks"
+	LABELS_PATH       = "/labels"
+	COMMENTS_PATH     = "/comments"
+	BLOCKERS_PATH     = "/blockers"
+	BLOCKS_PATH       = "/blocks"
+	SEARCH_PATH       = "/search"
+	HISTORY_PATH      = "/history"
+	TRASH_PATH        = "/trash"
+	RESTORE_PATH      = "/restore"
+	INVITATIONS_PATH  = "/invitations"
+	ACCEPT_PATH       = "/accept"
+	DECLINE_PATH      = "/decline"
+	MOVE_PATH         = "/move"
+	COPY_PATH         = "/copy"
+	BATCH_PATH        = "/batch"
+	REORDER_PATH      = "/reorder"
+	DUPLICATE_PATH    = "/duplicate"
+	TEMPLATES_PATH    = "/templates"
+	BOARD_PATH        = "/board"
+	ASSIGNEES_PATH    = "/assignees"
+	WATCHERS_PATH     = "/watchers"
+	WORK_LOGS_PATH    = "/worklogs"
+	TIME_PATH         = "/time"
+	SESSIONS_PATH     = "/sessions"
+	VERIFY_PATH       = "/verify"
+)
+
+const (
+	TODO_SEARCH_RESULT = "todo"
+	LIST_SEARCH_RESULT = "list"
+)
+
+const (
+	TODO_TRASH_ITEM = "todo"
+	LIST_TRASH_ITEM = "list"
 )
 
 const (
@@ -27,6 +62,10 @@ const (
 	ADMIN_LOWERCASE  = "admin"
 	WRITER_LOWERCASE = "writer"
 	READER_LOWERCASE = "reader"
+
+	VIEWER_LOWERCASE  = "viewer"
+	EDITOR_LOWERCASE  = "editor"
+	MANAGER_LOWERCASE = "manager"
 )
 
 const (
@@ -39,6 +78,16 @@ const (
 	BEFORE   = "before"
 	LAST     = "last"
 	NAME     = "name"
+	LABEL    = "label"
+	QUERY    = "q"
+	SORT     = "sort"
+
+	SORT_DIRECTION_SEPARATOR = ":"
+
+	EXCLUDE_SUBTASKS = "exclude_subtasks"
+	CASCADE          = "cascade"
+
+	REASSIGN_LISTS_TO = "reassign_lists_to"
 )
 
 const (
@@ -0,0 +1,42 @@
+package gql_converters
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+)
+
+type boardConverter struct {
+	tConverter *todoConverter
+}
+
+func NewBoardConverter(tConverter *todoConverter) *boardConverter {
+	return &boardConverter{tConverter: tConverter}
+}
+
+func (b *boardConverter) ToGQL(board *models.Board) *gql.Board {
+	if board == nil {
+		return nil
+	}
+
+	columns := make([]*gql.BoardColumn, len(board.Columns))
+	for index, column := range board.Columns {
+		var wipLimit *int32
+		if column.WipLimit != nil {
+			limit := int32(*column.WipLimit)
+			wipLimit = &limit
+		}
+
+		columns[index] = &gql.BoardColumn{
+			ID:       column.Id,
+			Name:     column.Name,
+			Status:   gql.TodoStatus(column.Status),
+			WipLimit: wipLimit,
+			Todos:    b.tConverter.ManyToGQL(column.Todos),
+		}
+	}
+
+	return &gql.Board{
+		ListID:  board.ListId,
+		Columns: columns,
+	}
+}
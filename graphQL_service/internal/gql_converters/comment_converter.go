@@ -0,0 +1,50 @@
+package gql_converters
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+)
+
+type commentConverter struct{}
+
+func NewCommentConverter() *commentConverter {
+	return &commentConverter{}
+}
+
+func (*commentConverter) ToGQL(comment *models.Comment) *gql.Comment {
+	return &gql.Comment{
+		ID:          comment.Id,
+		Content:     comment.Content,
+		Author:      &gql.User{ID: comment.Author},
+		CreatedAt:   comment.CreatedAt,
+		LastUpdated: comment.LastUpdated,
+	}
+}
+
+func (c *commentConverter) ToCommentPageGQL(commentPage *models.CommentPage) *gql.CommentPage {
+	if commentPage == nil || len(commentPage.Data) == 0 {
+		return &gql.CommentPage{
+			Data:       make([]*gql.Comment, 0),
+			PageInfo:   nil,
+			TotalCount: 0,
+		}
+	}
+
+	comments := commentPage.Data
+	gqlComments := make([]*gql.Comment, len(comments))
+
+	for index, comment := range comments {
+		gqlComments[index] = c.ToGQL(comment)
+	}
+
+	return &gql.CommentPage{
+		Data: gqlComments,
+		PageInfo: &gql.PageInfo{
+			HasPrevPage: commentPage.PageInfo.HasPrevPage,
+			HasNextPage: commentPage.PageInfo.HasNextPage,
+			StartCursor: commentPage.PageInfo.StartCursor,
+			EndCursor:   commentPage.PageInfo.EndCursor,
+		},
+		TotalCount: int32(commentPage.TotalCount),
+	}
+}
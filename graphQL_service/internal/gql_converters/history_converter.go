@@ -0,0 +1,57 @@
+package gql_converters
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+)
+
+type historyConverter struct{}
+
+func NewHistoryConverter() *historyConverter {
+	return &historyConverter{}
+}
+
+func (*historyConverter) ToGQL(entry *models.HistoryEntry) *gql.HistoryEntry {
+	var actor *gql.User
+	if entry.Actor != nil {
+		actor = &gql.User{ID: *entry.Actor}
+	}
+
+	return &gql.HistoryEntry{
+		ID:        entry.Id,
+		Action:    entry.Action,
+		Field:     entry.Field,
+		OldValue:  entry.OldValue,
+		NewValue:  entry.NewValue,
+		Actor:     actor,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+func (h *historyConverter) ToHistoryPageGQL(historyPage *models.HistoryPage) *gql.HistoryPage {
+	if historyPage == nil || len(historyPage.Data) == 0 {
+		return &gql.HistoryPage{
+			Data:       make([]*gql.HistoryEntry, 0),
+			PageInfo:   nil,
+			TotalCount: 0,
+		}
+	}
+
+	entries := historyPage.Data
+	gqlEntries := make([]*gql.HistoryEntry, len(entries))
+
+	for index, entry := range entries {
+		gqlEntries[index] = h.ToGQL(entry)
+	}
+
+	return &gql.HistoryPage{
+		Data: gqlEntries,
+		PageInfo: &gql.PageInfo{
+			HasPrevPage: historyPage.PageInfo.HasPrevPage,
+			HasNextPage: historyPage.PageInfo.HasNextPage,
+			StartCursor: historyPage.PageInfo.StartCursor,
+			EndCursor:   historyPage.PageInfo.EndCursor,
+		},
+		TotalCount: int32(historyPage.TotalCount),
+	}
+}
@@ -0,0 +1,57 @@
+package gql_converters
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"strings"
+)
+
+type invitationConverter struct {
+	rConverter *roleConverter
+}
+
+func NewInvitationConverter(rConverter *roleConverter) *invitationConverter {
+	return &invitationConverter{rConverter: rConverter}
+}
+
+func (*invitationConverter) ToGQL(invitation *models.Invitation) *gql.Invitation {
+	if invitation == nil {
+		return nil
+	}
+
+	return &gql.Invitation{
+		ID:        invitation.Id,
+		ListID:    invitation.ListId,
+		Email:     invitation.Email,
+		Role:      gql.CollaboratorRole(strings.ToUpper(string(invitation.Role))),
+		Status:    gql.InvitationStatus(strings.ToUpper(string(invitation.Status))),
+		Token:     invitation.Token,
+		CreatedAt: invitation.CreatedAt,
+		ExpiresAt: invitation.ExpiresAt,
+	}
+}
+
+func (i *invitationConverter) ManyToGQL(invitations []*models.Invitation) []*gql.Invitation {
+	gqlInvitations := make([]*gql.Invitation, len(invitations))
+
+	for index, invitation := range invitations {
+		gqlInvitations[index] = i.ToGQL(invitation)
+	}
+
+	return gqlInvitations
+}
+
+func (i *invitationConverter) FromInvitationInputToCreateInvitationHandlerModel(input *gql.InvitationInput) *handler_models.CreateInvitation {
+	var role *constants.CollaboratorRole
+	if input.Role != nil {
+		r := constants.CollaboratorRole(i.rConverter.ToStringCollaboratorRole(input.Role))
+		role = &r
+	}
+
+	return &handler_models.CreateInvitation{
+		Email: input.Email,
+		Role:  role,
+	}
+}
@@ -0,0 +1,48 @@
+package gql_converters
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+)
+
+type labelConverter struct{}
+
+func NewLabelConverter() *labelConverter {
+	return &labelConverter{}
+}
+
+func (*labelConverter) ToGQL(label *models.Label) *gql.Label {
+	return &gql.Label{
+		ID:        label.Id,
+		Name:      label.Name,
+		CreatedAt: label.CreatedAt,
+	}
+}
+
+func (l *labelConverter) ToLabelPageGQL(labelPage *models.LabelPage) *gql.LabelPage {
+	if labelPage == nil || len(labelPage.Data) == 0 {
+		return &gql.LabelPage{
+			Data:       make([]*gql.Label, 0),
+			PageInfo:   nil,
+			TotalCount: 0,
+		}
+	}
+
+	labels := labelPage.Data
+	gqlLabels := make([]*gql.Label, len(labels))
+
+	for index, label := range labels {
+		gqlLabels[index] = l.ToGQL(label)
+	}
+
+	return &gql.LabelPage{
+		Data: gqlLabels,
+		PageInfo: &gql.PageInfo{
+			HasPrevPage: labelPage.PageInfo.HasPrevPage,
+			HasNextPage: labelPage.PageInfo.HasNextPage,
+			StartCursor: labelPage.PageInfo.StartCursor,
+			EndCursor:   labelPage.PageInfo.EndCursor,
+		},
+		TotalCount: int32(labelPage.TotalCount),
+	}
+}
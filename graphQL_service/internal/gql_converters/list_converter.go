@@ -75,6 +75,23 @@ func (*listConverter) UpdateListInputGQLToHandlerModel(input gql.UpdateListInput
 	}
 }
 
+func (*listConverter) DuplicateListInputGQLToHandlerModel(input gql.DuplicateListInput) *handler_models.DuplicateList {
+	return &handler_models.DuplicateList{
+		Name:                 input.Name,
+		Description:          input.Description,
+		IncludeCollaborators: input.IncludeCollaborators,
+		StartDate:            input.StartDate,
+	}
+}
+
+func (*listConverter) CreateListFromTemplateInputGQLToHandlerModel(input gql.CreateListFromTemplateInput) *handler_models.CreateListFromTemplate {
+	return &handler_models.CreateListFromTemplate{
+		Name:        input.Name,
+		Description: input.Description,
+		StartDate:   input.StartDate,
+	}
+}
+
 func (*listConverter) FromGQLModelToDeleteListPayload(list *gql.List, success bool) *gql.DeleteListPayload {
 	return &gql.DeleteListPayload{
 		Success:     success,
@@ -24,3 +24,19 @@ func (*roleConverter) ToStringRole(role *gql.UserRole) string {
 
 	return ""
 }
+
+func (*roleConverter) ToStringCollaboratorRole(role *gql.CollaboratorRole) string {
+	if role == nil {
+		return ""
+	}
+
+	ptrValue := *role
+
+	if ptrValue == gql.CollaboratorRoleViewer {
+		return gql_constants.VIEWER_LOWERCASE
+	} else if ptrValue == gql.CollaboratorRoleManager {
+		return gql_constants.MANAGER_LOWERCASE
+	}
+
+	return gql_constants.EDITOR_LOWERCASE
+}
@@ -0,0 +1,68 @@
+package gql_converters
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+)
+
+type todoGQLConverter interface {
+	ToGQL(todo *models.Todo) *gql.Todo
+}
+
+type listGQLConverter interface {
+	ToGQL(list *models.List) *gql.List
+}
+
+type searchConverter struct {
+	tConverter todoGQLConverter
+	lConverter listGQLConverter
+}
+
+func NewSearchConverter(tConverter todoGQLConverter, lConverter listGQLConverter) *searchConverter {
+	return &searchConverter{
+		tConverter: tConverter,
+		lConverter: lConverter,
+	}
+}
+
+func (s *searchConverter) ToGQL(result *models.SearchResult) gql.SearchResult {
+	if result.Type == gql_constants.TODO_SEARCH_RESULT && result.Todo != nil {
+		return s.tConverter.ToGQL(result.Todo)
+	}
+
+	if result.Type == gql_constants.LIST_SEARCH_RESULT && result.List != nil {
+		return s.lConverter.ToGQL(result.List)
+	}
+
+	return nil
+}
+
+func (s *searchConverter) ToSearchResultPageGQL(resultPage *models.SearchResultPage) *gql.SearchResultPage {
+	if resultPage == nil || len(resultPage.Data) == 0 {
+		return &gql.SearchResultPage{
+			Data:       make([]gql.SearchResult, 0),
+			PageInfo:   nil,
+			TotalCount: 0,
+		}
+	}
+
+	gqlResults := make([]gql.SearchResult, 0, len(resultPage.Data))
+
+	for _, result := range resultPage.Data {
+		if gqlResult := s.ToGQL(result); gqlResult != nil {
+			gqlResults = append(gqlResults, gqlResult)
+		}
+	}
+
+	return &gql.SearchResultPage{
+		Data: gqlResults,
+		PageInfo: &gql.PageInfo{
+			HasPrevPage: resultPage.PageInfo.HasPrevPage,
+			HasNextPage: resultPage.PageInfo.HasNextPage,
+			StartCursor: resultPage.PageInfo.StartCursor,
+			EndCursor:   resultPage.PageInfo.EndCursor,
+		},
+		TotalCount: int32(resultPage.TotalCount),
+	}
+}
@@ -0,0 +1,34 @@
+package gql_converters
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+)
+
+type sessionConverter struct{}
+
+func NewSessionConverter() *sessionConverter {
+	return &sessionConverter{}
+}
+
+func (*sessionConverter) ToGQL(session *models.Session) *gql.Session {
+	return &gql.Session{
+		ID:          session.Id,
+		DeviceLabel: session.DeviceLabel,
+		UserAgent:   session.UserAgent,
+		IPAddress:   session.IpAddress,
+		CreatedAt:   session.CreatedAt,
+		LastUsedAt:  session.LastUsedAt,
+		Current:     session.Current,
+	}
+}
+
+func (s *sessionConverter) ManyToGQL(sessions []*models.Session) []*gql.Session {
+	gqlSessions := make([]*gql.Session, len(sessions))
+
+	for index, session := range sessions {
+		gqlSessions[index] = s.ToGQL(session)
+	}
+
+	return gqlSessions
+}
@@ -0,0 +1,39 @@
+package gql_converters
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
+	"strings"
+)
+
+type sortConverter struct{}
+
+func NewSortConverter() *sortConverter {
+	return &sortConverter{}
+}
+
+func (s *sortConverter) ToStringTodoSort(order *gql.TodoOrder) string {
+	if order == nil {
+		return ""
+	}
+
+	return s.toStringSort(string(order.Field), order.Direction)
+}
+
+func (s *sortConverter) ToStringListSort(order *gql.ListOrder) string {
+	if order == nil {
+		return ""
+	}
+
+	return s.toStringSort(string(order.Field), order.Direction)
+}
+
+// toStringSort builds the rest sort value in the form of field:direction, e.g. due_date:desc
+func (*sortConverter) toStringSort(field string, direction *gql.SortDirection) string {
+	sortDirection := gql.SortDirectionAsc
+	if direction != nil {
+		sortDirection = *direction
+	}
+
+	return strings.ToLower(field) + gql_constants.SORT_DIRECTION_SEPARATOR + strings.ToLower(string(sortDirection))
+}
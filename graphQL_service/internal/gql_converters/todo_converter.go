@@ -5,6 +5,7 @@ import (
 	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/handler_models"
 	"Todo-List/internProject/todo_app_service/pkg/models"
+	"strings"
 )
 
 type iPriorityConverter interface {
@@ -33,9 +34,23 @@ func (*todoConverter) ToGQL(todo *models.Todo) *gql.Todo {
 		LastUpdated: todo.LastUpdated,
 		Priority:    gql.Priority(todo.Priority),
 		DueDate:     todo.DueDate,
+		Recurrence:  recurrenceToGQL(todo.Recurrence),
+		Position:    todo.Position,
+		ColumnID:    todo.ColumnId,
+		Estimate:    intPtrToInt32Ptr(todo.Estimate),
 	}
 }
 
+func (t *todoConverter) ManyToGQL(todos []*models.Todo) []*gql.Todo {
+	gqlTodos := make([]*gql.Todo, len(todos))
+
+	for index, todo := range todos {
+		gqlTodos[index] = t.ToGQL(todo)
+	}
+
+	return gqlTodos
+}
+
 func (t *todoConverter) ToTodoPageGQL(todoPage *models.TodoPage) *gql.TodoPage {
 	if todoPage == nil {
 		return &gql.TodoPage{
@@ -85,6 +100,9 @@ func (t *todoConverter) ToHandlerModel(todoInput *gql.UpdateTodoInput) *handler_
 		Priority:    priority,
 		AssignedTo:  todoInput.AssignedTo,
 		DueDate:     todoInput.DueDate,
+		Recurrence:  recurrenceInputToHandlerModel(todoInput.Recurrence),
+		ColumnId:    todoInput.ColumnID,
+		Estimate:    int32PtrToIntPtr(todoInput.Estimate),
 	}
 }
 
@@ -96,6 +114,75 @@ func (t *todoConverter) CreateTodoInputToModel(todoInput *gql.CreateTodoInput) *
 		Priority:    constants.Priority(t.pConverter.ToStringPriority(&todoInput.Priority)),
 		AssignedTo:  todoInput.AssignedTo,
 		DueDate:     todoInput.DueDate,
+		Recurrence:  recurrenceInputToHandlerModel(todoInput.Recurrence),
+		Estimate:    int32PtrToIntPtr(todoInput.Estimate),
+	}
+}
+
+func (t *todoConverter) CreateSubtaskInputToModel(subtaskInput *gql.CreateSubtaskInput) *handler_models.CreateSubtask {
+	return &handler_models.CreateSubtask{
+		Name:        subtaskInput.Name,
+		Description: subtaskInput.Description,
+		Priority:    constants.Priority(t.pConverter.ToStringPriority(&subtaskInput.Priority)),
+		AssignedTo:  subtaskInput.AssignedTo,
+		DueDate:     subtaskInput.DueDate,
+	}
+}
+
+func (t *todoConverter) BatchTodosInputToModel(batchInput *gql.BatchTodosInput) *handler_models.BatchTodos {
+	var mode string
+	if batchInput.Mode != nil {
+		mode = strings.ToLower(string(*batchInput.Mode))
+	}
+
+	operations := make([]handler_models.BatchTodoOperation, len(batchInput.Operations))
+	for index, operationInput := range batchInput.Operations {
+		operation := handler_models.BatchTodoOperation{Op: strings.ToLower(string(operationInput.Op))}
+
+		if operationInput.TodoID != nil {
+			operation.TodoId = *operationInput.TodoID
+		}
+		if operationInput.Create != nil {
+			operation.Create = t.CreateTodoInputToModel(operationInput.Create)
+		}
+		if operationInput.Update != nil {
+			operation.Update = t.ToHandlerModel(operationInput.Update)
+		}
+
+		operations[index] = operation
+	}
+
+	return &handler_models.BatchTodos{
+		Mode:       mode,
+		Operations: operations,
+	}
+}
+
+func (t *todoConverter) ToBatchTodosPayloadGQL(batchResult *models.BatchTodosResult) *gql.BatchTodosPayload {
+	results := make([]*gql.BatchTodoResult, len(batchResult.Results))
+	for index, result := range batchResult.Results {
+		var todo *gql.Todo
+		if result.Todo != nil {
+			todo = t.ToGQL(result.Todo)
+		}
+
+		var errMessage *string
+		if result.Error != "" {
+			errMessage = &result.Error
+		}
+
+		results[index] = &gql.BatchTodoResult{
+			Index:  int32(result.Index),
+			Op:     gql.BatchOperation(strings.ToUpper(result.Op)),
+			Status: int32(result.Status),
+			Todo:   todo,
+			Error:  errMessage,
+		}
+	}
+
+	return &gql.BatchTodosPayload{
+		Committed: batchResult.Committed,
+		Results:   results,
 	}
 }
 
@@ -121,3 +208,61 @@ func (t *todoConverter) ManyToDeleteTodoPayload(todos []*gql.Todo, success bool)
 	}
 	return deleteTodoPayloads
 }
+
+func recurrenceToGQL(recurrence *models.Recurrence) *gql.Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	gqlRecurrence := &gql.Recurrence{
+		Frequency: gql.RecurrenceFrequency(strings.ToUpper(string(recurrence.Frequency))),
+		Interval:  int32(recurrence.Interval),
+		Until:     recurrence.Until,
+	}
+
+	if recurrence.Count != nil {
+		count := int32(*recurrence.Count)
+		gqlRecurrence.Count = &count
+	}
+
+	return gqlRecurrence
+}
+
+func intPtrToInt32Ptr(value *int) *int32 {
+	if value == nil {
+		return nil
+	}
+
+	converted := int32(*value)
+	return &converted
+}
+
+func int32PtrToIntPtr(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+
+	converted := int(*value)
+	return &converted
+}
+
+func recurrenceInputToHandlerModel(recurrenceInput *gql.RecurrenceInput) *handler_models.Recurrence {
+	if recurrenceInput == nil {
+		return nil
+	}
+
+	recurrence := &handler_models.Recurrence{
+		Frequency: constants.RecurrenceFrequency(strings.ToLower(recurrenceInput.Frequency.String())),
+		Until:     recurrenceInput.Until,
+	}
+
+	if recurrenceInput.Interval != nil {
+		recurrence.Interval = int(*recurrenceInput.Interval)
+	}
+	if recurrenceInput.Count != nil {
+		count := int(*recurrenceInput.Count)
+		recurrence.Count = &count
+	}
+
+	return recurrence
+}
@@ -0,0 +1,61 @@
+package gql_converters
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+)
+
+type trashConverter struct {
+	tConverter todoGQLConverter
+	lConverter listGQLConverter
+}
+
+func NewTrashConverter(tConverter todoGQLConverter, lConverter listGQLConverter) *trashConverter {
+	return &trashConverter{
+		tConverter: tConverter,
+		lConverter: lConverter,
+	}
+}
+
+func (t *trashConverter) ToGQL(item *models.TrashItem) *gql.TrashItem {
+	var record gql.TrashRecord
+	if item.Type == gql_constants.TODO_TRASH_ITEM && item.Todo != nil {
+		record = t.tConverter.ToGQL(item.Todo)
+	}
+
+	if item.Type == gql_constants.LIST_TRASH_ITEM && item.List != nil {
+		record = t.lConverter.ToGQL(item.List)
+	}
+
+	if record == nil {
+		return nil
+	}
+
+	return &gql.TrashItem{
+		DeletedAt: item.DeletedAt,
+		Record:    record,
+	}
+}
+
+func (t *trashConverter) ToTrashGQL(trash *models.Trash) *gql.Trash {
+	if trash == nil || len(trash.Data) == 0 {
+		return &gql.Trash{
+			Data:       make([]*gql.TrashItem, 0),
+			TotalCount: 0,
+		}
+	}
+
+	gqlItems := make([]*gql.TrashItem, 0, len(trash.Data))
+
+	for _, item := range trash.Data {
+		if gqlItem := t.ToGQL(item); gqlItem != nil {
+			gqlItems = append(gqlItems, gqlItem)
+		}
+	}
+
+	return &gql.Trash{
+		Data:       gqlItems,
+		TotalCount: int32(trash.TotalCount),
+	}
+}
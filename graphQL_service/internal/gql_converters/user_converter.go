@@ -2,8 +2,10 @@ package gql_converters
 
 import (
 	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/handler_models"
 	"Todo-List/internProject/todo_app_service/pkg/models"
+	"strings"
 )
 
 type userConverter struct {
@@ -20,10 +22,17 @@ func (*userConverter) ToGQL(user *models.User) *gql.User {
 	}
 	role := gql.UserRole(user.Role)
 
+	var listRole *gql.CollaboratorRole
+	if len(user.ListRole) != 0 {
+		r := gql.CollaboratorRole(strings.ToUpper(string(user.ListRole)))
+		listRole = &r
+	}
+
 	return &gql.User{
-		ID:    user.Id,
-		Email: user.Email,
-		Role:  &role,
+		ID:       user.Id,
+		Email:    user.Email,
+		Role:     &role,
+		ListRole: listRole,
 	}
 }
 
@@ -56,13 +65,20 @@ func (u *userConverter) ToUserPageGQL(userPage *models.UserPage) *gql.UserPage {
 	}
 }
 
-func (*userConverter) FromCollaboratorInputToAddCollaboratorHandlerModel(user *gql.CollaboratorInput) *handler_models.AddCollaborator {
+func (u *userConverter) FromCollaboratorInputToAddCollaboratorHandlerModel(user *gql.CollaboratorInput) *handler_models.AddCollaborator {
 	if user == nil {
 		return nil
 	}
 
+	var role *constants.CollaboratorRole
+	if user.Role != nil {
+		r := constants.CollaboratorRole(u.rConverter.ToStringCollaboratorRole(user.Role))
+		role = &r
+	}
+
 	return &handler_models.AddCollaborator{
 		Email: user.UserEmail,
+		Role:  role,
 	}
 }
 
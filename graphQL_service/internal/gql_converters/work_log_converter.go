@@ -0,0 +1,35 @@
+package gql_converters
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+)
+
+type workLogConverter struct{}
+
+func NewWorkLogConverter() *workLogConverter {
+	return &workLogConverter{}
+}
+
+func (*workLogConverter) ToGQL(workLog *models.WorkLog) *gql.WorkLog {
+	return &gql.WorkLog{
+		ID:          workLog.Id,
+		TodoID:      workLog.TodoId,
+		UserID:      workLog.UserId,
+		Duration:    int32(workLog.Duration),
+		Note:        workLog.Note,
+		LoggedOn:    workLog.LoggedOn,
+		CreatedAt:   workLog.CreatedAt,
+		LastUpdated: workLog.LastUpdated,
+	}
+}
+
+func (w *workLogConverter) ManyToGQL(workLogs []*models.WorkLog) []*gql.WorkLog {
+	gqlWorkLogs := make([]*gql.WorkLog, len(workLogs))
+
+	for index, workLog := range workLogs {
+		gqlWorkLogs[index] = w.ToGQL(workLog)
+	}
+
+	return gqlWorkLogs
+}
@@ -5,12 +5,34 @@ import (
 	"context"
 	"github.com/gorilla/mux"
 	"net/http"
+	"sync"
 )
 
 type authToken struct{}
 
 var AuthToken = authToken{}
 
+type tokenVerificationKey struct{}
+
+// TokenVerification holds the *TokenVerificationResult of the request
+var TokenVerification = tokenVerificationKey{}
+
+// TokenVerificationResult remembers whether the access token of the request has been revoked,
+// so that the rest api is asked only once per request
+type TokenVerificationResult struct {
+	once sync.Once
+	err  error
+}
+
+// Get runs verify the first time it is called and returns its outcome on every call
+func (t *TokenVerificationResult) Get(verify func() error) error {
+	t.once.Do(func() {
+		t.err = verify()
+	})
+
+	return t.err
+}
+
 type populateJwtMiddleware struct {
 	next http.Handler
 }
@@ -25,6 +47,7 @@ func (p *populateJwtMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request
 
 	jwt := r.Header.Get("Authorization")
 	ctx = context.WithValue(ctx, AuthToken, jwt)
+	ctx = context.WithValue(ctx, TokenVerification, &TokenVerificationResult{})
 
 	p.next.ServeHTTP(w, r.WithContext(ctx))
 }
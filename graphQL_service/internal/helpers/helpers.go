@@ -41,17 +41,25 @@ func InitBaseFilters(first *int32, after *string, last *int32, before *string) *
 	}
 }
 
-func InitTodoFilters(first *int32, after *string, last *int32, before *string, tFilters *gql.TodosFilterInput) *url_filters.TodoFilters {
+func InitTodoFilters(first *int32, after *string, last *int32, before *string, tFilters *gql.TodosFilterInput, tOrder *gql.TodoOrder) *url_filters.TodoFilters {
 	statusConverter := gql_converters.NewStatusConverter()
 	priorityConverter := gql_converters.NewPriorityConverter()
 	typeConverter := gql_converters.NewOverdueConverter()
+	sortConverter := gql_converters.NewSortConverter()
 	bFilters := InitBaseFilters(first, after, last, before)
 
-	return url_filters.NewTodoFilters(*bFilters, tFilters, statusConverter, priorityConverter, typeConverter)
+	return url_filters.NewTodoFilters(*bFilters, tFilters, tOrder, statusConverter, priorityConverter, typeConverter, sortConverter)
 }
 
-func InitListFilters(first *int32, after *string, last *int32, before *string, lFilters *gql.ListFilterInput) *url_filters.ListFilters {
+func InitListFilters(first *int32, after *string, last *int32, before *string, lFilters *gql.ListFilterInput, lOrder *gql.ListOrder) *url_filters.ListFilters {
+	sortConverter := gql_converters.NewSortConverter()
 	bFilters := InitBaseFilters(first, after, last, before)
 
-	return url_filters.NewListFilters(*bFilters, lFilters)
+	return url_filters.NewListFilters(*bFilters, lFilters, lOrder, sortConverter)
+}
+
+func InitSearchFilters(query string, first *int32, after *string) *url_filters.SearchFilters {
+	bFilters := InitBaseFilters(first, after, nil, nil)
+
+	return url_filters.NewSearchFilters(*bFilters, query)
 }
@@ -0,0 +1,172 @@
+package invitation
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/graphQL_service/graph/utils"
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type httpService interface {
+	GetHttpResponseWithAuthHeader(ctx context.Context, httpMethod string, url string, body io.Reader) (*http.Response, error)
+}
+
+type jsonMarshaller interface {
+	Marshal(obj interface{}) ([]byte, error)
+}
+
+type invitationConverter interface {
+	ToGQL(invitation *models.Invitation) *gql.Invitation
+	ManyToGQL(invitations []*models.Invitation) []*gql.Invitation
+	FromInvitationInputToCreateInvitationHandlerModel(input *gql.InvitationInput) *handler_models.CreateInvitation
+}
+
+type resolver struct {
+	converter   invitationConverter
+	restUrl     string
+	marshaller  jsonMarshaller
+	httpService httpService
+}
+
+func NewResolver(converter invitationConverter, restUrl string, marshaller jsonMarshaller, httpService httpService) *resolver {
+	return &resolver{
+		converter:   converter,
+		restUrl:     restUrl,
+		marshaller:  marshaller,
+		httpService: httpService,
+	}
+}
+
+func (r *resolver) Invitations(ctx context.Context, userID string) ([]*gql.Invitation, error) {
+	log.C(ctx).Infof("getting invitations of user with id %s in invitation resolver", userID)
+
+	url := r.restUrl + gql_constants.USER_PATH + fmt.Sprintf("/%s%s", userID, gql_constants.INVITATIONS_PATH)
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in invitation resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.C(ctx).Debugf("http status not found received...")
+		return make([]*gql.Invitation, 0), nil
+	}
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get invitations in invitation resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var invitations []*models.Invitation
+	if err = json.NewDecoder(resp.Body).Decode(&invitations); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.converter.ManyToGQL(invitations), nil
+}
+
+func (r *resolver) InviteCollaborator(ctx context.Context, input gql.InvitationInput) (*gql.Invitation, error) {
+	log.C(ctx).Infof("inviting %s to list with id %s in invitation resolver", input.Email, input.ListID)
+
+	url := r.restUrl + gql_constants.LISTS_PATH + fmt.Sprintf("/%s%s", input.ListID, gql_constants.INVITATIONS_PATH)
+
+	jsonBody, err := r.marshaller.Marshal(r.converter.FromInvitationInputToCreateInvitationHandlerModel(&input))
+	if err != nil {
+		log.C(ctx).Errorf("failed to invite collaborator, error %s when trying to marshal invitation", err.Error())
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in invitation resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return r.decodeInvitation(ctx, resp)
+}
+
+func (r *resolver) AcceptInvitation(ctx context.Context, token string) (*gql.Invitation, error) {
+	log.C(ctx).Info("accepting invitation in invitation resolver")
+
+	url := r.restUrl + gql_constants.INVITATIONS_PATH + gql_constants.ACCEPT_PATH
+
+	resp, err := r.postInvitationToken(ctx, url, token)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return r.decodeInvitation(ctx, resp)
+}
+
+func (r *resolver) DeclineInvitation(ctx context.Context, token string) (bool, error) {
+	log.C(ctx).Info("declining invitation in invitation resolver")
+
+	url := r.restUrl + gql_constants.INVITATIONS_PATH + gql_constants.DECLINE_PATH
+
+	resp, err := r.postInvitationToken(ctx, url, token)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.C(ctx).Debugf("http status not found received...")
+		return false, nil
+	}
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to decline invitation in invitation resolver, error %s due to bad response status code", err.Error())
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *resolver) postInvitationToken(ctx context.Context, url string, token string) (*http.Response, error) {
+	jsonBody, err := r.marshaller.Marshal(&handler_models.InvitationToken{Token: token})
+	if err != nil {
+		log.C(ctx).Errorf("failed to marshal invitation token, error %s", err.Error())
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in invitation resolver, error %s", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *resolver) decodeInvitation(ctx context.Context, resp *http.Response) (*gql.Invitation, error) {
+	if resp.StatusCode == http.StatusNotFound {
+		log.C(ctx).Debugf("http status not found received...")
+		return nil, nil
+	}
+
+	if err := utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get invitation in invitation resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var invitation models.Invitation
+	if err := json.NewDecoder(resp.Body).Decode(&invitation); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.converter.ToGQL(&invitation), nil
+}
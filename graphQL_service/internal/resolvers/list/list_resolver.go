@@ -37,6 +37,8 @@ type listConverter interface {
 	ToModel(list *gql.List) *models.List
 	CreateListInputGQLToHandlerModel(list gql.CreateListInput) *handler_models.CreateList
 	UpdateListInputGQLToHandlerModel(list gql.UpdateListInput) *handler_models.UpdateList
+	DuplicateListInputGQLToHandlerModel(input gql.DuplicateListInput) *handler_models.DuplicateList
+	CreateListFromTemplateInputGQLToHandlerModel(input gql.CreateListFromTemplateInput) *handler_models.CreateListFromTemplate
 	FromGQLModelToDeleteListPayload(list *gql.List, success bool) *gql.DeleteListPayload
 	ManyFromGQLModelToDeleteListPayload(lists []*gql.List, success bool) []*gql.DeleteListPayload
 }
@@ -54,21 +56,33 @@ type todoConverter interface {
 	ToTodoPageGQL(todoPage *models.TodoPage) *gql.TodoPage
 }
 
+type historyConverter interface {
+	ToHistoryPageGQL(historyPage *models.HistoryPage) *gql.HistoryPage
+}
+
+type boardConverter interface {
+	ToGQL(board *models.Board) *gql.Board
+}
+
 type resolver struct {
 	lConverter  listConverter
 	uConverter  userConverter
 	tConverter  todoConverter
+	hConverter  historyConverter
+	bConverter  boardConverter
 	restUrl     string
 	factory     urlDecoratorFactory
 	httpService httpService
 	marshaller  jsonMarshaller
 }
 
-func NewResolver(lConverter listConverter, uConverter userConverter, tConverter todoConverter, restUrl string, factory urlDecoratorFactory, httpService httpService, marshaller jsonMarshaller) *resolver {
+func NewResolver(lConverter listConverter, uConverter userConverter, tConverter todoConverter, hConverter historyConverter, bConverter boardConverter, restUrl string, factory urlDecoratorFactory, httpService httpService, marshaller jsonMarshaller) *resolver {
 	return &resolver{
 		lConverter:  lConverter,
 		uConverter:  uConverter,
 		tConverter:  tConverter,
+		hConverter:  hConverter,
+		bConverter:  bConverter,
 		restUrl:     restUrl,
 		factory:     factory,
 		httpService: httpService,
@@ -270,6 +284,44 @@ func (r *resolver) UpdateList(ctx context.Context, id string, input gql.UpdateLi
 	return r.lConverter.ToGQL(&updatedList), nil
 }
 
+func (r *resolver) TransferListOwnership(ctx context.Context, id string, userID string) (*gql.List, error) {
+	log.C(ctx).Infof("transferring ownership of list with id %s to user with id %s in list resolver", id, userID)
+
+	formattedSuffix := fmt.Sprintf("/%s%s", id, gql_constants.OWNER_PATH)
+	url := r.restUrl + gql_constants.LISTS_PATH + formattedSuffix
+
+	jsonBody, err := r.marshaller.Marshal(&handler_models.TransferListOwnership{UserId: userID})
+	if err != nil {
+		log.C(ctx).Errorf("failed to transfer ownership of list with id %s, error when trying to marshal rest model", id)
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.C(ctx).Debugf("http status not found received...")
+		return nil, nil
+	}
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to transfer ownership of list with id %s in list resolver, error %s due to bad response status code", id, err.Error())
+		return nil, err
+	}
+
+	var list models.List
+	if err = json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.lConverter.ToGQL(&list), nil
+}
+
 func (r *resolver) AddListCollaborator(ctx context.Context, input gql.CollaboratorInput) (*gql.CreateCollaboratorPayload, error) {
 	log.C(ctx).Debugf("adding collaborator %s in list %s", input.UserEmail, input.ListID)
 
@@ -388,6 +440,66 @@ func (r *resolver) Collaborators(ctx context.Context, obj *gql.List, filters *ur
 	return r.uConverter.ToUserPageGQL(&collaboratorsPage), nil
 }
 
+func (r *resolver) History(ctx context.Context, obj *gql.List, filters *url_filters.BaseFilters) (*gql.HistoryPage, error) {
+	log.C(ctx).Info("getting list history in list resolver")
+
+	formattedSuffix := fmt.Sprintf("/%s", obj.ID)
+
+	decorator := r.factory.CreateUrlDecorator(ctx, gql_constants.LISTS_PATH+formattedSuffix+gql_constants.HISTORY_PATH, filters)
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Error("failed to get list history, error when calling common decorator function")
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get history of a list in list resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var historyPage models.HistoryPage
+	if err = json.NewDecoder(resp.Body).Decode(&historyPage); err != nil {
+		log.C(ctx).Errorf("failed to decode json body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.hConverter.ToHistoryPageGQL(&historyPage), nil
+}
+
+func (r *resolver) Board(ctx context.Context, obj *gql.List) (*gql.Board, error) {
+	log.C(ctx).Infof("getting board of list with id %s in list resolver", obj.ID)
+
+	url := r.restUrl + gql_constants.LISTS_PATH + fmt.Sprintf("/%s%s", obj.ID, gql_constants.BOARD_PATH)
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get board of a list in list resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var board models.Board
+	if err = json.NewDecoder(resp.Body).Decode(&board); err != nil {
+		log.C(ctx).Errorf("failed to decode json body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.bConverter.ToGQL(&board), nil
+}
+
 func (r *resolver) CreateList(ctx context.Context, input gql.CreateListInput) (*gql.List, error) {
 	log.C(ctx).Info("creating list in list resolver")
 
@@ -468,3 +580,53 @@ func (r *resolver) getLists(ctx context.Context) (*gql.ListPage, error) {
 
 	return r.lConverter.ToListPageGQL(&listPage), nil
 }
+
+func (r *resolver) DuplicateList(ctx context.Context, id string, input gql.DuplicateListInput) (*gql.List, error) {
+	log.C(ctx).Infof("duplicating list with id %s in list resolver", id)
+
+	url := r.restUrl + gql_constants.LISTS_PATH + fmt.Sprintf("/%s%s", id, gql_constants.DUPLICATE_PATH)
+
+	return r.createListFrom(ctx, url, r.lConverter.DuplicateListInputGQLToHandlerModel(input))
+}
+
+func (r *resolver) CreateListFromTemplate(ctx context.Context, templateID string, input gql.CreateListFromTemplateInput) (*gql.List, error) {
+	log.C(ctx).Infof("creating list from template with id %s in list resolver", templateID)
+
+	url := r.restUrl + gql_constants.TEMPLATES_PATH + fmt.Sprintf("/%s%s", templateID, gql_constants.LISTS_PATH)
+
+	return r.createListFrom(ctx, url, r.lConverter.CreateListFromTemplateInputGQLToHandlerModel(input))
+}
+
+// createListFrom posts the rest model to the url which creates a new list out of an existing list or template
+func (r *resolver) createListFrom(ctx context.Context, url string, restModel interface{}) (*gql.List, error) {
+	jsonBody, err := r.marshaller.Marshal(restModel)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create list, error %s when trying to marshal json body", err.Error())
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.C(ctx).Debugf("http status not found received...")
+		return nil, nil
+	}
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to create list in list resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var list models.List
+	if err = json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		log.C(ctx).Errorf("failed to decode json body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.lConverter.ToGQL(&list), nil
+}
@@ -142,7 +142,7 @@ func TestResolver_List(t *testing.T) {
 				mockClient = test.mockHttpClient()
 			}
 
-			listResolver := NewResolver(mockClient, mockConverter, nil, nil, "", nil, nil)
+			listResolver := NewResolver(mockClient, mockConverter, nil, nil, nil, "", nil, nil)
 			receivedGqlList, err := listResolver.List(context.Background(), test.listId)
 			if test.expectedError != nil {
 				require.EqualError(t, test.expectedError, err.Error())
@@ -270,7 +270,7 @@ func TestResolver_DeleteList(t *testing.T) {
 				mockClient = test.mockHttpClient()
 			}
 
-			listResolver := NewResolver(mockClient, mockConverter, nil, nil, "", nil, nil)
+			listResolver := NewResolver(mockClient, mockConverter, nil, nil, nil, "", nil, nil)
 
 			receivedPayload, err := listResolver.DeleteList(context.Background(), test.listId)
 			if test.expectedError != nil {
@@ -445,7 +445,7 @@ func TestResolver_UpdateList(t *testing.T) {
 				mockClient = test.mockHttpClient()
 			}
 
-			listResolver := NewResolver(mockClient, mockConverter, nil, nil, "", nil, nil)
+			listResolver := NewResolver(mockClient, mockConverter, nil, nil, nil, "", nil, nil)
 
 			receivedGqlList, err := listResolver.UpdateList(context.Background(), test.listId, test.updateListInput)
 			if test.expectedError != nil {
@@ -590,7 +590,7 @@ func TestResolver_CreateList(t *testing.T) {
 				mockClient = test.mockHttpClient()
 			}
 
-			listResolver := NewResolver(mockClient, mockConverter, nil, nil, "", nil, nil)
+			listResolver := NewResolver(mockClient, mockConverter, nil, nil, nil, "", nil, nil)
 
 			receivedGqlList, err := listResolver.CreateList(context.Background(), test.input)
 			if test.expectedError != nil {
@@ -0,0 +1,75 @@
+package search
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/graphQL_service/graph/utils"
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
+	"Todo-List/internProject/graphQL_service/internal/url_decorators"
+	"Todo-List/internProject/graphQL_service/internal/url_decorators/url_filters"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+type httpService interface {
+	GetHttpResponseWithAuthHeader(ctx context.Context, httpMethod string, url string, body io.Reader) (*http.Response, error)
+}
+
+type urlDecoratorFactory interface {
+	CreateUrlDecorator(ctx context.Context, url string, uFilters url_decorators.UrlFilters) url_decorators.QueryParamsRetrievers
+}
+
+type searchConverter interface {
+	ToSearchResultPageGQL(resultPage *models.SearchResultPage) *gql.SearchResultPage
+}
+
+type resolver struct {
+	factory     urlDecoratorFactory
+	converter   searchConverter
+	restUrl     string
+	httpService httpService
+}
+
+func NewResolver(factory urlDecoratorFactory, converter searchConverter, restUrl string, httpService httpService) *resolver {
+	return &resolver{
+		factory:     factory,
+		converter:   converter,
+		restUrl:     restUrl,
+		httpService: httpService,
+	}
+}
+
+func (r *resolver) Search(ctx context.Context, filters *url_filters.SearchFilters) (*gql.SearchResultPage, error) {
+	log.C(ctx).Infof("searching for %q in search resolver", filters.Query)
+
+	decorator := r.factory.CreateUrlDecorator(ctx, gql_constants.SEARCH_PATH, filters)
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Errorf("failed to determine correct query param in search resolver")
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in search resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to search in search resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var resultPage models.SearchResultPage
+	if err = json.NewDecoder(resp.Body).Decode(&resultPage); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.converter.ToSearchResultPageGQL(&resultPage), nil
+}
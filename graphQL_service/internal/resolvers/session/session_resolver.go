@@ -0,0 +1,113 @@
+package session
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/graphQL_service/graph/utils"
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type httpService interface {
+	GetHttpResponseWithAuthHeader(ctx context.Context, httpMethod string, url string, body io.Reader) (*http.Response, error)
+}
+
+type sessionConverter interface {
+	ManyToGQL(sessions []*models.Session) []*gql.Session
+}
+
+type resolver struct {
+	converter   sessionConverter
+	restUrl     string
+	httpService httpService
+}
+
+func NewResolver(converter sessionConverter, restUrl string, httpService httpService) *resolver {
+	return &resolver{
+		converter:   converter,
+		restUrl:     restUrl,
+		httpService: httpService,
+	}
+}
+
+func (r *resolver) MySessions(ctx context.Context) ([]*gql.Session, error) {
+	log.C(ctx).Info("getting sessions of the current user in session resolver")
+
+	url := r.restUrl + gql_constants.SESSIONS_PATH
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in session resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get sessions in session resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var sessions []*models.Session
+	if err = json.NewDecoder(resp.Body).Decode(&sessions); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.converter.ManyToGQL(sessions), nil
+}
+
+func (r *resolver) RevokeSession(ctx context.Context, id string) (bool, error) {
+	log.C(ctx).Infof("revoking session with id %s in session resolver", id)
+
+	url := r.restUrl + gql_constants.SESSIONS_PATH + fmt.Sprintf("/%s", id)
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in session resolver, error %s", err.Error())
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.C(ctx).Debugf("http status not found received...")
+		return false, nil
+	}
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to revoke session in session resolver, error %s due to bad response status code", err.Error())
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RevokeUserSessions logs the user out of every device, the rest api only lets admins do it
+func (r *resolver) RevokeUserSessions(ctx context.Context, userId string) (bool, error) {
+	log.C(ctx).Infof("revoking sessions of user with id %s in session resolver", userId)
+
+	url := r.restUrl + gql_constants.USER_PATH + fmt.Sprintf("/%s", userId) + gql_constants.SESSIONS_PATH
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in session resolver, error %s", err.Error())
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.C(ctx).Debugf("http status not found received...")
+		return false, nil
+	}
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to revoke sessions of user in session resolver, error %s due to bad response status code", err.Error())
+		return false, err
+	}
+
+	return true, nil
+}
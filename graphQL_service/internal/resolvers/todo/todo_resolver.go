@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 )
 
 type httpService interface {
@@ -31,9 +32,13 @@ type jsonMarshaller interface {
 
 type todoConverter interface {
 	ToGQL(todo *models.Todo) *gql.Todo
+	ManyToGQL(todos []*models.Todo) []*gql.Todo
 	ToTodoPageGQL(todoPage *models.TodoPage) *gql.TodoPage
 	ToHandlerModel(todo *gql.UpdateTodoInput) *handler_models.UpdateTodo
 	CreateTodoInputToModel(todo *gql.CreateTodoInput) *handler_models.CreateTodo
+	CreateSubtaskInputToModel(subtask *gql.CreateSubtaskInput) *handler_models.CreateSubtask
+	BatchTodosInputToModel(batch *gql.BatchTodosInput) *handler_models.BatchTodos
+	ToBatchTodosPayloadGQL(batchResult *models.BatchTodosResult) *gql.BatchTodosPayload
 	FromGQLModelToDeleteTodoPayload(todo *gql.Todo, success bool) *gql.DeleteTodoPayload
 	ManyToDeleteTodoPayload(todos []*gql.Todo, success bool) []*gql.DeleteTodoPayload
 }
@@ -44,27 +49,54 @@ type listConverter interface {
 
 type userConverter interface {
 	ToGQL(list *models.User) *gql.User
+	ToUserPageGQL(userPage *models.UserPage) *gql.UserPage
+}
+
+type labelConverter interface {
+	ToLabelPageGQL(labelPage *models.LabelPage) *gql.LabelPage
+}
+
+type commentConverter interface {
+	ToCommentPageGQL(commentPage *models.CommentPage) *gql.CommentPage
+}
+
+type historyConverter interface {
+	ToHistoryPageGQL(historyPage *models.HistoryPage) *gql.HistoryPage
+}
+
+type workLogConverter interface {
+	ManyToGQL(workLogs []*models.WorkLog) []*gql.WorkLog
 }
 
 type resolver struct {
-	factory        urlDecoratorFactory
-	tConverter     todoConverter
-	uConverter     userConverter
-	lConverter     listConverter
-	restUrl        string
-	jsonMarshaller jsonMarshaller
-	httpService    httpService
+	factory          urlDecoratorFactory
+	tConverter       todoConverter
+	uConverter       userConverter
+	lConverter       listConverter
+	labelConverter   labelConverter
+	commentConverter commentConverter
+	historyConverter historyConverter
+	workLogConverter workLogConverter
+	restUrl          string
+	jsonMarshaller   jsonMarshaller
+	httpService      httpService
 }
 
-func NewResolver(factory urlDecoratorFactory, tConverter todoConverter, uConverter userConverter, lConverter listConverter, restUrl string, jsonMarshaller jsonMarshaller, httpService httpService) *resolver {
+func NewResolver(factory urlDecoratorFactory, tConverter todoConverter, uConverter userConverter, lConverter listConverter,
+	labelConverter labelConverter, commentConverter commentConverter, historyConverter historyConverter, workLogConverter workLogConverter,
+	restUrl string, jsonMarshaller jsonMarshaller, httpService httpService) *resolver {
 	return &resolver{
-		factory:        factory,
-		tConverter:     tConverter,
-		uConverter:     uConverter,
-		lConverter:     lConverter,
-		restUrl:        restUrl,
-		jsonMarshaller: jsonMarshaller,
-		httpService:    httpService,
+		factory:          factory,
+		tConverter:       tConverter,
+		uConverter:       uConverter,
+		lConverter:       lConverter,
+		labelConverter:   labelConverter,
+		commentConverter: commentConverter,
+		historyConverter: historyConverter,
+		workLogConverter: workLogConverter,
+		restUrl:          restUrl,
+		jsonMarshaller:   jsonMarshaller,
+		httpService:      httpService,
 	}
 }
 
@@ -179,7 +211,7 @@ func (r *resolver) UpdateTodo(ctx context.Context, id string, input gql.UpdateTo
 	return r.tConverter.ToGQL(&todo), nil
 }
 
-func (r *resolver) DeleteTodo(ctx context.Context, id string) (*gql.DeleteTodoPayload, error) {
+func (r *resolver) DeleteTodo(ctx context.Context, id string, cascade *bool) (*gql.DeleteTodoPayload, error) {
 	log.C(ctx).Infof("deleting todo with id %s in todo resolver", id)
 
 	gqlTodo, err := r.Todo(ctx, id)
@@ -198,7 +230,16 @@ func (r *resolver) DeleteTodo(ctx context.Context, id string) (*gql.DeleteTodoPa
 	}
 
 	formattedSuffix := fmt.Sprintf("/%s", id)
-	url := r.restUrl + gql_constants.TODO_PATH + formattedSuffix
+	decorator := url_decorators.NewBaseUrl(gql_constants.TODO_PATH + formattedSuffix)
+	if cascade != nil && !*cascade {
+		decorator = url_decorators.NewCriteriaDecorator(decorator, gql_constants.CASCADE, strconv.FormatBool(*cascade))
+	}
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Errorf("failed to determine correct query params in todo resolver, error %s", err.Error())
+		return nil, err
+	}
 
 	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodDelete, url, nil)
 	if err != nil {
@@ -265,6 +306,454 @@ func (r *resolver) CreateTodo(ctx context.Context, input gql.CreateTodoInput) (*
 	return r.tConverter.ToGQL(&todo), nil
 }
 
+func (r *resolver) CreateSubtask(ctx context.Context, parentID string, input gql.CreateSubtaskInput) (*gql.Todo, error) {
+	log.C(ctx).Infof("creating subtask of todo with id %s in todo resolver", parentID)
+
+	restModelSubtask := r.tConverter.CreateSubtaskInputToModel(&input)
+
+	formattedSuffix := fmt.Sprintf("/%s%s", parentID, gql_constants.SUBTASKS_PATH)
+	url := r.restUrl + gql_constants.TODO_PATH + formattedSuffix
+
+	jsonBody, err := r.jsonMarshaller.Marshal(restModelSubtask)
+	if err != nil {
+		log.C(ctx).Errorf("failed to JSON marshal subtask handler model %s", err.Error())
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to create subtask in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var todo models.Todo
+	if err = json.NewDecoder(resp.Body).Decode(&todo); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.tConverter.ToGQL(&todo), nil
+}
+
+func (r *resolver) Parent(ctx context.Context, obj *gql.Todo) (*gql.Todo, error) {
+	log.C(ctx).Infof("getting parent of todo with id %s in todo resolver", obj.ID)
+
+	modelTodo, err := r.getModelTodo(ctx, obj.ID)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo parent, error when trying to get model todo")
+		return nil, err
+	}
+
+	if modelTodo == nil || modelTodo.ParentId == nil {
+		log.C(ctx).Debugf("todo with id %s has no parent...", obj.ID)
+		return nil, nil
+	}
+
+	return r.Todo(ctx, *modelTodo.ParentId)
+}
+
+func (r *resolver) Subtasks(ctx context.Context, obj *gql.Todo, filters *url_filters.TodoFilters) (*gql.TodoPage, error) {
+	log.C(ctx).Infof("getting subtasks of todo with id %s in todo resolver", obj.ID)
+
+	formattedSuffix := fmt.Sprintf("/%s%s", obj.ID, gql_constants.SUBTASKS_PATH)
+
+	decorator := r.factory.CreateUrlDecorator(ctx, gql_constants.TODO_PATH+formattedSuffix, filters)
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get todo subtasks, error when calling factory function")
+		return utils.InitEmptyTodoPage(), err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get subtasks in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var todoPage models.TodoPage
+	if err = json.NewDecoder(resp.Body).Decode(&todoPage); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.tConverter.ToTodoPageGQL(&todoPage), nil
+}
+
+func (r *resolver) Labels(ctx context.Context, obj *gql.Todo, filters *url_filters.BaseFilters) (*gql.LabelPage, error) {
+	log.C(ctx).Infof("getting labels of todo with id %s in todo resolver", obj.ID)
+
+	formattedSuffix := fmt.Sprintf("/%s%s", obj.ID, gql_constants.LABELS_PATH)
+
+	decorator := r.factory.CreateUrlDecorator(ctx, gql_constants.TODO_PATH+formattedSuffix, filters)
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get todo labels, error when calling factory function")
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get labels in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var labelPage models.LabelPage
+	if err = json.NewDecoder(resp.Body).Decode(&labelPage); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.labelConverter.ToLabelPageGQL(&labelPage), nil
+}
+
+func (r *resolver) AddLabel(ctx context.Context, todoID string, labelID string) (*gql.Todo, error) {
+	log.C(ctx).Infof("attaching label with id %s to todo with id %s in todo resolver", labelID, todoID)
+
+	formattedSuffix := fmt.Sprintf("/%s%s", todoID, gql_constants.LABELS_PATH)
+	url := r.restUrl + gql_constants.TODO_PATH + formattedSuffix
+
+	jsonBody, err := r.jsonMarshaller.Marshal(&handler_models.AddLabel{LabelId: labelID})
+	if err != nil {
+		log.C(ctx).Errorf("failed to JSON marshal add label handler model %s", err.Error())
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to attach label in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	return r.Todo(ctx, todoID)
+}
+
+func (r *resolver) RemoveLabel(ctx context.Context, todoID string, labelID string) (*gql.Todo, error) {
+	log.C(ctx).Infof("detaching label with id %s from todo with id %s in todo resolver", labelID, todoID)
+
+	formattedSuffix := fmt.Sprintf("/%s%s/%s", todoID, gql_constants.LABELS_PATH, labelID)
+	url := r.restUrl + gql_constants.TODO_PATH + formattedSuffix
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to detach label in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	return r.Todo(ctx, todoID)
+}
+
+func (r *resolver) Assignees(ctx context.Context, obj *gql.Todo, filters *url_filters.BaseFilters) (*gql.UserPage, error) {
+	log.C(ctx).Infof("getting assignees of todo with id %s in todo resolver", obj.ID)
+
+	return r.getTodoParticipants(ctx, obj.ID, gql_constants.ASSIGNEES_PATH, filters)
+}
+
+func (r *resolver) Watchers(ctx context.Context, obj *gql.Todo, filters *url_filters.BaseFilters) (*gql.UserPage, error) {
+	log.C(ctx).Infof("getting watchers of todo with id %s in todo resolver", obj.ID)
+
+	return r.getTodoParticipants(ctx, obj.ID, gql_constants.WATCHERS_PATH, filters)
+}
+
+func (r *resolver) AddTodoAssignee(ctx context.Context, todoID string, userID string) (*gql.Todo, error) {
+	log.C(ctx).Infof("assigning user with id %s to todo with id %s in todo resolver", userID, todoID)
+
+	return r.addTodoParticipant(ctx, todoID, userID, gql_constants.ASSIGNEES_PATH)
+}
+
+func (r *resolver) RemoveTodoAssignee(ctx context.Context, todoID string, userID string) (*gql.Todo, error) {
+	log.C(ctx).Infof("unassigning user with id %s from todo with id %s in todo resolver", userID, todoID)
+
+	return r.removeTodoParticipant(ctx, todoID, userID, gql_constants.ASSIGNEES_PATH)
+}
+
+func (r *resolver) AddTodoWatcher(ctx context.Context, todoID string, userID string) (*gql.Todo, error) {
+	log.C(ctx).Infof("adding watcher with id %s to todo with id %s in todo resolver", userID, todoID)
+
+	return r.addTodoParticipant(ctx, todoID, userID, gql_constants.WATCHERS_PATH)
+}
+
+func (r *resolver) RemoveTodoWatcher(ctx context.Context, todoID string, userID string) (*gql.Todo, error) {
+	log.C(ctx).Infof("removing watcher with id %s from todo with id %s in todo resolver", userID, todoID)
+
+	return r.removeTodoParticipant(ctx, todoID, userID, gql_constants.WATCHERS_PATH)
+}
+
+func (r *resolver) TimeSpent(ctx context.Context, obj *gql.Todo) (int32, error) {
+	log.C(ctx).Infof("getting time spent on todo with id %s in todo resolver", obj.ID)
+
+	url := r.restUrl + gql_constants.TODO_PATH + fmt.Sprintf("/%s%s", obj.ID, gql_constants.TIME_PATH)
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get time spent in todo resolver, error %s due to bad response status code", err.Error())
+		return 0, err
+	}
+
+	var totals models.TimeTotals
+	if err = json.NewDecoder(resp.Body).Decode(&totals); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return 0, err
+	}
+
+	return int32(totals.TimeSpent), nil
+}
+
+func (r *resolver) WorkLogs(ctx context.Context, obj *gql.Todo) ([]*gql.WorkLog, error) {
+	log.C(ctx).Infof("getting work logs of todo with id %s in todo resolver", obj.ID)
+
+	url := r.restUrl + gql_constants.TODO_PATH + fmt.Sprintf("/%s%s", obj.ID, gql_constants.WORK_LOGS_PATH)
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get work logs in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var workLogs []*models.WorkLog
+	if err = json.NewDecoder(resp.Body).Decode(&workLogs); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.workLogConverter.ManyToGQL(workLogs), nil
+}
+
+func (r *resolver) getTodoParticipants(ctx context.Context, todoID string, participantsPath string, filters *url_filters.BaseFilters) (*gql.UserPage, error) {
+	formattedSuffix := fmt.Sprintf("/%s%s", todoID, participantsPath)
+
+	decorator := r.factory.CreateUrlDecorator(ctx, gql_constants.TODO_PATH+formattedSuffix, filters)
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get todo participants, error when calling factory function")
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get todo participants in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var userPage models.UserPage
+	if err = json.NewDecoder(resp.Body).Decode(&userPage); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.uConverter.ToUserPageGQL(&userPage), nil
+}
+
+func (r *resolver) addTodoParticipant(ctx context.Context, todoID string, userID string, participantsPath string) (*gql.Todo, error) {
+	formattedSuffix := fmt.Sprintf("/%s%s", todoID, participantsPath)
+	url := r.restUrl + gql_constants.TODO_PATH + formattedSuffix
+
+	jsonBody, err := r.jsonMarshaller.Marshal(&handler_models.AddParticipant{UserId: userID})
+	if err != nil {
+		log.C(ctx).Errorf("failed to JSON marshal add participant handler model %s", err.Error())
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to add todo participant in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	return r.Todo(ctx, todoID)
+}
+
+func (r *resolver) removeTodoParticipant(ctx context.Context, todoID string, userID string, participantsPath string) (*gql.Todo, error) {
+	formattedSuffix := fmt.Sprintf("/%s%s/%s", todoID, participantsPath, userID)
+	url := r.restUrl + gql_constants.TODO_PATH + formattedSuffix
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to remove todo participant in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	return r.Todo(ctx, todoID)
+}
+
+func (r *resolver) BlockedBy(ctx context.Context, obj *gql.Todo, filters *url_filters.TodoFilters) (*gql.TodoPage, error) {
+	log.C(ctx).Infof("getting blockers of todo with id %s in todo resolver", obj.ID)
+
+	formattedSuffix := fmt.Sprintf("/%s%s", obj.ID, gql_constants.BLOCKERS_PATH)
+
+	decorator := r.factory.CreateUrlDecorator(ctx, gql_constants.TODO_PATH+formattedSuffix, filters)
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get todo blockers, error when calling factory function")
+		return utils.InitEmptyTodoPage(), err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get todo blockers in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var todoPage models.TodoPage
+	if err = json.NewDecoder(resp.Body).Decode(&todoPage); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.tConverter.ToTodoPageGQL(&todoPage), nil
+}
+
+func (r *resolver) Blocks(ctx context.Context, obj *gql.Todo, filters *url_filters.TodoFilters) (*gql.TodoPage, error) {
+	log.C(ctx).Infof("getting todos blocked by todo with id %s in todo resolver", obj.ID)
+
+	formattedSuffix := fmt.Sprintf("/%s%s", obj.ID, gql_constants.BLOCKS_PATH)
+
+	decorator := r.factory.CreateUrlDecorator(ctx, gql_constants.TODO_PATH+formattedSuffix, filters)
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get blocked todos, error when calling factory function")
+		return utils.InitEmptyTodoPage(), err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get blocked todos in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var todoPage models.TodoPage
+	if err = json.NewDecoder(resp.Body).Decode(&todoPage); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.tConverter.ToTodoPageGQL(&todoPage), nil
+}
+
+func (r *resolver) AddBlocker(ctx context.Context, todoID string, blockerID string) (*gql.Todo, error) {
+	log.C(ctx).Infof("adding todo with id %s as a blocker of todo with id %s in todo resolver", blockerID, todoID)
+
+	formattedSuffix := fmt.Sprintf("/%s%s", todoID, gql_constants.BLOCKERS_PATH)
+	url := r.restUrl + gql_constants.TODO_PATH + formattedSuffix
+
+	jsonBody, err := r.jsonMarshaller.Marshal(&handler_models.AddBlocker{BlockerId: blockerID})
+	if err != nil {
+		log.C(ctx).Errorf("failed to JSON marshal add blocker handler model %s", err.Error())
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to add blocker in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	return r.Todo(ctx, todoID)
+}
+
+func (r *resolver) RemoveBlocker(ctx context.Context, todoID string, blockerID string) (*gql.Todo, error) {
+	log.C(ctx).Infof("removing blocker with id %s from todo with id %s in todo resolver", blockerID, todoID)
+
+	formattedSuffix := fmt.Sprintf("/%s%s/%s", todoID, gql_constants.BLOCKERS_PATH, blockerID)
+	url := r.restUrl + gql_constants.TODO_PATH + formattedSuffix
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to remove blocker in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	return r.Todo(ctx, todoID)
+}
+
 func (r *resolver) AssignedTo(ctx context.Context, obj *gql.Todo) (*gql.User, error) {
 	log.C(ctx).Info("getting todo assignee in todo resolver")
 
@@ -414,3 +903,182 @@ func (r *resolver) getTodos(ctx context.Context) (*gql.TodoPage, error) {
 
 	return r.tConverter.ToTodoPageGQL(&todoPage), nil
 }
+
+func (r *resolver) Comments(ctx context.Context, obj *gql.Todo, filters *url_filters.BaseFilters) (*gql.CommentPage, error) {
+	log.C(ctx).Infof("getting comments of todo with id %s in todo resolver", obj.ID)
+
+	formattedSuffix := fmt.Sprintf("/%s%s", obj.ID, gql_constants.COMMENTS_PATH)
+
+	decorator := r.factory.CreateUrlDecorator(ctx, gql_constants.TODO_PATH+formattedSuffix, filters)
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get todo comments, error when calling factory function")
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get comments in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var commentPage models.CommentPage
+	if err = json.NewDecoder(resp.Body).Decode(&commentPage); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.commentConverter.ToCommentPageGQL(&commentPage), nil
+}
+
+func (r *resolver) History(ctx context.Context, obj *gql.Todo, filters *url_filters.BaseFilters) (*gql.HistoryPage, error) {
+	log.C(ctx).Infof("getting history of todo with id %s in todo resolver", obj.ID)
+
+	formattedSuffix := fmt.Sprintf("/%s%s", obj.ID, gql_constants.HISTORY_PATH)
+
+	decorator := r.factory.CreateUrlDecorator(ctx, gql_constants.TODO_PATH+formattedSuffix, filters)
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get todo history, error when calling factory function")
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get history in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var historyPage models.HistoryPage
+	if err = json.NewDecoder(resp.Body).Decode(&historyPage); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.historyConverter.ToHistoryPageGQL(&historyPage), nil
+}
+
+func (r *resolver) MoveTodos(ctx context.Context, ids []string, listID string) ([]*gql.Todo, error) {
+	log.C(ctx).Infof("moving todos to list with id %s in todo resolver", listID)
+
+	return r.transferTodos(ctx, gql_constants.MOVE_PATH, ids, listID)
+}
+
+func (r *resolver) CopyTodos(ctx context.Context, ids []string, listID string) ([]*gql.Todo, error) {
+	log.C(ctx).Infof("copying todos to list with id %s in todo resolver", listID)
+
+	return r.transferTodos(ctx, gql_constants.COPY_PATH, ids, listID)
+}
+
+func (r *resolver) transferTodos(ctx context.Context, path string, ids []string, listID string) ([]*gql.Todo, error) {
+	url := r.restUrl + gql_constants.TODO_PATH + path
+
+	jsonBody, err := r.jsonMarshaller.Marshal(&handler_models.TransferTodos{TodoIds: ids, ListId: listID})
+	if err != nil {
+		log.C(ctx).Errorf("failed to JSON marshal transfer todos handler model %s", err.Error())
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to transfer todos in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var todos []*models.Todo
+	if err = json.NewDecoder(resp.Body).Decode(&todos); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.tConverter.ManyToGQL(todos), nil
+}
+
+func (r *resolver) ReorderTodo(ctx context.Context, id string, before *string, after *string) (*gql.Todo, error) {
+	log.C(ctx).Infof("reordering todo with id %s in todo resolver", id)
+
+	url := r.restUrl + gql_constants.TODO_PATH + fmt.Sprintf("/%s%s", id, gql_constants.REORDER_PATH)
+
+	jsonBody, err := r.jsonMarshaller.Marshal(&handler_models.ReorderTodo{Before: before, After: after})
+	if err != nil {
+		log.C(ctx).Errorf("failed to JSON marshal reorder todo handler model %s", err.Error())
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.C(ctx).Debugf("http status not found received...")
+		return nil, nil
+	}
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to reorder todo in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var todo models.Todo
+	if err = json.NewDecoder(resp.Body).Decode(&todo); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.tConverter.ToGQL(&todo), nil
+}
+
+func (r *resolver) BatchUpdateTodos(ctx context.Context, input gql.BatchTodosInput) (*gql.BatchTodosPayload, error) {
+	log.C(ctx).Infof("executing batch of %d todo operations in todo resolver", len(input.Operations))
+
+	url := r.restUrl + gql_constants.TODO_PATH + gql_constants.BATCH_PATH
+
+	jsonBody, err := r.jsonMarshaller.Marshal(r.tConverter.BatchTodosInputToModel(&input))
+	if err != nil {
+		log.C(ctx).Errorf("failed to JSON marshal batch todos handler model %s", err.Error())
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in todo resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to execute batch of todo operations in todo resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var batchResult models.BatchTodosResult
+	if err = json.NewDecoder(resp.Body).Decode(&batchResult); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.tConverter.ToBatchTodosPayloadGQL(&batchResult), nil
+}
@@ -0,0 +1,126 @@
+package trash
+
+import (
+	gql "Todo-List/internProject/graphQL_service/graph/model"
+	"Todo-List/internProject/graphQL_service/graph/utils"
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type httpService interface {
+	GetHttpResponseWithAuthHeader(ctx context.Context, httpMethod string, url string, body io.Reader) (*http.Response, error)
+}
+
+type trashConverter interface {
+	ToTrashGQL(trash *models.Trash) *gql.Trash
+}
+
+type todoConverter interface {
+	ToGQL(todo *models.Todo) *gql.Todo
+}
+
+type listConverter interface {
+	ToGQL(list *models.List) *gql.List
+}
+
+type resolver struct {
+	converter   trashConverter
+	tConverter  todoConverter
+	lConverter  listConverter
+	restUrl     string
+	httpService httpService
+}
+
+func NewResolver(converter trashConverter, tConverter todoConverter, lConverter listConverter, restUrl string, httpService httpService) *resolver {
+	return &resolver{
+		converter:   converter,
+		tConverter:  tConverter,
+		lConverter:  lConverter,
+		restUrl:     restUrl,
+		httpService: httpService,
+	}
+}
+
+func (r *resolver) Trash(ctx context.Context) (*gql.Trash, error) {
+	log.C(ctx).Info("getting trash in trash resolver")
+
+	url := r.restUrl + gql_constants.TRASH_PATH
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in trash resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get trash in trash resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var trash models.Trash
+	if err = json.NewDecoder(resp.Body).Decode(&trash); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.converter.ToTrashGQL(&trash), nil
+}
+
+func (r *resolver) RestoreTodo(ctx context.Context, id string) (*gql.Todo, error) {
+	log.C(ctx).Infof("restoring todo with id %s in trash resolver", id)
+
+	url := r.restUrl + gql_constants.TODO_PATH + fmt.Sprintf("/%s%s", id, gql_constants.RESTORE_PATH)
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in trash resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to restore todo in trash resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var todo models.Todo
+	if err = json.NewDecoder(resp.Body).Decode(&todo); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.tConverter.ToGQL(&todo), nil
+}
+
+func (r *resolver) RestoreList(ctx context.Context, id string) (*gql.List, error) {
+	log.C(ctx).Infof("restoring list with id %s in trash resolver", id)
+
+	url := r.restUrl + gql_constants.LISTS_PATH + fmt.Sprintf("/%s%s", id, gql_constants.RESTORE_PATH)
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in trash resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to restore list in trash resolver, error %s due to bad response status code", err.Error())
+		return nil, err
+	}
+
+	var list models.List
+	if err = json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		log.C(ctx).Errorf("failed to decode http response body, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.lConverter.ToGQL(&list), nil
+}
@@ -43,23 +43,31 @@ type listConverter interface {
 	ToListPageGQL(listPage *models.ListPage) *gql.ListPage
 }
 
+//go:generate mockery --name=labelConverter --exported --output=./mocks --outpkg=mocks --filename=label_converter.go --with-expecter=true
+type labelConverter interface {
+	ToLabelPageGQL(labelPage *models.LabelPage) *gql.LabelPage
+}
+
 type resolver struct {
-	uConverter  userConverter
-	lConverter  listConverter
-	tConverter  todoConverter
-	factory     urlDecoratorFactory
-	restUrl     string
-	httpService httpService
+	uConverter     userConverter
+	lConverter     listConverter
+	tConverter     todoConverter
+	labelConverter labelConverter
+	factory        urlDecoratorFactory
+	restUrl        string
+	httpService    httpService
 }
 
-func NewResolver(uConverter userConverter, lConverter listConverter, tConverter todoConverter, restUrl string, factory urlDecoratorFactory, httpService httpService) *resolver {
+func NewResolver(uConverter userConverter, lConverter listConverter, tConverter todoConverter, labelConverter labelConverter,
+	restUrl string, factory urlDecoratorFactory, httpService httpService) *resolver {
 	return &resolver{
-		uConverter:  uConverter,
-		lConverter:  lConverter,
-		tConverter:  tConverter,
-		restUrl:     restUrl,
-		factory:     factory,
-		httpService: httpService,
+		uConverter:     uConverter,
+		lConverter:     lConverter,
+		tConverter:     tConverter,
+		labelConverter: labelConverter,
+		restUrl:        restUrl,
+		factory:        factory,
+		httpService:    httpService,
 	}
 }
 
@@ -127,7 +135,7 @@ func (r *resolver) User(ctx context.Context, id string) (*gql.User, error) {
 	return r.uConverter.ToGQL(&user), nil
 }
 
-func (r *resolver) DeleteUser(ctx context.Context, id string) (*gql.DeleteUserPayload, error) {
+func (r *resolver) DeleteUser(ctx context.Context, id string, reassignListsTo *string) (*gql.DeleteUserPayload, error) {
 	log.C(ctx).Infof("deleting user with id %s in user resolver", id)
 
 	gqlUser, err := r.User(ctx, id)
@@ -146,7 +154,18 @@ func (r *resolver) DeleteUser(ctx context.Context, id string) (*gql.DeleteUserPa
 	}
 
 	formattedSuffix := fmt.Sprintf("/%s", id)
-	url := r.restUrl + gql_constants.USER_PATH + formattedSuffix
+	decorator := url_decorators.NewBaseUrl(gql_constants.USER_PATH + formattedSuffix)
+	if reassignListsTo != nil {
+		decorator = url_decorators.NewCriteriaDecorator(decorator, gql_constants.REASSIGN_LISTS_TO, *reassignListsTo)
+	}
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Errorf("failed to determine correct query params in user resolver, error %s", err.Error())
+		return &gql.DeleteUserPayload{
+			Success: false,
+		}, err
+	}
 
 	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodDelete, url, nil)
 	if err != nil {
@@ -265,6 +284,40 @@ func (r *resolver) Owns(ctx context.Context, obj *gql.User, filters *url_filters
 	return r.lConverter.ToListPageGQL(&listPage), nil
 }
 
+func (r *resolver) Labels(ctx context.Context, obj *gql.User, filters *url_filters.BaseFilters) (*gql.LabelPage, error) {
+	log.C(ctx).Infof("getting labels of user with id %s", obj.ID)
+
+	formattedSuffix := fmt.Sprintf("/%s", obj.ID)
+
+	decorator := r.factory.CreateUrlDecorator(ctx, gql_constants.USER_PATH+formattedSuffix+gql_constants.LABELS_PATH, filters)
+
+	url, err := decorator.DetermineCorrectQueryParams(ctx, r.restUrl)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get labels of user with id %s, error when trying to build url", obj.ID)
+		return nil, err
+	}
+
+	resp, err := r.httpService.GetHttpResponseWithAuthHeader(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get http response in user resolver, error %s", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = utils.HandleHttpCode(resp.StatusCode); err != nil {
+		log.C(ctx).Errorf("failed to get labels of user, error %s", err.Error())
+		return nil, err
+	}
+
+	var labelPage models.LabelPage
+	if err = json.NewDecoder(resp.Body).Decode(&labelPage); err != nil {
+		log.C(ctx).Errorf("failed to get labels of user with id %s, error when trying to decode JSON", obj.ID)
+		return nil, err
+	}
+
+	return r.labelConverter.ToLabelPageGQL(&labelPage), nil
+}
+
 func (r *resolver) DeleteUsers(ctx context.Context) ([]*gql.DeleteUserPayload, error) {
 	log.C(ctx).Info("deleting all users in user resolver")
 
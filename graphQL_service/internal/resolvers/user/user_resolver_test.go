@@ -172,7 +172,7 @@ func TestResolver_Users(t *testing.T) {
 				factoryMock = test.urlDecoratorFactoryMock()
 			}
 
-			userResolver := NewResolver(mockUserConverter, nil, nil, url, factoryMock, mockHttpService)
+			userResolver := NewResolver(mockUserConverter, nil, nil, nil, url, factoryMock, mockHttpService)
 
 			receivedUserPage, err := userResolver.Users(context.TODO(), test.filters)
 			if test.err != nil {
@@ -299,7 +299,7 @@ func TestResolver_User(t *testing.T) {
 				userConverterMock = test.userConverterMock()
 			}
 
-			userResolver := NewResolver(userConverterMock, nil, nil, url, nil, httpServiceMock)
+			userResolver := NewResolver(userConverterMock, nil, nil, nil, url, nil, httpServiceMock)
 
 			receivedUser, err := userResolver.User(context.TODO(), test.id)
 			if test.err != nil {
@@ -477,9 +477,9 @@ func TestResolver_DeleteUser(t *testing.T) {
 				userConverterMock = test.userConverterMock()
 			}
 
-			userResolver := NewResolver(userConverterMock, nil, nil, url, nil, httpServiceMock)
+			userResolver := NewResolver(userConverterMock, nil, nil, nil, url, nil, httpServiceMock)
 
-			receivedDeleteUserPayload, err := userResolver.DeleteUser(context.TODO(), test.id)
+			receivedDeleteUserPayload, err := userResolver.DeleteUser(context.TODO(), test.id, nil)
 			if test.err != nil {
 				require.EqualError(t, err, test.err.Error())
 			} else {
@@ -711,7 +711,7 @@ func TestResolver_AssignedTo(t *testing.T) {
 				todoConverterMock = test.todoConverterMock()
 			}
 
-			userResolver := NewResolver(nil, nil, todoConverterMock, "", decoratorFactoryMock, httpServiceMock)
+			userResolver := NewResolver(nil, nil, todoConverterMock, nil, "", decoratorFactoryMock, httpServiceMock)
 
 			receivedTodoPage, err := userResolver.AssignedTo(context.TODO(), test.obj, test.filters)
 			if test.err != nil {
@@ -943,7 +943,7 @@ func TestResolver_ParticipateIn(t *testing.T) {
 				listConverterMock = test.listConverterMock()
 			}
 
-			userResolver := NewResolver(nil, listConverterMock, nil, "", decoratorFactoryMock, httpServiceMock)
+			userResolver := NewResolver(nil, listConverterMock, nil, nil, "", decoratorFactoryMock, httpServiceMock)
 
 			receivedListPage, err := userResolver.ParticipateIn(context.TODO(), test.obj, test.filters)
 			if test.err != nil {
@@ -1061,7 +1061,7 @@ func TestResolver_DeleteUsers(t *testing.T) {
 				mockUserConverter = test.userConverterMock()
 			}
 
-			userResolver := NewResolver(mockUserConverter, nil, nil, url, nil, mockHttpService)
+			userResolver := NewResolver(mockUserConverter, nil, nil, nil, url, nil, mockHttpService)
 			receivedDeleteUsersPayload, err := userResolver.DeleteUsers(context.TODO())
 			if test.err != nil {
 				require.EqualError(t, err, test.err.Error())
@@ -0,0 +1,26 @@
+package url_decorators_creators
+
+import (
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
+	"Todo-List/internProject/graphQL_service/internal/url_decorators"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"context"
+)
+
+func init() {
+	url_decorators.GetUrlDecoratorFactoryInstance().Register(&excludeSubtasksCreator{})
+}
+
+type excludeSubtasksCreator struct{}
+
+func (*excludeSubtasksCreator) Create(ctx context.Context, inner url_decorators.QueryParamsRetrievers, uFilters url_decorators.UrlFilters) url_decorators.QueryParamsRetrievers {
+	log.C(ctx).Info("creating exclude subtasks url decorator in exclude subtasks creator")
+
+	excludeSubtasks, containsExcludeSubtasks := uFilters.GetFilters()[gql_constants.EXCLUDE_SUBTASKS]
+	if containsExcludeSubtasks && excludeSubtasks != nil {
+		log.C(ctx).Info("successfully creating exclude subtasks url decorator in exclude subtasks creator")
+		inner = url_decorators.NewCriteriaDecorator(inner, gql_constants.EXCLUDE_SUBTASKS, *excludeSubtasks)
+	}
+
+	return inner
+}
@@ -0,0 +1,26 @@
+package url_decorators_creators
+
+import (
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
+	"Todo-List/internProject/graphQL_service/internal/url_decorators"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"context"
+)
+
+func init() {
+	url_decorators.GetUrlDecoratorFactoryInstance().Register(&labelCreator{})
+}
+
+type labelCreator struct{}
+
+func (*labelCreator) Create(ctx context.Context, inner url_decorators.QueryParamsRetrievers, uFilters url_decorators.UrlFilters) url_decorators.QueryParamsRetrievers {
+	log.C(ctx).Info("creating label url decorator in label creator")
+
+	label, containsLabel := uFilters.GetFilters()[gql_constants.LABEL]
+	if containsLabel && label != nil {
+		log.C(ctx).Info("successfully creating label url decorator in label creator")
+		inner = url_decorators.NewCriteriaDecorator(inner, gql_constants.LABEL, *label)
+	}
+
+	return inner
+}
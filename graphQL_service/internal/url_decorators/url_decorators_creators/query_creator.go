@@ -0,0 +1,26 @@
+package url_decorators_creators
+
+import (
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
+	"Todo-List/internProject/graphQL_service/internal/url_decorators"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"context"
+)
+
+func init() {
+	url_decorators.GetUrlDecoratorFactoryInstance().Register(&queryCreator{})
+}
+
+type queryCreator struct{}
+
+func (*queryCreator) Create(ctx context.Context, inner url_decorators.QueryParamsRetrievers, uFilters url_decorators.UrlFilters) url_decorators.QueryParamsRetrievers {
+	log.C(ctx).Info("creating search query url decorator in query creator")
+
+	query, containsQuery := uFilters.GetFilters()[gql_constants.QUERY]
+	if containsQuery && query != nil {
+		log.C(ctx).Info("successfully creating search query url decorator in query creator")
+		inner = url_decorators.NewCriteriaDecorator(inner, gql_constants.QUERY, *query)
+	}
+
+	return inner
+}
@@ -0,0 +1,26 @@
+package url_decorators_creators
+
+import (
+	"Todo-List/internProject/graphQL_service/internal/gql_constants"
+	"Todo-List/internProject/graphQL_service/internal/url_decorators"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"context"
+)
+
+func init() {
+	url_decorators.GetUrlDecoratorFactoryInstance().Register(&sortCreator{})
+}
+
+type sortCreator struct{}
+
+func (*sortCreator) Create(ctx context.Context, inner url_decorators.QueryParamsRetrievers, uFilters url_decorators.UrlFilters) url_decorators.QueryParamsRetrievers {
+	log.C(ctx).Info("creating sort url decorator in sort creator")
+
+	sort, containsSort := uFilters.GetFilters()[gql_constants.SORT]
+	if containsSort && sort != nil && len(*sort) != 0 {
+		log.C(ctx).Info("successfully creating sort url decorator in sort creator")
+		inner = url_decorators.NewCriteriaDecorator(inner, gql_constants.SORT, *sort)
+	}
+
+	return inner
+}
@@ -4,6 +4,7 @@ import (
 	gql "Todo-List/internProject/graphQL_service/graph/model"
 	"Todo-List/internProject/graphQL_service/internal/gql_constants"
 	"bytes"
+	"strconv"
 )
 
 type statusConverter interface {
@@ -18,6 +19,11 @@ type overdueConverter interface {
 	ToStringType(overdue *gql.TodoType) string
 }
 
+type sortConverter interface {
+	ToStringTodoSort(order *gql.TodoOrder) string
+	ToStringListSort(order *gql.ListOrder) string
+}
+
 type BaseFilters struct {
 	First  *string
 	After  *string
@@ -37,18 +43,23 @@ func (b *BaseFilters) GetFilters() map[string]*string {
 type TodoFilters struct {
 	BaseFilters
 	TodoFilters       *gql.TodosFilterInput
+	TodoOrder         *gql.TodoOrder
 	statusConverter   statusConverter
 	priorityConverter priorityConverter
 	overdueConverter  overdueConverter
+	sortConverter     sortConverter
 }
 
-func NewTodoFilters(b BaseFilters, tFilters *gql.TodosFilterInput, statusConverter statusConverter, priorityConverter priorityConverter, overdueConverter overdueConverter) *TodoFilters {
+func NewTodoFilters(b BaseFilters, tFilters *gql.TodosFilterInput, tOrder *gql.TodoOrder, statusConverter statusConverter,
+	priorityConverter priorityConverter, overdueConverter overdueConverter, sortConverter sortConverter) *TodoFilters {
 	return &TodoFilters{
 		BaseFilters:       b,
 		TodoFilters:       tFilters,
+		TodoOrder:         tOrder,
 		statusConverter:   statusConverter,
 		priorityConverter: priorityConverter,
 		overdueConverter:  overdueConverter,
+		sortConverter:     sortConverter,
 	}
 }
 
@@ -57,6 +68,13 @@ func (t *TodoFilters) GetFilters() map[string]*string {
 	var convertedPriority string
 	var convertedType string
 	var name string
+	var excludeSubtasks string
+	var label string
+	var sort string
+
+	if t.TodoOrder != nil {
+		sort = t.sortConverter.ToStringTodoSort(t.TodoOrder)
+	}
 
 	if t.TodoFilters != nil {
 		convertedStatus = t.statusConverter.ToStringStatus(t.TodoFilters.Status)
@@ -66,29 +84,44 @@ func (t *TodoFilters) GetFilters() map[string]*string {
 		if t.TodoFilters.Name != nil {
 			name = *t.TodoFilters.Name
 		}
+
+		if t.TodoFilters.ExcludeSubtasks != nil {
+			excludeSubtasks = strconv.FormatBool(*t.TodoFilters.ExcludeSubtasks)
+		}
+
+		if t.TodoFilters.Label != nil {
+			label = *t.TodoFilters.Label
+		}
 	}
 
 	return map[string]*string{
-		gql_constants.FIRST:    t.First,
-		gql_constants.AFTER:    t.After,
-		gql_constants.LAST:     t.Last,
-		gql_constants.BEFORE:   t.Before,
-		gql_constants.PRIORITY: &convertedPriority,
-		gql_constants.STATUS:   &convertedStatus,
-		gql_constants.TYPE:     &convertedType,
-		gql_constants.NAME:     &name,
+		gql_constants.FIRST:            t.First,
+		gql_constants.AFTER:            t.After,
+		gql_constants.LAST:             t.Last,
+		gql_constants.BEFORE:           t.Before,
+		gql_constants.PRIORITY:         &convertedPriority,
+		gql_constants.STATUS:           &convertedStatus,
+		gql_constants.TYPE:             &convertedType,
+		gql_constants.NAME:             &name,
+		gql_constants.EXCLUDE_SUBTASKS: &excludeSubtasks,
+		gql_constants.LABEL:            &label,
+		gql_constants.SORT:             &sort,
 	}
 }
 
 type ListFilters struct {
 	BaseFilters
-	ListFilters *gql.ListFilterInput
+	ListFilters   *gql.ListFilterInput
+	ListOrder     *gql.ListOrder
+	sortConverter sortConverter
 }
 
-func NewListFilters(b BaseFilters, lFilters *gql.ListFilterInput) *ListFilters {
+func NewListFilters(b BaseFilters, lFilters *gql.ListFilterInput, lOrder *gql.ListOrder, sortConverter sortConverter) *ListFilters {
 	return &ListFilters{
-		BaseFilters: b,
-		ListFilters: lFilters,
+		BaseFilters:   b,
+		ListFilters:   lFilters,
+		ListOrder:     lOrder,
+		sortConverter: sortConverter,
 	}
 }
 
@@ -100,12 +133,18 @@ func (u *ListFilters) GetFilters() map[string]*string {
 		}
 	}
 
+	var sort string
+	if u.ListOrder != nil {
+		sort = u.sortConverter.ToStringListSort(u.ListOrder)
+	}
+
 	return map[string]*string{
 		gql_constants.FIRST:  u.First,
 		gql_constants.AFTER:  u.After,
 		gql_constants.LAST:   u.Last,
 		gql_constants.BEFORE: u.Before,
 		gql_constants.NAME:   &name,
+		gql_constants.SORT:   &sort,
 	}
 }
 
@@ -138,6 +177,26 @@ func extractUserRole(filters *gql.UserRoleFilter) *string {
 	return role
 }
 
+type SearchFilters struct {
+	BaseFilters
+	Query string
+}
+
+func NewSearchFilters(b BaseFilters, query string) *SearchFilters {
+	return &SearchFilters{
+		BaseFilters: b,
+		Query:       query,
+	}
+}
+
+func (s *SearchFilters) GetFilters() map[string]*string {
+	return map[string]*string{
+		gql_constants.FIRST: s.First,
+		gql_constants.AFTER: s.After,
+		gql_constants.QUERY: &s.Query,
+	}
+}
+
 func fromStringPointerToLowerStringPointer(ptr *string) *string {
 	value := bytes.ToLower([]byte(*ptr))
 	stringValue := string(value)
@@ -0,0 +1,16 @@
+BEGIN;
+
+ALTER TABLE todos
+ADD COLUMN parent_id UUID REFERENCES todos(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_todos_parent_id ON todos(parent_id);
+
+CREATE OR REPLACE VIEW user_todos
+AS
+
+SELECT todos.id AS id, todos.name, todos.description, todos.list_id,
+todos.status,todos.created_at, todos.last_updated, todos.assigned_to,
+todos.due_date, todos.priority, users.id AS user_id, todos.parent_id FROM todos
+JOIN users ON todos.assigned_to = users.id;
+
+COMMIT;
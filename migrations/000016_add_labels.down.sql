@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP VIEW IF EXISTS todos_labels;
+
+DROP TABLE IF EXISTS todo_labels;
+
+DROP TABLE IF EXISTS labels;
+
+COMMIT;
@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS labels(
+    id UUID PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    owner UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    CONSTRAINT labels_owner_name_key UNIQUE (owner, name)
+);
+
+CREATE TABLE IF NOT EXISTS todo_labels(
+    todo_id UUID REFERENCES todos(id) ON DELETE CASCADE,
+    label_id UUID REFERENCES labels(id) ON DELETE CASCADE,
+    PRIMARY KEY (todo_id, label_id)
+);
+
+CREATE INDEX idx_todo_labels_label_id ON todo_labels(label_id);
+
+CREATE OR REPLACE VIEW todos_labels
+AS
+
+SELECT labels.id AS id, labels.name, labels.owner, labels.created_at, todo_labels.todo_id FROM labels
+JOIN todo_labels ON labels.id = todo_labels.label_id;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+DROP VIEW IF EXISTS user_todos;
+
+CREATE VIEW user_todos
+AS
+
+SELECT todos.id AS id, todos.name, todos.description, todos.list_id,
+todos.status,todos.created_at, todos.last_updated, todos.assigned_to,
+todos.due_date, todos.priority, users.id AS user_id, todos.parent_id FROM todos
+JOIN users ON todos.assigned_to = users.id;
+
+ALTER TABLE todos
+DROP CONSTRAINT IF EXISTS recurrence_until_or_count,
+DROP CONSTRAINT IF EXISTS recurrence_requires_due_date,
+DROP COLUMN recurrence_count,
+DROP COLUMN recurrence_until,
+DROP COLUMN recurrence_interval,
+DROP COLUMN recurrence_frequency;
+
+DROP TYPE IF EXISTS todo_recurrence_frequency;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+CREATE TYPE todo_recurrence_frequency AS ENUM('daily','weekly','monthly');
+
+ALTER TABLE todos
+ADD COLUMN recurrence_frequency todo_recurrence_frequency,
+ADD COLUMN recurrence_interval INT CHECK (recurrence_interval > 0),
+ADD COLUMN recurrence_until TIMESTAMP,
+ADD COLUMN recurrence_count INT CHECK (recurrence_count > 0),
+ADD CONSTRAINT recurrence_requires_due_date CHECK (recurrence_frequency IS NULL OR due_date IS NOT NULL),
+ADD CONSTRAINT recurrence_until_or_count CHECK (recurrence_until IS NULL OR recurrence_count IS NULL);
+
+CREATE OR REPLACE VIEW user_todos
+AS
+
+SELECT todos.id AS id, todos.name, todos.description, todos.list_id,
+todos.status,todos.created_at, todos.last_updated, todos.assigned_to,
+todos.due_date, todos.priority, users.id AS user_id, todos.parent_id,
+todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until,
+todos.recurrence_count FROM todos
+JOIN users ON todos.assigned_to = users.id;
+
+COMMIT;
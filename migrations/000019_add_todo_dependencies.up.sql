@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS todo_dependencies(
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    blocker_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    PRIMARY KEY (todo_id, blocker_id),
+    CONSTRAINT todo_dependencies_no_self_block CHECK (todo_id <> blocker_id)
+);
+
+CREATE INDEX idx_todo_dependencies_blocker_id ON todo_dependencies(blocker_id);
+
+COMMIT;
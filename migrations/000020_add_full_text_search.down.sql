@@ -0,0 +1,16 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_comments_search_vector;
+DROP INDEX IF EXISTS idx_lists_search_vector;
+DROP INDEX IF EXISTS idx_todos_search_vector;
+
+ALTER TABLE comments
+DROP COLUMN search_vector;
+
+ALTER TABLE lists
+DROP COLUMN search_vector;
+
+ALTER TABLE todos
+DROP COLUMN search_vector;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+ALTER TABLE todos
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B')
+) STORED;
+
+ALTER TABLE lists
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B')
+) STORED;
+
+ALTER TABLE comments
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(content, '')), 'C')
+) STORED;
+
+CREATE INDEX idx_todos_search_vector ON todos USING GIN(search_vector);
+CREATE INDEX idx_lists_search_vector ON lists USING GIN(search_vector);
+CREATE INDEX idx_comments_search_vector ON comments USING GIN(search_vector);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS user_lists_history ON user_lists;
+DROP TRIGGER IF EXISTS lists_history ON lists;
+DROP TRIGGER IF EXISTS todos_history ON todos;
+
+DROP FUNCTION IF EXISTS record_collaborator_history();
+DROP FUNCTION IF EXISTS record_history();
+DROP FUNCTION IF EXISTS history_actor();
+
+DROP TABLE IF EXISTS history;
+
+DROP FUNCTION IF EXISTS prevent_history_modification();
+
+COMMIT;
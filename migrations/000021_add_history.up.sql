@@ -0,0 +1,104 @@
+BEGIN;
+
+-- history is append-only and outlives the entities and users it refers to, that is why it has no foreign keys
+CREATE TABLE IF NOT EXISTS history(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type VARCHAR(10) NOT NULL,
+    entity_id UUID NOT NULL,
+    action VARCHAR(10) NOT NULL,
+    field VARCHAR(50) NOT NULL,
+    old_value TEXT,
+    new_value TEXT,
+    actor UUID,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_history_entity ON history(entity_type, entity_id, created_at);
+
+CREATE FUNCTION prevent_history_modification()
+    RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'history is append-only';
+END;
+$$
+LANGUAGE plpgsql;
+
+CREATE TRIGGER history_before_modification
+    BEFORE UPDATE OR DELETE ON history
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_history_modification();
+
+-- the actor is set by the application for the current transaction, changes made outside of a request have no actor
+CREATE FUNCTION history_actor()
+    RETURNS UUID AS $$
+BEGIN
+    RETURN NULLIF(current_setting('history.actor_id', true), '')::UUID;
+END;
+$$
+LANGUAGE plpgsql;
+
+-- records one row for every field whose value changed, TG_ARGV[0] is the type of the audited entity
+CREATE FUNCTION record_history()
+    RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB := '{}';
+    new_row JSONB := '{}';
+    entity_action VARCHAR(10) := 'update';
+    changed_at TIMESTAMP := clock_timestamp();
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        entity_action := 'create';
+    ELSE
+        old_row := jsonb_strip_nulls(to_jsonb(OLD) - 'search_vector' - 'last_updated');
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        entity_action := 'delete';
+    ELSE
+        new_row := jsonb_strip_nulls(to_jsonb(NEW) - 'search_vector' - 'last_updated');
+    END IF;
+
+    INSERT INTO history (entity_type, entity_id, action, field, old_value, new_value, actor, created_at)
+    SELECT TG_ARGV[0], COALESCE(new_row ->> 'id', old_row ->> 'id')::UUID, entity_action, changed.field,
+           old_row ->> changed.field, new_row ->> changed.field, history_actor(), changed_at
+    FROM jsonb_object_keys(old_row || new_row) AS changed(field)
+    WHERE changed.field <> 'id' AND (old_row -> changed.field) IS DISTINCT FROM (new_row -> changed.field);
+
+    RETURN NULL;
+END;
+$$
+LANGUAGE plpgsql;
+
+-- adding and removing collaborators is recorded as an update of the list
+CREATE FUNCTION record_collaborator_history()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO history (entity_type, entity_id, action, field, new_value, actor, created_at)
+        VALUES ('list', NEW.list_id, 'update', 'collaborator', NEW.user_id::TEXT, history_actor(), clock_timestamp());
+    ELSE
+        INSERT INTO history (entity_type, entity_id, action, field, old_value, actor, created_at)
+        VALUES ('list', OLD.list_id, 'update', 'collaborator', OLD.user_id::TEXT, history_actor(), clock_timestamp());
+    END IF;
+
+    RETURN NULL;
+END;
+$$
+LANGUAGE plpgsql;
+
+CREATE TRIGGER todos_history
+    AFTER INSERT OR UPDATE OR DELETE ON todos
+    FOR EACH ROW
+    EXECUTE FUNCTION record_history('todo');
+
+CREATE TRIGGER lists_history
+    AFTER INSERT OR UPDATE OR DELETE ON lists
+    FOR EACH ROW
+    EXECUTE FUNCTION record_history('list');
+
+CREATE TRIGGER user_lists_history
+    AFTER INSERT OR DELETE ON user_lists
+    FOR EACH ROW
+    EXECUTE FUNCTION record_collaborator_history();
+
+COMMIT;
@@ -0,0 +1,78 @@
+BEGIN;
+
+CREATE OR REPLACE FUNCTION record_history()
+    RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB := '{}';
+    new_row JSONB := '{}';
+    entity_action VARCHAR(10) := 'update';
+    changed_at TIMESTAMP := clock_timestamp();
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        entity_action := 'create';
+    ELSE
+        old_row := jsonb_strip_nulls(to_jsonb(OLD) - 'search_vector' - 'last_updated');
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        entity_action := 'delete';
+    ELSE
+        new_row := jsonb_strip_nulls(to_jsonb(NEW) - 'search_vector' - 'last_updated');
+    END IF;
+
+    INSERT INTO history (entity_type, entity_id, action, field, old_value, new_value, actor, created_at)
+    SELECT TG_ARGV[0], COALESCE(new_row ->> 'id', old_row ->> 'id')::UUID, entity_action, changed.field,
+           old_row ->> changed.field, new_row ->> changed.field, history_actor(), changed_at
+    FROM jsonb_object_keys(old_row || new_row) AS changed(field)
+    WHERE changed.field <> 'id' AND (old_row -> changed.field) IS DISTINCT FROM (new_row -> changed.field);
+
+    RETURN NULL;
+END;
+$$
+LANGUAGE plpgsql;
+
+DROP VIEW IF EXISTS lists_collaborators;
+DROP VIEW IF EXISTS lists_and_users;
+DROP VIEW IF EXISTS user_todos;
+
+-- the records that are still in the trash can't be kept once the trash is gone
+DELETE FROM todos WHERE deleted_at IS NOT NULL;
+DELETE FROM lists WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_todos_deleted_at;
+DROP INDEX IF EXISTS idx_lists_deleted_at;
+DROP INDEX IF EXISTS lists_name_key;
+
+ALTER TABLE lists ADD CONSTRAINT lists_name_key UNIQUE (name);
+
+ALTER TABLE todos
+DROP COLUMN deleted_by,
+DROP COLUMN deleted_at;
+
+ALTER TABLE lists
+DROP COLUMN deleted_by,
+DROP COLUMN deleted_at;
+
+CREATE VIEW user_todos
+AS
+
+SELECT todos.id AS id, todos.name, todos.description, todos.list_id,
+todos.status,todos.created_at, todos.last_updated, todos.assigned_to,
+todos.due_date, todos.priority, users.id AS user_id, todos.parent_id,
+todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until,
+todos.recurrence_count FROM todos
+JOIN users ON todos.assigned_to = users.id;
+
+CREATE VIEW lists_and_users AS
+
+SELECT  lists.id AS id, name, created_at, last_updated, owner, description, user_lists.user_id AS user_id
+FROM lists
+LEFT JOIN user_lists ON lists.id = user_lists.list_id;
+
+CREATE VIEW lists_collaborators
+AS
+
+SELECT users.id AS id,users.email,users.role,list_id FROM users
+JOIN user_lists ON users.id = user_lists.user_id;
+
+COMMIT;
@@ -0,0 +1,88 @@
+BEGIN;
+
+ALTER TABLE lists
+ADD COLUMN deleted_at TIMESTAMP,
+ADD COLUMN deleted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE todos
+ADD COLUMN deleted_at TIMESTAMP,
+ADD COLUMN deleted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- a deleted list keeps its name until it is purged, so only the names of the live lists have to be unique
+ALTER TABLE lists DROP CONSTRAINT IF EXISTS lists_name_key;
+CREATE UNIQUE INDEX lists_name_key ON lists(name) WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_lists_deleted_at ON lists(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_todos_deleted_at ON todos(deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE OR REPLACE VIEW user_todos
+AS
+
+SELECT todos.id AS id, todos.name, todos.description, todos.list_id,
+todos.status,todos.created_at, todos.last_updated, todos.assigned_to,
+todos.due_date, todos.priority, users.id AS user_id, todos.parent_id,
+todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until,
+todos.recurrence_count, todos.deleted_at FROM todos
+JOIN users ON todos.assigned_to = users.id
+WHERE todos.deleted_at IS NULL;
+
+CREATE OR REPLACE VIEW lists_and_users AS
+
+SELECT  lists.id AS id, name, created_at, last_updated, owner, description, user_lists.user_id AS user_id, deleted_at
+FROM lists
+LEFT JOIN user_lists ON lists.id = user_lists.list_id
+WHERE lists.deleted_at IS NULL;
+
+CREATE OR REPLACE VIEW lists_collaborators
+AS
+
+SELECT users.id AS id,users.email,users.role,list_id FROM users
+JOIN user_lists ON users.id = user_lists.user_id
+JOIN lists ON lists.id = user_lists.list_id
+WHERE lists.deleted_at IS NULL;
+
+-- moving a record to the trash and restoring it are recorded as their own actions,
+-- purging a record that is already in the trash is not recorded again
+CREATE OR REPLACE FUNCTION record_history()
+    RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB := '{}';
+    new_row JSONB := '{}';
+    entity_action VARCHAR(10) := 'update';
+    changed_at TIMESTAMP := clock_timestamp();
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        entity_action := 'create';
+    ELSE
+        old_row := jsonb_strip_nulls(to_jsonb(OLD) - 'search_vector' - 'last_updated');
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        IF OLD.deleted_at IS NOT NULL THEN
+            RETURN NULL;
+        END IF;
+        entity_action := 'delete';
+    ELSE
+        new_row := jsonb_strip_nulls(to_jsonb(NEW) - 'search_vector' - 'last_updated');
+    END IF;
+
+    IF TG_OP = 'UPDATE' THEN
+        IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+            entity_action := 'delete';
+        ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+            entity_action := 'restore';
+        END IF;
+    END IF;
+
+    INSERT INTO history (entity_type, entity_id, action, field, old_value, new_value, actor, created_at)
+    SELECT TG_ARGV[0], COALESCE(new_row ->> 'id', old_row ->> 'id')::UUID, entity_action, changed.field,
+           old_row ->> changed.field, new_row ->> changed.field, history_actor(), changed_at
+    FROM jsonb_object_keys(old_row || new_row) AS changed(field)
+    WHERE changed.field <> 'id' AND (old_row -> changed.field) IS DISTINCT FROM (new_row -> changed.field);
+
+    RETURN NULL;
+END;
+$$
+LANGUAGE plpgsql;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+DROP VIEW IF EXISTS lists_collaborators;
+
+CREATE VIEW lists_collaborators
+AS
+
+SELECT users.id AS id,users.email,users.role,list_id FROM users
+JOIN user_lists ON users.id = user_lists.user_id
+JOIN lists ON lists.id = user_lists.list_id
+WHERE lists.deleted_at IS NULL;
+
+ALTER TABLE user_lists DROP COLUMN IF EXISTS role;
+
+DROP TYPE IF EXISTS COLLABORATOR_ROLE;
+
+COMMIT;
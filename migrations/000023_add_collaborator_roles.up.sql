@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TYPE COLLABORATOR_ROLE AS ENUM ('viewer','editor','manager');
+
+-- the existing collaborators keep being able to work on the todos of their lists
+ALTER TABLE user_lists
+ADD COLUMN role COLLABORATOR_ROLE NOT NULL DEFAULT 'editor';
+
+CREATE OR REPLACE VIEW lists_collaborators
+AS
+
+SELECT users.id AS id,users.email,users.role,list_id,user_lists.role AS list_role FROM users
+JOIN user_lists ON users.id = user_lists.user_id
+JOIN lists ON lists.id = user_lists.list_id
+WHERE lists.deleted_at IS NULL;
+
+COMMIT;
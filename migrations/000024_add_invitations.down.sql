@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS invitations;
+
+DROP TYPE IF EXISTS INVITATION_STATUS;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+CREATE TYPE INVITATION_STATUS AS ENUM ('pending','accepted','declined','revoked');
+
+-- the invited email does not have to belong to a registered user,
+-- the invitation is honoured when the user logs in for the first time
+CREATE TABLE IF NOT EXISTS invitations(
+    id UUID PRIMARY KEY,
+    list_id UUID NOT NULL REFERENCES lists(id) ON DELETE CASCADE,
+    email VARCHAR(150) NOT NULL,
+    role COLLABORATOR_ROLE NOT NULL DEFAULT 'editor',
+    invited_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    token TEXT NOT NULL UNIQUE,
+    status INVITATION_STATUS NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+-- a list can have only one pending invitation for a certain email
+CREATE UNIQUE INDEX idx_invitations_pending_list_email ON invitations(list_id, LOWER(email)) WHERE status = 'pending';
+CREATE INDEX idx_invitations_email ON invitations(LOWER(email));
+
+COMMIT;
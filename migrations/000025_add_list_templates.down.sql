@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS template_todos;
+
+DROP TABLE IF EXISTS list_templates;
+
+COMMIT;
@@ -0,0 +1,31 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS list_templates(
+    id UUID PRIMARY KEY,
+    name VARCHAR(250) NOT NULL,
+    description VARCHAR(250) NOT NULL DEFAULT '',
+    owner UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL
+);
+
+-- the due date of a template todo is kept as the number of seconds after the start date the template is instantiated with,
+-- the position keeps the parents before their subtasks so that the todos can be created in that order
+CREATE TABLE IF NOT EXISTS template_todos(
+    id UUID PRIMARY KEY,
+    template_id UUID NOT NULL REFERENCES list_templates(id) ON DELETE CASCADE,
+    name VARCHAR(150) NOT NULL,
+    description VARCHAR(250) NOT NULL,
+    priority todo_priority NOT NULL DEFAULT 'low',
+    due_offset BIGINT CHECK (due_offset > 0),
+    parent_id UUID REFERENCES template_todos(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    recurrence_frequency todo_recurrence_frequency,
+    recurrence_interval INT CHECK (recurrence_interval > 0),
+    recurrence_count INT CHECK (recurrence_count > 0),
+    CONSTRAINT template_recurrence_requires_due_offset CHECK (recurrence_frequency IS NULL OR due_offset IS NOT NULL)
+);
+
+CREATE INDEX idx_list_templates_owner ON list_templates(owner);
+CREATE INDEX idx_template_todos_template_id ON template_todos(template_id);
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+DROP VIEW IF EXISTS user_todos;
+
+CREATE VIEW user_todos
+AS
+
+SELECT todos.id AS id, todos.name, todos.description, todos.list_id,
+todos.status,todos.created_at, todos.last_updated, todos.assigned_to,
+todos.due_date, todos.priority, users.id AS user_id, todos.parent_id,
+todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until,
+todos.recurrence_count, todos.deleted_at FROM todos
+JOIN users ON todos.assigned_to = users.id
+WHERE todos.deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_todos_list_id_position;
+
+ALTER TABLE todos DROP COLUMN position;
+
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+
+-- the position is a fractional rank, placing a todo between two others only changes the position of the placed todo,
+-- the existing todos are ranked in the order they were created without touching their last_updated or their history
+ALTER TABLE todos ADD COLUMN position NUMERIC;
+
+ALTER TABLE todos DISABLE TRIGGER USER;
+
+UPDATE todos SET position = ranked.rank
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY list_id ORDER BY created_at, id) AS rank FROM todos) AS ranked
+WHERE todos.id = ranked.id;
+
+ALTER TABLE todos ENABLE TRIGGER USER;
+
+ALTER TABLE todos ALTER COLUMN position SET NOT NULL;
+
+CREATE INDEX idx_todos_list_id_position ON todos(list_id, position);
+
+CREATE OR REPLACE VIEW user_todos
+AS
+
+SELECT todos.id AS id, todos.name, todos.description, todos.list_id,
+todos.status,todos.created_at, todos.last_updated, todos.assigned_to,
+todos.due_date, todos.priority, users.id AS user_id, todos.parent_id,
+todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until,
+todos.recurrence_count, todos.deleted_at, todos.position FROM todos
+JOIN users ON todos.assigned_to = users.id
+WHERE todos.deleted_at IS NULL;
+
+COMMIT;
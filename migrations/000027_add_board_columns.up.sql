@@ -0,0 +1,33 @@
+BEGIN;
+
+-- the workflow columns of a list, every column is mapped onto one of the base statuses and the columns of a status
+-- are ordered by their position, a todo without a column belongs to the first column of its status
+CREATE TABLE IF NOT EXISTS list_columns(
+    id UUID PRIMARY KEY,
+    list_id UUID NOT NULL REFERENCES lists(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    status STATUS NOT NULL,
+    wip_limit INT CHECK (wip_limit > 0),
+    position INT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    CONSTRAINT list_columns_name_key UNIQUE (list_id, name)
+);
+
+CREATE INDEX idx_list_columns_list_id ON list_columns(list_id);
+
+ALTER TABLE todos ADD COLUMN column_id UUID REFERENCES list_columns(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_todos_column_id ON todos(column_id);
+
+CREATE OR REPLACE VIEW user_todos
+AS
+
+SELECT todos.id AS id, todos.name, todos.description, todos.list_id,
+todos.status,todos.created_at, todos.last_updated, todos.assigned_to,
+todos.due_date, todos.priority, users.id AS user_id, todos.parent_id,
+todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until,
+todos.recurrence_count, todos.deleted_at, todos.position, todos.column_id FROM todos
+JOIN users ON todos.assigned_to = users.id
+WHERE todos.deleted_at IS NULL;
+
+COMMIT;
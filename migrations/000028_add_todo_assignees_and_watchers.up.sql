@@ -0,0 +1,57 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS todo_assignees(
+    todo_id UUID REFERENCES todos(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (todo_id, user_id)
+);
+
+CREATE INDEX idx_todo_assignees_user_id ON todo_assignees(user_id);
+
+CREATE TABLE IF NOT EXISTS todo_watchers(
+    todo_id UUID REFERENCES todos(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (todo_id, user_id)
+);
+
+CREATE INDEX idx_todo_watchers_user_id ON todo_watchers(user_id);
+
+-- the user in assigned_to stays an assignee of the todo next to the users in todo_assignees
+CREATE OR REPLACE VIEW todos_assignees
+AS
+
+SELECT users.id AS id, users.email, users.role, assignees.todo_id FROM users
+JOIN (
+    SELECT todo_id, user_id FROM todo_assignees
+    UNION
+    SELECT id AS todo_id, assigned_to AS user_id FROM todos WHERE assigned_to IS NOT NULL
+) AS assignees ON users.id = assignees.user_id;
+
+CREATE OR REPLACE VIEW todos_watchers
+AS
+
+SELECT users.id AS id, users.email, users.role, todo_watchers.todo_id FROM users
+JOIN todo_watchers ON users.id = todo_watchers.user_id;
+
+-- a todo belongs to the todos of a user when the user is assigned to it through either source or watches it
+CREATE OR REPLACE VIEW user_todos
+AS
+
+SELECT todos.id AS id, todos.name, todos.description, todos.list_id,
+todos.status,todos.created_at, todos.last_updated, todos.assigned_to,
+todos.due_date, todos.priority, users.id AS user_id, todos.parent_id,
+todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until,
+todos.recurrence_count, todos.deleted_at, todos.position, todos.column_id FROM todos
+JOIN (
+    SELECT id AS todo_id, assigned_to AS user_id FROM todos WHERE assigned_to IS NOT NULL
+    UNION
+    SELECT todo_id, user_id FROM todo_assignees
+    UNION
+    SELECT todo_id, user_id FROM todo_watchers
+) AS todo_users ON todos.id = todo_users.todo_id
+JOIN users ON todo_users.user_id = users.id
+WHERE todos.deleted_at IS NULL;
+
+COMMIT;
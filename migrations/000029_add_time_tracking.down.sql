@@ -0,0 +1,27 @@
+BEGIN;
+
+DROP VIEW IF EXISTS user_todos;
+
+CREATE VIEW user_todos
+AS
+
+SELECT todos.id AS id, todos.name, todos.description, todos.list_id,
+todos.status,todos.created_at, todos.last_updated, todos.assigned_to,
+todos.due_date, todos.priority, users.id AS user_id, todos.parent_id,
+todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until,
+todos.recurrence_count, todos.deleted_at, todos.position, todos.column_id FROM todos
+JOIN (
+    SELECT id AS todo_id, assigned_to AS user_id FROM todos WHERE assigned_to IS NOT NULL
+    UNION
+    SELECT todo_id, user_id FROM todo_assignees
+    UNION
+    SELECT todo_id, user_id FROM todo_watchers
+) AS todo_users ON todos.id = todo_users.todo_id
+JOIN users ON todo_users.user_id = users.id
+WHERE todos.deleted_at IS NULL;
+
+DROP TABLE IF EXISTS work_logs;
+
+ALTER TABLE todos DROP COLUMN estimate;
+
+COMMIT;
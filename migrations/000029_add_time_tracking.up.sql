@@ -0,0 +1,40 @@
+BEGIN;
+
+-- the estimate of a todo is in minutes
+ALTER TABLE todos ADD COLUMN estimate INT CHECK (estimate > 0);
+
+-- the work logged on a todo, the duration is in minutes and logged_on is the day the work was done
+CREATE TABLE IF NOT EXISTS work_logs(
+    id UUID PRIMARY KEY,
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    duration INT NOT NULL CHECK (duration > 0),
+    note TEXT NOT NULL DEFAULT '',
+    logged_on DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    last_updated TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_work_logs_todo_id ON work_logs(todo_id);
+
+CREATE INDEX idx_work_logs_user_id_logged_on ON work_logs(user_id, logged_on);
+
+CREATE OR REPLACE VIEW user_todos
+AS
+
+SELECT todos.id AS id, todos.name, todos.description, todos.list_id,
+todos.status,todos.created_at, todos.last_updated, todos.assigned_to,
+todos.due_date, todos.priority, users.id AS user_id, todos.parent_id,
+todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until,
+todos.recurrence_count, todos.deleted_at, todos.position, todos.column_id, todos.estimate FROM todos
+JOIN (
+    SELECT id AS todo_id, assigned_to AS user_id FROM todos WHERE assigned_to IS NOT NULL
+    UNION
+    SELECT todo_id, user_id FROM todo_assignees
+    UNION
+    SELECT todo_id, user_id FROM todo_watchers
+) AS todo_users ON todos.id = todo_users.todo_id
+JOIN users ON todo_users.user_id = users.id
+WHERE todos.deleted_at IS NULL;
+
+COMMIT;
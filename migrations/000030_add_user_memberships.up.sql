@@ -0,0 +1,11 @@
+BEGIN;
+
+-- the github organizations and teams ("org/team-slug") of the user at their last login,
+-- the role mapping is evaluated against them again whenever the tokens of the user are renewed
+CREATE TABLE IF NOT EXISTS user_memberships(
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    organizations TEXT[] NOT NULL DEFAULT '{}',
+    teams TEXT[] NOT NULL DEFAULT '{}'
+);
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS user_refresh_tokens(
+    refresh_token VARCHAR(255) UNIQUE,
+    user_id UUID PRIMARY KEY,
+    CONSTRAINT user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_token ON user_refresh_tokens(refresh_token);
+
+-- only the most recently used session of every user can be kept
+INSERT INTO user_refresh_tokens (refresh_token, user_id)
+SELECT DISTINCT ON (user_id) refresh_token, user_id FROM user_sessions ORDER BY user_id, last_used_at DESC;
+
+DROP TABLE IF EXISTS user_sessions;
+
+COMMIT;
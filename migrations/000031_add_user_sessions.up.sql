@@ -0,0 +1,22 @@
+BEGIN;
+
+-- one row per logged in device, the refresh token of a session is rotated every time the tokens are renewed
+CREATE TABLE IF NOT EXISTS user_sessions(
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    refresh_token VARCHAR(255) NOT NULL UNIQUE,
+    device_label TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip_address TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_sessions_user_id ON user_sessions(user_id);
+
+INSERT INTO user_sessions (id, user_id, refresh_token)
+SELECT gen_random_uuid(), user_id, refresh_token FROM user_refresh_tokens WHERE refresh_token IS NOT NULL;
+
+DROP TABLE IF EXISTS user_refresh_tokens;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP TABLE IF EXISTS consumed_refresh_tokens;
+
+-- the plaintext of the hashed tokens is lost, the sessions have to log in again
+DELETE FROM user_sessions;
+ALTER TABLE user_sessions RENAME CONSTRAINT user_sessions_refresh_token_hash_key TO user_sessions_refresh_token_key;
+ALTER TABLE user_sessions RENAME COLUMN refresh_token_hash TO refresh_token;
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+-- refresh tokens are stored as their sha256 hex digest, the plaintext tokens of the existing sessions are hashed in place
+ALTER TABLE user_sessions RENAME COLUMN refresh_token TO refresh_token_hash;
+ALTER TABLE user_sessions RENAME CONSTRAINT user_sessions_refresh_token_key TO user_sessions_refresh_token_hash_key;
+UPDATE user_sessions SET refresh_token_hash = encode(sha256(convert_to(refresh_token_hash, 'UTF8')), 'hex');
+
+-- every session is a token family, the tokens it rotated away from are kept so that presenting one of them again
+-- is detected as reuse of a stolen token
+CREATE TABLE IF NOT EXISTS consumed_refresh_tokens(
+    token_hash VARCHAR(64) PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES user_sessions(id) ON DELETE CASCADE,
+    consumed_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_consumed_refresh_tokens_session_id ON consumed_refresh_tokens(session_id);
+
+COMMIT;
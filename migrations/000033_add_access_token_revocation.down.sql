@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE user_sessions DROP COLUMN IF EXISTS access_token_expires_at;
+ALTER TABLE user_sessions DROP COLUMN IF EXISTS access_token_id;
+
+DROP TABLE IF EXISTS revoked_access_tokens;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+-- access tokens revoked before their expiry, the rows are pruned once the tokens have expired
+CREATE TABLE IF NOT EXISTS revoked_access_tokens(
+    jti VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_revoked_access_tokens_expires_at ON revoked_access_tokens(expires_at);
+
+-- the last access token issued for the session, it is revoked together with the session
+ALTER TABLE user_sessions ADD COLUMN IF NOT EXISTS access_token_id VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE user_sessions ADD COLUMN IF NOT EXISTS access_token_expires_at TIMESTAMP;
+
+COMMIT;
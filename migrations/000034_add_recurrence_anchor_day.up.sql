@@ -0,0 +1,7 @@
+BEGIN;
+
+-- the day of the month a monthly series was started on, the due dates of later occurrences are clamped
+-- to shorter months without losing the original day
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS recurrence_anchor_day SMALLINT CHECK (recurrence_anchor_day BETWEEN 1 AND 31);
+
+COMMIT;
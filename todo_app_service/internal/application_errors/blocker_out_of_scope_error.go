@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var BlockerOutOfScopeError = errors.New("blocker must be a todo from the same list")
@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var CollaboratorsCopyForbiddenError = errors.New("only administrators, the list owner and the list managers can copy the collaborators of a list")
@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var ColumnOutOfScopeError = errors.New("column must be a column of the list of the todo")
@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var ColumnStatusMismatchError = errors.New("status of the todo must be the status of its column")
@@ -0,0 +1,16 @@
+package application_errors
+
+import "fmt"
+
+type DependencyCycleError struct {
+	todoId    string
+	blockerId string
+}
+
+func NewDependencyCycleError(todoId string, blockerId string) *DependencyCycleError {
+	return &DependencyCycleError{todoId: todoId, blockerId: blockerId}
+}
+
+func (d DependencyCycleError) Error() string {
+	return fmt.Sprintf("todo with id %q can't block todo with id %q because it would create a dependency cycle", d.blockerId, d.todoId)
+}
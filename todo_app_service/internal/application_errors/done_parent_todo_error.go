@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var DoneParentTodoError = errors.New("can't add subtask to a todo that is already done")
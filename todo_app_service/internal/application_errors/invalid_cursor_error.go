@@ -0,0 +1,15 @@
+package application_errors
+
+import "fmt"
+
+type InvalidCursorError struct {
+	cursor string
+}
+
+func NewInvalidCursorError(cursor string) *InvalidCursorError {
+	return &InvalidCursorError{cursor: cursor}
+}
+
+func (i InvalidCursorError) Error() string {
+	return fmt.Sprintf("cursor %q is not valid for the requested sort order", i.cursor)
+}
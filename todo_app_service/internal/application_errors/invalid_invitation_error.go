@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var InvalidInvitationError = errors.New("invitation is not valid or has expired")
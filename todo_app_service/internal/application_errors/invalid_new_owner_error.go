@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var InvalidNewOwnerError = errors.New("the new owner must be an existing user different from the current owner")
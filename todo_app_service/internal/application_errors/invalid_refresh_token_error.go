@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var InvalidRefreshTokenError = errors.New("invalid refresh token")
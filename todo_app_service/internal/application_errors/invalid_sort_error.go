@@ -0,0 +1,15 @@
+package application_errors
+
+import "fmt"
+
+type InvalidSortError struct {
+	sort string
+}
+
+func NewInvalidSortError(sort string) *InvalidSortError {
+	return &InvalidSortError{sort: sort}
+}
+
+func (i InvalidSortError) Error() string {
+	return fmt.Sprintf("records can't be sorted by %q", i.sort)
+}
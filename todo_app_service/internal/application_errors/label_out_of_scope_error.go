@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var LabelOutOfScopeError = errors.New("label does not belong to the owner of the list the todo is in")
@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var OpenBlockersError = errors.New("todo can't be started or marked as done while it has open blockers")
@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var OpenSubtasksError = errors.New("todo can't be marked as done while it has open subtasks")
@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var ParticipantOutOfScopeError = errors.New("only the list owner and the list collaborators can be assigned to or watch todo")
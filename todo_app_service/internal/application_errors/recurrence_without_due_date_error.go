@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var RecurrenceWithoutDueDateError = errors.New("recurring todo must have a due date")
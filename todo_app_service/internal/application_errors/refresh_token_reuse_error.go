@@ -0,0 +1,7 @@
+package application_errors
+
+import "errors"
+
+// RefreshTokenReuseError is returned when an already rotated refresh token is presented again,
+// the session the token belongs to is revoked because the token has most likely been stolen
+var RefreshTokenReuseError = errors.New("refresh token has already been used, the session has been revoked")
@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var ReorderOutOfScopeError = errors.New("todo can only be placed before or after another todo from the same list")
@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var TodoAccessForbiddenError = errors.New("only administrators, the list owner, the list editors and the assignees can modify todo")
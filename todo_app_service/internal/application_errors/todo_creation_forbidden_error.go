@@ -0,0 +1,5 @@
+package application_errors
+
+import "errors"
+
+var TodoCreationForbiddenError = errors.New("only administrators, the list owner and the list editors can create todo")
@@ -0,0 +1,16 @@
+package application_errors
+
+import "fmt"
+
+type WipLimitExceededError struct {
+	column string
+	limit  int
+}
+
+func NewWipLimitExceededError(column string, limit int) *WipLimitExceededError {
+	return &WipLimitExceededError{column: column, limit: limit}
+}
+
+func (w WipLimitExceededError) Error() string {
+	return fmt.Sprintf("column %q already holds its limit of %d todos", w.column, w.limit)
+}
@@ -0,0 +1,290 @@
+package boards
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+//go:generate mockery --name=boardService --exported --output=./mocks --outpkg=mocks --filename=board_service.go --with-expecter=true
+type boardService interface {
+	GetBoardRecord(ctx context.Context, listId string) (*models.Board, error)
+	GetListColumnsRecords(ctx context.Context, listId string) ([]*models.Column, error)
+	CreateColumnRecord(ctx context.Context, listId string, column *handler_models.CreateColumn) (*models.Column, error)
+	UpdateColumnRecord(ctx context.Context, listId string, columnId string, column *handler_models.UpdateColumn) (*models.Column, error)
+	DeleteColumnRecord(ctx context.Context, listId string, columnId string) error
+}
+
+//go:generate mockery --name=fieldValidator --exported --output=./mocks --outpkg=mocks --filename=field_validator.go --with-expecter=true
+type fieldValidator interface {
+	Struct(interface{}) error
+}
+
+type Handler struct {
+	serv       boardService
+	fValidator fieldValidator
+	transact   persistence.Transactioner
+}
+
+func NewHandler(service boardService, fValidator fieldValidator, transact persistence.Transactioner) *Handler {
+	return &Handler{
+		serv:       service,
+		fValidator: fValidator,
+		transact:   transact,
+	}
+}
+
+func (h *Handler) HandleGetBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting board in board handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in board handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Error("failed to get list_id from the context in board handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	board, err := h.serv.GetBoardRecord(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get board of list with id %s, error %s when calling board service", listId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(board); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get board of list with id %s, error %s", listId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleGetColumns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting columns in board handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in board handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Error("failed to get list_id from the context in board handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	columns, err := h.serv.GetListColumnsRecords(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get columns of list with id %s, error %s when calling board service", listId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(columns); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get columns of list with id %s, error %s", listId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleCreateColumn(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("creating column in board handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in board handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Error("failed to get list_id from the context in board handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	var column handler_models.CreateColumn
+	if err = json.NewDecoder(r.Body).Decode(&column); err != nil {
+		log.C(ctx).Errorf("failed to decode column handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, column)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create column, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	createdColumn, err := h.serv.CreateColumnRecord(ctx, listId, &column)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create column in list with id %s, error %s when calling board service", listId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to create column, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(createdColumn); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		return
+	}
+}
+
+func (h *Handler) HandleUpdateColumn(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("updating column in board handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in board handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Error("failed to get list_id from the context in board handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	columnId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ColumnId)
+	if err != nil {
+		log.C(ctx).Error("failed to get column_id from the context in board handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_COLUMN_ID, http.StatusBadRequest)
+		return
+	}
+
+	var column handler_models.UpdateColumn
+	if err = json.NewDecoder(r.Body).Decode(&column); err != nil {
+		log.C(ctx).Errorf("failed to decode column handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, column)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update column, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	updatedColumn, err := h.serv.UpdateColumnRecord(ctx, listId, columnId, &column)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update column with id %s, error %s when calling board service", columnId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(updatedColumn); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to update column with id %s, error %s", columnId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleDeleteColumn(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("deleting column in board handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in board handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Error("failed to get list_id from the context in board handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	columnId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ColumnId)
+	if err != nil {
+		log.C(ctx).Error("failed to get column_id from the context in board handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_COLUMN_ID, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.serv.DeleteColumnRecord(ctx, listId, columnId); err != nil {
+		log.C(ctx).Errorf("failed to delete column with id %s, error %s when calling board service", columnId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to delete column with id %s, error %s", columnId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
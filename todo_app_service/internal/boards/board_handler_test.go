@@ -0,0 +1,74 @@
+package boards
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/boards/mocks"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"context"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_HandleDeleteColumn(t *testing.T) {
+	tests := []struct {
+		testName       string
+		serviceErr     error
+		dbMock         func(mck sqlmock.Sqlmock)
+		expectedStatus int
+	}{
+		{
+			testName: "Successfully deleting column",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			testName:   "Failed to delete column which is not in the list",
+			serviceErr: columnNotFound,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			mService := &mocks.BoardService{}
+			mService.EXPECT().
+				DeleteColumnRecord(mock.Anything, listId.String(), reviewColumnId.String()).
+				Return(test.serviceErr).Once()
+
+			handler := NewHandler(mService, validator.New(), persistence.NewSqlDb(db))
+
+			req := httptest.NewRequest(http.MethodDelete, "/lists/"+listId.String()+"/columns/"+reviewColumnId.String(), nil)
+			ctx := context.WithValue(req.Context(), middlewares.ListId, listId.String())
+			req = req.WithContext(context.WithValue(ctx, middlewares.ColumnId, reviewColumnId.String()))
+			rr := httptest.NewRecorder()
+
+			handler.HandleDeleteColumn(rr, req)
+
+			require.Equal(t, test.expectedStatus, rr.Code)
+			if test.serviceErr != nil {
+				extractErrorFromResponseRecorder(t, rr, test.serviceErr.Error())
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, mService)
+		})
+	}
+}
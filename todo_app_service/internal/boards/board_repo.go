@@ -0,0 +1,174 @@
+package boards
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
+	"database/sql"
+	"errors"
+)
+
+type repository struct{}
+
+func NewRepo() *repository {
+	return &repository{}
+}
+
+func (*repository) GetColumn(ctx context.Context, columnId string) (*entities.Column, error) {
+	log.C(ctx).Infof("getting column with id %s from board repository", columnId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	entity := &entities.Column{}
+	if err = persist.GetContext(ctx, entity, getColumnQuery, columnId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("failed to get column with id %s due to sqlErrNoRows", columnId)
+			return nil, application_errors.NewNotFoundError(constants.COLUMN_TARGET, columnId)
+		}
+
+		log.C(ctx).Errorf("failed to get column with id %s because of a database error %s", columnId, err.Error())
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (*repository) GetListColumns(ctx context.Context, listId string) ([]entities.Column, error) {
+	log.C(ctx).Infof("getting columns of list with id %s from board repository", listId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var columns []entities.Column
+	if err = persist.SelectContext(ctx, &columns, getListColumnsQuery, listId); err != nil {
+		log.C(ctx).Errorf("failed to get columns due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return columns, nil
+}
+
+// GetBoardTodos returns the todos of the list which are not in the trash in their manual order
+func (*repository) GetBoardTodos(ctx context.Context, listId string) ([]entities.Todo, error) {
+	log.C(ctx).Infof("getting todos of the board of list with id %s from board repository", listId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var todos []entities.Todo
+	if err = persist.SelectContext(ctx, &todos, boardTodosQuery, listId); err != nil {
+		log.C(ctx).Errorf("failed to get board todos due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+func (*repository) CountColumnTodos(ctx context.Context, column *entities.Column) (int, error) {
+	log.C(ctx).Infof("counting todos in column with id %s in board repository", column.Id)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return 0, err
+	}
+
+	var count int
+	if err = persist.GetContext(ctx, &count, countColumnTodosQuery, column.ListId, column.Id, column.Status); err != nil {
+		log.C(ctx).Errorf("failed to count todos in column with id %s, error %s", column.Id, err.Error())
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *repository) CreateColumn(ctx context.Context, entity *entities.Column) (*entities.Column, error) {
+	log.C(ctx).Infof("creating column %s in board repository", entity.Name)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	if _, err = persist.NamedExecContext(ctx, createColumnQuery, entity); err != nil {
+		log.C(ctx).Errorf("failed to create column, error %s when executing sql query", err.Error())
+		return nil, persistence.MapPostgresColumnError(err, entity)
+	}
+
+	return r.GetColumn(ctx, entity.Id.String())
+}
+
+func (r *repository) UpdateColumn(ctx context.Context, sqlExecParams map[string]interface{}, sqlFields []string) (*entities.Column, error) {
+	columnId := sqlExecParams["id"].(string)
+	log.C(ctx).Infof("updating column with id %s in board repository", columnId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	res, err := persist.NamedExecContext(ctx, parseSqlUpdateColumnQuery(sqlFields), sqlExecParams)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update column, error when executing sql query %s", err.Error())
+
+		name, _ := sqlExecParams["name"].(string)
+		return nil, persistence.MapPostgresColumnError(err, &entities.Column{Name: name})
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to update column, error when trying to get the number of rows affected")
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to update column, invalid column_id provided %s", columnId)
+		return nil, application_errors.NewNotFoundError(constants.COLUMN_TARGET, columnId)
+	}
+
+	return r.GetColumn(ctx, columnId)
+}
+
+func (*repository) DeleteColumn(ctx context.Context, columnId string) error {
+	log.C(ctx).Infof("deleting column with id %s in board repository", columnId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	res, err := persist.ExecContext(ctx, deleteColumnQuery, columnId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to delete column with id %s, error %s", columnId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to delete column, error when trying to get the number of rows affected")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to delete column, invalid column_id provided %s", columnId)
+		return application_errors.NewNotFoundError(constants.COLUMN_TARGET, columnId)
+	}
+
+	return nil
+}
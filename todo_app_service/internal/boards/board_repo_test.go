@@ -0,0 +1,107 @@
+package boards
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"context"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"regexp"
+	"testing"
+)
+
+func TestRepository_CountColumnTodos(t *testing.T) {
+	tests := []struct {
+		testName      string
+		dbMock        func(mck sqlmock.Sqlmock)
+		expectedCount int
+		err           error
+	}{
+		{
+			testName: "Successfully counting the todos of the column",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryCountColumnTodos)).
+					WithArgs(listId, reviewColumnId, reviewColumnEntity.Status).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			},
+			expectedCount: 2,
+		},
+		{
+			testName: "Failed to count the todos of the column due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryCountColumnTodos)).
+					WithArgs(listId, reviewColumnId, reviewColumnEntity.Status).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			count, err := NewRepo().CountColumnTodos(ctx, &reviewColumnEntity)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedCount, count)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_DeleteColumn(t *testing.T) {
+	tests := []struct {
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
+	}{
+		{
+			testName: "Successfully deleting column",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteColumn)).
+					WithArgs(reviewColumnId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			testName: "Failed to delete column which does not exist",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteColumn)).
+					WithArgs(reviewColumnId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			err: columnNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo().DeleteColumn(ctx, reviewColumnId.String())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package boards
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"database/sql"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+// boardStatuses are the base statuses in the order the board shows them
+var boardStatuses = []constants.TodoStatus{constants.Open, constants.InProgress, constants.Done}
+
+//go:generate mockery --name=columnRepo --exported --output=./mocks --outpkg=mocks --filename=column_repo.go --with-expecter=true
+type columnRepo interface {
+	GetColumn(ctx context.Context, columnId string) (*entities.Column, error)
+	GetListColumns(ctx context.Context, listId string) ([]entities.Column, error)
+	GetBoardTodos(ctx context.Context, listId string) ([]entities.Todo, error)
+	CreateColumn(ctx context.Context, entity *entities.Column) (*entities.Column, error)
+	UpdateColumn(ctx context.Context, sqlExecParams map[string]interface{}, sqlFields []string) (*entities.Column, error)
+	DeleteColumn(ctx context.Context, columnId string) error
+}
+
+//go:generate mockery --name=listRepo --exported --output=./mocks --outpkg=mocks --filename=list_repo.go --with-expecter=true
+type listRepo interface {
+	GetList(ctx context.Context, listId string) (*entities.List, error)
+}
+
+//go:generate mockery --name=columnConverter --exported --output=./mocks --outpkg=mocks --filename=column_converter.go --with-expecter=true
+type columnConverter interface {
+	ToModel(column *entities.Column) *models.Column
+	ManyToModel(columns []entities.Column) []*models.Column
+}
+
+//go:generate mockery --name=todoConverter --exported --output=./mocks --outpkg=mocks --filename=todo_converter.go --with-expecter=true
+type todoConverter interface {
+	ToModel(todo *entities.Todo) *models.Todo
+}
+
+//go:generate mockery --name=historyRecorder --exported --output=./mocks --outpkg=mocks --filename=history_recorder.go --with-expecter=true
+type historyRecorder interface {
+	RecordActor(ctx context.Context) error
+}
+
+//go:generate mockery --name=uuidGenerator --exported --output=./mocks --outpkg=mocks --filename=uuid_generator.go --with-expecter=true
+type uuidGenerator interface {
+	Generate() string
+}
+
+//go:generate mockery --name=timeGenerator --exported --output=./mocks --outpkg=mocks --filename=time_generator.go --with-expecter=true
+type timeGenerator interface {
+	Now() time.Time
+}
+
+type service struct {
+	cRepo      columnRepo
+	lRepo      listRepo
+	cConverter columnConverter
+	tConverter todoConverter
+	hRecorder  historyRecorder
+	uuidGen    uuidGenerator
+	timeGen    timeGenerator
+}
+
+func NewService(cRepo columnRepo, lRepo listRepo, cConverter columnConverter, tConverter todoConverter, hRecorder historyRecorder,
+	uuidGen uuidGenerator, timeGen timeGenerator) *service {
+	return &service{
+		cRepo:      cRepo,
+		lRepo:      lRepo,
+		cConverter: cConverter,
+		tConverter: tConverter,
+		hRecorder:  hRecorder,
+		uuidGen:    uuidGen,
+		timeGen:    timeGen,
+	}
+}
+
+func (s *service) GetListColumnsRecords(ctx context.Context, listId string) ([]*models.Column, error) {
+	log.C(ctx).Infof("getting columns of list with id %s in board service", listId)
+
+	if _, err := s.lRepo.GetList(ctx, listId); err != nil {
+		log.C(ctx).Errorf("failed to get columns, error %s when getting list with id %s", err.Error(), listId)
+		return nil, err
+	}
+
+	columns, err := s.cRepo.GetListColumns(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get columns of list with id %s, error %s when calling board repo", listId, err.Error())
+		return nil, err
+	}
+
+	return s.cConverter.ManyToModel(columns), nil
+}
+
+func (s *service) CreateColumnRecord(ctx context.Context, listId string, column *handler_models.CreateColumn) (*models.Column, error) {
+	log.C(ctx).Infof("creating column %s in list with id %s in board service", column.Name, listId)
+
+	if _, err := s.lRepo.GetList(ctx, listId); err != nil {
+		log.C(ctx).Errorf("failed to create column, error %s when getting list with id %s", err.Error(), listId)
+		return nil, err
+	}
+
+	entity := &entities.Column{
+		Id:        uuid.FromStringOrNil(s.uuidGen.Generate()),
+		ListId:    uuid.FromStringOrNil(listId),
+		Name:      column.Name,
+		Status:    string(column.Status),
+		CreatedAt: s.timeGen.Now(),
+	}
+
+	if column.WipLimit != nil {
+		entity.WipLimit = sql.NullInt32{Int32: int32(*column.WipLimit), Valid: true}
+	}
+
+	createdColumn, err := s.cRepo.CreateColumn(ctx, entity)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create column in list with id %s, error %s when calling board repo", listId, err.Error())
+		return nil, err
+	}
+
+	return s.cConverter.ToModel(createdColumn), nil
+}
+
+func (s *service) UpdateColumnRecord(ctx context.Context, listId string, columnId string, column *handler_models.UpdateColumn) (*models.Column, error) {
+	log.C(ctx).Infof("updating column with id %s in board service", columnId)
+
+	currentColumn, err := s.getListColumn(ctx, listId, columnId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update column, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlExecParams := map[string]interface{}{"id": columnId}
+	sqlFields := make([]string, 0, 3)
+
+	determineSqlFieldsAndParamsColumn(column, sqlExecParams, &sqlFields)
+	if len(sqlFields) == 0 {
+		return s.cConverter.ToModel(currentColumn), nil
+	}
+
+	entity, err := s.cRepo.UpdateColumn(ctx, sqlExecParams, sqlFields)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update column with id %s, error %s when calling board repo", columnId, err.Error())
+		return nil, err
+	}
+
+	return s.cConverter.ToModel(entity), nil
+}
+
+// DeleteColumnRecord deletes the column, its todos move to the first remaining column of their status
+func (s *service) DeleteColumnRecord(ctx context.Context, listId string, columnId string) error {
+	log.C(ctx).Infof("deleting column with id %s in board service", columnId)
+
+	if _, err := s.getListColumn(ctx, listId, columnId); err != nil {
+		log.C(ctx).Errorf("failed to delete column, error %s", err.Error())
+		return err
+	}
+
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
+	if err := s.cRepo.DeleteColumn(ctx, columnId); err != nil {
+		log.C(ctx).Errorf("failed to delete column with id %s, error %s when calling board repo", columnId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetBoardRecord returns the columns of the list with their todos in their manual order, a status without
+// custom columns is shown as a default column named after the status
+func (s *service) GetBoardRecord(ctx context.Context, listId string) (*models.Board, error) {
+	log.C(ctx).Infof("getting board of list with id %s in board service", listId)
+
+	if _, err := s.lRepo.GetList(ctx, listId); err != nil {
+		log.C(ctx).Errorf("failed to get board, error %s when getting list with id %s", err.Error(), listId)
+		return nil, err
+	}
+
+	columns, err := s.cRepo.GetListColumns(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get board of list with id %s, error %s when getting its columns", listId, err.Error())
+		return nil, err
+	}
+
+	todos, err := s.cRepo.GetBoardTodos(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get board of list with id %s, error %s when getting its todos", listId, err.Error())
+		return nil, err
+	}
+
+	board := &models.Board{ListId: listId, Columns: make([]*models.BoardColumn, 0, len(columns)+len(boardStatuses))}
+	boardColumnsById := make(map[string]*models.BoardColumn, len(columns))
+	firstColumnOfStatus := make(map[constants.TodoStatus]*models.BoardColumn, len(boardStatuses))
+
+	modelColumns := s.cConverter.ManyToModel(columns)
+	for _, status := range boardStatuses {
+		for _, column := range modelColumns {
+			if column.Status != status {
+				continue
+			}
+
+			boardColumn := &models.BoardColumn{Id: &column.Id, Name: column.Name, Status: status, WipLimit: column.WipLimit, Todos: make([]*models.Todo, 0)}
+			board.Columns = append(board.Columns, boardColumn)
+			boardColumnsById[column.Id] = boardColumn
+
+			if _, ok := firstColumnOfStatus[status]; !ok {
+				firstColumnOfStatus[status] = boardColumn
+			}
+		}
+
+		if _, ok := firstColumnOfStatus[status]; !ok {
+			defaultColumn := &models.BoardColumn{Name: string(status), Status: status, Todos: make([]*models.Todo, 0)}
+			board.Columns = append(board.Columns, defaultColumn)
+			firstColumnOfStatus[status] = defaultColumn
+		}
+	}
+
+	for index := range todos {
+		todo := s.tConverter.ToModel(&todos[index])
+
+		boardColumn := firstColumnOfStatus[todo.Status]
+		if todo.ColumnId != nil {
+			if columnOfTodo, ok := boardColumnsById[*todo.ColumnId]; ok {
+				boardColumn = columnOfTodo
+			}
+		}
+
+		boardColumn.Todos = append(boardColumn.Todos, todo)
+	}
+
+	return board, nil
+}
+
+// getListColumn returns the column only when it belongs to the list from the request path
+func (s *service) getListColumn(ctx context.Context, listId string, columnId string) (*entities.Column, error) {
+	column, err := s.cRepo.GetColumn(ctx, columnId)
+	if err != nil {
+		return nil, err
+	}
+
+	if column.ListId.String() != listId {
+		return nil, application_errors.NewNotFoundError(constants.COLUMN_TARGET, columnId)
+	}
+
+	return column, nil
+}
@@ -0,0 +1,274 @@
+package boards
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/boards/mocks"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestService_GetBoardRecord(t *testing.T) {
+	columns := []entities.Column{backlogColumnEntity, reviewColumnEntity}
+	todos := []entities.Todo{openTodoEntity, reviewedTodoEntity, doneTodoEntity}
+
+	tests := []struct {
+		testName      string
+		mockListRepo  func() *mocks.ListRepo
+		mockCRepo     func() *mocks.ColumnRepo
+		mockCConv     func() *mocks.ColumnConverter
+		mockTConv     func() *mocks.TodoConverter
+		expectedBoard *models.Board
+		err           error
+	}{
+		{
+			testName: "Successfully getting board with the todos in their columns and a default column for the status without columns",
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+				mRepo.EXPECT().GetList(context.TODO(), listId.String()).Return(&entities.List{Id: listId}, nil).Once()
+
+				return mRepo
+			},
+			mockCRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+				mRepo.EXPECT().GetListColumns(context.TODO(), listId.String()).Return(columns, nil).Once()
+				mRepo.EXPECT().GetBoardTodos(context.TODO(), listId.String()).Return(todos, nil).Once()
+
+				return mRepo
+			},
+			mockCConv: func() *mocks.ColumnConverter {
+				mConverter := &mocks.ColumnConverter{}
+				mConverter.EXPECT().ManyToModel(columns).Return([]*models.Column{backlogColumnModel, reviewColumnModel}).Once()
+
+				return mConverter
+			},
+			mockTConv: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+				mConverter.EXPECT().ToModel(&openTodoEntity).Return(openTodoModel).Once()
+				mConverter.EXPECT().ToModel(&reviewedTodoEntity).Return(reviewedModel).Once()
+				mConverter.EXPECT().ToModel(&doneTodoEntity).Return(doneTodoModel).Once()
+
+				return mConverter
+			},
+			expectedBoard: &models.Board{
+				ListId: listId.String(),
+				Columns: []*models.BoardColumn{
+					{Id: &backlogColumnModel.Id, Name: backlogName, Status: constants.Open, Todos: []*models.Todo{openTodoModel}},
+					{Id: &reviewColumnModel.Id, Name: reviewName, Status: constants.InProgress, WipLimit: &wipLimit, Todos: []*models.Todo{reviewedModel}},
+					{Name: string(constants.Done), Status: constants.Done, Todos: []*models.Todo{doneTodoModel}},
+				},
+			},
+		},
+		{
+			testName: "Failed to get board of list which does not exist",
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+				mRepo.EXPECT().GetList(context.TODO(), listId.String()).Return(nil, listNotFound).Once()
+
+				return mRepo
+			},
+			err: listNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mListRepo := test.mockListRepo()
+
+			mCRepo := &mocks.ColumnRepo{}
+			if test.mockCRepo != nil {
+				mCRepo = test.mockCRepo()
+			}
+
+			mCConv := &mocks.ColumnConverter{}
+			if test.mockCConv != nil {
+				mCConv = test.mockCConv()
+			}
+
+			mTConv := &mocks.TodoConverter{}
+			if test.mockTConv != nil {
+				mTConv = test.mockTConv()
+			}
+
+			bService := NewService(mCRepo, mListRepo, mCConv, mTConv, nil, nil, nil)
+			board, err := bService.GetBoardRecord(context.TODO(), listId.String())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, board)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedBoard, board)
+			}
+
+			mock.AssertExpectationsForObjects(t, mListRepo, mCRepo, mCConv, mTConv)
+		})
+	}
+}
+
+func TestService_CreateColumnRecord(t *testing.T) {
+	column := &entities.Column{Id: reviewColumnId, ListId: listId, Name: reviewName, Status: string(constants.InProgress),
+		WipLimit: sql.NullInt32{Int32: int32(wipLimit), Valid: true}, CreatedAt: createdAt}
+
+	mListRepo := &mocks.ListRepo{}
+	mListRepo.EXPECT().GetList(context.TODO(), listId.String()).Return(&entities.List{Id: listId}, nil).Once()
+
+	mCRepo := &mocks.ColumnRepo{}
+	mCRepo.EXPECT().CreateColumn(context.TODO(), column).Return(&reviewColumnEntity, nil).Once()
+
+	mCConv := &mocks.ColumnConverter{}
+	mCConv.EXPECT().ToModel(&reviewColumnEntity).Return(reviewColumnModel).Once()
+
+	mUuidGen := &mocks.UuidGenerator{}
+	mUuidGen.EXPECT().Generate().Return(reviewColumnId.String()).Once()
+
+	mTimeGen := &mocks.TimeGenerator{}
+	mTimeGen.EXPECT().Now().Return(createdAt).Once()
+
+	bService := NewService(mCRepo, mListRepo, mCConv, nil, nil, mUuidGen, mTimeGen)
+	created, err := bService.CreateColumnRecord(context.TODO(), listId.String(),
+		&handler_models.CreateColumn{Name: reviewName, Status: constants.InProgress, WipLimit: &wipLimit})
+
+	require.NoError(t, err)
+	require.Equal(t, reviewColumnModel, created)
+	mock.AssertExpectationsForObjects(t, mListRepo, mCRepo, mCConv, mUuidGen, mTimeGen)
+}
+
+func TestService_UpdateColumnRecord(t *testing.T) {
+	noLimit := 0
+	unlimitedEntity := reviewColumnEntity
+	unlimitedEntity.WipLimit = sql.NullInt32{}
+	unlimitedModel := &models.Column{Id: reviewColumnId.String(), ListId: listId.String(), Name: reviewName, Status: constants.InProgress}
+
+	tests := []struct {
+		testName       string
+		update         *handler_models.UpdateColumn
+		mockCRepo      func() *mocks.ColumnRepo
+		mockCConv      func() *mocks.ColumnConverter
+		expectedColumn *models.Column
+		err            error
+	}{
+		{
+			testName: "Successfully removing the wip limit of the column",
+			update:   &handler_models.UpdateColumn{WipLimit: &noLimit},
+			mockCRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+				mRepo.EXPECT().GetColumn(context.TODO(), reviewColumnId.String()).Return(&reviewColumnEntity, nil).Once()
+				mRepo.EXPECT().
+					UpdateColumn(context.TODO(), map[string]interface{}{"id": reviewColumnId.String()}, []string{"wip_limit = NULL"}).
+					Return(&unlimitedEntity, nil).Once()
+
+				return mRepo
+			},
+			mockCConv: func() *mocks.ColumnConverter {
+				mConverter := &mocks.ColumnConverter{}
+				mConverter.EXPECT().ToModel(&unlimitedEntity).Return(unlimitedModel).Once()
+
+				return mConverter
+			},
+			expectedColumn: unlimitedModel,
+		},
+		{
+			testName: "Failed to update column of another list",
+			update:   &handler_models.UpdateColumn{WipLimit: &noLimit},
+			mockCRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+				mRepo.EXPECT().
+					GetColumn(context.TODO(), reviewColumnId.String()).
+					Return(&entities.Column{Id: reviewColumnId, ListId: otherListId}, nil).Once()
+
+				return mRepo
+			},
+			err: columnNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mCRepo := test.mockCRepo()
+
+			mCConv := &mocks.ColumnConverter{}
+			if test.mockCConv != nil {
+				mCConv = test.mockCConv()
+			}
+
+			bService := NewService(mCRepo, nil, mCConv, nil, nil, nil, nil)
+			column, err := bService.UpdateColumnRecord(context.TODO(), listId.String(), reviewColumnId.String(), test.update)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, column)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedColumn, column)
+			}
+
+			mock.AssertExpectationsForObjects(t, mCRepo, mCConv)
+		})
+	}
+}
+
+func TestService_DeleteColumnRecord(t *testing.T) {
+	tests := []struct {
+		testName     string
+		mockCRepo    func() *mocks.ColumnRepo
+		mockRecorder func() *mocks.HistoryRecorder
+		err          error
+	}{
+		{
+			testName: "Successfully deleting column",
+			mockCRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+				mRepo.EXPECT().GetColumn(context.TODO(), reviewColumnId.String()).Return(&reviewColumnEntity, nil).Once()
+				mRepo.EXPECT().DeleteColumn(context.TODO(), reviewColumnId.String()).Return(nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Once()
+
+				return mRecorder
+			},
+		},
+		{
+			testName: "Failed to delete column of another list",
+			mockCRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+				mRepo.EXPECT().
+					GetColumn(context.TODO(), reviewColumnId.String()).
+					Return(&entities.Column{Id: reviewColumnId, ListId: otherListId}, nil).Once()
+
+				return mRepo
+			},
+			err: columnNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mCRepo := test.mockCRepo()
+
+			mRecorder := &mocks.HistoryRecorder{}
+			if test.mockRecorder != nil {
+				mRecorder = test.mockRecorder()
+			}
+
+			bService := NewService(mCRepo, nil, nil, nil, mRecorder, nil, nil)
+			err := bService.DeleteColumnRecord(context.TODO(), listId.String(), reviewColumnId.String())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, mCRepo, mRecorder)
+		})
+	}
+}
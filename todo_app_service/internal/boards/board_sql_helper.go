@@ -0,0 +1,58 @@
+package boards
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"fmt"
+	"strings"
+)
+
+const columnColumns = `id, list_id, name, status, wip_limit, position, created_at`
+
+var getColumnQuery = `SELECT ` + columnColumns + ` FROM list_columns WHERE id = $1`
+
+// getListColumnsQuery returns the columns in the order of the board, the statuses are ordered as they are declared
+// in their enum and the columns of a status by their position
+var getListColumnsQuery = `SELECT ` + columnColumns + ` FROM list_columns WHERE list_id = $1 ORDER BY status, position, created_at, id`
+
+// createColumnQuery puts the new column after the other columns of its status
+var createColumnQuery = `INSERT INTO list_columns (` + columnColumns + `)
+VALUES (:id, :list_id, :name, :status, :wip_limit,
+(SELECT COALESCE(MAX(position), 0) + 1 FROM list_columns WHERE list_id = :list_id AND status = :status), :created_at)`
+
+var deleteColumnQuery = `DELETE FROM list_columns WHERE id = $1`
+
+// countColumnTodosQuery counts the todos in the column, the todos without a column are counted in the first column of their status
+const countColumnTodosQuery = `SELECT COUNT(*) FROM todos
+WHERE list_id = $1 AND deleted_at IS NULL AND (column_id = $2 OR (column_id IS NULL AND status = $3 AND $2 = (
+    SELECT id FROM list_columns WHERE list_id = $1 AND status = $3 ORDER BY position, created_at, id LIMIT 1
+)))`
+
+const boardTodosQuery = `SELECT id, name, description, list_id, status, created_at, last_updated, assigned_to, due_date, priority, parent_id,
+recurrence_frequency, recurrence_interval, recurrence_until, recurrence_count, position, column_id, estimate FROM todos
+WHERE list_id = $1 AND deleted_at IS NULL
+ORDER BY position, id`
+
+func parseSqlUpdateColumnQuery(sqlFields []string) string {
+	return fmt.Sprintf("UPDATE list_columns SET %s WHERE id = :id", strings.Join(sqlFields, ", "))
+}
+
+func determineSqlFieldsAndParamsColumn(column *handler_models.UpdateColumn, sqlExecParams map[string]interface{}, sqlFields *[]string) {
+	if column.Name != nil {
+		sqlExecParams["name"] = *column.Name
+		*sqlFields = append(*sqlFields, "name = :name")
+	}
+
+	if column.WipLimit != nil {
+		if *column.WipLimit == 0 {
+			*sqlFields = append(*sqlFields, "wip_limit = NULL")
+		} else {
+			sqlExecParams["wip_limit"] = *column.WipLimit
+			*sqlFields = append(*sqlFields, "wip_limit = :wip_limit")
+		}
+	}
+
+	if column.Position != nil {
+		sqlExecParams["position"] = *column.Position
+		*sqlFields = append(*sqlFields, "position = :position")
+	}
+}
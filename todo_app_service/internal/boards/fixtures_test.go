@@ -0,0 +1,62 @@
+package boards
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	backlogName              = "backlog"
+	reviewName               = "review"
+	sqlQueryDeleteColumn     = `DELETE FROM list_columns WHERE id = $1`
+	sqlQueryCountColumnTodos = `SELECT COUNT(*) FROM todos`
+)
+
+var (
+	listId          = uuid.Must(uuid.NewV4())
+	otherListId     = uuid.Must(uuid.NewV4())
+	backlogColumnId = uuid.Must(uuid.NewV4())
+	reviewColumnId  = uuid.Must(uuid.NewV4())
+	openTodoId      = uuid.Must(uuid.NewV4())
+	reviewedTodoId  = uuid.Must(uuid.NewV4())
+	doneTodoId      = uuid.Must(uuid.NewV4())
+	createdAt       = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	wipLimit        = 2
+	dbError         = errors.New("database error")
+
+	columnNotFound = application_errors.NewNotFoundError(constants.COLUMN_TARGET, reviewColumnId.String())
+	listNotFound   = application_errors.NewNotFoundError(constants.LIST_TARGET, listId.String())
+
+	backlogColumnEntity = entities.Column{Id: backlogColumnId, ListId: listId, Name: backlogName, Status: string(constants.Open), Position: 1}
+	reviewColumnEntity  = entities.Column{Id: reviewColumnId, ListId: listId, Name: reviewName, Status: string(constants.InProgress),
+		WipLimit: sql.NullInt32{Int32: int32(wipLimit), Valid: true}, Position: 1, CreatedAt: createdAt}
+	backlogColumnModel = &models.Column{Id: backlogColumnId.String(), ListId: listId.String(), Name: backlogName, Status: constants.Open, Position: 1}
+	reviewColumnModel  = &models.Column{Id: reviewColumnId.String(), ListId: listId.String(), Name: reviewName, Status: constants.InProgress,
+		WipLimit: &wipLimit, Position: 1, CreatedAt: createdAt}
+
+	openTodoEntity     = entities.Todo{Id: openTodoId, ListId: listId, Status: string(constants.Open)}
+	reviewedTodoEntity = entities.Todo{Id: reviewedTodoId, ListId: listId, Status: string(constants.InProgress),
+		ColumnId: uuid.NullUUID{UUID: reviewColumnId, Valid: true}}
+	doneTodoEntity      = entities.Todo{Id: doneTodoId, ListId: listId, Status: string(constants.Done)}
+	openTodoModel       = &models.Todo{Id: openTodoId.String(), ListId: listId.String(), Status: constants.Open}
+	reviewColumnIdValue = reviewColumnId.String()
+	reviewedModel       = &models.Todo{Id: reviewedTodoId.String(), ListId: listId.String(), Status: constants.InProgress, ColumnId: &reviewColumnIdValue}
+	doneTodoModel       = &models.Todo{Id: doneTodoId.String(), ListId: listId.String(), Status: constants.Done}
+)
+
+func extractErrorFromResponseRecorder(tb testing.TB, rr *httptest.ResponseRecorder, errMessage string) {
+	tb.Helper()
+	var got map[string]string
+	require.NoError(tb, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Equal(tb, map[string]string{"error": errMessage}, got)
+}
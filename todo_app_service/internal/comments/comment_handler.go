@@ -0,0 +1,278 @@
+package comments
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+//go:generate mockery --name=commentService --exported --output=./mocks --outpkg=mocks --filename=comment_service.go --with-expecter=true
+type commentService interface {
+	CreateCommentRecord(ctx context.Context, todoId string, comment *handler_models.CreateComment, authorId string) (*models.Comment, error)
+	GetCommentsRecords(ctx context.Context, todoId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.CommentPage, error)
+	UpdateCommentRecord(ctx context.Context, todoId string, commentId string, comment *handler_models.UpdateComment) (*models.Comment, error)
+	DeleteCommentRecord(ctx context.Context, todoId string, commentId string) error
+}
+
+//go:generate mockery --name=fieldValidator --exported --output=./mocks --outpkg=mocks --filename=field_validator.go --with-expecter=true
+type fieldValidator interface {
+	Struct(interface{}) error
+}
+
+type Handler struct {
+	serv       commentService
+	fValidator fieldValidator
+	transact   persistence.Transactioner
+}
+
+func NewHandler(service commentService, fValidator fieldValidator, transact persistence.Transactioner) *Handler {
+	return &Handler{
+		serv:       service,
+		fValidator: fValidator,
+		transact:   transact,
+	}
+}
+
+func (h *Handler) HandleGetComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting todo's comments in comment handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in comment handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in comment handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	first := utils.GetContentFromUrl(r, constants.FIRST)
+	after := utils.GetContentFromUrl(r, constants.AFTER)
+	before := utils.GetContentFromUrl(r, constants.BEFORE)
+	last := utils.GetContentFromUrl(r, constants.LAST)
+
+	if len(first) == 0 && len(last) == 0 {
+		first = constants.DEFAULT_LIMIT_VALUE
+	}
+
+	f := &filters.CommentFilters{
+		PaginationFilters: filters.PaginationFilters{
+			First:  first,
+			Last:   last,
+			After:  after,
+			Before: before,
+		},
+		TodoID: todoId,
+	}
+
+	rf := &resource_identifier.GenericResourceIdentifier{}
+	rf.SetResourceIdentifier(constants.CommentsIdentifier)
+
+	comments, err := h.serv.GetCommentsRecords(ctx, todoId, f, rf)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get comments of todo with id %s, error %s when calling comment service", todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(comments); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get comments of todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleCreateComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("creating comment in comment handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in comment handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in comment handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	var comment handler_models.CreateComment
+	if err = json.NewDecoder(r.Body).Decode(&comment); err != nil {
+		log.C(ctx).Errorf("failed to decode comment handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, comment)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create comment, error because one of the required fields is missing %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	author, err := utils.GetValueFromContext[*models.User](r.Context(), middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get comment's author due to an error %s when trying to get value from context in comment handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	c, err := h.serv.CreateCommentRecord(ctx, todoId, &comment, author.Id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create comment in comment handler due to an error %s in comment service", err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(c); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to create comment, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handler) HandleUpdateComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("updating comment in comment handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in comment handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in comment handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	commentId, err := utils.GetValueFromContext[string](r.Context(), middlewares.CommentId)
+	if err != nil {
+		log.C(ctx).Error("failed to get comment_id from the context in comment handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_COMMENT_ID, http.StatusBadRequest)
+		return
+	}
+
+	var updateModel handler_models.UpdateComment
+	if err = json.NewDecoder(r.Body).Decode(&updateModel); err != nil {
+		log.C(ctx).Error("failed to decode comment handler model")
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, updateModel)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update comment, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	comment, err := h.serv.UpdateCommentRecord(ctx, todoId, commentId, &updateModel)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update comment, error %s when calling comment service", err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(comment); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to update comment with id %s, error %s", commentId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleDeleteComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("deleting comment in comment handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in comment handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in comment handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	commentId, err := utils.GetValueFromContext[string](r.Context(), middlewares.CommentId)
+	if err != nil {
+		log.C(ctx).Error("failed to get comment_id from the context in comment handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_COMMENT_ID, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.serv.DeleteCommentRecord(ctx, todoId, commentId); err != nil {
+		log.C(ctx).Errorf("failed to delete comment in comment handler due to an error %s in comment service", err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to delete comment with id %s, error %s", commentId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,173 @@
+package comments
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/source"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+type genericRepository interface {
+	GetPaginationInfo(ctx context.Context, sourceName string, filter string, params []interface{}) (*entities.PaginationInfo, error)
+}
+
+type sqlDecoratorFactory interface {
+	CreateSqlDecorator(context.Context, sql_query_decorators.Filters, string, []interface{}) (sql_query_decorators.SqlQueryRetriever, error)
+}
+
+type repository struct {
+	genericRepo genericRepository
+	factory     sqlDecoratorFactory
+}
+
+func NewRepo(genericRepo genericRepository, factory sqlDecoratorFactory) *repository {
+	return &repository{
+		genericRepo: genericRepo,
+		factory:     factory,
+	}
+}
+
+func (*repository) GetComment(ctx context.Context, todoId string, commentId string) (*entities.Comment, error) {
+	log.C(ctx).Infof("getting comment with id %s of todo with id %s from comment repository", commentId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQueryString := `SELECT id, todo_id, author, content, created_at, last_updated 
+FROM comments WHERE id = $1 AND todo_id = $2`
+
+	var entity entities.Comment
+	if err = persist.GetContext(ctx, &entity, sqlQueryString, commentId, todoId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("failed to get comment with id %s due to sqlErrNoRows", commentId)
+			return nil, application_errors.NewNotFoundError(constants.COMMENT_TARGET, commentId)
+		}
+
+		log.C(ctx).Errorf("failed to get comment with id %s due to database error", commentId)
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+func (r *repository) GetComments(ctx context.Context, f filters.SqlFilters) ([]entities.Comment, error) {
+	log.C(ctx).Info("getting comments from comment repository")
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	baseQuery := `SELECT id, todo_id, author, content, created_at, last_updated FROM comments`
+	filteringClause, params := f.BuildSQLFiltering()
+	baseQuery += filteringClause
+
+	retriever, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery, params)
+	if err != nil {
+		log.C(ctx).Error("failed to determine sql query, error when calling decorator factory")
+		return nil, err
+	}
+
+	sqlQueryString, params := retriever.DetermineCorrectSqlQuery(ctx)
+	completeQuery := fmt.Sprintf(`SELECT id, todo_id, author, content, created_at, last_updated
+FROM (%s) ORDER BY id`, sqlQueryString)
+
+	var comments []entities.Comment
+	if err = persist.SelectContext(ctx, &comments, completeQuery, params...); err != nil {
+		log.C(ctx).Errorf("failed to parse comment entities due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (r *repository) CreateComment(ctx context.Context, entity *entities.Comment) (*entities.Comment, error) {
+	log.C(ctx).Infof("creating comment on todo with id %s in comment repository", entity.TodoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQueryString := `INSERT INTO comments (id, todo_id, author, content, created_at, last_updated) 
+						VALUES (:id, :todo_id, :author, :content, :created_at, :last_updated)`
+
+	if _, err = persist.NamedExecContext(ctx, sqlQueryString, entity); err != nil {
+		log.C(ctx).Errorf("failed to create comment due to a failure in the execution of the sql query %s", err.Error())
+		return nil, persistence.MapPostgresCommentError(err, entity)
+	}
+
+	return r.GetComment(ctx, entity.TodoId.String(), entity.Id.String())
+}
+
+func (r *repository) UpdateComment(ctx context.Context, sqlExecParams map[string]interface{}, sqlFields []string) (*entities.Comment, error) {
+	commentId := sqlExecParams["id"].(string)
+	todoId := sqlExecParams["todo_id"].(string)
+	log.C(ctx).Infof("updating comment with id %s in comment repository", commentId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQueryString := parseSqlUpdateCommentQuery(sqlFields)
+
+	res, err := persist.NamedExecContext(ctx, sqlQueryString, sqlExecParams)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update comment, error when executing sql query %s", err.Error())
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to update comment, error when trying to get the number of rows affected")
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to update comment, invalid comment_id provided %s", commentId)
+		return nil, application_errors.NewNotFoundError(constants.COMMENT_TARGET, commentId)
+	}
+
+	return r.GetComment(ctx, todoId, commentId)
+}
+
+func (*repository) DeleteComment(ctx context.Context, todoId string, commentId string) error {
+	log.C(ctx).Infof("deleting comment with id %s of todo with id %s in comment repository", commentId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	sqlQueryString := `DELETE FROM comments WHERE id = $1 AND todo_id = $2`
+
+	if _, err = persist.ExecContext(ctx, sqlQueryString, commentId, todoId); err != nil {
+		log.C(ctx).Errorf("failed to delete comment with id %s due to a failiure in the execution of the sql query", commentId)
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error) {
+	log.C(ctx).Info("getting pagination info about comments in comment repo")
+
+	filteringClause, params := f.BuildSQLFiltering()
+	return r.genericRepo.GetPaginationInfo(ctx, s.GetSource(), filteringClause, params)
+}
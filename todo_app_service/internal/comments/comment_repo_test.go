@@ -0,0 +1,181 @@
+package comments
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"regexp"
+	"testing"
+)
+
+var commentColumns = []string{"id", "todo_id", "author", "content", "created_at", "last_updated"}
+
+func TestRepository_GetComment(t *testing.T) {
+	tests := []struct {
+		testName       string
+		dbMock         func(mck sqlmock.Sqlmock)
+		err            error
+		expectedEntity *entities.Comment
+	}{
+		{
+			testName: "Successfully getting comment",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(commentColumns).
+					AddRow(commentId, todoId, authorId, commentContent, createdAt, createdAt)
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetComment)).
+					WithArgs(commentId.String(), todoId.String()).
+					WillReturnRows(rows)
+			},
+			expectedEntity: commentEntity,
+		},
+		{
+			testName: "Failed to get comment which does not exist",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetComment)).
+					WithArgs(commentId.String(), todoId.String()).
+					WillReturnError(sql.ErrNoRows)
+			},
+			err: commentNotFound,
+		},
+		{
+			testName: "Failed to get comment due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetComment)).
+					WithArgs(commentId.String(), todoId.String()).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			comment, err := NewRepo(nil, nil).GetComment(ctx, todoId.String(), commentId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedEntity, comment)
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_UpdateComment(t *testing.T) {
+	tests := []struct {
+		testName       string
+		dbMock         func(mck sqlmock.Sqlmock)
+		err            error
+		expectedEntity *entities.Comment
+	}{
+		{
+			testName: "Successfully updating comment",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryUpdateComment)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				rows := sqlmock.NewRows(commentColumns).
+					AddRow(commentId, todoId, authorId, updatedCommentContent, createdAt, lastUpdated)
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetComment)).
+					WithArgs(commentId.String(), todoId.String()).
+					WillReturnRows(rows)
+			},
+			expectedEntity: updatedCommentEntity,
+		},
+		{
+			testName: "Failed to update comment which does not belong to the todo",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryUpdateComment)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			err: commentNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			sqlExecParams := map[string]interface{}{
+				"id":           commentId.String(),
+				"todo_id":      todoId.String(),
+				"content":      updatedCommentContent,
+				"last_updated": lastUpdated,
+			}
+			sqlFields := []string{"content = :content", "last_updated = :last_updated"}
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			comment, err := NewRepo(nil, nil).UpdateComment(ctx, sqlExecParams, sqlFields)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedEntity, comment)
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_DeleteComment(t *testing.T) {
+	tests := []struct {
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
+	}{
+		{
+			testName: "Successfully deleting comment",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteComment)).
+					WithArgs(commentId.String(), todoId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			testName: "Failed to delete comment due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteComment)).
+					WithArgs(commentId.String(), todoId.String()).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo(nil, nil).DeleteComment(ctx, todoId.String(), commentId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package comments
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
+	"Todo-List/internProject/todo_app_service/internal/source"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"time"
+)
+
+//go:generate mockery --name=commentRepo --exported --output=./mocks --outpkg=mocks --filename=comment_repo.go --with-expecter=true
+type commentRepo interface {
+	GetComment(ctx context.Context, todoId string, commentId string) (*entities.Comment, error)
+	GetComments(ctx context.Context, f filters.SqlFilters) ([]entities.Comment, error)
+	CreateComment(ctx context.Context, entity *entities.Comment) (*entities.Comment, error)
+	UpdateComment(ctx context.Context, sqlExecParams map[string]interface{}, sqlFields []string) (*entities.Comment, error)
+	DeleteComment(ctx context.Context, todoId string, commentId string) error
+	GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error)
+}
+
+//go:generate mockery --name=todoRepo --exported --output=./mocks --outpkg=mocks --filename=todo_repo.go --with-expecter=true
+type todoRepo interface {
+	GetTodo(ctx context.Context, todoId string) (*entities.Todo, error)
+}
+
+//go:generate mockery --name=uuidGenerator --exported --output=./mocks --outpkg=mocks --filename=uuid_generator.go --with-expecter=true
+type uuidGenerator interface {
+	Generate() string
+}
+
+//go:generate mockery --name=timeGenerator --exported --output=./mocks --outpkg=mocks --filename=time_generator.go --with-expecter=true
+type timeGenerator interface {
+	Now() time.Time
+}
+
+//go:generate mockery --name=commentConverter --exported --output=./mocks --outpkg=mocks --filename=comment_converter.go --with-expecter=true
+type commentConverter interface {
+	ToModel(comment *entities.Comment) *models.Comment
+	ToEntity(comment *models.Comment) *entities.Comment
+	ManyToPage(comments []entities.Comment, pageInfo *entities.PaginationInfo) *models.CommentPage
+	FromCreateHandlerModelToModel(comment *handler_models.CreateComment) *models.Comment
+	FromUpdateHandlerModelToModel(comment *handler_models.UpdateComment) *models.Comment
+}
+
+//go:generate mockery --name=resourceIdentifierAdapter --exported --output=./mocks --outpkg=mocks --filename=resource_identifier_adapter.go --with-expecter=true
+type resourceIdentifierAdapter interface {
+	AdaptResourceIdentifier(rf resource_identifier.ResourceIdentifier) string
+}
+
+type service struct {
+	cRepo     commentRepo
+	tRepo     todoRepo
+	uuidGen   uuidGenerator
+	timeGen   timeGenerator
+	converter commentConverter
+	rfAdapter resourceIdentifierAdapter
+}
+
+func NewService(cRepo commentRepo, tRepo todoRepo, uuidGen uuidGenerator, timeGen timeGenerator,
+	converter commentConverter, rfAdapter resourceIdentifierAdapter) *service {
+	return &service{
+		cRepo:     cRepo,
+		tRepo:     tRepo,
+		uuidGen:   uuidGen,
+		timeGen:   timeGen,
+		converter: converter,
+		rfAdapter: rfAdapter,
+	}
+}
+
+func (s *service) CreateCommentRecord(ctx context.Context, todoId string, comment *handler_models.CreateComment, authorId string) (*models.Comment, error) {
+	log.C(ctx).Infof("creating comment on todo with id %s in comment service", todoId)
+
+	modelComment := s.converter.FromCreateHandlerModelToModel(comment)
+	modelComment.Id = s.uuidGen.Generate()
+	modelComment.TodoId = todoId
+	modelComment.Author = authorId
+	modelComment.CreatedAt = s.timeGen.Now()
+	modelComment.LastUpdated = modelComment.CreatedAt
+
+	entity, err := s.cRepo.CreateComment(ctx, s.converter.ToEntity(modelComment))
+	if err != nil {
+		log.C(ctx).Errorf("failed to create comment on todo with id %s, error %s when calling comment repo", todoId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(entity), nil
+}
+
+func (s *service) GetCommentRecord(ctx context.Context, todoId string, commentId string) (*models.Comment, error) {
+	log.C(ctx).Infof("getting comment with id %s in comment service", commentId)
+
+	entity, err := s.cRepo.GetComment(ctx, todoId, commentId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get comment with id %s, error %s when calling comment repo", commentId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(entity), nil
+}
+
+func (s *service) GetCommentsRecords(ctx context.Context, todoId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.CommentPage, error) {
+	log.C(ctx).Infof("getting comments of todo with id %s in comment service", todoId)
+
+	if _, err := s.tRepo.GetTodo(ctx, todoId); err != nil {
+		log.C(ctx).Errorf("failed to get comments of todo with id %s, error when calling todo repo", todoId)
+		return nil, err
+	}
+
+	eComments, err := s.cRepo.GetComments(ctx, f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get comments of todo with id %s, error %s when calling comment repo", todoId, err.Error())
+		return nil, err
+	}
+
+	sqlSource := prepareSqlSource(s.rfAdapter, rf)
+	paginationInfo, err := s.cRepo.GetPaginationInfo(ctx, f, sqlSource)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get first and last ids of comments, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.converter.ManyToPage(eComments, paginationInfo), nil
+}
+
+func (s *service) UpdateCommentRecord(ctx context.Context, todoId string, commentId string, comment *handler_models.UpdateComment) (*models.Comment, error) {
+	log.C(ctx).Infof("updating comment with id %s in comment service", commentId)
+
+	modelComment := s.converter.FromUpdateHandlerModelToModel(comment)
+	if len(modelComment.Content) == 0 {
+		return s.GetCommentRecord(ctx, todoId, commentId)
+	}
+	modelComment.LastUpdated = s.timeGen.Now()
+
+	sqlExecParams := map[string]interface{}{"id": commentId, "todo_id": todoId}
+	sqlFields := make([]string, 0, 2)
+
+	determineSqlFieldsAndParamsComment(modelComment, sqlExecParams, &sqlFields)
+
+	entity, err := s.cRepo.UpdateComment(ctx, sqlExecParams, sqlFields)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update comment with id %s, error %s when calling comment repo", commentId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(entity), nil
+}
+
+func (s *service) DeleteCommentRecord(ctx context.Context, todoId string, commentId string) error {
+	log.C(ctx).Infof("deleting comment with id %s in comment service", commentId)
+
+	if err := s.cRepo.DeleteComment(ctx, todoId, commentId); err != nil {
+		log.C(ctx).Errorf("failed to delete comment with id %s, error %s when calling comment repo", commentId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func prepareSqlSource(adapter resourceIdentifierAdapter, rf resource_identifier.ResourceIdentifier) source.Source {
+	adaptedRf := adapter.AdaptResourceIdentifier(rf)
+
+	sqlSource := &source.SqlSource{}
+	sqlSource.SetSource(adaptedRf)
+
+	return sqlSource
+}
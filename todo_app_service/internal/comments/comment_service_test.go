@@ -0,0 +1,304 @@
+package comments
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/comments/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestService_CreateCommentRecord(t *testing.T) {
+	tests := []struct {
+		testName        string
+		mockCommentRepo func() *mocks.CommentRepo
+		mockConverter   func() *mocks.CommentConverter
+		expectedComment *models.Comment
+		err             error
+	}{
+		{
+			testName: "Successfully creating comment",
+			mockCommentRepo: func() *mocks.CommentRepo {
+				mRepo := &mocks.CommentRepo{}
+
+				mRepo.EXPECT().
+					CreateComment(context.TODO(), commentEntity).
+					Return(commentEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.CommentConverter {
+				mConverter := &mocks.CommentConverter{}
+
+				mConverter.EXPECT().
+					FromCreateHandlerModelToModel(createCommentHandlerModel).
+					Return(&models.Comment{Content: commentContent}).Once()
+
+				mConverter.EXPECT().
+					ToEntity(commentModel).
+					Return(commentEntity).Once()
+
+				mConverter.EXPECT().
+					ToModel(commentEntity).
+					Return(commentModel).Once()
+
+				return mConverter
+			},
+			expectedComment: commentModel,
+		},
+		{
+			testName: "Failed to create comment due to error in comment repository",
+			mockCommentRepo: func() *mocks.CommentRepo {
+				mRepo := &mocks.CommentRepo{}
+
+				mRepo.EXPECT().
+					CreateComment(context.TODO(), commentEntity).
+					Return(nil, todoNotFound).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.CommentConverter {
+				mConverter := &mocks.CommentConverter{}
+
+				mConverter.EXPECT().
+					FromCreateHandlerModelToModel(createCommentHandlerModel).
+					Return(&models.Comment{Content: commentContent}).Once()
+
+				mConverter.EXPECT().
+					ToEntity(commentModel).
+					Return(commentEntity).Once()
+
+				return mConverter
+			},
+			err: todoNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockCommentRepo()
+			mConverter := test.mockConverter()
+
+			mUuidGen := &mocks.UuidGenerator{}
+			mUuidGen.EXPECT().Generate().Return(commentId.String()).Once()
+
+			mTimeGen := &mocks.TimeGenerator{}
+			mTimeGen.EXPECT().Now().Return(createdAt).Once()
+
+			cService := NewService(mRepo, nil, mUuidGen, mTimeGen, mConverter, nil)
+
+			comment, err := cService.CreateCommentRecord(context.TODO(), todoId.String(), createCommentHandlerModel, authorId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedComment, comment)
+			mock.AssertExpectationsForObjects(t, mRepo, mConverter, mUuidGen, mTimeGen)
+		})
+	}
+}
+
+func TestService_GetCommentsRecords(t *testing.T) {
+	mTodoRepo := &mocks.TodoRepo{}
+	mTodoRepo.EXPECT().
+		GetTodo(context.TODO(), todoId.String()).
+		Return(nil, todoNotFound).Once()
+
+	mCommentRepo := &mocks.CommentRepo{}
+
+	cService := NewService(mCommentRepo, mTodoRepo, nil, nil, nil, nil)
+
+	comments, err := cService.GetCommentsRecords(context.TODO(), todoId.String(), nil, nil)
+	require.EqualError(t, err, todoNotFound.Error())
+	require.Nil(t, comments)
+
+	mock.AssertExpectationsForObjects(t, mTodoRepo, mCommentRepo)
+}
+
+func TestService_UpdateCommentRecord(t *testing.T) {
+	tests := []struct {
+		testName        string
+		comment         *handler_models.UpdateComment
+		mockCommentRepo func() *mocks.CommentRepo
+		mockConverter   func() *mocks.CommentConverter
+		mockTimeGen     func() *mocks.TimeGenerator
+		expectedComment *models.Comment
+		err             error
+	}{
+		{
+			testName: "Successfully updating comment content",
+			comment:  &handler_models.UpdateComment{Content: stringPointer(updatedCommentContent)},
+			mockCommentRepo: func() *mocks.CommentRepo {
+				mRepo := &mocks.CommentRepo{}
+
+				mRepo.EXPECT().
+					UpdateComment(context.TODO(), map[string]interface{}{
+						"id":           commentId.String(),
+						"todo_id":      todoId.String(),
+						"content":      updatedCommentContent,
+						"last_updated": lastUpdated,
+					}, []string{"content = :content", "last_updated = :last_updated"}).
+					Return(updatedCommentEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.CommentConverter {
+				mConverter := &mocks.CommentConverter{}
+
+				mConverter.EXPECT().
+					FromUpdateHandlerModelToModel(&handler_models.UpdateComment{Content: stringPointer(updatedCommentContent)}).
+					Return(&models.Comment{Content: updatedCommentContent}).Once()
+
+				mConverter.EXPECT().
+					ToModel(updatedCommentEntity).
+					Return(updatedCommentModel).Once()
+
+				return mConverter
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				mTimeGen := &mocks.TimeGenerator{}
+
+				mTimeGen.EXPECT().Now().Return(lastUpdated).Once()
+
+				return mTimeGen
+			},
+			expectedComment: updatedCommentModel,
+		},
+		{
+			testName: "Returning the stored comment when no content is provided",
+			comment:  &handler_models.UpdateComment{},
+			mockCommentRepo: func() *mocks.CommentRepo {
+				mRepo := &mocks.CommentRepo{}
+
+				mRepo.EXPECT().
+					GetComment(context.TODO(), todoId.String(), commentId.String()).
+					Return(commentEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.CommentConverter {
+				mConverter := &mocks.CommentConverter{}
+
+				mConverter.EXPECT().
+					FromUpdateHandlerModelToModel(&handler_models.UpdateComment{}).
+					Return(&models.Comment{}).Once()
+
+				mConverter.EXPECT().
+					ToModel(commentEntity).
+					Return(commentModel).Once()
+
+				return mConverter
+			},
+			expectedComment: commentModel,
+		},
+		{
+			testName: "Failed to update comment which does not exist",
+			comment:  &handler_models.UpdateComment{Content: stringPointer(updatedCommentContent)},
+			mockCommentRepo: func() *mocks.CommentRepo {
+				mRepo := &mocks.CommentRepo{}
+
+				mRepo.EXPECT().
+					UpdateComment(context.TODO(), mock.Anything, mock.Anything).
+					Return(nil, commentNotFound).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.CommentConverter {
+				mConverter := &mocks.CommentConverter{}
+
+				mConverter.EXPECT().
+					FromUpdateHandlerModelToModel(&handler_models.UpdateComment{Content: stringPointer(updatedCommentContent)}).
+					Return(&models.Comment{Content: updatedCommentContent}).Once()
+
+				return mConverter
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				mTimeGen := &mocks.TimeGenerator{}
+
+				mTimeGen.EXPECT().Now().Return(lastUpdated).Once()
+
+				return mTimeGen
+			},
+			err: commentNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockCommentRepo()
+			mConverter := test.mockConverter()
+
+			mTimeGen := &mocks.TimeGenerator{}
+			if test.mockTimeGen != nil {
+				mTimeGen = test.mockTimeGen()
+			}
+
+			cService := NewService(mRepo, nil, nil, mTimeGen, mConverter, nil)
+
+			comment, err := cService.UpdateCommentRecord(context.TODO(), todoId.String(), commentId.String(), test.comment)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedComment, comment)
+			mock.AssertExpectationsForObjects(t, mRepo, mConverter, mTimeGen)
+		})
+	}
+}
+
+func TestService_DeleteCommentRecord(t *testing.T) {
+	tests := []struct {
+		testName        string
+		mockCommentRepo func() *mocks.CommentRepo
+		err             error
+	}{
+		{
+			testName: "Successfully deleting comment",
+			mockCommentRepo: func() *mocks.CommentRepo {
+				mRepo := &mocks.CommentRepo{}
+
+				mRepo.EXPECT().
+					DeleteComment(context.TODO(), todoId.String(), commentId.String()).
+					Return(nil).Once()
+
+				return mRepo
+			},
+		},
+		{
+			testName: "Failed to delete comment due to database error",
+			mockCommentRepo: func() *mocks.CommentRepo {
+				mRepo := &mocks.CommentRepo{}
+
+				mRepo.EXPECT().
+					DeleteComment(context.TODO(), todoId.String(), commentId.String()).
+					Return(dbError).Once()
+
+				return mRepo
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockCommentRepo()
+
+			cService := NewService(mRepo, nil, nil, nil, nil, nil)
+
+			err := cService.DeleteCommentRecord(context.TODO(), todoId.String(), commentId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, mRepo)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package comments
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"fmt"
+	"strings"
+)
+
+func parseSqlUpdateCommentQuery(sqlFields []string) string {
+	sqlQuery := fmt.Sprintf("UPDATE comments SET %s WHERE id = :id AND todo_id = :todo_id", strings.Join(sqlFields, ", "))
+	return sqlQuery
+}
+
+func determineSqlFieldsAndParamsComment(comment *models.Comment, sqlExecParams map[string]interface{}, sqlFields *[]string) {
+	if len(comment.Content) != 0 {
+		sqlExecParams["content"] = comment.Content
+		*sqlFields = append(*sqlFields, "content = :content")
+	}
+
+	if !comment.LastUpdated.IsZero() {
+		sqlExecParams["last_updated"] = comment.LastUpdated
+		*sqlFields = append(*sqlFields, "last_updated = :last_updated")
+	}
+}
@@ -0,0 +1,64 @@
+package comments
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"errors"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+const (
+	commentContent        = "comment content"
+	updatedCommentContent = "updated comment content"
+	sqlQueryGetComment    = `SELECT id, todo_id, author, content, created_at, last_updated 
+FROM comments WHERE id = $1 AND todo_id = $2`
+	sqlQueryUpdateComment = `UPDATE comments SET content = `
+	sqlQueryDeleteComment = `DELETE FROM comments WHERE id = $1 AND todo_id = $2`
+)
+
+var (
+	commentId       = uuid.Must(uuid.NewV4())
+	todoId          = uuid.Must(uuid.NewV4())
+	authorId        = uuid.Must(uuid.NewV4())
+	createdAt       = time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+	lastUpdated     = time.Date(2025, time.January, 16, 10, 30, 0, 0, time.UTC)
+	dbError         = errors.New("database error")
+	commentNotFound = application_errors.NewNotFoundError(constants.COMMENT_TARGET, commentId.String())
+	todoNotFound    = application_errors.NewNotFoundError(constants.TODO_TARGET, todoId.String())
+
+	createCommentHandlerModel = &handler_models.CreateComment{Content: commentContent}
+	commentEntity             = initCommentEntity(commentContent, createdAt)
+	updatedCommentEntity      = initCommentEntity(updatedCommentContent, lastUpdated)
+	commentModel              = initCommentModel(commentContent, createdAt)
+	updatedCommentModel       = initCommentModel(updatedCommentContent, lastUpdated)
+)
+
+func initCommentEntity(content string, lastUpdated time.Time) *entities.Comment {
+	return &entities.Comment{
+		Id:          commentId,
+		TodoId:      todoId,
+		Author:      authorId,
+		Content:     content,
+		CreatedAt:   createdAt,
+		LastUpdated: lastUpdated,
+	}
+}
+
+func initCommentModel(content string, lastUpdated time.Time) *models.Comment {
+	return &models.Comment{
+		Id:          commentId.String(),
+		TodoId:      todoId.String(),
+		Author:      authorId.String(),
+		Content:     content,
+		CreatedAt:   createdAt,
+		LastUpdated: lastUpdated,
+	}
+}
+
+func stringPointer(str string) *string {
+	return &str
+}
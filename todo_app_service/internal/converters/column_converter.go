@@ -0,0 +1,40 @@
+package converters
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+)
+
+type columnConverter struct{}
+
+func NewColumnConverter() *columnConverter {
+	return &columnConverter{}
+}
+
+func (*columnConverter) ToModel(column *entities.Column) *models.Column {
+	modelColumn := &models.Column{
+		Id:        column.Id.String(),
+		ListId:    column.ListId.String(),
+		Name:      column.Name,
+		Status:    constants.TodoStatus(column.Status),
+		Position:  column.Position,
+		CreatedAt: column.CreatedAt,
+	}
+
+	if column.WipLimit.Valid {
+		wipLimit := int(column.WipLimit.Int32)
+		modelColumn.WipLimit = &wipLimit
+	}
+
+	return modelColumn
+}
+
+func (c *columnConverter) ManyToModel(columns []entities.Column) []*models.Column {
+	modelColumns := make([]*models.Column, 0, len(columns))
+	for index := range columns {
+		modelColumns = append(modelColumns, c.ToModel(&columns[index]))
+	}
+
+	return modelColumns
+}
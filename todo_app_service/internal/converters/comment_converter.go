@@ -0,0 +1,89 @@
+package converters
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"github.com/gofrs/uuid"
+)
+
+type commentConverter struct{}
+
+func NewCommentConverter() *commentConverter {
+	return &commentConverter{}
+}
+
+func (*commentConverter) ToModel(comment *entities.Comment) *models.Comment {
+	return &models.Comment{
+		Id:          comment.Id.String(),
+		TodoId:      comment.TodoId.String(),
+		Author:      comment.Author.String(),
+		Content:     comment.Content,
+		CreatedAt:   comment.CreatedAt,
+		LastUpdated: comment.LastUpdated,
+	}
+}
+
+func (*commentConverter) ToEntity(comment *models.Comment) *entities.Comment {
+	return &entities.Comment{
+		Id:          uuid.FromStringOrNil(comment.Id),
+		TodoId:      uuid.FromStringOrNil(comment.TodoId),
+		Author:      uuid.FromStringOrNil(comment.Author),
+		Content:     comment.Content,
+		CreatedAt:   comment.CreatedAt,
+		LastUpdated: comment.LastUpdated,
+	}
+}
+
+func (c *commentConverter) ManyToPage(comments []entities.Comment, pageInfo *entities.PaginationInfo) *models.CommentPage {
+	if len(comments) == 0 || pageInfo == nil || !pageInfo.FirstID.Valid || !pageInfo.LastID.Valid {
+		return &models.CommentPage{
+			Data: make([]*models.Comment, 0),
+			PageInfo: &pagination.Page{
+				HasNextPage: false,
+				HasPrevPage: false,
+			},
+			TotalCount: 0,
+		}
+	}
+
+	modelsComments := make([]*models.Comment, 0, len(comments))
+	for _, entity := range comments {
+		model := c.ToModel(&entity)
+		modelsComments = append(modelsComments, model)
+	}
+
+	startCursor := pagination.NewCursor("", modelsComments[0].Id)
+	endCursor := pagination.NewCursor("", modelsComments[len(modelsComments)-1].Id)
+
+	lastEntityID := pageInfo.LastID.UUID.String()
+	firstEntityID := pageInfo.FirstID.UUID.String()
+
+	return &models.CommentPage{
+		Data:       modelsComments,
+		TotalCount: pageInfo.TotalCount,
+		PageInfo: &pagination.Page{
+			StartCursor: startCursor.String(),
+			EndCursor:   endCursor.String(),
+			HasNextPage: lastEntityID != endCursor.Id,
+			HasPrevPage: firstEntityID != startCursor.Id,
+		},
+	}
+}
+
+func (*commentConverter) FromCreateHandlerModelToModel(comment *handler_models.CreateComment) *models.Comment {
+	return &models.Comment{
+		Content: comment.Content,
+	}
+}
+
+func (*commentConverter) FromUpdateHandlerModelToModel(comment *handler_models.UpdateComment) *models.Comment {
+	var modelComment models.Comment
+
+	if comment.Content != nil {
+		modelComment.Content = *comment.Content
+	}
+
+	return &modelComment
+}
@@ -0,0 +1,83 @@
+package converters
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"database/sql"
+)
+
+type historyConverter struct{}
+
+func NewHistoryConverter() *historyConverter {
+	return &historyConverter{}
+}
+
+func (*historyConverter) ToModel(entry *entities.HistoryEntry) *models.HistoryEntry {
+	var actor *string
+	if entry.Actor.Valid {
+		actorId := entry.Actor.UUID.String()
+		actor = &actorId
+	}
+
+	return &models.HistoryEntry{
+		Id:         entry.Id.String(),
+		EntityType: entry.EntityType,
+		EntityId:   entry.EntityId.String(),
+		Action:     entry.Action,
+		Field:      entry.Field,
+		OldValue:   nullStringToPointer(entry.OldValue),
+		NewValue:   nullStringToPointer(entry.NewValue),
+		Actor:      actor,
+		CreatedAt:  entry.CreatedAt,
+	}
+}
+
+// ManyToPage builds cursors on the creation time since the history is always ordered chronologically
+func (h *historyConverter) ManyToPage(entries []entities.HistoryEntry, pageInfo *entities.PaginationInfo) *models.HistoryPage {
+	if len(entries) == 0 || pageInfo == nil || !pageInfo.LastID.Valid || !pageInfo.FirstID.Valid {
+		return &models.HistoryPage{
+			Data: make([]*models.HistoryEntry, 0),
+			PageInfo: &pagination.Page{
+				HasNextPage: false,
+				HasPrevPage: false,
+			},
+			TotalCount: 0,
+		}
+	}
+
+	modelsEntries := make([]*models.HistoryEntry, 0, len(entries))
+	for _, entity := range entries {
+		model := h.ToModel(&entity)
+		modelsEntries = append(modelsEntries, model)
+	}
+
+	firstEntry := &entries[0]
+	lastEntry := &entries[len(entries)-1]
+
+	startCursor := pagination.NewCursor(firstEntry.CreatedAt.Format(constants.CURSOR_TIME_LAYOUT), firstEntry.Id.String())
+	endCursor := pagination.NewCursor(lastEntry.CreatedAt.Format(constants.CURSOR_TIME_LAYOUT), lastEntry.Id.String())
+
+	lastEntityID := pageInfo.LastID.UUID.String()
+	firstEntityID := pageInfo.FirstID.UUID.String()
+
+	return &models.HistoryPage{
+		Data:       modelsEntries,
+		TotalCount: pageInfo.TotalCount,
+		PageInfo: &pagination.Page{
+			StartCursor: startCursor.String(),
+			EndCursor:   endCursor.String(),
+			HasNextPage: lastEntityID != endCursor.Id,
+			HasPrevPage: firstEntityID != startCursor.Id,
+		},
+	}
+}
+
+func nullStringToPointer(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.String
+}
@@ -0,0 +1,61 @@
+package converters
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"github.com/gofrs/uuid"
+)
+
+type invitationConverter struct{}
+
+func NewInvitationConverter() *invitationConverter {
+	return &invitationConverter{}
+}
+
+func (*invitationConverter) ToModel(invitation *entities.Invitation) *models.Invitation {
+	var invitedBy string
+	if invitation.InvitedBy.Valid {
+		invitedBy = invitation.InvitedBy.UUID.String()
+	}
+
+	return &models.Invitation{
+		Id:        invitation.Id.String(),
+		ListId:    invitation.ListId.String(),
+		Email:     invitation.Email,
+		Role:      constants.CollaboratorRole(invitation.Role),
+		InvitedBy: invitedBy,
+		Token:     invitation.Token,
+		Status:    constants.InvitationStatus(invitation.Status),
+		CreatedAt: invitation.CreatedAt,
+		ExpiresAt: invitation.ExpiresAt,
+	}
+}
+
+func (*invitationConverter) ToEntity(invitation *models.Invitation) *entities.Invitation {
+	invitedBy := uuid.NullUUID{}
+	if len(invitation.InvitedBy) != 0 {
+		invitedBy = uuid.NullUUID{UUID: uuid.FromStringOrNil(invitation.InvitedBy), Valid: true}
+	}
+
+	return &entities.Invitation{
+		Id:        uuid.FromStringOrNil(invitation.Id),
+		ListId:    uuid.FromStringOrNil(invitation.ListId),
+		Email:     invitation.Email,
+		Role:      string(invitation.Role),
+		InvitedBy: invitedBy,
+		Token:     invitation.Token,
+		Status:    string(invitation.Status),
+		CreatedAt: invitation.CreatedAt,
+		ExpiresAt: invitation.ExpiresAt,
+	}
+}
+
+func (i *invitationConverter) ManyToModel(invitations []entities.Invitation) []*models.Invitation {
+	modelInvitations := make([]*models.Invitation, 0, len(invitations))
+	for index := range invitations {
+		modelInvitations = append(modelInvitations, i.ToModel(&invitations[index]))
+	}
+
+	return modelInvitations
+}
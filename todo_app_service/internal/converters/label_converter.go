@@ -0,0 +1,85 @@
+package converters
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"github.com/gofrs/uuid"
+)
+
+type labelConverter struct{}
+
+func NewLabelConverter() *labelConverter {
+	return &labelConverter{}
+}
+
+func (*labelConverter) ToModel(label *entities.Label) *models.Label {
+	return &models.Label{
+		Id:        label.Id.String(),
+		Name:      label.Name,
+		Owner:     label.Owner.String(),
+		CreatedAt: label.CreatedAt,
+	}
+}
+
+func (*labelConverter) ToEntity(label *models.Label) *entities.Label {
+	return &entities.Label{
+		Id:        uuid.FromStringOrNil(label.Id),
+		Name:      label.Name,
+		Owner:     uuid.FromStringOrNil(label.Owner),
+		CreatedAt: label.CreatedAt,
+	}
+}
+
+func (l *labelConverter) ManyToPage(labels []entities.Label, pageInfo *entities.PaginationInfo) *models.LabelPage {
+	if len(labels) == 0 || pageInfo == nil || !pageInfo.FirstID.Valid || !pageInfo.LastID.Valid {
+		return &models.LabelPage{
+			Data: make([]*models.Label, 0),
+			PageInfo: &pagination.Page{
+				HasNextPage: false,
+				HasPrevPage: false,
+			},
+			TotalCount: 0,
+		}
+	}
+
+	modelsLabels := make([]*models.Label, 0, len(labels))
+	for _, entity := range labels {
+		model := l.ToModel(&entity)
+		modelsLabels = append(modelsLabels, model)
+	}
+
+	startCursor := pagination.NewCursor("", modelsLabels[0].Id)
+	endCursor := pagination.NewCursor("", modelsLabels[len(modelsLabels)-1].Id)
+
+	lastEntityID := pageInfo.LastID.UUID.String()
+	firstEntityID := pageInfo.FirstID.UUID.String()
+
+	return &models.LabelPage{
+		Data:       modelsLabels,
+		TotalCount: pageInfo.TotalCount,
+		PageInfo: &pagination.Page{
+			StartCursor: startCursor.String(),
+			EndCursor:   endCursor.String(),
+			HasNextPage: lastEntityID != endCursor.Id,
+			HasPrevPage: firstEntityID != startCursor.Id,
+		},
+	}
+}
+
+func (*labelConverter) FromCreateHandlerModelToModel(label *handler_models.CreateLabel) *models.Label {
+	return &models.Label{
+		Name: label.Name,
+	}
+}
+
+func (*labelConverter) FromUpdateHandlerModelToModel(label *handler_models.UpdateLabel) *models.Label {
+	var modelLabel models.Label
+
+	if label.Name != nil {
+		modelLabel.Name = *label.Name
+	}
+
+	return &modelLabel
+}
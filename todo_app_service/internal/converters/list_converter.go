@@ -2,6 +2,7 @@ package converters
 
 import (
 	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/handler_models"
 	"Todo-List/internProject/todo_app_service/pkg/models"
 	"Todo-List/internProject/todo_app_service/pkg/pagination"
@@ -36,7 +37,7 @@ func (*listConverter) ToEntity(list *models.List) *entities.List {
 	}
 }
 
-func (l *listConverter) ManyToPage(lists []entities.List, pageInfo *entities.PaginationInfo) *models.ListPage {
+func (l *listConverter) ManyToPage(lists []entities.List, pageInfo *entities.PaginationInfo, sortField string) *models.ListPage {
 	if len(lists) == 0 || pageInfo == nil || !pageInfo.FirstID.Valid || !pageInfo.LastID.Valid {
 		return &models.ListPage{
 			Data: make([]*models.List, 0),
@@ -54,8 +55,11 @@ func (l *listConverter) ManyToPage(lists []entities.List, pageInfo *entities.Pag
 		modelsLists = append(modelsLists, model)
 	}
 
-	startCursor := modelsLists[0].Id
-	endCursor := modelsLists[len(modelsLists)-1].Id
+	firstList := &lists[0]
+	lastList := &lists[len(lists)-1]
+
+	startCursor := pagination.NewCursor(listSortValue(firstList, sortField), firstList.Id.String())
+	endCursor := pagination.NewCursor(listSortValue(lastList, sortField), lastList.Id.String())
 
 	lastEntityID := pageInfo.LastID.UUID.String()
 	firstEntityID := pageInfo.FirstID.UUID.String()
@@ -64,10 +68,10 @@ func (l *listConverter) ManyToPage(lists []entities.List, pageInfo *entities.Pag
 		Data:       modelsLists,
 		TotalCount: pageInfo.TotalCount,
 		PageInfo: &pagination.Page{
-			StartCursor: startCursor,
-			EndCursor:   endCursor,
-			HasNextPage: lastEntityID != endCursor,
-			HasPrevPage: firstEntityID != startCursor,
+			StartCursor: startCursor.String(),
+			EndCursor:   endCursor.String(),
+			HasNextPage: lastEntityID != endCursor.Id,
+			HasPrevPage: firstEntityID != startCursor.Id,
 		},
 	}
 }
@@ -92,3 +96,16 @@ func (*listConverter) FromUpdateHandlerModelToModel(list *handler_models.UpdateL
 
 	return &modelList
 }
+
+func listSortValue(list *entities.List, sortField string) string {
+	switch sortField {
+	case constants.NAME:
+		return list.Name
+	case constants.CREATED_AT:
+		return list.CreatedAt.Format(constants.CURSOR_TIME_LAYOUT)
+	case constants.LAST_UPDATED:
+		return list.LastUpdated.Format(constants.CURSOR_TIME_LAYOUT)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,38 @@
+package converters
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"github.com/gofrs/uuid"
+)
+
+type membershipConverter struct{}
+
+func NewMembershipConverter() *membershipConverter {
+	return &membershipConverter{}
+}
+
+func (*membershipConverter) ToModel(membership *entities.Membership) *models.Memberships {
+	return &models.Memberships{
+		Organizations: membership.Organizations,
+		Teams:         membership.Teams,
+	}
+}
+
+func (*membershipConverter) ToEntity(userId string, memberships *models.Memberships) *entities.Membership {
+	entity := &entities.Membership{
+		UserId:        uuid.FromStringOrNil(userId),
+		Organizations: memberships.Organizations,
+		Teams:         memberships.Teams,
+	}
+
+	// the columns are not nullable, no memberships are stored as empty arrays
+	if entity.Organizations == nil {
+		entity.Organizations = make([]string, 0)
+	}
+	if entity.Teams == nil {
+		entity.Teams = make([]string, 0)
+	}
+
+	return entity
+}
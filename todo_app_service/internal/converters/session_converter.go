@@ -0,0 +1,66 @@
+package converters
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"database/sql"
+	"github.com/gofrs/uuid"
+)
+
+type sessionConverter struct{}
+
+func NewSessionConverter() *sessionConverter {
+	return &sessionConverter{}
+}
+
+func (*sessionConverter) ToModel(session *entities.Session) *models.Session {
+	return &models.Session{
+		Id:          session.Id.String(),
+		UserId:      session.UserId.String(),
+		DeviceLabel: session.DeviceLabel,
+		UserAgent:   session.UserAgent,
+		IpAddress:   session.IpAddress,
+		CreatedAt:   session.CreatedAt,
+		LastUsedAt:  session.LastUsedAt,
+		AccessToken: accessTokenInfoToModel(session.AccessTokenId, session.AccessTokenExpiresAt),
+	}
+}
+
+func (*sessionConverter) ToEntity(session *models.Session) *entities.Session {
+	entity := &entities.Session{
+		Id:          uuid.FromStringOrNil(session.Id),
+		UserId:      uuid.FromStringOrNil(session.UserId),
+		DeviceLabel: session.DeviceLabel,
+		UserAgent:   session.UserAgent,
+		IpAddress:   session.IpAddress,
+		CreatedAt:   session.CreatedAt,
+		LastUsedAt:  session.LastUsedAt,
+	}
+
+	if session.AccessToken != nil {
+		entity.AccessTokenId = session.AccessToken.Id
+		entity.AccessTokenExpiresAt = sql.NullTime{Time: session.AccessToken.ExpiresAt, Valid: true}
+	}
+
+	return entity
+}
+
+func (s *sessionConverter) ManyToModel(sessions []entities.Session) []*models.Session {
+	modelSessions := make([]*models.Session, 0, len(sessions))
+	for _, entity := range sessions {
+		modelSessions = append(modelSessions, s.ToModel(&entity))
+	}
+
+	return modelSessions
+}
+
+func accessTokenInfoToModel(id string, expiresAt sql.NullTime) *models.AccessTokenInfo {
+	if id == "" || !expiresAt.Valid {
+		return nil
+	}
+
+	return &models.AccessTokenInfo{
+		Id:        id,
+		ExpiresAt: expiresAt.Time,
+	}
+}
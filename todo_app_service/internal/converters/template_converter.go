@@ -0,0 +1,74 @@
+package converters
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+)
+
+type templateConverter struct{}
+
+func NewTemplateConverter() *templateConverter {
+	return &templateConverter{}
+}
+
+func (t *templateConverter) ToModel(template *entities.Template, todos []entities.TemplateTodo) *models.Template {
+	modelTemplate := &models.Template{
+		Id:          template.Id.String(),
+		Name:        template.Name,
+		Description: template.Description,
+		Owner:       template.Owner.String(),
+		CreatedAt:   template.CreatedAt,
+		Todos:       make([]*models.TemplateTodo, 0, len(todos)),
+	}
+
+	for index := range todos {
+		modelTemplate.Todos = append(modelTemplate.Todos, t.todoToModel(&todos[index]))
+	}
+
+	return modelTemplate
+}
+
+func (t *templateConverter) ManyToModel(templates []entities.Template) []*models.Template {
+	modelTemplates := make([]*models.Template, 0, len(templates))
+	for index := range templates {
+		modelTemplates = append(modelTemplates, t.ToModel(&templates[index], nil))
+	}
+
+	return modelTemplates
+}
+
+func (*templateConverter) todoToModel(todo *entities.TemplateTodo) *models.TemplateTodo {
+	var dueOffset *int64
+	if todo.DueOffset.Valid {
+		offset := todo.DueOffset.Int64
+		dueOffset = &offset
+	}
+
+	var recurrence *models.Recurrence
+	if todo.RecurrenceFrequency.Valid {
+		recurrence = &models.Recurrence{
+			Frequency: constants.RecurrenceFrequency(todo.RecurrenceFrequency.String),
+			Interval:  constants.DEFAULT_RECURRENCE_INTERVAL,
+		}
+
+		if todo.RecurrenceInterval.Valid {
+			recurrence.Interval = int(todo.RecurrenceInterval.Int32)
+		}
+		if todo.RecurrenceCount.Valid {
+			count := int(todo.RecurrenceCount.Int32)
+			recurrence.Count = &count
+		}
+	}
+
+	return &models.TemplateTodo{
+		Id:          todo.Id.String(),
+		Name:        todo.Name,
+		Description: todo.Description,
+		Priority:    constants.Priority(todo.Priority),
+		DueOffset:   dueOffset,
+		ParentId:    utils.ConvertFromNullUuidToStringPtr(todo.ParentId),
+		Recurrence:  recurrence,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"Todo-List/internProject/todo_app_service/pkg/handler_models"
 	"Todo-List/internProject/todo_app_service/pkg/models"
 	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"database/sql"
 	"github.com/gofrs/uuid"
 )
 
@@ -19,6 +20,7 @@ func NewTodoConverter() *todoConverter {
 func (t *todoConverter) ToModel(todo *entities.Todo) *models.Todo {
 	dueDate := utils.ExtractDueDateValueFromSQLNull(todo)
 	assignedTo := utils.ConvertFromNullUuidToStringPtr(todo.AssignedTo)
+	parentId := utils.ConvertFromNullUuidToStringPtr(todo.ParentId)
 
 	return &models.Todo{
 		Id:          todo.Id.String(),
@@ -31,14 +33,20 @@ func (t *todoConverter) ToModel(todo *entities.Todo) *models.Todo {
 		Priority:    constants.Priority(todo.Priority),
 		AssignedTo:  assignedTo,
 		DueDate:     dueDate,
+		ParentId:    parentId,
+		Recurrence:  recurrenceEntityToModel(todo),
+		Position:    todo.Position,
+		ColumnId:    utils.ConvertFromNullUuidToStringPtr(todo.ColumnId),
+		Estimate:    estimateEntityToModel(todo.Estimate),
 	}
 }
 
 func (t *todoConverter) ToEntity(todo *models.Todo) *entities.Todo {
 	dueDate := utils.ConvertFromPointerToSQLNullTime(todo.DueDate)
 	assignedTo := utils.ConvertFromPointerToNullUUID(todo.AssignedTo)
+	parentId := utils.ConvertFromPointerToNullUUID(todo.ParentId)
 
-	return &entities.Todo{
+	entity := &entities.Todo{
 		Id:          uuid.FromStringOrNil(todo.Id),
 		Name:        todo.Name,
 		Description: todo.Description,
@@ -49,7 +57,28 @@ func (t *todoConverter) ToEntity(todo *models.Todo) *entities.Todo {
 		AssignedTo:  assignedTo,
 		DueDate:     dueDate,
 		Priority:    string(todo.Priority),
+		ParentId:    parentId,
+		Position:    todo.Position,
+		ColumnId:    utils.ConvertFromPointerToNullUUID(todo.ColumnId),
 	}
+
+	if todo.Estimate != nil {
+		entity.Estimate = sql.NullInt32{Int32: int32(*todo.Estimate), Valid: true}
+	}
+
+	if todo.Recurrence != nil {
+		entity.RecurrenceFrequency = sql.NullString{String: string(todo.Recurrence.Frequency), Valid: true}
+		entity.RecurrenceInterval = sql.NullInt32{Int32: int32(todo.Recurrence.Interval), Valid: true}
+		entity.RecurrenceUntil = utils.ConvertFromPointerToSQLNullTime(todo.Recurrence.Until)
+		if todo.Recurrence.Count != nil {
+			entity.RecurrenceCount = sql.NullInt32{Int32: int32(*todo.Recurrence.Count), Valid: true}
+		}
+		if todo.Recurrence.AnchorDay != 0 {
+			entity.RecurrenceAnchorDay = sql.NullInt32{Int32: int32(todo.Recurrence.AnchorDay), Valid: true}
+		}
+	}
+
+	return entity
 }
 
 func (t *todoConverter) ConvertFromUpdateHandlerModelToModel(todo *handler_models.UpdateTodo) *models.Todo {
@@ -70,6 +99,9 @@ func (t *todoConverter) ConvertFromUpdateHandlerModelToModel(todo *handler_model
 
 	modelTodo.AssignedTo = todo.AssignedTo
 	modelTodo.DueDate = todo.DueDate
+	modelTodo.Recurrence = recurrenceHandlerModelToModel(todo.Recurrence)
+	modelTodo.ColumnId = todo.ColumnId
+	modelTodo.Estimate = todo.Estimate
 
 	return &modelTodo
 }
@@ -82,10 +114,24 @@ func (*todoConverter) ConvertFromCreateHandlerModelToModel(todo *handler_models.
 		Priority:    todo.Priority,
 		AssignedTo:  todo.AssignedTo,
 		DueDate:     todo.DueDate,
+		Recurrence:  recurrenceHandlerModelToModel(todo.Recurrence),
+		Estimate:    todo.Estimate,
 	}
 }
 
-func (t *todoConverter) ManyToPage(todos []entities.Todo, pageInfo *entities.PaginationInfo) *models.TodoPage {
+func (*todoConverter) ConvertFromCreateSubtaskHandlerModelToModel(subtask *handler_models.CreateSubtask, parent *models.Todo) *models.Todo {
+	return &models.Todo{
+		Name:        subtask.Name,
+		Description: subtask.Description,
+		ListId:      parent.ListId,
+		Priority:    subtask.Priority,
+		AssignedTo:  subtask.AssignedTo,
+		DueDate:     subtask.DueDate,
+		ParentId:    &parent.Id,
+	}
+}
+
+func (t *todoConverter) ManyToPage(todos []entities.Todo, pageInfo *entities.PaginationInfo, sortField string) *models.TodoPage {
 	if len(todos) == 0 || pageInfo == nil || !pageInfo.LastID.Valid || !pageInfo.FirstID.Valid {
 		return &models.TodoPage{
 			Data: make([]*models.Todo, 0),
@@ -103,8 +149,11 @@ func (t *todoConverter) ManyToPage(todos []entities.Todo, pageInfo *entities.Pag
 		modelsTodos[index] = model
 	}
 
-	startCursor := modelsTodos[0].Id
-	endCursor := modelsTodos[len(todos)-1].Id
+	firstTodo := &todos[0]
+	lastTodo := &todos[len(todos)-1]
+
+	startCursor := pagination.NewCursor(todoSortValue(firstTodo, sortField), firstTodo.Id.String())
+	endCursor := pagination.NewCursor(todoSortValue(lastTodo, sortField), lastTodo.Id.String())
 
 	lastEntityID := pageInfo.LastID.UUID.String()
 	firstEntityID := pageInfo.FirstID.UUID.String()
@@ -113,10 +162,88 @@ func (t *todoConverter) ManyToPage(todos []entities.Todo, pageInfo *entities.Pag
 		Data:       modelsTodos,
 		TotalCount: pageInfo.TotalCount,
 		PageInfo: &pagination.Page{
-			StartCursor: startCursor,
-			EndCursor:   endCursor,
-			HasNextPage: lastEntityID != endCursor,
-			HasPrevPage: firstEntityID != startCursor,
+			StartCursor: startCursor.String(),
+			EndCursor:   endCursor.String(),
+			HasNextPage: lastEntityID != endCursor.Id,
+			HasPrevPage: firstEntityID != startCursor.Id,
 		},
 	}
 }
+
+// todoSortValue mirrors the sort expressions of the sql decorators, todos without due date are sorted as due in infinity
+func todoSortValue(todo *entities.Todo, sortField string) string {
+	switch sortField {
+	case constants.NAME:
+		return todo.Name
+	case constants.CREATED_AT:
+		return todo.CreatedAt.Format(constants.CURSOR_TIME_LAYOUT)
+	case constants.LAST_UPDATED:
+		return todo.LastUpdated.Format(constants.CURSOR_TIME_LAYOUT)
+	case constants.PRIORITY:
+		return todo.Priority
+	case constants.POSITION:
+		return todo.Position
+	case constants.DUE_DATE:
+		if !todo.DueDate.Valid {
+			return constants.INFINITY_TIMESTAMP
+		}
+		return todo.DueDate.Time.Format(constants.CURSOR_TIME_LAYOUT)
+	default:
+		return ""
+	}
+}
+
+func estimateEntityToModel(estimate sql.NullInt32) *int {
+	if !estimate.Valid {
+		return nil
+	}
+
+	minutes := int(estimate.Int32)
+	return &minutes
+}
+
+func recurrenceEntityToModel(todo *entities.Todo) *models.Recurrence {
+	if !todo.RecurrenceFrequency.Valid {
+		return nil
+	}
+
+	recurrence := &models.Recurrence{
+		Frequency: constants.RecurrenceFrequency(todo.RecurrenceFrequency.String),
+		Interval:  constants.DEFAULT_RECURRENCE_INTERVAL,
+	}
+
+	if todo.RecurrenceInterval.Valid {
+		recurrence.Interval = int(todo.RecurrenceInterval.Int32)
+	}
+	if todo.RecurrenceUntil.Valid {
+		until := todo.RecurrenceUntil.Time
+		recurrence.Until = &until
+	}
+	if todo.RecurrenceCount.Valid {
+		count := int(todo.RecurrenceCount.Int32)
+		recurrence.Count = &count
+	}
+	if todo.RecurrenceAnchorDay.Valid {
+		recurrence.AnchorDay = int(todo.RecurrenceAnchorDay.Int32)
+	}
+
+	return recurrence
+}
+
+func recurrenceHandlerModelToModel(recurrence *handler_models.Recurrence) *models.Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	interval := recurrence.Interval
+	if interval == 0 {
+		interval = constants.DEFAULT_RECURRENCE_INTERVAL
+	}
+
+	return &models.Recurrence{
+		Frequency: recurrence.Frequency,
+		Interval:  interval,
+		Until:     recurrence.Until,
+		Count:     recurrence.Count,
+	}
+}
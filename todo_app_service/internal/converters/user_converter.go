@@ -21,9 +21,10 @@ func (*userConverter) ToModel(user *entities.User) *models.User {
 	}
 
 	return &models.User{
-		Id:    user.Id.String(),
-		Email: user.Email,
-		Role:  constants.UserRole(user.Role),
+		Id:       user.Id.String(),
+		Email:    user.Email,
+		Role:     constants.UserRole(user.Role),
+		ListRole: constants.CollaboratorRole(user.ListRole.String),
 	}
 }
 
@@ -74,8 +75,8 @@ func (u *userConverter) ManyToPage(users []entities.User, pageInfo *entities.Pag
 		modelUsers = append(modelUsers, model)
 	}
 
-	startCursor := modelUsers[0].Id
-	endCursor := modelUsers[len(users)-1].Id
+	startCursor := pagination.NewCursor("", modelUsers[0].Id)
+	endCursor := pagination.NewCursor("", modelUsers[len(users)-1].Id)
 
 	lastEntityID := pageInfo.LastID.UUID.String()
 	firstEntityID := pageInfo.FirstID.UUID.String()
@@ -84,10 +85,10 @@ func (u *userConverter) ManyToPage(users []entities.User, pageInfo *entities.Pag
 		Data:       modelUsers,
 		TotalCount: pageInfo.TotalCount,
 		PageInfo: &pagination.Page{
-			StartCursor: startCursor,
-			EndCursor:   endCursor,
-			HasNextPage: endCursor != lastEntityID,
-			HasPrevPage: startCursor != firstEntityID,
+			StartCursor: startCursor.String(),
+			EndCursor:   endCursor.String(),
+			HasNextPage: endCursor.Id != lastEntityID,
+			HasPrevPage: startCursor.Id != firstEntityID,
 		},
 	}
 }
@@ -0,0 +1,55 @@
+package converters
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"github.com/gofrs/uuid"
+)
+
+type workLogConverter struct{}
+
+func NewWorkLogConverter() *workLogConverter {
+	return &workLogConverter{}
+}
+
+func (*workLogConverter) ToModel(workLog *entities.WorkLog) *models.WorkLog {
+	return &models.WorkLog{
+		Id:          workLog.Id.String(),
+		TodoId:      workLog.TodoId.String(),
+		UserId:      workLog.UserId.String(),
+		Duration:    workLog.Duration,
+		Note:        workLog.Note,
+		LoggedOn:    workLog.LoggedOn,
+		CreatedAt:   workLog.CreatedAt,
+		LastUpdated: workLog.LastUpdated,
+	}
+}
+
+func (*workLogConverter) ToEntity(workLog *models.WorkLog) *entities.WorkLog {
+	return &entities.WorkLog{
+		Id:          uuid.FromStringOrNil(workLog.Id),
+		TodoId:      uuid.FromStringOrNil(workLog.TodoId),
+		UserId:      uuid.FromStringOrNil(workLog.UserId),
+		Duration:    workLog.Duration,
+		Note:        workLog.Note,
+		LoggedOn:    workLog.LoggedOn,
+		CreatedAt:   workLog.CreatedAt,
+		LastUpdated: workLog.LastUpdated,
+	}
+}
+
+func (w *workLogConverter) ManyToModel(workLogs []entities.WorkLog) []*models.WorkLog {
+	modelWorkLogs := make([]*models.WorkLog, 0, len(workLogs))
+	for _, entity := range workLogs {
+		modelWorkLogs = append(modelWorkLogs, w.ToModel(&entity))
+	}
+
+	return modelWorkLogs
+}
+
+func (*workLogConverter) TotalsToModel(totals *entities.TimeTotals) *models.TimeTotals {
+	return &models.TimeTotals{
+		Estimate:  totals.Estimate,
+		TimeSpent: totals.TimeSpent,
+	}
+}
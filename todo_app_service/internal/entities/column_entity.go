@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"database/sql"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type Column struct {
+	Id        uuid.UUID     `db:"id"`
+	ListId    uuid.UUID     `db:"list_id"`
+	Name      string        `db:"name"`
+	Status    string        `db:"status"`
+	WipLimit  sql.NullInt32 `db:"wip_limit"`
+	Position  int           `db:"position"`
+	CreatedAt time.Time     `db:"created_at"`
+}
@@ -0,0 +1,15 @@
+package entities
+
+import (
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type Comment struct {
+	Id          uuid.UUID `db:"id"`
+	TodoId      uuid.UUID `db:"todo_id"`
+	Author      uuid.UUID `db:"author"`
+	Content     string    `db:"content"`
+	CreatedAt   time.Time `db:"created_at"`
+	LastUpdated time.Time `db:"last_updated"`
+}
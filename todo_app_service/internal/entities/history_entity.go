@@ -0,0 +1,19 @@
+package entities
+
+import (
+	"database/sql"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type HistoryEntry struct {
+	Id         uuid.UUID      `db:"id"`
+	EntityType string         `db:"entity_type"`
+	EntityId   uuid.UUID      `db:"entity_id"`
+	Action     string         `db:"action"`
+	Field      string         `db:"field"`
+	OldValue   sql.NullString `db:"old_value"`
+	NewValue   sql.NullString `db:"new_value"`
+	Actor      uuid.NullUUID  `db:"actor"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type Invitation struct {
+	Id        uuid.UUID     `db:"id"`
+	ListId    uuid.UUID     `db:"list_id"`
+	Email     string        `db:"email"`
+	Role      string        `db:"role"`
+	InvitedBy uuid.NullUUID `db:"invited_by"`
+	Token     string        `db:"token"`
+	Status    string        `db:"status"`
+	CreatedAt time.Time     `db:"created_at"`
+	ExpiresAt time.Time     `db:"expires_at"`
+}
@@ -0,0 +1,13 @@
+package entities
+
+import (
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type Label struct {
+	Id        uuid.UUID `db:"id"`
+	Name      string    `db:"name"`
+	Owner     uuid.UUID `db:"owner"`
+	CreatedAt time.Time `db:"created_at"`
+}
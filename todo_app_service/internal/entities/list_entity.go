@@ -1,16 +1,19 @@
 package entities
 
 import (
+	"database/sql"
 	"github.com/gofrs/uuid"
 	"time"
 )
 
 type List struct {
-	Id          uuid.UUID `db:"id"`
-	Name        string    `db:"name"`
-	CreatedAt   time.Time `db:"created_at"`
-	LastUpdated time.Time `db:"last_updated"`
-	Owner       uuid.UUID `db:"owner"`
-	Description string    `db:"description"`
-	TotalCount  int       `db:"total_count"`
+	Id          uuid.UUID     `db:"id"`
+	Name        string        `db:"name"`
+	CreatedAt   time.Time     `db:"created_at"`
+	LastUpdated time.Time     `db:"last_updated"`
+	Owner       uuid.UUID     `db:"owner"`
+	Description string        `db:"description"`
+	TotalCount  int           `db:"total_count"`
+	DeletedAt   sql.NullTime  `db:"deleted_at"`
+	DeletedBy   uuid.NullUUID `db:"deleted_by"`
 }
@@ -0,0 +1,12 @@
+package entities
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+)
+
+type Membership struct {
+	UserId        uuid.UUID      `db:"user_id"`
+	Organizations pq.StringArray `db:"organizations"`
+	Teams         pq.StringArray `db:"teams"`
+}
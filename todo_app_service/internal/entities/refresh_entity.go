@@ -1,8 +0,0 @@
-package entities
-
-import "github.com/gofrs/uuid"
-
-type Refresh struct {
-	RefreshToken string    `db:"refresh_token"`
-	UserId       uuid.UUID `db:"user_id"`
-}
@@ -0,0 +1,11 @@
+package entities
+
+import "github.com/gofrs/uuid"
+
+type SearchHit struct {
+	ResultType string    `db:"result_type"`
+	Id         uuid.UUID `db:"id"`
+	Rank       float64   `db:"rank"`
+	Position   int       `db:"position"`
+	TotalCount int       `db:"total_count"`
+}
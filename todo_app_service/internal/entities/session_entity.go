@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"database/sql"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type Session struct {
+	Id                   uuid.UUID    `db:"id"`
+	UserId               uuid.UUID    `db:"user_id"`
+	RefreshTokenHash     string       `db:"refresh_token_hash"`
+	DeviceLabel          string       `db:"device_label"`
+	UserAgent            string       `db:"user_agent"`
+	IpAddress            string       `db:"ip_address"`
+	CreatedAt            time.Time    `db:"created_at"`
+	LastUsedAt           time.Time    `db:"last_used_at"`
+	AccessTokenId        string       `db:"access_token_id"`
+	AccessTokenExpiresAt sql.NullTime `db:"access_token_expires_at"`
+}
+
+type ConsumedRefreshToken struct {
+	TokenHash  string    `db:"token_hash"`
+	SessionId  uuid.UUID `db:"session_id"`
+	ConsumedAt time.Time `db:"consumed_at"`
+}
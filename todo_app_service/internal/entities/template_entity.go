@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"database/sql"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type Template struct {
+	Id          uuid.UUID `db:"id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	Owner       uuid.UUID `db:"owner"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type TemplateTodo struct {
+	Id                  uuid.UUID      `db:"id"`
+	TemplateId          uuid.UUID      `db:"template_id"`
+	Name                string         `db:"name"`
+	Description         string         `db:"description"`
+	Priority            string         `db:"priority"`
+	DueOffset           sql.NullInt64  `db:"due_offset"`
+	ParentId            uuid.NullUUID  `db:"parent_id"`
+	Position            int            `db:"position"`
+	RecurrenceFrequency sql.NullString `db:"recurrence_frequency"`
+	RecurrenceInterval  sql.NullInt32  `db:"recurrence_interval"`
+	RecurrenceCount     sql.NullInt32  `db:"recurrence_count"`
+}
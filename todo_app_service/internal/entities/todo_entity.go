@@ -7,15 +7,26 @@ import (
 )
 
 type Todo struct {
-	Id          uuid.UUID     `db:"id"`
-	Name        string        `db:"name"`
-	Description string        `db:"description"`
-	ListId      uuid.UUID     `db:"list_id"`
-	Status      string        `db:"status"`
-	CreatedAt   time.Time     `db:"created_at"`
-	LastUpdated time.Time     `db:"last_updated"`
-	AssignedTo  uuid.NullUUID `db:"assigned_to"`
-	DueDate     sql.NullTime  `db:"due_date"`
-	Priority    string        `db:"priority"`
-	TotalCount  int           `db:"total_count"`
+	Id                  uuid.UUID      `db:"id"`
+	Name                string         `db:"name"`
+	Description         string         `db:"description"`
+	ListId              uuid.UUID      `db:"list_id"`
+	Status              string         `db:"status"`
+	CreatedAt           time.Time      `db:"created_at"`
+	LastUpdated         time.Time      `db:"last_updated"`
+	AssignedTo          uuid.NullUUID  `db:"assigned_to"`
+	DueDate             sql.NullTime   `db:"due_date"`
+	Priority            string         `db:"priority"`
+	ParentId            uuid.NullUUID  `db:"parent_id"`
+	TotalCount          int            `db:"total_count"`
+	RecurrenceFrequency sql.NullString `db:"recurrence_frequency"`
+	RecurrenceInterval  sql.NullInt32  `db:"recurrence_interval"`
+	RecurrenceUntil     sql.NullTime   `db:"recurrence_until"`
+	RecurrenceCount     sql.NullInt32  `db:"recurrence_count"`
+	RecurrenceAnchorDay sql.NullInt32  `db:"recurrence_anchor_day"`
+	DeletedAt           sql.NullTime   `db:"deleted_at"`
+	DeletedBy           uuid.NullUUID  `db:"deleted_by"`
+	Position            string         `db:"position"`
+	ColumnId            uuid.NullUUID  `db:"column_id"`
+	Estimate            sql.NullInt32  `db:"estimate"`
 }
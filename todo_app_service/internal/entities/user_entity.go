@@ -1,10 +1,14 @@
 package entities
 
-import "github.com/gofrs/uuid"
+import (
+	"database/sql"
+	"github.com/gofrs/uuid"
+)
 
 type User struct {
-	Id         uuid.UUID `db:"id"`
-	Email      string    `db:"email"`
-	Role       string    `db:"role"`
-	TotalCount int       `db:"total_count"`
+	Id         uuid.UUID      `db:"id"`
+	Email      string         `db:"email"`
+	Role       string         `db:"role"`
+	ListRole   sql.NullString `db:"list_role"`
+	TotalCount int            `db:"total_count"`
 }
@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type WorkLog struct {
+	Id          uuid.UUID `db:"id"`
+	TodoId      uuid.UUID `db:"todo_id"`
+	UserId      uuid.UUID `db:"user_id"`
+	Duration    int       `db:"duration"`
+	Note        string    `db:"note"`
+	LoggedOn    time.Time `db:"logged_on"`
+	CreatedAt   time.Time `db:"created_at"`
+	LastUpdated time.Time `db:"last_updated"`
+}
+
+type TimeTotals struct {
+	Estimate  int `db:"estimate"`
+	TimeSpent int `db:"time_spent"`
+}
@@ -4,6 +4,7 @@ import (
 	"Todo-List/internProject/todo_app_service/internal/entities"
 	"Todo-List/internProject/todo_app_service/internal/persistence"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"context"
 	"database/sql"
 	"errors"
@@ -16,7 +17,13 @@ func NewRepo() *repository {
 	return &repository{}
 }
 
-func (*repository) GetPaginationInfo(ctx context.Context, sourceName string, filter string, params []interface{}) (*entities.PaginationInfo, error) {
+func (r *repository) GetPaginationInfo(ctx context.Context, sourceName string, filter string, params []interface{}) (*entities.PaginationInfo, error) {
+	return r.GetSortedPaginationInfo(ctx, sourceName, filter, constants.ID, constants.ASC_ORDER, params)
+}
+
+// GetSortedPaginationInfo returns the ids of the first and the last record when ordering by the sort expression in the given
+// direction with the id as a tiebreaker, together with the total count of records
+func (*repository) GetSortedPaginationInfo(ctx context.Context, sourceName string, filter string, sortExpression string, direction string, params []interface{}) (*entities.PaginationInfo, error) {
 	log.C(ctx).Infof("getting pagination info from source %s", sourceName)
 
 	persist, err := persistence.FromCtx(ctx)
@@ -25,16 +32,22 @@ func (*repository) GetPaginationInfo(ctx context.Context, sourceName string, fil
 		return nil, err
 	}
 
+	reversedDirection := constants.DESC_ORDER
+	if direction == constants.DESC_ORDER {
+		reversedDirection = constants.ASC_ORDER
+	}
+
 	sqlQuery := fmt.Sprintf(
 		`WITH filtered AS(
-						SELECT id FROM %s %s 
+						SELECT id, %s AS sort_key FROM %s %s 
 					)
 					 SELECT
     				(SELECT id FROM filtered
-					ORDER BY id ASC LIMIT 1) AS first_id,
+					ORDER BY sort_key %s, id %s LIMIT 1) AS first_id,
 					(SELECT id FROM filtered
-    				ORDER BY id DESC LIMIT 1) AS last_id,
-    				COUNT(*) AS total_count FROM filtered`, sourceName, filter)
+    				ORDER BY sort_key %s, id %s LIMIT 1) AS last_id,
+    				COUNT(*) AS total_count FROM filtered`, sortExpression, sourceName, filter,
+		direction, direction, reversedDirection, reversedDirection)
 
 	var paginationInfo entities.PaginationInfo
 	if err = persist.GetContext(ctx, &paginationInfo, sqlQuery, params...); err != nil {
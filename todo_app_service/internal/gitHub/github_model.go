@@ -6,3 +6,8 @@ type Organization struct {
 type UserInfo struct {
 	Email *string `json:"email"`
 }
+
+type Team struct {
+	Slug         string       `json:"slug"`
+	Organization Organization `json:"organization"`
+}
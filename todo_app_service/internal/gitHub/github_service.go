@@ -24,7 +24,7 @@ func NewService(httpService httpService) *service {
 func (s *service) GetUserOrganizations(ctx context.Context, accessToken string) ([]*Organization, error) {
 	log.C(ctx).Info("getting user organizations in github service")
 
-	url := "https://api.github.com/user/orgs"
+	url := "https://api.github.com/user/orgs?per_page=100"
 
 	resp, err := s.httpService.GetHttpResponseWithAccessCode(ctx, http.MethodGet, url, nil, accessToken)
 	if err != nil {
@@ -42,6 +42,27 @@ func (s *service) GetUserOrganizations(ctx context.Context, accessToken string)
 	return userOrganizations, nil
 }
 
+func (s *service) GetUserTeams(ctx context.Context, accessToken string) ([]*Team, error) {
+	log.C(ctx).Info("getting user teams in github service")
+
+	url := "https://api.github.com/user/teams?per_page=100"
+
+	resp, err := s.httpService.GetHttpResponseWithAccessCode(ctx, http.MethodGet, url, nil, accessToken)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get user teams, error %s when trying to get http response", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var userTeams []*Team
+	if err = json.NewDecoder(resp.Body).Decode(&userTeams); err != nil {
+		log.C(ctx).Errorf("failed to get user teams, error %s when trying to decode JSON response body", err.Error())
+		return nil, err
+	}
+
+	return userTeams, nil
+}
+
 func (s *service) GetUserInfo(ctx context.Context, accessToken string) (*UserInfo, error) {
 	log.C(ctx).Info("getting user info in github service")
 
@@ -0,0 +1,64 @@
+package history
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/source"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"encoding/json"
+	"errors"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	historyIdentifier = "history"
+	sqlQuerySetActor  = `SELECT set_config('history.actor_id', $1, true)`
+)
+
+var (
+	actorId     = uuid.Must(uuid.NewV4()).String()
+	todoId      = uuid.Must(uuid.NewV4())
+	listId      = uuid.Must(uuid.NewV4())
+	createdAt   = time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+	dbError     = errors.New("database error")
+	actor       = &models.User{Id: actorId, Role: constants.Writer}
+	todoMissing = application_errors.NewNotFoundError(constants.TODO_TARGET, todoId.String())
+	listMissing = application_errors.NewNotFoundError(constants.LIST_TARGET, listId.String())
+
+	historyEntries = []entities.HistoryEntry{{EntityType: constants.TODO_HISTORY_ENTITY, EntityId: todoId, CreatedAt: createdAt}}
+	paginationInfo = &entities.PaginationInfo{}
+	historyPage    = &models.HistoryPage{
+		Data:       []*models.HistoryEntry{{EntityType: constants.TODO_HISTORY_ENTITY, EntityId: todoId.String(), CreatedAt: createdAt}},
+		PageInfo:   &pagination.Page{},
+		TotalCount: 1,
+	}
+)
+
+func initHistoryFilters(entityType string, entityId string) *filters.HistoryFilters {
+	return &filters.HistoryFilters{
+		PaginationFilters: filters.PaginationFilters{First: constants.DEFAULT_LIMIT_VALUE},
+		EntityType:        entityType,
+		EntityID:          entityId,
+	}
+}
+
+func historySource() source.Source {
+	sqlSource := &source.SqlSource{}
+	sqlSource.SetSource(historyIdentifier)
+
+	return sqlSource
+}
+
+func extractErrorFromResponseRecorder(tb testing.TB, rr *httptest.ResponseRecorder, errMessage string) {
+	tb.Helper()
+	var got map[string]string
+	require.NoError(tb, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Equal(tb, map[string]string{"error": errMessage}, got)
+}
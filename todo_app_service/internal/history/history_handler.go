@@ -0,0 +1,144 @@
+package history
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+//go:generate mockery --name=historyService --exported --output=./mocks --outpkg=mocks --filename=history_service.go --with-expecter=true
+type historyService interface {
+	GetTodoHistoryRecords(ctx context.Context, todoId string, f *filters.HistoryFilters, rf resource_identifier.ResourceIdentifier) (*models.HistoryPage, error)
+	GetListHistoryRecords(ctx context.Context, listId string, f *filters.HistoryFilters, rf resource_identifier.ResourceIdentifier) (*models.HistoryPage, error)
+}
+
+type Handler struct {
+	serv     historyService
+	transact persistence.Transactioner
+}
+
+func NewHandler(service historyService, transact persistence.Transactioner) *Handler {
+	return &Handler{
+		serv:     service,
+		transact: transact,
+	}
+}
+
+func (h *Handler) HandleGetTodoHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting todo's history in history handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in history handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in history handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	history, err := h.serv.GetTodoHistoryRecords(ctx, todoId, historyFiltersFromRequest(r), historyResourceIdentifier())
+	if err != nil {
+		log.C(ctx).Errorf("failed to get history of todo with id %s, error %s when calling history service", todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(history); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get history of todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleGetListHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting list's history in history handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in history handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Error("failed to get list_id from the context in history handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	history, err := h.serv.GetListHistoryRecords(ctx, listId, historyFiltersFromRequest(r), historyResourceIdentifier())
+	if err != nil {
+		log.C(ctx).Errorf("failed to get history of list with id %s, error %s when calling history service", listId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(history); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get history of list with id %s, error %s", listId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func historyFiltersFromRequest(r *http.Request) *filters.HistoryFilters {
+	first := utils.GetContentFromUrl(r, constants.FIRST)
+	after := utils.GetContentFromUrl(r, constants.AFTER)
+	before := utils.GetContentFromUrl(r, constants.BEFORE)
+	last := utils.GetContentFromUrl(r, constants.LAST)
+
+	if len(first) == 0 && len(last) == 0 {
+		first = constants.DEFAULT_LIMIT_VALUE
+	}
+
+	return &filters.HistoryFilters{
+		PaginationFilters: filters.PaginationFilters{
+			First:  first,
+			Last:   last,
+			After:  after,
+			Before: before,
+		},
+	}
+}
+
+func historyResourceIdentifier() resource_identifier.ResourceIdentifier {
+	rf := &resource_identifier.GenericResourceIdentifier{}
+	rf.SetResourceIdentifier(constants.HistoryIdentifier)
+
+	return rf
+}
@@ -0,0 +1,113 @@
+package history
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/history/mocks"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_HandleGetTodoHistory(t *testing.T) {
+	tests := []struct {
+		testName           string
+		ctx                context.Context
+		mockHistoryService func() *mocks.HistoryService
+		dbMock             func(mck sqlmock.Sqlmock)
+		expectedStatus     int
+		expectedPage       *models.HistoryPage
+		errMessage         string
+	}{
+		{
+			testName: "Successfully getting the history of a todo with the default limit",
+			ctx:      context.WithValue(context.TODO(), middlewares.TodoId, todoId.String()),
+			mockHistoryService: func() *mocks.HistoryService {
+				mService := &mocks.HistoryService{}
+
+				mService.EXPECT().
+					GetTodoHistoryRecords(mock.Anything, todoId.String(), initHistoryFilters("", ""), historyResourceIdentifier()).
+					Return(historyPage, nil).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			expectedStatus: http.StatusOK,
+			expectedPage:   historyPage,
+		},
+		{
+			testName: "Failed to get the history of a todo without todo id in the context",
+			ctx:      context.TODO(),
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusBadRequest,
+			errMessage:     constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID,
+		},
+		{
+			testName: "Failed to get the history of a todo which does not exist",
+			ctx:      context.WithValue(context.TODO(), middlewares.TodoId, todoId.String()),
+			mockHistoryService: func() *mocks.HistoryService {
+				mService := &mocks.HistoryService{}
+
+				mService.EXPECT().
+					GetTodoHistoryRecords(mock.Anything, todoId.String(), initHistoryFilters("", ""), historyResourceIdentifier()).
+					Return(nil, todoMissing).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusNotFound,
+			errMessage:     todoMissing.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			mService := &mocks.HistoryService{}
+			if test.mockHistoryService != nil {
+				mService = test.mockHistoryService()
+			}
+
+			handler := NewHandler(mService, persistence.NewSqlDb(db))
+
+			req := httptest.NewRequest(http.MethodGet, "/todos/"+todoId.String()+"/history", nil)
+			req = req.WithContext(test.ctx)
+			rr := httptest.NewRecorder()
+
+			handler.HandleGetTodoHistory(rr, req)
+
+			require.Equal(t, test.expectedStatus, rr.Code)
+			if len(test.errMessage) != 0 {
+				extractErrorFromResponseRecorder(t, rr, test.errMessage)
+			} else {
+				var received models.HistoryPage
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &received))
+				require.Equal(t, test.expectedPage, &received)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, mService)
+		})
+	}
+}
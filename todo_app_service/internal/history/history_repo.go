@@ -0,0 +1,102 @@
+package history
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/source"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"context"
+	"fmt"
+)
+
+type genericRepository interface {
+	GetSortedPaginationInfo(ctx context.Context, sourceName string, filter string, sortExpression string, direction string, params []interface{}) (*entities.PaginationInfo, error)
+}
+
+type sqlDecoratorFactory interface {
+	CreateSqlDecorator(context.Context, sql_query_decorators.Filters, string, []interface{}) (sql_query_decorators.SqlQueryRetriever, error)
+}
+
+type repository struct {
+	genericRepo genericRepository
+	factory     sqlDecoratorFactory
+}
+
+func NewRepo(genericRepo genericRepository, factory sqlDecoratorFactory) *repository {
+	return &repository{
+		genericRepo: genericRepo,
+		factory:     factory,
+	}
+}
+
+// SetActor makes the database triggers that fill the history attribute the changes of the current transaction to the actor
+func (*repository) SetActor(ctx context.Context, actorId string) error {
+	log.C(ctx).Infof("setting history actor to user with id %s in history repository", actorId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, `SELECT set_config('history.actor_id', $1, true)`, actorId); err != nil {
+		log.C(ctx).Errorf("failed to set history actor due to a failure in the execution of the sql query %s", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) GetHistory(ctx context.Context, f filters.SqlFilters) ([]entities.HistoryEntry, error) {
+	log.C(ctx).Info("getting history from history repository")
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	baseQuery := `SELECT id, entity_type, entity_id, action, field, old_value, new_value, actor, created_at FROM history`
+	filteringClause, params := f.BuildSQLFiltering()
+	baseQuery += filteringClause
+
+	retriever, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery, params)
+	if err != nil {
+		log.C(ctx).Error("failed to determine sql query, error when calling decorator factory")
+		return nil, err
+	}
+
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to parse sort, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQueryString, params := retriever.DetermineCorrectSqlQuery(ctx)
+	completeQuery := fmt.Sprintf(`SELECT id, entity_type, entity_id, action, field, old_value, new_value, actor, created_at
+FROM (%s) %s`, sqlQueryString, sql_query_decorators.OrderByClause(sort, sort.Direction))
+
+	var entries []entities.HistoryEntry
+	if err = persist.SelectContext(ctx, &entries, completeQuery, params...); err != nil {
+		log.C(ctx).Errorf("failed to parse history entities due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r *repository) GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error) {
+	log.C(ctx).Info("getting pagination info about history in history repo")
+
+	filter, params := f.BuildSQLFiltering()
+
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to parse sort, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.genericRepo.GetSortedPaginationInfo(ctx, s.GetSource(), filter, sql_query_decorators.SortExpression(sort.Field), sort.Direction, params)
+}
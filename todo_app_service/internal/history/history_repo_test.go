@@ -0,0 +1,57 @@
+package history
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"context"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"regexp"
+	"testing"
+)
+
+func TestRepository_SetActor(t *testing.T) {
+	tests := []struct {
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
+	}{
+		{
+			testName: "Successfully setting the actor of the transaction",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQuerySetActor)).
+					WithArgs(actorId).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			testName: "Failed to set the actor due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQuerySetActor)).
+					WithArgs(actorId).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo(nil, nil).SetActor(ctx, actorId)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package history
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
+	"Todo-List/internProject/todo_app_service/internal/source"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+)
+
+//go:generate mockery --name=historyRepo --exported --output=./mocks --outpkg=mocks --filename=history_repo.go --with-expecter=true
+type historyRepo interface {
+	SetActor(ctx context.Context, actorId string) error
+	GetHistory(ctx context.Context, f filters.SqlFilters) ([]entities.HistoryEntry, error)
+	GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error)
+}
+
+//go:generate mockery --name=todoRepo --exported --output=./mocks --outpkg=mocks --filename=todo_repo.go --with-expecter=true
+type todoRepo interface {
+	GetTodo(ctx context.Context, todoId string) (*entities.Todo, error)
+}
+
+//go:generate mockery --name=listRepo --exported --output=./mocks --outpkg=mocks --filename=list_repo.go --with-expecter=true
+type listRepo interface {
+	GetList(ctx context.Context, listId string) (*entities.List, error)
+}
+
+//go:generate mockery --name=historyConverter --exported --output=./mocks --outpkg=mocks --filename=history_converter.go --with-expecter=true
+type historyConverter interface {
+	ManyToPage(entries []entities.HistoryEntry, pageInfo *entities.PaginationInfo) *models.HistoryPage
+}
+
+//go:generate mockery --name=resourceIdentifierAdapter --exported --output=./mocks --outpkg=mocks --filename=resource_identifier_adapter.go --with-expecter=true
+type resourceIdentifierAdapter interface {
+	AdaptResourceIdentifier(rf resource_identifier.ResourceIdentifier) string
+}
+
+type service struct {
+	hRepo     historyRepo
+	tRepo     todoRepo
+	lRepo     listRepo
+	converter historyConverter
+	rfAdapter resourceIdentifierAdapter
+}
+
+func NewService(hRepo historyRepo, tRepo todoRepo, lRepo listRepo, converter historyConverter, rfAdapter resourceIdentifierAdapter) *service {
+	return &service{
+		hRepo:     hRepo,
+		tRepo:     tRepo,
+		lRepo:     lRepo,
+		converter: converter,
+		rfAdapter: rfAdapter,
+	}
+}
+
+// RecordActor attributes the changes made in the current transaction to the user who sent the request,
+// changes made without a user in the context are recorded without an actor
+func (s *service) RecordActor(ctx context.Context) error {
+	actor, err := utils.GetValueFromContext[*models.User](ctx, middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Debug("no user in the context, changes will be recorded in the history without an actor")
+		return nil
+	}
+
+	if err = s.hRepo.SetActor(ctx, actor.Id); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s when calling history repo", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetTodoHistoryRecords(ctx context.Context, todoId string, f *filters.HistoryFilters, rf resource_identifier.ResourceIdentifier) (*models.HistoryPage, error) {
+	log.C(ctx).Infof("getting history of todo with id %s in history service", todoId)
+
+	if _, err := s.tRepo.GetTodo(ctx, todoId); err != nil {
+		log.C(ctx).Errorf("failed to get history of todo with id %s, error when calling todo repo", todoId)
+		return nil, err
+	}
+
+	f.EntityType = constants.TODO_HISTORY_ENTITY
+	f.EntityID = todoId
+
+	return s.getHistoryRecords(ctx, f, rf)
+}
+
+func (s *service) GetListHistoryRecords(ctx context.Context, listId string, f *filters.HistoryFilters, rf resource_identifier.ResourceIdentifier) (*models.HistoryPage, error) {
+	log.C(ctx).Infof("getting history of list with id %s in history service", listId)
+
+	if _, err := s.lRepo.GetList(ctx, listId); err != nil {
+		log.C(ctx).Errorf("failed to get history of list with id %s, error when calling list repo", listId)
+		return nil, err
+	}
+
+	f.EntityType = constants.LIST_HISTORY_ENTITY
+	f.EntityID = listId
+
+	return s.getHistoryRecords(ctx, f, rf)
+}
+
+func (s *service) getHistoryRecords(ctx context.Context, f *filters.HistoryFilters, rf resource_identifier.ResourceIdentifier) (*models.HistoryPage, error) {
+	entries, err := s.hRepo.GetHistory(ctx, f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get history of %s with id %s, error %s when calling history repo", f.EntityType, f.EntityID, err.Error())
+		return nil, err
+	}
+
+	sqlSource := prepareSqlSource(s.rfAdapter, rf)
+	paginationInfo, err := s.hRepo.GetPaginationInfo(ctx, f, sqlSource)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get first and last ids of history entries, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.converter.ManyToPage(entries, paginationInfo), nil
+}
+
+func prepareSqlSource(adapter resourceIdentifierAdapter, rf resource_identifier.ResourceIdentifier) source.Source {
+	adaptedRf := adapter.AdaptResourceIdentifier(rf)
+
+	sqlSource := &source.SqlSource{}
+	sqlSource.SetSource(adaptedRf)
+
+	return sqlSource
+}
@@ -0,0 +1,345 @@
+package history
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/history/mocks"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestService_RecordActor(t *testing.T) {
+	tests := []struct {
+		testName        string
+		ctx             context.Context
+		mockHistoryRepo func() *mocks.HistoryRepo
+		err             error
+	}{
+		{
+			testName: "Successfully recording the user who sent the request as the actor",
+			ctx:      context.WithValue(context.TODO(), middlewares.UserKey, actor),
+			mockHistoryRepo: func() *mocks.HistoryRepo {
+				mRepo := &mocks.HistoryRepo{}
+
+				mRepo.EXPECT().
+					SetActor(mock.Anything, actorId).
+					Return(nil).Once()
+
+				return mRepo
+			},
+		},
+		{
+			testName: "Changes made without a user in the context are recorded without an actor",
+			ctx:      context.TODO(),
+			mockHistoryRepo: func() *mocks.HistoryRepo {
+				return &mocks.HistoryRepo{}
+			},
+		},
+		{
+			testName: "Failed to record the actor due to error in history repository",
+			ctx:      context.WithValue(context.TODO(), middlewares.UserKey, actor),
+			mockHistoryRepo: func() *mocks.HistoryRepo {
+				mRepo := &mocks.HistoryRepo{}
+
+				mRepo.EXPECT().
+					SetActor(mock.Anything, actorId).
+					Return(dbError).Once()
+
+				return mRepo
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockHistoryRepo()
+
+			err := NewService(mRepo, nil, nil, nil, nil).RecordActor(test.ctx)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, mRepo)
+		})
+	}
+}
+
+func TestService_GetTodoHistoryRecords(t *testing.T) {
+	expectedFilters := initHistoryFilters(constants.TODO_HISTORY_ENTITY, todoId.String())
+
+	tests := []struct {
+		testName        string
+		mockHistoryRepo func() *mocks.HistoryRepo
+		mockTodoRepo    func() *mocks.TodoRepo
+		mockConverter   func() *mocks.HistoryConverter
+		mockAdapter     func() *mocks.ResourceIdentifierAdapter
+		expectedPage    *models.HistoryPage
+		err             error
+	}{
+		{
+			testName: "Successfully getting the history of a todo",
+			mockHistoryRepo: func() *mocks.HistoryRepo {
+				mRepo := &mocks.HistoryRepo{}
+
+				mRepo.EXPECT().
+					GetHistory(context.TODO(), expectedFilters).
+					Return(historyEntries, nil).Once()
+
+				mRepo.EXPECT().
+					GetPaginationInfo(context.TODO(), expectedFilters, historySource()).
+					Return(paginationInfo, nil).Once()
+
+				return mRepo
+			},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					GetTodo(context.TODO(), todoId.String()).
+					Return(&entities.Todo{Id: todoId}, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.HistoryConverter {
+				mConverter := &mocks.HistoryConverter{}
+
+				mConverter.EXPECT().
+					ManyToPage(historyEntries, paginationInfo).
+					Return(historyPage).Once()
+
+				return mConverter
+			},
+			mockAdapter: func() *mocks.ResourceIdentifierAdapter {
+				mAdapter := &mocks.ResourceIdentifierAdapter{}
+
+				mAdapter.EXPECT().
+					AdaptResourceIdentifier(historyResourceIdentifier()).
+					Return(historyIdentifier).Once()
+
+				return mAdapter
+			},
+			expectedPage: historyPage,
+		},
+		{
+			testName: "Failed to get the history of a todo which does not exist",
+			mockHistoryRepo: func() *mocks.HistoryRepo {
+				return &mocks.HistoryRepo{}
+			},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					GetTodo(context.TODO(), todoId.String()).
+					Return(nil, todoMissing).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.HistoryConverter {
+				return &mocks.HistoryConverter{}
+			},
+			mockAdapter: func() *mocks.ResourceIdentifierAdapter {
+				return &mocks.ResourceIdentifierAdapter{}
+			},
+			err: todoMissing,
+		},
+		{
+			testName: "Failed to get the history of a todo due to error in history repository",
+			mockHistoryRepo: func() *mocks.HistoryRepo {
+				mRepo := &mocks.HistoryRepo{}
+
+				mRepo.EXPECT().
+					GetHistory(context.TODO(), expectedFilters).
+					Return(nil, dbError).Once()
+
+				return mRepo
+			},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					GetTodo(context.TODO(), todoId.String()).
+					Return(&entities.Todo{Id: todoId}, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.HistoryConverter {
+				return &mocks.HistoryConverter{}
+			},
+			mockAdapter: func() *mocks.ResourceIdentifierAdapter {
+				return &mocks.ResourceIdentifierAdapter{}
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mHistoryRepo := test.mockHistoryRepo()
+			mTodoRepo := test.mockTodoRepo()
+			mConverter := test.mockConverter()
+			mAdapter := test.mockAdapter()
+
+			hService := NewService(mHistoryRepo, mTodoRepo, nil, mConverter, mAdapter)
+			page, err := hService.GetTodoHistoryRecords(context.TODO(), todoId.String(), initHistoryFilters("", ""), historyResourceIdentifier())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, page)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedPage, page)
+			}
+
+			mock.AssertExpectationsForObjects(t, mHistoryRepo, mTodoRepo, mConverter, mAdapter)
+		})
+	}
+}
+
+func TestService_GetListHistoryRecords(t *testing.T) {
+	expectedFilters := initHistoryFilters(constants.LIST_HISTORY_ENTITY, listId.String())
+
+	tests := []struct {
+		testName        string
+		mockHistoryRepo func() *mocks.HistoryRepo
+		mockListRepo    func() *mocks.ListRepo
+		mockConverter   func() *mocks.HistoryConverter
+		mockAdapter     func() *mocks.ResourceIdentifierAdapter
+		expectedPage    *models.HistoryPage
+		err             error
+	}{
+		{
+			testName: "Successfully getting the history of a list",
+			mockHistoryRepo: func() *mocks.HistoryRepo {
+				mRepo := &mocks.HistoryRepo{}
+
+				mRepo.EXPECT().
+					GetHistory(context.TODO(), expectedFilters).
+					Return(historyEntries, nil).Once()
+
+				mRepo.EXPECT().
+					GetPaginationInfo(context.TODO(), expectedFilters, historySource()).
+					Return(paginationInfo, nil).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(&entities.List{Id: listId}, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.HistoryConverter {
+				mConverter := &mocks.HistoryConverter{}
+
+				mConverter.EXPECT().
+					ManyToPage(historyEntries, paginationInfo).
+					Return(historyPage).Once()
+
+				return mConverter
+			},
+			mockAdapter: func() *mocks.ResourceIdentifierAdapter {
+				mAdapter := &mocks.ResourceIdentifierAdapter{}
+
+				mAdapter.EXPECT().
+					AdaptResourceIdentifier(historyResourceIdentifier()).
+					Return(historyIdentifier).Once()
+
+				return mAdapter
+			},
+			expectedPage: historyPage,
+		},
+		{
+			testName: "Failed to get the history of a list which does not exist",
+			mockHistoryRepo: func() *mocks.HistoryRepo {
+				return &mocks.HistoryRepo{}
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(nil, listMissing).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.HistoryConverter {
+				return &mocks.HistoryConverter{}
+			},
+			mockAdapter: func() *mocks.ResourceIdentifierAdapter {
+				return &mocks.ResourceIdentifierAdapter{}
+			},
+			err: listMissing,
+		},
+		{
+			testName: "Failed to get the history of a list due to error when getting pagination info",
+			mockHistoryRepo: func() *mocks.HistoryRepo {
+				mRepo := &mocks.HistoryRepo{}
+
+				mRepo.EXPECT().
+					GetHistory(context.TODO(), expectedFilters).
+					Return(historyEntries, nil).Once()
+
+				mRepo.EXPECT().
+					GetPaginationInfo(context.TODO(), expectedFilters, historySource()).
+					Return(nil, dbError).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(&entities.List{Id: listId}, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.HistoryConverter {
+				return &mocks.HistoryConverter{}
+			},
+			mockAdapter: func() *mocks.ResourceIdentifierAdapter {
+				mAdapter := &mocks.ResourceIdentifierAdapter{}
+
+				mAdapter.EXPECT().
+					AdaptResourceIdentifier(historyResourceIdentifier()).
+					Return(historyIdentifier).Once()
+
+				return mAdapter
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mHistoryRepo := test.mockHistoryRepo()
+			mListRepo := test.mockListRepo()
+			mConverter := test.mockConverter()
+			mAdapter := test.mockAdapter()
+
+			hService := NewService(mHistoryRepo, nil, mListRepo, mConverter, mAdapter)
+			page, err := hService.GetListHistoryRecords(context.TODO(), listId.String(), initHistoryFilters("", ""), historyResourceIdentifier())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, page)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedPage, page)
+			}
+
+			mock.AssertExpectationsForObjects(t, mHistoryRepo, mListRepo, mConverter, mAdapter)
+		})
+	}
+}
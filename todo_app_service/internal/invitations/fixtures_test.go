@@ -0,0 +1,49 @@
+package invitations
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/jwt"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"errors"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+const (
+	invitationToken = "signed invitation token"
+	otherToken      = "another signed invitation token"
+	inviteeEmail    = "Invitee@Example.com"
+)
+
+var (
+	invitationId = uuid.Must(uuid.NewV4())
+	listId       = uuid.Must(uuid.NewV4())
+	callerId     = uuid.Must(uuid.NewV4()).String()
+	now          = time.Date(2025, time.April, 10, 9, 0, 0, 0, time.UTC)
+	tokenError   = errors.New("token is expired")
+
+	caller   = &models.User{Id: callerId, Email: "invitee@example.com", Role: constants.Writer}
+	stranger = &models.User{Id: callerId, Email: "stranger@example.com", Role: constants.Writer}
+
+	invitationClaims   = &jwt.InvitationClaims{InvitationId: invitationId.String()}
+	invitationEntity   = &entities.Invitation{Id: invitationId, ListId: listId, Email: inviteeEmail, Token: invitationToken}
+	listEntity         = &entities.List{Id: listId}
+	invitationNotFound = application_errors.NewNotFoundError(constants.INVITATION_TARGET, invitationId.String())
+	listNotFound       = application_errors.NewNotFoundError(constants.LIST_TARGET, listId.String())
+)
+
+// initInvitationModel returns a new model every time since accepting an invitation updates its status
+func initInvitationModel(token string, status constants.InvitationStatus, expiresAt time.Time) *models.Invitation {
+	return &models.Invitation{
+		Id:        invitationId.String(),
+		ListId:    listId.String(),
+		Email:     inviteeEmail,
+		Role:      constants.Editor,
+		Token:     token,
+		Status:    status,
+		CreatedAt: now.Add(-time.Hour),
+		ExpiresAt: expiresAt,
+	}
+}
@@ -0,0 +1,294 @@
+package invitations
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+//go:generate mockery --name=invitationService --exported --output=./mocks --outpkg=mocks --filename=invitation_service.go --with-expecter=true
+type invitationService interface {
+	CreateInvitationRecord(ctx context.Context, listId string, invitation *handler_models.CreateInvitation, inviter *models.User) (*models.Invitation, error)
+	GetUserInvitationsRecords(ctx context.Context, userId string) ([]*models.Invitation, error)
+	AcceptInvitationRecord(ctx context.Context, token string, caller *models.User) (*models.Invitation, error)
+	DeclineInvitationRecord(ctx context.Context, token string, caller *models.User) error
+	RevokeInvitationRecord(ctx context.Context, listId string, invitationId string) error
+}
+
+//go:generate mockery --name=fieldValidator --exported --output=./mocks --outpkg=mocks --filename=field_validator.go --with-expecter=true
+type fieldValidator interface {
+	Struct(interface{}) error
+}
+
+type Handler struct {
+	serv       invitationService
+	fValidator fieldValidator
+	transact   persistence.Transactioner
+}
+
+func NewHandler(service invitationService, fValidator fieldValidator, transact persistence.Transactioner) *Handler {
+	return &Handler{
+		serv:       service,
+		fValidator: fValidator,
+		transact:   transact,
+	}
+}
+
+func (h *Handler) HandleCreateInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("creating invitation in invitation handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in invitation handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Error("failed to get list_id from the context in invitation handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	inviter, err := utils.GetValueFromContext[*models.User](r.Context(), middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get inviter due to an error %s when trying to get value from context in invitation handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	var invitation handler_models.CreateInvitation
+	if err = json.NewDecoder(r.Body).Decode(&invitation); err != nil {
+		log.C(ctx).Errorf("failed to decode invitation handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, invitation)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create invitation, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	createdInvitation, err := h.serv.CreateInvitationRecord(ctx, listId, &invitation, inviter)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create invitation to list with id %s, error %s when calling invitation service", listId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to create invitation, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(createdInvitation); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		return
+	}
+}
+
+func (h *Handler) HandleGetUserInvitations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting user invitations in invitation handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in invitation handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	userId, err := utils.GetValueFromContext[string](r.Context(), middlewares.UserId)
+	if err != nil {
+		log.C(ctx).Error("failed to get user_id from the context in invitation handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER_ID, http.StatusBadRequest)
+		return
+	}
+
+	invitations, err := h.serv.GetUserInvitationsRecords(ctx, userId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get invitations of user with id %s, error %s when calling invitation service", userId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(invitations); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get invitations of user with id %s, error %s", userId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleAcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("accepting invitation in invitation handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in invitation handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	caller, token, ok := h.getCallerAndToken(w, r)
+	if !ok {
+		return
+	}
+
+	invitation, err := h.serv.AcceptInvitationRecord(ctx, token, caller)
+	if err != nil {
+		log.C(ctx).Errorf("failed to accept invitation, error %s when calling invitation service", err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(invitation); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to accept invitation, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleDeclineInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("declining invitation in invitation handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in invitation handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	caller, token, ok := h.getCallerAndToken(w, r)
+	if !ok {
+		return
+	}
+
+	if err = h.serv.DeclineInvitationRecord(ctx, token, caller); err != nil {
+		log.C(ctx).Errorf("failed to decline invitation, error %s when calling invitation service", err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to decline invitation, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) HandleRevokeInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("revoking invitation in invitation handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in invitation handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Error("failed to get list_id from the context in invitation handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	invitationId, err := utils.GetValueFromContext[string](r.Context(), middlewares.InvitationId)
+	if err != nil {
+		log.C(ctx).Error("failed to get invitation_id from the context in invitation handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_INVITATION_ID, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.serv.RevokeInvitationRecord(ctx, listId, invitationId); err != nil {
+		log.C(ctx).Errorf("failed to revoke invitation with id %s, error %s when calling invitation service", invitationId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to revoke invitation with id %s, error %s", invitationId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getCallerAndToken reads the user answering an invitation and the invitation token from the request,
+// in case of failure the error is already encoded
+func (h *Handler) getCallerAndToken(w http.ResponseWriter, r *http.Request) (*models.User, string, bool) {
+	ctx := r.Context()
+
+	caller, err := utils.GetValueFromContext[*models.User](ctx, middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get caller due to an error %s when trying to get value from context in invitation handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return nil, "", false
+	}
+
+	var invitationToken handler_models.InvitationToken
+	if err = json.NewDecoder(r.Body).Decode(&invitationToken); err != nil {
+		log.C(ctx).Errorf("failed to decode invitation token handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return nil, "", false
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, invitationToken)
+	if err != nil {
+		log.C(ctx).Errorf("failed to answer invitation, error because one of the required fields is missing %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return nil, "", false
+	}
+
+	return caller, invitationToken.Token, true
+}
@@ -0,0 +1,145 @@
+package invitations
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+type repository struct{}
+
+func NewRepo() *repository {
+	return &repository{}
+}
+
+func (*repository) GetInvitation(ctx context.Context, invitationId string) (*entities.Invitation, error) {
+	log.C(ctx).Infof("getting invitation with id %s from invitation repository", invitationId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	entity := &entities.Invitation{}
+	if err = persist.GetContext(ctx, entity, getInvitationQuery, invitationId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("failed to get invitation with id %s due to sqlErrNoRows", invitationId)
+			return nil, application_errors.NewNotFoundError(constants.INVITATION_TARGET, invitationId)
+		}
+
+		log.C(ctx).Errorf("failed to get invitation with id %s because of a database error %s", invitationId, err.Error())
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// GetPendingInvitationsByEmail returns the invitations sent to the email that have been neither answered nor revoked
+// and are still not expired at the given time
+func (*repository) GetPendingInvitationsByEmail(ctx context.Context, email string, now time.Time) ([]entities.Invitation, error) {
+	log.C(ctx).Infof("getting pending invitations for email %s from invitation repository", email)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var invitations []entities.Invitation
+	if err = persist.SelectContext(ctx, &invitations, pendingInvitationsByEmailQuery, email, now); err != nil {
+		log.C(ctx).Errorf("failed to get pending invitations due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+// CreateInvitation stores the invitation, the pending invitation for the same email in the list is revoked,
+// so that re-inviting someone hands out a fresh token
+func (r *repository) CreateInvitation(ctx context.Context, entity *entities.Invitation) (*entities.Invitation, error) {
+	log.C(ctx).Infof("creating invitation for email %s to list with id %s in invitation repository", entity.Email, entity.ListId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	if _, err = persist.ExecContext(ctx, revokePendingInvitationsQuery, entity.ListId, entity.Email); err != nil {
+		log.C(ctx).Errorf("failed to revoke previous invitations due to a database error %s", err.Error())
+		return nil, err
+	}
+
+	if _, err = persist.NamedExecContext(ctx, createInvitationQuery, entity); err != nil {
+		log.C(ctx).Errorf("failed to create invitation due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return r.GetInvitation(ctx, entity.Id.String())
+}
+
+// UpdateInvitationStatus answers a pending invitation, an invitation that is no longer pending can't be answered again
+func (*repository) UpdateInvitationStatus(ctx context.Context, invitationId string, status constants.InvitationStatus) error {
+	log.C(ctx).Infof("updating status of invitation with id %s to %s in invitation repository", invitationId, status)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	res, err := persist.ExecContext(ctx, updateInvitationStatusQuery, invitationId, status)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update status of invitation with id %s due to a database error %s", invitationId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to update invitation status, error when trying to get the number of rows affected")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to update invitation status, invitation with id %s is not pending", invitationId)
+		return application_errors.InvalidInvitationError
+	}
+
+	return nil
+}
+
+func (*repository) RevokeInvitation(ctx context.Context, listId string, invitationId string) error {
+	log.C(ctx).Infof("revoking invitation with id %s to list with id %s in invitation repository", invitationId, listId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	res, err := persist.ExecContext(ctx, revokeInvitationQuery, invitationId, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to revoke invitation with id %s due to a database error %s", invitationId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to revoke invitation, error when trying to get the number of rows affected")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to revoke invitation, there is no pending invitation with id %s to list with id %s", invitationId, listId)
+		return application_errors.NewNotFoundError(constants.INVITATION_TARGET, invitationId)
+	}
+
+	return nil
+}
@@ -0,0 +1,297 @@
+package invitations
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/jwt"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+//go:generate mockery --name=invitationRepo --exported --output=./mocks --outpkg=mocks --filename=invitation_repo.go --with-expecter=true
+type invitationRepo interface {
+	GetInvitation(ctx context.Context, invitationId string) (*entities.Invitation, error)
+	GetPendingInvitationsByEmail(ctx context.Context, email string, now time.Time) ([]entities.Invitation, error)
+	CreateInvitation(ctx context.Context, entity *entities.Invitation) (*entities.Invitation, error)
+	UpdateInvitationStatus(ctx context.Context, invitationId string, status constants.InvitationStatus) error
+	RevokeInvitation(ctx context.Context, listId string, invitationId string) error
+}
+
+//go:generate mockery --name=listRepo --exported --output=./mocks --outpkg=mocks --filename=list_repo.go --with-expecter=true
+type listRepo interface {
+	GetList(ctx context.Context, listId string) (*entities.List, error)
+	CheckWhetherUserIsCollaborator(ctx context.Context, listId string, userId string) (bool, error)
+	UpdateListSharedWith(ctx context.Context, listId string, userId string, role constants.CollaboratorRole) error
+}
+
+//go:generate mockery --name=userRepo --exported --output=./mocks --outpkg=mocks --filename=user_repo.go --with-expecter=true
+type userRepo interface {
+	GetUser(ctx context.Context, userId string) (*entities.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*entities.User, error)
+}
+
+//go:generate mockery --name=invitationConverter --exported --output=./mocks --outpkg=mocks --filename=invitation_converter.go --with-expecter=true
+type invitationConverter interface {
+	ToModel(invitation *entities.Invitation) *models.Invitation
+	ToEntity(invitation *models.Invitation) *entities.Invitation
+	ManyToModel(invitations []entities.Invitation) []*models.Invitation
+}
+
+//go:generate mockery --name=tokenService --exported --output=./mocks --outpkg=mocks --filename=token_service.go --with-expecter=true
+type tokenService interface {
+	GenerateInvitationToken(ctx context.Context, invitationId string, expiresAt time.Time) (string, error)
+	ParseInvitationToken(ctx context.Context, tokenString string) (*jwt.InvitationClaims, error)
+}
+
+//go:generate mockery --name=historyRecorder --exported --output=./mocks --outpkg=mocks --filename=history_recorder.go --with-expecter=true
+type historyRecorder interface {
+	RecordActor(ctx context.Context) error
+}
+
+//go:generate mockery --name=uuidGenerator --exported --output=./mocks --outpkg=mocks --filename=uuid_generator.go --with-expecter=true
+type uuidGenerator interface {
+	Generate() string
+}
+
+//go:generate mockery --name=timeGenerator --exported --output=./mocks --outpkg=mocks --filename=time_generator.go --with-expecter=true
+type timeGenerator interface {
+	Now() time.Time
+}
+
+type service struct {
+	iRepo      invitationRepo
+	lRepo      listRepo
+	uRepo      userRepo
+	converter  invitationConverter
+	tService   tokenService
+	hRecorder  historyRecorder
+	uuidGen    uuidGenerator
+	timeGen    timeGenerator
+	expiration time.Duration
+}
+
+func NewService(iRepo invitationRepo, lRepo listRepo, uRepo userRepo, converter invitationConverter, tService tokenService,
+	hRecorder historyRecorder, uuidGen uuidGenerator, timeGen timeGenerator, expiration time.Duration) *service {
+	return &service{
+		iRepo:      iRepo,
+		lRepo:      lRepo,
+		uRepo:      uRepo,
+		converter:  converter,
+		tService:   tService,
+		hRecorder:  hRecorder,
+		uuidGen:    uuidGen,
+		timeGen:    timeGen,
+		expiration: expiration,
+	}
+}
+
+func (s *service) CreateInvitationRecord(ctx context.Context, listId string, invitation *handler_models.CreateInvitation, inviter *models.User) (*models.Invitation, error) {
+	log.C(ctx).Infof("inviting %s to list with id %s in invitation service", invitation.Email, listId)
+
+	list, err := s.lRepo.GetList(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create invitation, error %s when getting list with id %s", err.Error(), listId)
+		return nil, err
+	}
+
+	if err = s.checkWhetherEmailIsNotCollaborator(ctx, list, invitation.Email); err != nil {
+		log.C(ctx).Errorf("failed to create invitation, error %s", err.Error())
+		return nil, err
+	}
+
+	role := constants.Editor
+	if invitation.Role != nil {
+		role = *invitation.Role
+	}
+
+	modelInvitation := &models.Invitation{
+		Id:        s.uuidGen.Generate(),
+		ListId:    listId,
+		Email:     invitation.Email,
+		Role:      role,
+		InvitedBy: inviter.Id,
+		Status:    constants.Pending,
+		CreatedAt: s.timeGen.Now(),
+	}
+	modelInvitation.ExpiresAt = modelInvitation.CreatedAt.Add(s.expiration)
+
+	if modelInvitation.Token, err = s.tService.GenerateInvitationToken(ctx, modelInvitation.Id, modelInvitation.ExpiresAt); err != nil {
+		log.C(ctx).Errorf("failed to create invitation, error %s when generating invitation token", err.Error())
+		return nil, err
+	}
+
+	entity, err := s.iRepo.CreateInvitation(ctx, s.converter.ToEntity(modelInvitation))
+	if err != nil {
+		log.C(ctx).Errorf("failed to create invitation, error %s when calling invitation repo", err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(entity), nil
+}
+
+func (s *service) GetUserInvitationsRecords(ctx context.Context, userId string) ([]*models.Invitation, error) {
+	log.C(ctx).Infof("getting invitations of user with id %s in invitation service", userId)
+
+	user, err := s.uRepo.GetUser(ctx, userId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get invitations, error %s when getting user with id %s", err.Error(), userId)
+		return nil, err
+	}
+
+	pending, err := s.iRepo.GetPendingInvitationsByEmail(ctx, user.Email, s.timeGen.Now())
+	if err != nil {
+		log.C(ctx).Errorf("failed to get invitations of user with id %s, error %s when calling invitation repo", userId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ManyToModel(pending), nil
+}
+
+func (s *service) AcceptInvitationRecord(ctx context.Context, token string, caller *models.User) (*models.Invitation, error) {
+	log.C(ctx).Infof("accepting invitation by user with id %s in invitation service", caller.Id)
+
+	invitation, err := s.getInvitationOfCaller(ctx, token, caller)
+	if err != nil {
+		log.C(ctx).Errorf("failed to accept invitation, error %s", err.Error())
+		return nil, err
+	}
+
+	if err = s.acceptInvitation(ctx, invitation, caller.Id); err != nil {
+		log.C(ctx).Errorf("failed to accept invitation with id %s, error %s", invitation.Id, err.Error())
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func (s *service) DeclineInvitationRecord(ctx context.Context, token string, caller *models.User) error {
+	log.C(ctx).Infof("declining invitation by user with id %s in invitation service", caller.Id)
+
+	invitation, err := s.getInvitationOfCaller(ctx, token, caller)
+	if err != nil {
+		log.C(ctx).Errorf("failed to decline invitation, error %s", err.Error())
+		return err
+	}
+
+	if err = s.iRepo.UpdateInvitationStatus(ctx, invitation.Id, constants.Declined); err != nil {
+		log.C(ctx).Errorf("failed to decline invitation with id %s, error %s when calling invitation repo", invitation.Id, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) RevokeInvitationRecord(ctx context.Context, listId string, invitationId string) error {
+	log.C(ctx).Infof("revoking invitation with id %s to list with id %s in invitation service", invitationId, listId)
+
+	if err := s.iRepo.RevokeInvitation(ctx, listId, invitationId); err != nil {
+		log.C(ctx).Errorf("failed to revoke invitation with id %s, error %s when calling invitation repo", invitationId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// AcceptPendingInvitations makes the user a collaborator of every list the user has been invited to,
+// it is used when the user logs in for the first time, so invitations sent before the user was registered are honoured
+func (s *service) AcceptPendingInvitations(ctx context.Context, user *models.User) error {
+	log.C(ctx).Infof("accepting pending invitations of user with id %s in invitation service", user.Id)
+
+	pending, err := s.iRepo.GetPendingInvitationsByEmail(ctx, user.Email, s.timeGen.Now())
+	if err != nil {
+		log.C(ctx).Errorf("failed to accept pending invitations, error %s when calling invitation repo", err.Error())
+		return err
+	}
+
+	for _, invitation := range s.converter.ManyToModel(pending) {
+		if err = s.acceptInvitation(ctx, invitation, user.Id); err != nil {
+			log.C(ctx).Errorf("failed to accept pending invitation with id %s, error %s", invitation.Id, err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) acceptInvitation(ctx context.Context, invitation *models.Invitation, userId string) error {
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
+	if err := s.lRepo.UpdateListSharedWith(ctx, invitation.ListId, userId, invitation.Role); err != nil {
+		log.C(ctx).Errorf("failed to add user with id %s to list with id %s, error %s", userId, invitation.ListId, err.Error())
+		return err
+	}
+
+	if err := s.iRepo.UpdateInvitationStatus(ctx, invitation.Id, constants.Accepted); err != nil {
+		log.C(ctx).Errorf("failed to update status of invitation with id %s, error %s", invitation.Id, err.Error())
+		return err
+	}
+
+	invitation.Status = constants.Accepted
+	return nil
+}
+
+// getInvitationOfCaller resolves a signed invitation token to a pending invitation, the invitations sent
+// to someone else do not exist for the caller
+func (s *service) getInvitationOfCaller(ctx context.Context, token string, caller *models.User) (*models.Invitation, error) {
+	claims, err := s.tService.ParseInvitationToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := s.iRepo.GetInvitation(ctx, claims.InvitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation := s.converter.ToModel(entity)
+	if !strings.EqualFold(invitation.Email, caller.Email) {
+		log.C(ctx).Debugf("invitation with id %s is not sent to user with id %s...", invitation.Id, caller.Id)
+		return nil, application_errors.NewNotFoundError(constants.INVITATION_TARGET, invitation.Id)
+	}
+
+	if invitation.Token != token || invitation.Status != constants.Pending || !invitation.ExpiresAt.After(s.timeGen.Now()) {
+		log.C(ctx).Debugf("invitation with id %s can no longer be answered...", invitation.Id)
+		return nil, application_errors.InvalidInvitationError
+	}
+
+	if _, err = s.lRepo.GetList(ctx, invitation.ListId); err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func (s *service) checkWhetherEmailIsNotCollaborator(ctx context.Context, list *entities.List, email string) error {
+	user, err := s.uRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		var notFoundErr *application_errors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return err
+	}
+
+	if user.Id == list.Owner {
+		return application_errors.NewAlreadyExistError(constants.COLLABORATOR_TARGET, email)
+	}
+
+	isCollaborator, err := s.lRepo.CheckWhetherUserIsCollaborator(ctx, list.Id.String(), user.Id.String())
+	if err != nil {
+		return err
+	}
+
+	if isCollaborator {
+		return application_errors.NewAlreadyExistError(constants.COLLABORATOR_TARGET, email)
+	}
+
+	return nil
+}
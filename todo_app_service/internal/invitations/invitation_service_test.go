@@ -0,0 +1,381 @@
+package invitations
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/invitations/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestService_AcceptInvitationRecord(t *testing.T) {
+	tests := []struct {
+		testName           string
+		caller             *models.User
+		mockTokenService   func() *mocks.TokenService
+		mockInvitationRepo func() *mocks.InvitationRepo
+		mockConverter      func() *mocks.InvitationConverter
+		mockListRepo       func() *mocks.ListRepo
+		mockRecorder       func() *mocks.HistoryRecorder
+		mockTimeGen        func() *mocks.TimeGenerator
+		expectedInvitation *models.Invitation
+		err                error
+	}{
+		{
+			testName: "Successfully accepting invitation sent to the email of the caller regardless of its case",
+			caller:   caller,
+			mockTokenService: func() *mocks.TokenService {
+				return mockParsedToken()
+			},
+			mockInvitationRepo: func() *mocks.InvitationRepo {
+				mRepo := mockFoundInvitation()
+
+				mRepo.EXPECT().
+					UpdateInvitationStatus(context.TODO(), invitationId.String(), constants.Accepted).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.InvitationConverter {
+				return mockConvertedInvitation(initInvitationModel(invitationToken, constants.Pending, now.Add(time.Hour)))
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(listEntity, nil).Once()
+
+				mRepo.EXPECT().
+					UpdateListSharedWith(context.TODO(), listId.String(), callerId, constants.Editor).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(nil).Once()
+
+				return mRecorder
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				return mockNow()
+			},
+			expectedInvitation: initInvitationModel(invitationToken, constants.Accepted, now.Add(time.Hour)),
+		},
+		{
+			testName: "Failed to accept invitation with a token which can't be parsed",
+			caller:   caller,
+			mockTokenService: func() *mocks.TokenService {
+				mService := &mocks.TokenService{}
+
+				mService.EXPECT().
+					ParseInvitationToken(context.TODO(), invitationToken).
+					Return(nil, tokenError).Once()
+
+				return mService
+			},
+			mockInvitationRepo: func() *mocks.InvitationRepo {
+				return &mocks.InvitationRepo{}
+			},
+			mockConverter: func() *mocks.InvitationConverter {
+				return &mocks.InvitationConverter{}
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				return &mocks.ListRepo{}
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				return &mocks.HistoryRecorder{}
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				return &mocks.TimeGenerator{}
+			},
+			err: tokenError,
+		},
+		{
+			testName: "Failed to accept invitation sent to another email",
+			caller:   stranger,
+			mockTokenService: func() *mocks.TokenService {
+				return mockParsedToken()
+			},
+			mockInvitationRepo: func() *mocks.InvitationRepo {
+				return mockFoundInvitation()
+			},
+			mockConverter: func() *mocks.InvitationConverter {
+				return mockConvertedInvitation(initInvitationModel(invitationToken, constants.Pending, now.Add(time.Hour)))
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				return &mocks.ListRepo{}
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				return &mocks.HistoryRecorder{}
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				return &mocks.TimeGenerator{}
+			},
+			err: invitationNotFound,
+		},
+		{
+			testName: "Failed to accept invitation with a token which is not the latest one of the invitation",
+			caller:   caller,
+			mockTokenService: func() *mocks.TokenService {
+				return mockParsedToken()
+			},
+			mockInvitationRepo: func() *mocks.InvitationRepo {
+				return mockFoundInvitation()
+			},
+			mockConverter: func() *mocks.InvitationConverter {
+				return mockConvertedInvitation(initInvitationModel(otherToken, constants.Pending, now.Add(time.Hour)))
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				return &mocks.ListRepo{}
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				return &mocks.HistoryRecorder{}
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				return &mocks.TimeGenerator{}
+			},
+			err: application_errors.InvalidInvitationError,
+		},
+		{
+			testName: "Failed to accept invitation which has already been answered",
+			caller:   caller,
+			mockTokenService: func() *mocks.TokenService {
+				return mockParsedToken()
+			},
+			mockInvitationRepo: func() *mocks.InvitationRepo {
+				return mockFoundInvitation()
+			},
+			mockConverter: func() *mocks.InvitationConverter {
+				return mockConvertedInvitation(initInvitationModel(invitationToken, constants.Declined, now.Add(time.Hour)))
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				return &mocks.ListRepo{}
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				return &mocks.HistoryRecorder{}
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				return &mocks.TimeGenerator{}
+			},
+			err: application_errors.InvalidInvitationError,
+		},
+		{
+			testName: "Failed to accept invitation which has expired",
+			caller:   caller,
+			mockTokenService: func() *mocks.TokenService {
+				return mockParsedToken()
+			},
+			mockInvitationRepo: func() *mocks.InvitationRepo {
+				return mockFoundInvitation()
+			},
+			mockConverter: func() *mocks.InvitationConverter {
+				return mockConvertedInvitation(initInvitationModel(invitationToken, constants.Pending, now))
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				return &mocks.ListRepo{}
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				return &mocks.HistoryRecorder{}
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				return mockNow()
+			},
+			err: application_errors.InvalidInvitationError,
+		},
+		{
+			testName: "Failed to accept invitation to a list which does not exist anymore",
+			caller:   caller,
+			mockTokenService: func() *mocks.TokenService {
+				return mockParsedToken()
+			},
+			mockInvitationRepo: func() *mocks.InvitationRepo {
+				return mockFoundInvitation()
+			},
+			mockConverter: func() *mocks.InvitationConverter {
+				return mockConvertedInvitation(initInvitationModel(invitationToken, constants.Pending, now.Add(time.Hour)))
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(nil, listNotFound).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				return &mocks.HistoryRecorder{}
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				return mockNow()
+			},
+			err: listNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mTokenService := test.mockTokenService()
+			mInvitationRepo := test.mockInvitationRepo()
+			mConverter := test.mockConverter()
+			mListRepo := test.mockListRepo()
+			mRecorder := test.mockRecorder()
+			mTimeGen := test.mockTimeGen()
+
+			iService := NewService(mInvitationRepo, mListRepo, nil, mConverter, mTokenService, mRecorder, nil, mTimeGen, time.Hour)
+			invitation, err := iService.AcceptInvitationRecord(context.TODO(), invitationToken, test.caller)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, invitation)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedInvitation, invitation)
+			}
+
+			mock.AssertExpectationsForObjects(t, mTokenService, mInvitationRepo, mConverter, mListRepo, mRecorder, mTimeGen)
+		})
+	}
+}
+
+func TestService_DeclineInvitationRecord(t *testing.T) {
+	tests := []struct {
+		testName           string
+		caller             *models.User
+		mockInvitationRepo func() *mocks.InvitationRepo
+		mockConverter      func() *mocks.InvitationConverter
+		mockListRepo       func() *mocks.ListRepo
+		mockTimeGen        func() *mocks.TimeGenerator
+		err                error
+	}{
+		{
+			testName: "Successfully declining invitation",
+			caller:   caller,
+			mockInvitationRepo: func() *mocks.InvitationRepo {
+				mRepo := mockFoundInvitation()
+
+				mRepo.EXPECT().
+					UpdateInvitationStatus(context.TODO(), invitationId.String(), constants.Declined).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.InvitationConverter {
+				return mockConvertedInvitation(initInvitationModel(invitationToken, constants.Pending, now.Add(time.Hour)))
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(listEntity, nil).Once()
+
+				return mRepo
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				return mockNow()
+			},
+		},
+		{
+			testName: "Failed to decline invitation sent to another email",
+			caller:   stranger,
+			mockInvitationRepo: func() *mocks.InvitationRepo {
+				return mockFoundInvitation()
+			},
+			mockConverter: func() *mocks.InvitationConverter {
+				return mockConvertedInvitation(initInvitationModel(invitationToken, constants.Pending, now.Add(time.Hour)))
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				return &mocks.ListRepo{}
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				return &mocks.TimeGenerator{}
+			},
+			err: invitationNotFound,
+		},
+		{
+			testName: "Failed to decline invitation which has expired",
+			caller:   caller,
+			mockInvitationRepo: func() *mocks.InvitationRepo {
+				return mockFoundInvitation()
+			},
+			mockConverter: func() *mocks.InvitationConverter {
+				return mockConvertedInvitation(initInvitationModel(invitationToken, constants.Pending, now.Add(-time.Minute)))
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				return &mocks.ListRepo{}
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				return mockNow()
+			},
+			err: application_errors.InvalidInvitationError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mTokenService := mockParsedToken()
+			mInvitationRepo := test.mockInvitationRepo()
+			mConverter := test.mockConverter()
+			mListRepo := test.mockListRepo()
+			mTimeGen := test.mockTimeGen()
+
+			iService := NewService(mInvitationRepo, mListRepo, nil, mConverter, mTokenService, nil, nil, mTimeGen, time.Hour)
+			err := iService.DeclineInvitationRecord(context.TODO(), invitationToken, test.caller)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, mTokenService, mInvitationRepo, mConverter, mListRepo, mTimeGen)
+		})
+	}
+}
+
+func mockParsedToken() *mocks.TokenService {
+	mService := &mocks.TokenService{}
+
+	mService.EXPECT().
+		ParseInvitationToken(context.TODO(), invitationToken).
+		Return(invitationClaims, nil).Once()
+
+	return mService
+}
+
+func mockFoundInvitation() *mocks.InvitationRepo {
+	mRepo := &mocks.InvitationRepo{}
+
+	mRepo.EXPECT().
+		GetInvitation(context.TODO(), invitationId.String()).
+		Return(invitationEntity, nil).Once()
+
+	return mRepo
+}
+
+func mockConvertedInvitation(invitation *models.Invitation) *mocks.InvitationConverter {
+	mConverter := &mocks.InvitationConverter{}
+
+	mConverter.EXPECT().
+		ToModel(invitationEntity).
+		Return(invitation).Once()
+
+	return mConverter
+}
+
+func mockNow() *mocks.TimeGenerator {
+	mTimeGen := &mocks.TimeGenerator{}
+	mTimeGen.EXPECT().Now().Return(now).Once()
+
+	return mTimeGen
+}
@@ -0,0 +1,22 @@
+package invitations
+
+const invitationColumns = `id, list_id, email, role, invited_by, token, status, created_at, expires_at`
+
+var getInvitationQuery = `SELECT ` + invitationColumns + ` FROM invitations WHERE id = $1`
+
+var pendingInvitationsByEmailQuery = `SELECT invitations.id, invitations.list_id, invitations.email, invitations.role,
+invitations.invited_by, invitations.token, invitations.status, invitations.created_at, invitations.expires_at FROM invitations
+JOIN lists ON lists.id = invitations.list_id
+WHERE LOWER(invitations.email) = LOWER($1) AND invitations.status = 'pending' AND invitations.expires_at > $2
+AND lists.deleted_at IS NULL
+ORDER BY invitations.created_at DESC`
+
+var createInvitationQuery = `INSERT INTO invitations (` + invitationColumns + `)
+VALUES (:id, :list_id, :email, :role, :invited_by, :token, :status, :created_at, :expires_at)`
+
+var revokePendingInvitationsQuery = `UPDATE invitations SET status = 'revoked'
+WHERE list_id = $1 AND LOWER(email) = LOWER($2) AND status = 'pending'`
+
+var updateInvitationStatusQuery = `UPDATE invitations SET status = $2 WHERE id = $1 AND status = 'pending'`
+
+var revokeInvitationQuery = `UPDATE invitations SET status = 'revoked' WHERE id = $1 AND list_id = $2 AND status = 'pending'`
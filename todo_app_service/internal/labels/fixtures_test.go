@@ -0,0 +1,71 @@
+package labels
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"encoding/json"
+	"errors"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	labelName              = "urgent"
+	updatedLabelName       = "blocked"
+	sqlQueryGetLabel       = `SELECT id, name, owner, created_at FROM labels WHERE id = $1`
+	sqlQueryDeleteLabel    = `DELETE FROM labels WHERE id = $1`
+	sqlQueryAddLabelToTodo = `INSERT INTO todo_labels (todo_id, label_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	sqlQueryRemoveLabel    = `DELETE FROM todo_labels WHERE todo_id = $1 AND label_id = $2`
+)
+
+var (
+	labelId       = uuid.Must(uuid.NewV4())
+	todoId        = uuid.Must(uuid.NewV4())
+	listId        = uuid.Must(uuid.NewV4())
+	ownerId       = uuid.Must(uuid.NewV4())
+	otherUserId   = uuid.Must(uuid.NewV4())
+	createdAt     = time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+	dbError       = errors.New("database error")
+	labelNotFound = application_errors.NewNotFoundError(constants.LABEL_TARGET, labelId.String())
+	todoNotFound  = application_errors.NewNotFoundError(constants.TODO_TARGET, todoId.String())
+
+	labelColumns = []string{"id", "name", "owner", "created_at"}
+
+	createLabelHandlerModel = &handler_models.CreateLabel{Name: labelName}
+	labelEntity             = initLabelEntity(labelName, ownerId)
+	updatedLabelEntity      = initLabelEntity(updatedLabelName, ownerId)
+	labelModel              = initLabelModel(labelName)
+	updatedLabelModel       = initLabelModel(updatedLabelName)
+	todoEntity              = &entities.Todo{Id: todoId, ListId: listId}
+)
+
+func initLabelEntity(name string, owner uuid.UUID) *entities.Label {
+	return &entities.Label{
+		Id:        labelId,
+		Name:      name,
+		Owner:     owner,
+		CreatedAt: createdAt,
+	}
+}
+
+func initLabelModel(name string) *models.Label {
+	return &models.Label{
+		Id:        labelId.String(),
+		Name:      name,
+		Owner:     ownerId.String(),
+		CreatedAt: createdAt,
+	}
+}
+
+func extractErrorFromResponseRecorder(tb testing.TB, rr *httptest.ResponseRecorder, errMessage string) {
+	tb.Helper()
+	var got map[string]string
+	require.NoError(tb, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Equal(tb, map[string]string{"error": errMessage}, got)
+}
@@ -0,0 +1,471 @@
+package labels
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+//go:generate mockery --name=labelService --exported --output=./mocks --outpkg=mocks --filename=label_service.go --with-expecter=true
+type labelService interface {
+	CreateLabelRecord(ctx context.Context, label *handler_models.CreateLabel, ownerId string) (*models.Label, error)
+	GetLabelRecord(ctx context.Context, labelId string) (*models.Label, error)
+	UpdateLabelRecord(ctx context.Context, labelId string, label *handler_models.UpdateLabel) (*models.Label, error)
+	DeleteLabelRecord(ctx context.Context, labelId string) error
+	GetUserLabelsRecords(ctx context.Context, userId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.LabelPage, error)
+	GetTodoLabelsRecords(ctx context.Context, todoId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.LabelPage, error)
+	AddLabelToTodoRecord(ctx context.Context, todoId string, labelId string) (*models.Label, error)
+	RemoveLabelFromTodoRecord(ctx context.Context, todoId string, labelId string) error
+}
+
+//go:generate mockery --name=fieldValidator --exported --output=./mocks --outpkg=mocks --filename=field_validator.go --with-expecter=true
+type fieldValidator interface {
+	Struct(interface{}) error
+}
+
+type Handler struct {
+	serv       labelService
+	fValidator fieldValidator
+	transact   persistence.Transactioner
+}
+
+func NewHandler(service labelService, fValidator fieldValidator, transact persistence.Transactioner) *Handler {
+	return &Handler{
+		serv:       service,
+		fValidator: fValidator,
+		transact:   transact,
+	}
+}
+
+func (h *Handler) HandleCreateLabel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("creating label in label handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in label handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	var label handler_models.CreateLabel
+	if err = json.NewDecoder(r.Body).Decode(&label); err != nil {
+		log.C(ctx).Errorf("failed to decode label handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, label)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create label, error because one of the required fields is missing %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	owner, err := utils.GetValueFromContext[*models.User](r.Context(), middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get label's owner due to an error %s when trying to get value from context in label handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	l, err := h.serv.CreateLabelRecord(ctx, &label, owner.Id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create label in label handler due to an error %s in label service", err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(l); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to create label, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handler) HandleGetLabel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting label in label handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in label handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	labelId, err := utils.GetValueFromContext[string](r.Context(), middlewares.LabelId)
+	if err != nil {
+		log.C(ctx).Error("failed to get label_id from the context in label handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LABEL_ID, http.StatusBadRequest)
+		return
+	}
+
+	label, err := h.serv.GetLabelRecord(ctx, labelId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get label in label handler due to an error %s in label service", err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(label); err != nil {
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get label with id %s, error %s", labelId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleUpdateLabel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("updating label in label handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in label handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	labelId, err := utils.GetValueFromContext[string](r.Context(), middlewares.LabelId)
+	if err != nil {
+		log.C(ctx).Error("failed to get label_id from the context in label handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LABEL_ID, http.StatusBadRequest)
+		return
+	}
+
+	var updateModel handler_models.UpdateLabel
+	if err = json.NewDecoder(r.Body).Decode(&updateModel); err != nil {
+		log.C(ctx).Error("failed to decode label handler model")
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, updateModel)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update label, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	label, err := h.serv.UpdateLabelRecord(ctx, labelId, &updateModel)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update label, error %s when calling label service", err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(label); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to update label with id %s, error %s", labelId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleDeleteLabel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("deleting label in label handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in label handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	labelId, err := utils.GetValueFromContext[string](r.Context(), middlewares.LabelId)
+	if err != nil {
+		log.C(ctx).Error("failed to get label_id from the context in label handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LABEL_ID, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.serv.DeleteLabelRecord(ctx, labelId); err != nil {
+		log.C(ctx).Errorf("failed to delete label in label handler due to an error %s in label service", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to delete label with id %s, error %s", labelId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) HandleGetUserLabels(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting user's labels in label handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in label handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	userId, err := utils.GetValueFromContext[string](r.Context(), middlewares.UserId)
+	if err != nil {
+		log.C(ctx).Error("failed to get user_id from the context in label handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER_ID, http.StatusBadRequest)
+		return
+	}
+
+	first := utils.GetContentFromUrl(r, constants.FIRST)
+	after := utils.GetContentFromUrl(r, constants.AFTER)
+	before := utils.GetContentFromUrl(r, constants.BEFORE)
+	last := utils.GetContentFromUrl(r, constants.LAST)
+
+	name := utils.GetContentFromUrl(r, constants.NAME)
+
+	if len(first) == 0 && len(last) == 0 {
+		first = constants.DEFAULT_LIMIT_VALUE
+	}
+
+	f := &filters.LabelFilters{
+		PaginationFilters: filters.PaginationFilters{
+			First:  first,
+			Last:   last,
+			After:  after,
+			Before: before,
+		},
+		Name:    name,
+		OwnerID: userId,
+	}
+
+	rf := &resource_identifier.GenericResourceIdentifier{}
+	rf.SetResourceIdentifier(constants.LabelsIdentifier)
+
+	labels, err := h.serv.GetUserLabelsRecords(ctx, userId, f, rf)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get labels of user with id %s, error %s when calling label service", userId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(labels); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get labels of user with id %s, error %s", userId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleGetTodoLabels(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting todo's labels in label handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in label handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in label handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	first := utils.GetContentFromUrl(r, constants.FIRST)
+	after := utils.GetContentFromUrl(r, constants.AFTER)
+	before := utils.GetContentFromUrl(r, constants.BEFORE)
+	last := utils.GetContentFromUrl(r, constants.LAST)
+
+	name := utils.GetContentFromUrl(r, constants.NAME)
+
+	if len(first) == 0 && len(last) == 0 {
+		first = constants.DEFAULT_LIMIT_VALUE
+	}
+
+	f := &filters.LabelFilters{
+		PaginationFilters: filters.PaginationFilters{
+			First:  first,
+			Last:   last,
+			After:  after,
+			Before: before,
+		},
+		Name:   name,
+		TodoID: todoId,
+	}
+
+	rf := &resource_identifier.GenericResourceIdentifier{}
+	rf.SetResourceIdentifier(constants.TodosLabelsIdentifier)
+
+	labels, err := h.serv.GetTodoLabelsRecords(ctx, todoId, f, rf)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get labels of todo with id %s, error %s when calling label service", todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(labels); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get labels of todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleAddLabelToTodo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("attaching label to todo in label handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in label handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in label handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	var addLabel handler_models.AddLabel
+	if err = json.NewDecoder(r.Body).Decode(&addLabel); err != nil {
+		log.C(ctx).Errorf("failed to decode add label handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, addLabel)
+	if err != nil {
+		log.C(ctx).Errorf("failed to attach label, error because one of the required fields is missing %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	label, err := h.serv.AddLabelToTodoRecord(ctx, todoId, addLabel.LabelId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to attach label with id %s to todo with id %s, error %s when calling label service", addLabel.LabelId, todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(label); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to attach label to todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handler) HandleRemoveLabelFromTodo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("detaching label from todo in label handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in label handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in label handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	labelId, err := utils.GetValueFromContext[string](r.Context(), middlewares.LabelId)
+	if err != nil {
+		log.C(ctx).Error("failed to get label_id from the context in label handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LABEL_ID, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.serv.RemoveLabelFromTodoRecord(ctx, todoId, labelId); err != nil {
+		log.C(ctx).Errorf("failed to detach label with id %s from todo with id %s, error %s when calling label service", labelId, todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to detach label from todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
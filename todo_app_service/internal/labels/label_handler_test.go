@@ -0,0 +1,193 @@
+package labels
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/labels/mocks"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_HandleAddLabelToTodo(t *testing.T) {
+	tests := []struct {
+		testName        string
+		body            string
+		mockService     func() *mocks.LabelService
+		dbMock          func(mck sqlmock.Sqlmock)
+		expectedStatus  int
+		expectedLabel   *models.Label
+		expectedMessage string
+	}{
+		{
+			testName: "Successfully attaching label to todo",
+			body:     `{"label_id":"` + labelId.String() + `"}`,
+			mockService: func() *mocks.LabelService {
+				mService := &mocks.LabelService{}
+
+				mService.EXPECT().
+					AddLabelToTodoRecord(mock.Anything, todoId.String(), labelId.String()).
+					Return(labelModel, nil).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			expectedStatus: http.StatusOK,
+			expectedLabel:  labelModel,
+		},
+		{
+			testName: "Failed to attach label owned by another user than the list owner",
+			body:     `{"label_id":"` + labelId.String() + `"}`,
+			mockService: func() *mocks.LabelService {
+				mService := &mocks.LabelService{}
+
+				mService.EXPECT().
+					AddLabelToTodoRecord(mock.Anything, todoId.String(), labelId.String()).
+					Return(nil, application_errors.LabelOutOfScopeError).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: application_errors.LabelOutOfScopeError.Error(),
+		},
+		{
+			testName: "Failed to attach label which does not exist",
+			body:     `{"label_id":"` + labelId.String() + `"}`,
+			mockService: func() *mocks.LabelService {
+				mService := &mocks.LabelService{}
+
+				mService.EXPECT().
+					AddLabelToTodoRecord(mock.Anything, todoId.String(), labelId.String()).
+					Return(nil, labelNotFound).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectedMessage: labelNotFound.Error(),
+		},
+		{
+			testName: "Failed to attach label with id which is not an uuid",
+			body:     `{"label_id":"label"}`,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: application_errors.NewEmptyFieldError("LabelId").Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			mService := &mocks.LabelService{}
+			if test.mockService != nil {
+				mService = test.mockService()
+			}
+
+			handler := NewHandler(mService, validator.New(), persistence.NewSqlDb(db))
+
+			req := httptest.NewRequest(http.MethodPost, "/todos/"+todoId.String()+"/labels", strings.NewReader(test.body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.TodoId, todoId.String()))
+			rr := httptest.NewRecorder()
+
+			handler.HandleAddLabelToTodo(rr, req)
+
+			require.Equal(t, test.expectedStatus, rr.Code)
+			if len(test.expectedMessage) != 0 {
+				extractErrorFromResponseRecorder(t, rr, test.expectedMessage)
+			} else {
+				var received models.Label
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &received))
+				require.Equal(t, test.expectedLabel, &received)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, mService)
+		})
+	}
+}
+
+func TestHandler_HandleRemoveLabelFromTodo(t *testing.T) {
+	tests := []struct {
+		testName       string
+		serviceErr     error
+		dbMock         func(mck sqlmock.Sqlmock)
+		expectedStatus int
+	}{
+		{
+			testName: "Successfully detaching label from todo",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			testName:   "Failed to detach label which is not attached to the todo",
+			serviceErr: labelNotFound,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			mService := &mocks.LabelService{}
+			mService.EXPECT().
+				RemoveLabelFromTodoRecord(mock.Anything, todoId.String(), labelId.String()).
+				Return(test.serviceErr).Once()
+
+			handler := NewHandler(mService, validator.New(), persistence.NewSqlDb(db))
+
+			req := httptest.NewRequest(http.MethodDelete, "/todos/"+todoId.String()+"/labels/"+labelId.String(), nil)
+			ctx := context.WithValue(req.Context(), middlewares.TodoId, todoId.String())
+			req = req.WithContext(context.WithValue(ctx, middlewares.LabelId, labelId.String()))
+			rr := httptest.NewRecorder()
+
+			handler.HandleRemoveLabelFromTodo(rr, req)
+
+			require.Equal(t, test.expectedStatus, rr.Code)
+			if test.serviceErr != nil {
+				extractErrorFromResponseRecorder(t, rr, test.serviceErr.Error())
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, mService)
+		})
+	}
+}
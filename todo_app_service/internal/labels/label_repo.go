@@ -0,0 +1,231 @@
+package labels
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/source"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+type genericRepository interface {
+	GetPaginationInfo(ctx context.Context, sourceName string, filter string, params []interface{}) (*entities.PaginationInfo, error)
+}
+
+type sqlDecoratorFactory interface {
+	CreateSqlDecorator(context.Context, sql_query_decorators.Filters, string, []interface{}) (sql_query_decorators.SqlQueryRetriever, error)
+}
+
+type repository struct {
+	genericRepo genericRepository
+	factory     sqlDecoratorFactory
+}
+
+func NewRepo(genericRepo genericRepository, factory sqlDecoratorFactory) *repository {
+	return &repository{
+		genericRepo: genericRepo,
+		factory:     factory,
+	}
+}
+
+func (*repository) GetLabel(ctx context.Context, labelId string) (*entities.Label, error) {
+	log.C(ctx).Infof("getting label with id %s from label repository", labelId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQueryString := `SELECT id, name, owner, created_at FROM labels WHERE id = $1`
+
+	var entity entities.Label
+	if err = persist.GetContext(ctx, &entity, sqlQueryString, labelId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("failed to get label with id %s due to sqlErrNoRows", labelId)
+			return nil, application_errors.NewNotFoundError(constants.LABEL_TARGET, labelId)
+		}
+
+		log.C(ctx).Errorf("failed to get label with id %s due to database error", labelId)
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+func (r *repository) CreateLabel(ctx context.Context, entity *entities.Label) (*entities.Label, error) {
+	log.C(ctx).Info("creating label in label repository")
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQueryString := `INSERT INTO labels (id, name, owner, created_at) VALUES (:id, :name, :owner, :created_at)`
+
+	if _, err = persist.NamedExecContext(ctx, sqlQueryString, entity); err != nil {
+		log.C(ctx).Errorf("failed to create label due to a failure in the execution of the sql query %s", err.Error())
+		return nil, persistence.MapPostgresLabelError(err, entity)
+	}
+
+	return r.GetLabel(ctx, entity.Id.String())
+}
+
+func (r *repository) UpdateLabel(ctx context.Context, sqlExecParams map[string]interface{}, sqlFields []string) (*entities.Label, error) {
+	labelId := sqlExecParams["id"].(string)
+	log.C(ctx).Infof("updating label with id %s in label repository", labelId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQueryString := parseSqlUpdateLabelQuery(sqlFields)
+
+	res, err := persist.NamedExecContext(ctx, sqlQueryString, sqlExecParams)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update label, error when executing sql query %s", err.Error())
+
+		name, _ := sqlExecParams["name"].(string)
+		return nil, persistence.MapPostgresLabelError(err, &entities.Label{Name: name})
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to update label, error when trying to get the number of rows affected")
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to update label, invalid label_id provided %s", labelId)
+		return nil, application_errors.NewNotFoundError(constants.LABEL_TARGET, labelId)
+	}
+
+	return r.GetLabel(ctx, labelId)
+}
+
+func (*repository) DeleteLabel(ctx context.Context, labelId string) error {
+	log.C(ctx).Infof("deleting label with id %s from label repository", labelId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	sqlQueryString := `DELETE FROM labels WHERE id = $1`
+
+	if _, err = persist.ExecContext(ctx, sqlQueryString, labelId); err != nil {
+		log.C(ctx).Errorf("failed to delete label with id %s due to a failiure in the execution of the sql query", labelId)
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) GetLabels(ctx context.Context, f filters.SqlFilters) ([]entities.Label, error) {
+	log.C(ctx).Info("getting labels from label repository")
+
+	return r.getLabelsFromSource(ctx, constants.LabelsSQLTableName, f)
+}
+
+func (r *repository) GetTodoLabels(ctx context.Context, f filters.SqlFilters) ([]entities.Label, error) {
+	log.C(ctx).Info("getting labels attached to a todo from label repository")
+
+	return r.getLabelsFromSource(ctx, constants.TodosLabelsViewName, f)
+}
+
+func (*repository) AddLabelToTodo(ctx context.Context, todoId string, labelId string) error {
+	log.C(ctx).Infof("attaching label with id %s to todo with id %s in label repository", labelId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	sqlQueryString := `INSERT INTO todo_labels (todo_id, label_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	if _, err = persist.ExecContext(ctx, sqlQueryString, todoId, labelId); err != nil {
+		log.C(ctx).Errorf("failed to attach label with id %s to todo with id %s, error %s when executing sql query", labelId, todoId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (*repository) RemoveLabelFromTodo(ctx context.Context, todoId string, labelId string) error {
+	log.C(ctx).Infof("detaching label with id %s from todo with id %s in label repository", labelId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	sqlQueryString := `DELETE FROM todo_labels WHERE todo_id = $1 AND label_id = $2`
+
+	res, err := persist.ExecContext(ctx, sqlQueryString, todoId, labelId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to detach label with id %s from todo with id %s, error %s when executing sql query", labelId, todoId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Errorf("failed to detach label with id %s from todo with id %s, error when trying to get the number of rows affected", labelId, todoId)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Debugf("label with id %s is not attached to todo with id %s", labelId, todoId)
+		return application_errors.NewNotFoundError(constants.LABEL_TARGET, labelId)
+	}
+
+	return nil
+}
+
+func (r *repository) GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error) {
+	log.C(ctx).Info("getting pagination info about labels in label repo")
+
+	filteringClause, params := f.BuildSQLFiltering()
+	return r.genericRepo.GetPaginationInfo(ctx, s.GetSource(), filteringClause, params)
+}
+
+func (r *repository) getLabelsFromSource(ctx context.Context, sourceName string, f filters.SqlFilters) ([]entities.Label, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	baseQuery := fmt.Sprintf(`SELECT id, name, owner, created_at FROM %s`, sourceName)
+	filteringClause, params := f.BuildSQLFiltering()
+	baseQuery += filteringClause
+
+	retriever, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery, params)
+	if err != nil {
+		log.C(ctx).Error("failed to determine sql query, error when calling decorator factory")
+		return nil, err
+	}
+
+	sqlQueryString, params := retriever.DetermineCorrectSqlQuery(ctx)
+	completeQuery := fmt.Sprintf(`SELECT id, name, owner, created_at FROM (%s) ORDER BY id`, sqlQueryString)
+
+	var labels []entities.Label
+	if err = persist.SelectContext(ctx, &labels, completeQuery, params...); err != nil {
+		log.C(ctx).Errorf("failed to parse label entities due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return labels, nil
+}
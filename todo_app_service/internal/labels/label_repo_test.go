@@ -0,0 +1,219 @@
+package labels
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"regexp"
+	"testing"
+)
+
+func TestRepository_GetLabel(t *testing.T) {
+	tests := []struct {
+		testName       string
+		dbMock         func(mck sqlmock.Sqlmock)
+		err            error
+		expectedEntity *entities.Label
+	}{
+		{
+			testName: "Successfully getting label",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(labelColumns).
+					AddRow(labelId, labelName, ownerId, createdAt)
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetLabel)).
+					WithArgs(labelId.String()).
+					WillReturnRows(rows)
+			},
+			expectedEntity: labelEntity,
+		},
+		{
+			testName: "Failed to get label which does not exist",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetLabel)).
+					WithArgs(labelId.String()).
+					WillReturnError(sql.ErrNoRows)
+			},
+			err: labelNotFound,
+		},
+		{
+			testName: "Failed to get label due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetLabel)).
+					WithArgs(labelId.String()).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			label, err := NewRepo(nil, nil).GetLabel(ctx, labelId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedEntity, label)
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_DeleteLabel(t *testing.T) {
+	tests := []struct {
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
+	}{
+		{
+			testName: "Successfully deleting label",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteLabel)).
+					WithArgs(labelId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			testName: "Failed to delete label due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteLabel)).
+					WithArgs(labelId.String()).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo(nil, nil).DeleteLabel(ctx, labelId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_AddLabelToTodo(t *testing.T) {
+	tests := []struct {
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
+	}{
+		{
+			testName: "Successfully attaching label to todo, attaching it twice is not an error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryAddLabelToTodo)).
+					WithArgs(todoId.String(), labelId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			testName: "Failed to attach label to todo due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryAddLabelToTodo)).
+					WithArgs(todoId.String(), labelId.String()).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo(nil, nil).AddLabelToTodo(ctx, todoId.String(), labelId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_RemoveLabelFromTodo(t *testing.T) {
+	tests := []struct {
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
+	}{
+		{
+			testName: "Successfully detaching label from todo",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryRemoveLabel)).
+					WithArgs(todoId.String(), labelId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			testName: "Failed to detach label which is not attached to the todo",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryRemoveLabel)).
+					WithArgs(todoId.String(), labelId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			err: labelNotFound,
+		},
+		{
+			testName: "Failed to detach label due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryRemoveLabel)).
+					WithArgs(todoId.String(), labelId.String()).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo(nil, nil).RemoveLabelFromTodo(ctx, todoId.String(), labelId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package labels
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
+	"Todo-List/internProject/todo_app_service/internal/source"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"time"
+)
+
+//go:generate mockery --name=labelRepo --exported --output=./mocks --outpkg=mocks --filename=label_repo.go --with-expecter=true
+type labelRepo interface {
+	GetLabel(ctx context.Context, labelId string) (*entities.Label, error)
+	GetLabels(ctx context.Context, f filters.SqlFilters) ([]entities.Label, error)
+	GetTodoLabels(ctx context.Context, f filters.SqlFilters) ([]entities.Label, error)
+	CreateLabel(ctx context.Context, entity *entities.Label) (*entities.Label, error)
+	UpdateLabel(ctx context.Context, sqlExecParams map[string]interface{}, sqlFields []string) (*entities.Label, error)
+	DeleteLabel(ctx context.Context, labelId string) error
+	AddLabelToTodo(ctx context.Context, todoId string, labelId string) error
+	RemoveLabelFromTodo(ctx context.Context, todoId string, labelId string) error
+	GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error)
+}
+
+//go:generate mockery --name=todoRepo --exported --output=./mocks --outpkg=mocks --filename=todo_repo.go --with-expecter=true
+type todoRepo interface {
+	GetTodo(ctx context.Context, todoId string) (*entities.Todo, error)
+}
+
+//go:generate mockery --name=listRepo --exported --output=./mocks --outpkg=mocks --filename=list_repo.go --with-expecter=true
+type listRepo interface {
+	GetListOwner(ctx context.Context, listId string) (*entities.User, error)
+}
+
+//go:generate mockery --name=userRepo --exported --output=./mocks --outpkg=mocks --filename=user_repo.go --with-expecter=true
+type userRepo interface {
+	GetUser(ctx context.Context, userId string) (*entities.User, error)
+}
+
+//go:generate mockery --name=uuidGenerator --exported --output=./mocks --outpkg=mocks --filename=uuid_generator.go --with-expecter=true
+type uuidGenerator interface {
+	Generate() string
+}
+
+//go:generate mockery --name=timeGenerator --exported --output=./mocks --outpkg=mocks --filename=time_generator.go --with-expecter=true
+type timeGenerator interface {
+	Now() time.Time
+}
+
+//go:generate mockery --name=labelConverter --exported --output=./mocks --outpkg=mocks --filename=label_converter.go --with-expecter=true
+type labelConverter interface {
+	ToModel(label *entities.Label) *models.Label
+	ToEntity(label *models.Label) *entities.Label
+	ManyToPage(labels []entities.Label, pageInfo *entities.PaginationInfo) *models.LabelPage
+	FromCreateHandlerModelToModel(label *handler_models.CreateLabel) *models.Label
+	FromUpdateHandlerModelToModel(label *handler_models.UpdateLabel) *models.Label
+}
+
+//go:generate mockery --name=resourceIdentifierAdapter --exported --output=./mocks --outpkg=mocks --filename=resource_identifier_adapter.go --with-expecter=true
+type resourceIdentifierAdapter interface {
+	AdaptResourceIdentifier(rf resource_identifier.ResourceIdentifier) string
+}
+
+type service struct {
+	labelRepo labelRepo
+	tRepo     todoRepo
+	lRepo     listRepo
+	uRepo     userRepo
+	uuidGen   uuidGenerator
+	timeGen   timeGenerator
+	converter labelConverter
+	rfAdapter resourceIdentifierAdapter
+}
+
+func NewService(labelRepo labelRepo, tRepo todoRepo, lRepo listRepo, uRepo userRepo, uuidGen uuidGenerator,
+	timeGen timeGenerator, converter labelConverter, rfAdapter resourceIdentifierAdapter) *service {
+	return &service{
+		labelRepo: labelRepo,
+		tRepo:     tRepo,
+		lRepo:     lRepo,
+		uRepo:     uRepo,
+		uuidGen:   uuidGen,
+		timeGen:   timeGen,
+		converter: converter,
+		rfAdapter: rfAdapter,
+	}
+}
+
+func (s *service) CreateLabelRecord(ctx context.Context, label *handler_models.CreateLabel, ownerId string) (*models.Label, error) {
+	log.C(ctx).Info("creating label record in label service")
+
+	modelLabel := s.converter.FromCreateHandlerModelToModel(label)
+	modelLabel.Id = s.uuidGen.Generate()
+	modelLabel.Owner = ownerId
+	modelLabel.CreatedAt = s.timeGen.Now()
+
+	entity, err := s.labelRepo.CreateLabel(ctx, s.converter.ToEntity(modelLabel))
+	if err != nil {
+		log.C(ctx).Errorf("failed to create label, error %s when calling label repo", err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(entity), nil
+}
+
+func (s *service) GetLabelRecord(ctx context.Context, labelId string) (*models.Label, error) {
+	log.C(ctx).Infof("getting label with id %s in label service", labelId)
+
+	entity, err := s.labelRepo.GetLabel(ctx, labelId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get label with id %s, error %s when calling label repo", labelId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(entity), nil
+}
+
+func (s *service) UpdateLabelRecord(ctx context.Context, labelId string, label *handler_models.UpdateLabel) (*models.Label, error) {
+	log.C(ctx).Infof("updating label with id %s in label service", labelId)
+
+	modelLabel := s.converter.FromUpdateHandlerModelToModel(label)
+
+	sqlExecParams := map[string]interface{}{"id": labelId}
+	sqlFields := make([]string, 0, 1)
+
+	determineSqlFieldsAndParamsLabel(modelLabel, sqlExecParams, &sqlFields)
+	if len(sqlFields) == 0 {
+		return s.GetLabelRecord(ctx, labelId)
+	}
+
+	entity, err := s.labelRepo.UpdateLabel(ctx, sqlExecParams, sqlFields)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update label with id %s, error %s when calling label repo", labelId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(entity), nil
+}
+
+func (s *service) DeleteLabelRecord(ctx context.Context, labelId string) error {
+	log.C(ctx).Infof("deleting label with id %s in label service", labelId)
+
+	if err := s.labelRepo.DeleteLabel(ctx, labelId); err != nil {
+		log.C(ctx).Errorf("failed to delete label with id %s, error %s when calling label repo", labelId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetUserLabelsRecords(ctx context.Context, userId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.LabelPage, error) {
+	log.C(ctx).Infof("getting labels of user with id %s in label service", userId)
+
+	if _, err := s.uRepo.GetUser(ctx, userId); err != nil {
+		log.C(ctx).Errorf("failed to get labels of user with id %s, error when calling user repo", userId)
+		return nil, err
+	}
+
+	eLabels, err := s.labelRepo.GetLabels(ctx, f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get labels of user with id %s, error %s when calling label repo", userId, err.Error())
+		return nil, err
+	}
+
+	sqlSource := prepareSqlSource(s.rfAdapter, rf)
+	paginationInfo, err := s.labelRepo.GetPaginationInfo(ctx, f, sqlSource)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get first and last ids of labels, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.converter.ManyToPage(eLabels, paginationInfo), nil
+}
+
+func (s *service) GetTodoLabelsRecords(ctx context.Context, todoId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.LabelPage, error) {
+	log.C(ctx).Infof("getting labels of todo with id %s in label service", todoId)
+
+	if _, err := s.tRepo.GetTodo(ctx, todoId); err != nil {
+		log.C(ctx).Errorf("failed to get labels of todo with id %s, error when calling todo repo", todoId)
+		return nil, err
+	}
+
+	eLabels, err := s.labelRepo.GetTodoLabels(ctx, f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get labels of todo with id %s, error %s when calling label repo", todoId, err.Error())
+		return nil, err
+	}
+
+	sqlSource := prepareSqlSource(s.rfAdapter, rf)
+	paginationInfo, err := s.labelRepo.GetPaginationInfo(ctx, f, sqlSource)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get first and last ids of labels, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.converter.ManyToPage(eLabels, paginationInfo), nil
+}
+
+func (s *service) AddLabelToTodoRecord(ctx context.Context, todoId string, labelId string) (*models.Label, error) {
+	log.C(ctx).Infof("attaching label with id %s to todo with id %s in label service", labelId, todoId)
+
+	todo, err := s.tRepo.GetTodo(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to attach label to todo with id %s, error when calling todo repo", todoId)
+		return nil, err
+	}
+
+	label, err := s.labelRepo.GetLabel(ctx, labelId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to attach label with id %s, error when calling label repo", labelId)
+		return nil, err
+	}
+
+	listOwner, err := s.lRepo.GetListOwner(ctx, todo.ListId.String())
+	if err != nil {
+		log.C(ctx).Errorf("failed to get owner of list with id %s, error %s when calling list repo", todo.ListId, err.Error())
+		return nil, err
+	}
+
+	// labels are scoped per list owner, so only the owner's labels can be attached to todos in their lists
+	if listOwner.Id != label.Owner {
+		log.C(ctx).Errorf("failed to attach label with id %s to todo with id %s, label is owned by another user", labelId, todoId)
+		return nil, application_errors.LabelOutOfScopeError
+	}
+
+	if err = s.labelRepo.AddLabelToTodo(ctx, todoId, labelId); err != nil {
+		log.C(ctx).Errorf("failed to attach label with id %s to todo with id %s, error %s when calling label repo", labelId, todoId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(label), nil
+}
+
+func (s *service) RemoveLabelFromTodoRecord(ctx context.Context, todoId string, labelId string) error {
+	log.C(ctx).Infof("detaching label with id %s from todo with id %s in label service", labelId, todoId)
+
+	if err := s.labelRepo.RemoveLabelFromTodo(ctx, todoId, labelId); err != nil {
+		log.C(ctx).Errorf("failed to detach label with id %s from todo with id %s, error %s when calling label repo", labelId, todoId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func prepareSqlSource(adapter resourceIdentifierAdapter, rf resource_identifier.ResourceIdentifier) source.Source {
+	adaptedRf := adapter.AdaptResourceIdentifier(rf)
+
+	sqlSource := &source.SqlSource{}
+	sqlSource.SetSource(adaptedRf)
+
+	return sqlSource
+}
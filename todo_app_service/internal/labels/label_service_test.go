@@ -0,0 +1,458 @@
+package labels
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/labels/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestService_CreateLabelRecord(t *testing.T) {
+	tests := []struct {
+		testName      string
+		mockLabelRepo func() *mocks.LabelRepo
+		mockConverter func() *mocks.LabelConverter
+		expectedLabel *models.Label
+		err           error
+	}{
+		{
+			testName: "Successfully creating label owned by the user",
+			mockLabelRepo: func() *mocks.LabelRepo {
+				mRepo := &mocks.LabelRepo{}
+
+				mRepo.EXPECT().
+					CreateLabel(context.TODO(), labelEntity).
+					Return(labelEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.LabelConverter {
+				mConverter := &mocks.LabelConverter{}
+
+				mConverter.EXPECT().
+					FromCreateHandlerModelToModel(createLabelHandlerModel).
+					Return(&models.Label{Name: labelName}).Once()
+
+				mConverter.EXPECT().
+					ToEntity(labelModel).
+					Return(labelEntity).Once()
+
+				mConverter.EXPECT().
+					ToModel(labelEntity).
+					Return(labelModel).Once()
+
+				return mConverter
+			},
+			expectedLabel: labelModel,
+		},
+		{
+			testName: "Failed to create label due to error in label repository",
+			mockLabelRepo: func() *mocks.LabelRepo {
+				mRepo := &mocks.LabelRepo{}
+
+				mRepo.EXPECT().
+					CreateLabel(context.TODO(), labelEntity).
+					Return(nil, dbError).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.LabelConverter {
+				mConverter := &mocks.LabelConverter{}
+
+				mConverter.EXPECT().
+					FromCreateHandlerModelToModel(createLabelHandlerModel).
+					Return(&models.Label{Name: labelName}).Once()
+
+				mConverter.EXPECT().
+					ToEntity(labelModel).
+					Return(labelEntity).Once()
+
+				return mConverter
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockLabelRepo()
+			mConverter := test.mockConverter()
+
+			mUuidGen := &mocks.UuidGenerator{}
+			mUuidGen.EXPECT().Generate().Return(labelId.String()).Once()
+
+			mTimeGen := &mocks.TimeGenerator{}
+			mTimeGen.EXPECT().Now().Return(createdAt).Once()
+
+			lService := NewService(mRepo, nil, nil, nil, mUuidGen, mTimeGen, mConverter, nil)
+
+			label, err := lService.CreateLabelRecord(context.TODO(), createLabelHandlerModel, ownerId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedLabel, label)
+			mock.AssertExpectationsForObjects(t, mRepo, mConverter, mUuidGen, mTimeGen)
+		})
+	}
+}
+
+func TestService_UpdateLabelRecord(t *testing.T) {
+	newName := updatedLabelName
+
+	tests := []struct {
+		testName      string
+		updateModel   *handler_models.UpdateLabel
+		mockLabelRepo func() *mocks.LabelRepo
+		mockConverter func() *mocks.LabelConverter
+		expectedLabel *models.Label
+		err           error
+	}{
+		{
+			testName:    "Successfully renaming label",
+			updateModel: &handler_models.UpdateLabel{Name: &newName},
+			mockLabelRepo: func() *mocks.LabelRepo {
+				mRepo := &mocks.LabelRepo{}
+
+				mRepo.EXPECT().
+					UpdateLabel(context.TODO(), map[string]interface{}{"id": labelId.String(), "name": updatedLabelName},
+						[]string{"name = :name"}).
+					Return(updatedLabelEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.LabelConverter {
+				mConverter := &mocks.LabelConverter{}
+
+				mConverter.EXPECT().
+					FromUpdateHandlerModelToModel(&handler_models.UpdateLabel{Name: &newName}).
+					Return(&models.Label{Name: updatedLabelName}).Once()
+
+				mConverter.EXPECT().
+					ToModel(updatedLabelEntity).
+					Return(updatedLabelModel).Once()
+
+				return mConverter
+			},
+			expectedLabel: updatedLabelModel,
+		},
+		{
+			testName:    "Successfully returning the label as it is when there is nothing to update",
+			updateModel: &handler_models.UpdateLabel{},
+			mockLabelRepo: func() *mocks.LabelRepo {
+				mRepo := &mocks.LabelRepo{}
+
+				mRepo.EXPECT().
+					GetLabel(context.TODO(), labelId.String()).
+					Return(labelEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.LabelConverter {
+				mConverter := &mocks.LabelConverter{}
+
+				mConverter.EXPECT().
+					FromUpdateHandlerModelToModel(&handler_models.UpdateLabel{}).
+					Return(&models.Label{}).Once()
+
+				mConverter.EXPECT().
+					ToModel(labelEntity).
+					Return(labelModel).Once()
+
+				return mConverter
+			},
+			expectedLabel: labelModel,
+		},
+		{
+			testName:    "Failed to rename label which does not exist",
+			updateModel: &handler_models.UpdateLabel{Name: &newName},
+			mockLabelRepo: func() *mocks.LabelRepo {
+				mRepo := &mocks.LabelRepo{}
+
+				mRepo.EXPECT().
+					UpdateLabel(context.TODO(), map[string]interface{}{"id": labelId.String(), "name": updatedLabelName},
+						[]string{"name = :name"}).
+					Return(nil, labelNotFound).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.LabelConverter {
+				mConverter := &mocks.LabelConverter{}
+
+				mConverter.EXPECT().
+					FromUpdateHandlerModelToModel(&handler_models.UpdateLabel{Name: &newName}).
+					Return(&models.Label{Name: updatedLabelName}).Once()
+
+				return mConverter
+			},
+			err: labelNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockLabelRepo()
+			mConverter := test.mockConverter()
+
+			lService := NewService(mRepo, nil, nil, nil, nil, nil, mConverter, nil)
+
+			label, err := lService.UpdateLabelRecord(context.TODO(), labelId.String(), test.updateModel)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedLabel, label)
+			mock.AssertExpectationsForObjects(t, mRepo, mConverter)
+		})
+	}
+}
+
+func TestService_GetTodoLabelsRecords(t *testing.T) {
+	mTodoRepo := &mocks.TodoRepo{}
+	mTodoRepo.EXPECT().
+		GetTodo(context.TODO(), todoId.String()).
+		Return(nil, todoNotFound).Once()
+
+	mLabelRepo := &mocks.LabelRepo{}
+
+	lService := NewService(mLabelRepo, mTodoRepo, nil, nil, nil, nil, nil, nil)
+
+	labels, err := lService.GetTodoLabelsRecords(context.TODO(), todoId.String(), nil, nil)
+	require.EqualError(t, err, todoNotFound.Error())
+	require.Nil(t, labels)
+
+	mock.AssertExpectationsForObjects(t, mTodoRepo, mLabelRepo)
+}
+
+func TestService_AddLabelToTodoRecord(t *testing.T) {
+	otherUsersLabel := initLabelEntity(labelName, otherUserId)
+
+	tests := []struct {
+		testName      string
+		mockTodoRepo  func() *mocks.TodoRepo
+		mockLabelRepo func() *mocks.LabelRepo
+		mockListRepo  func() *mocks.ListRepo
+		mockConverter func() *mocks.LabelConverter
+		expectedLabel *models.Label
+		err           error
+	}{
+		{
+			testName: "Successfully attaching label of the list owner to the todo",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					GetTodo(context.TODO(), todoId.String()).
+					Return(todoEntity, nil).Once()
+
+				return mRepo
+			},
+			mockLabelRepo: func() *mocks.LabelRepo {
+				mRepo := &mocks.LabelRepo{}
+
+				mRepo.EXPECT().
+					GetLabel(context.TODO(), labelId.String()).
+					Return(labelEntity, nil).Once()
+
+				mRepo.EXPECT().
+					AddLabelToTodo(context.TODO(), todoId.String(), labelId.String()).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetListOwner(context.TODO(), listId.String()).
+					Return(&entities.User{Id: ownerId}, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.LabelConverter {
+				mConverter := &mocks.LabelConverter{}
+
+				mConverter.EXPECT().
+					ToModel(labelEntity).
+					Return(labelModel).Once()
+
+				return mConverter
+			},
+			expectedLabel: labelModel,
+		},
+		{
+			testName: "Failed to attach label owned by another user than the list owner",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					GetTodo(context.TODO(), todoId.String()).
+					Return(todoEntity, nil).Once()
+
+				return mRepo
+			},
+			mockLabelRepo: func() *mocks.LabelRepo {
+				mRepo := &mocks.LabelRepo{}
+
+				mRepo.EXPECT().
+					GetLabel(context.TODO(), labelId.String()).
+					Return(otherUsersLabel, nil).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetListOwner(context.TODO(), listId.String()).
+					Return(&entities.User{Id: ownerId}, nil).Once()
+
+				return mRepo
+			},
+			err: application_errors.LabelOutOfScopeError,
+		},
+		{
+			testName: "Failed to attach label to todo which does not exist",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					GetTodo(context.TODO(), todoId.String()).
+					Return(nil, todoNotFound).Once()
+
+				return mRepo
+			},
+			err: todoNotFound,
+		},
+		{
+			testName: "Failed to attach label which does not exist",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					GetTodo(context.TODO(), todoId.String()).
+					Return(todoEntity, nil).Once()
+
+				return mRepo
+			},
+			mockLabelRepo: func() *mocks.LabelRepo {
+				mRepo := &mocks.LabelRepo{}
+
+				mRepo.EXPECT().
+					GetLabel(context.TODO(), labelId.String()).
+					Return(nil, labelNotFound).Once()
+
+				return mRepo
+			},
+			err: labelNotFound,
+		},
+		{
+			testName: "Failed to attach label due to error when getting the list owner",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					GetTodo(context.TODO(), todoId.String()).
+					Return(todoEntity, nil).Once()
+
+				return mRepo
+			},
+			mockLabelRepo: func() *mocks.LabelRepo {
+				mRepo := &mocks.LabelRepo{}
+
+				mRepo.EXPECT().
+					GetLabel(context.TODO(), labelId.String()).
+					Return(labelEntity, nil).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetListOwner(context.TODO(), listId.String()).
+					Return(nil, dbError).Once()
+
+				return mRepo
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mTodoRepo := test.mockTodoRepo()
+
+			mLabelRepo := &mocks.LabelRepo{}
+			if test.mockLabelRepo != nil {
+				mLabelRepo = test.mockLabelRepo()
+			}
+
+			mListRepo := &mocks.ListRepo{}
+			if test.mockListRepo != nil {
+				mListRepo = test.mockListRepo()
+			}
+
+			mConverter := &mocks.LabelConverter{}
+			if test.mockConverter != nil {
+				mConverter = test.mockConverter()
+			}
+
+			lService := NewService(mLabelRepo, mTodoRepo, mListRepo, nil, nil, nil, mConverter, nil)
+
+			label, err := lService.AddLabelToTodoRecord(context.TODO(), todoId.String(), labelId.String())
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedLabel, label)
+			mock.AssertExpectationsForObjects(t, mTodoRepo, mLabelRepo, mListRepo, mConverter)
+		})
+	}
+}
+
+func TestService_RemoveLabelFromTodoRecord(t *testing.T) {
+	tests := []struct {
+		testName string
+		repoErr  error
+	}{
+		{
+			testName: "Successfully detaching label from todo",
+		},
+		{
+			testName: "Failed to detach label which is not attached to the todo",
+			repoErr:  labelNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := &mocks.LabelRepo{}
+			mRepo.EXPECT().
+				RemoveLabelFromTodo(context.TODO(), todoId.String(), labelId.String()).
+				Return(test.repoErr).Once()
+
+			lService := NewService(mRepo, nil, nil, nil, nil, nil, nil, nil)
+
+			err := lService.RemoveLabelFromTodoRecord(context.TODO(), todoId.String(), labelId.String())
+			if test.repoErr != nil {
+				require.EqualError(t, err, test.repoErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, mRepo)
+		})
+	}
+}
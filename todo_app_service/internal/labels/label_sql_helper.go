@@ -0,0 +1,19 @@
+package labels
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"fmt"
+	"strings"
+)
+
+func parseSqlUpdateLabelQuery(sqlFields []string) string {
+	sqlQuery := fmt.Sprintf("UPDATE labels SET %s WHERE id = :id", strings.Join(sqlFields, ", "))
+	return sqlQuery
+}
+
+func determineSqlFieldsAndParamsLabel(label *models.Label, sqlExecParams map[string]interface{}, sqlFields *[]string) {
+	if len(label.Name) != 0 {
+		sqlExecParams["name"] = label.Name
+		*sqlFields = append(*sqlFields, "name = :name")
+	}
+}
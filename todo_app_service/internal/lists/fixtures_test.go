@@ -117,3 +117,21 @@ func initTimeGenMock() *mocks.TimeGenerator {
 
 	return mTimeGen
 }
+
+var (
+	listId            = uuid.Must(uuid.NewV4())
+	ownerId           = uuid.Must(uuid.NewV4())
+	newOwnerId        = uuid.Must(uuid.NewV4())
+	listNotFound      = application_errors.NewNotFoundError(constants.LIST_TARGET, listId.String())
+	newOwnerNotFound  = application_errors.NewNotFoundError(constants.USER_TARGET, newOwnerId.String())
+	ownedListEntity   = &entities.List{Id: listId, Name: VALID_NAME, Owner: ownerId}
+	transferredEntity = &entities.List{Id: listId, Name: VALID_NAME, Owner: newOwnerId, LastUpdated: lastUpdate}
+	transferredModel  = &models.List{Id: listId.String(), Name: VALID_NAME, Owner: newOwnerId.String(), LastUpdated: lastUpdate}
+	transferParams    = map[string]interface{}{"id": listId.String(), "last_updated": lastUpdate, "owner": newOwnerId.String()}
+	transferFields    = []string{"last_updated = :last_updated", "owner = :owner"}
+	copyListId        = uuid.Must(uuid.NewV4())
+	parentTodoId      = uuid.Must(uuid.NewV4())
+	subtaskId         = uuid.Must(uuid.NewV4())
+	parentCopyId      = uuid.Must(uuid.NewV4())
+	subtaskCopyId     = uuid.Must(uuid.NewV4())
+)
@@ -16,7 +16,7 @@ import (
 	"net/http"
 )
 
-//go:generate mockery --name=IService --output=./mocks --outpkg=mocks --filename=Iservice.go --with-expecter=true
+//go:generate mockery --name=listService --exported --output=./mocks --outpkg=mocks --filename=list_service.go --with-expecter=true
 type listService interface {
 	GetListRecord(ctx context.Context, listId string) (*models.List, error)
 	GetListsRecords(ctx context.Context, filters filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.ListPage, error)
@@ -26,10 +26,13 @@ type listService interface {
 	DeleteLists(ctx context.Context) error
 	CreateListRecord(ctx context.Context, list *handler_models.CreateList, ownerId string) (*models.List, error)
 	UpdateListPartiallyRecord(ctx context.Context, listId string, list *handler_models.UpdateList) (*models.List, error)
-	AddCollaborator(ctx context.Context, listId string, userEmail string) (*models.User, error)
+	AddCollaborator(ctx context.Context, listId string, userEmail string, role constants.CollaboratorRole) (*models.User, error)
 	DeleteCollaborator(ctx context.Context, listId string, userId string) error
+	TransferListOwnershipRecord(ctx context.Context, listId string, newOwnerId string) (*models.List, error)
+	DuplicateListRecord(ctx context.Context, listId string, duplicate *handler_models.DuplicateList, owner *models.User) (*models.List, error)
 }
 
+//go:generate mockery --name=fieldValidator --exported --output=./mocks --outpkg=mocks --filename=field_validator.go --with-expecter=true
 type fieldValidator interface {
 	Struct(interface{}) error
 }
@@ -73,6 +76,13 @@ func (h *Handler) HandleGetLists(w http.ResponseWriter, r *http.Request) {
 		first = constants.DEFAULT_LIMIT_VALUE
 	}
 
+	sort := utils.GetContentFromUrl(r, constants.SORT)
+	if _, err = filters.ParseSort(sort, filters.ListSortFields); err != nil {
+		log.C(ctx).Errorf("failed to get lists, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	lFilter := &filters.ListFilters{
 		PaginationFilters: filters.PaginationFilters{
 			First:  first,
@@ -81,6 +91,7 @@ func (h *Handler) HandleGetLists(w http.ResponseWriter, r *http.Request) {
 			Before: before,
 		},
 		Name: name,
+		Sort: sort,
 	}
 
 	rf := &resource_identifier.GenericResourceIdentifier{}
@@ -89,7 +100,7 @@ func (h *Handler) HandleGetLists(w http.ResponseWriter, r *http.Request) {
 	lists, err := h.serv.GetListsRecords(ctx, lFilter, rf)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get lists in list handler due to an error %s", err.Error())
-		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
 		return
 	}
 
@@ -374,7 +385,19 @@ func (h *Handler) HandleAddCollaborator(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	modelUser, err := h.serv.AddCollaborator(ctx, listId, user.Email)
+	field, err := utils.CheckForValidationError(h.fValidator, user)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add a collaborator, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	role := constants.Editor
+	if user.Role != nil {
+		role = *user.Role
+	}
+
+	modelUser, err := h.serv.AddCollaborator(ctx, listId, user.Email, role)
 	if err != nil {
 		log.C(ctx).Error("failed to add collaborator in list handler, error when calling service function")
 
@@ -438,6 +461,125 @@ func (h *Handler) HandleDeleteCollaborator(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (h *Handler) HandleTransferListOwnership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("transferring list ownership in list handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in list handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get list_id from the context in list handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	var transferModel handler_models.TransferListOwnership
+	if err = json.NewDecoder(r.Body).Decode(&transferModel); err != nil {
+		log.C(ctx).Errorf("failed to decode transfer list ownership handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, transferModel)
+	if err != nil {
+		log.C(ctx).Errorf("failed to transfer list ownership, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	list, err := h.serv.TransferListOwnershipRecord(ctx, listId, transferModel.UserId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to transfer ownership of list with id %s, error %s when calling list service", listId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(list); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to transfer ownership of list with id %s, error %s", listId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleDuplicateList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("duplicating list in list handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in list handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get list_id from the context in list handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	owner, err := utils.GetValueFromContext[*models.User](r.Context(), middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get owner of the copy due to an error %s when trying to get value from context in list handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	var duplicate handler_models.DuplicateList
+	if err = json.NewDecoder(r.Body).Decode(&duplicate); err != nil {
+		log.C(ctx).Errorf("failed to decode duplicate list handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, duplicate)
+	if err != nil {
+		log.C(ctx).Errorf("failed to duplicate list, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	list, err := h.serv.DuplicateListRecord(ctx, listId, &duplicate, owner)
+	if err != nil {
+		log.C(ctx).Errorf("failed to duplicate list with id %s, error %s when calling list service", listId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to duplicate list with id %s, error %s", listId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(list); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		return
+	}
+}
+
 func (h *Handler) HandleGetListRecord(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log.C(ctx).Info("getting list record in list handler")
@@ -16,11 +16,12 @@ import (
 )
 
 type genericRepository interface {
-	GetPaginationInfo(ctx context.Context, sourceName string, filter string, params []interface{}) (*entities.PaginationInfo, error)
+	GetSortedPaginationInfo(ctx context.Context, sourceName string, filter string, sortExpression string, direction string, params []interface{}) (*entities.PaginationInfo, error)
 }
 
+//go:generate mockery --name=sqlDecoratorFactory --exported --output=./mocks --outpkg=mocks --filename=sql_decorator_factory.go --with-expecter=true
 type sqlDecoratorFactory interface {
-	CreateSqlDecorator(context.Context, sql_query_decorators.Filters, string) (sql_query_decorators.SqlQueryRetriever, error)
+	CreateSqlDecorator(context.Context, sql_query_decorators.Filters, string, []interface{}) (sql_query_decorators.SqlQueryRetriever, error)
 }
 
 type repository struct {
@@ -45,7 +46,7 @@ func (*repository) GetList(ctx context.Context, listId string) (*entities.List,
 	}
 
 	sqlQueryString := `SELECT id, name, created_at, last_updated, owner, description 
-FROM lists where id = $1`
+FROM lists where id = $1 AND deleted_at IS NULL`
 
 	var entity entities.List
 	if err = persist.GetContext(ctx, &entity, sqlQueryString, listId); err != nil {
@@ -70,7 +71,7 @@ func (*repository) DeleteList(ctx context.Context, listID string) error {
 		return err
 	}
 
-	sqlQueryString := `DELETE FROM lists WHERE id = $1`
+	sqlQueryString := softDeleteListsQuery(`id = $1`)
 
 	_, err = persist.ExecContext(ctx, sqlQueryString, listID)
 	if err != nil {
@@ -111,7 +112,7 @@ func (*repository) DeleteLists(ctx context.Context) error {
 		return err
 	}
 
-	sqlQueryString := `DELETE FROM lists`
+	sqlQueryString := softDeleteListsQuery(`TRUE`)
 	if _, err = persist.ExecContext(ctx, sqlQueryString); err != nil {
 		log.C(ctx).Errorf("failed to delete lists, error %s when trying to execute sql query", err.Error())
 		return err
@@ -133,15 +134,21 @@ func (r *repository) GetLists(ctx context.Context, f filters.SqlFilters) ([]enti
 	filteringClause, params := f.BuildSQLFiltering()
 	baseQuery += filteringClause
 
-	retriever, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery)
+	retriever, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery, params)
 	if err != nil {
 		log.C(ctx).Error("failed to determine sql query, error when calling decorator factory")
 		return nil, err
 	}
 
-	sqlQueryString := retriever.DetermineCorrectSqlQuery(ctx)
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to parse sort, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQueryString, params := retriever.DetermineCorrectSqlQuery(ctx)
 	completeQuery := fmt.Sprintf(`SELECT id, name, created_at, last_updated, owner, description
-FROM (%s) ORDER BY id`, sqlQueryString)
+FROM (%s) %s`, sqlQueryString, sql_query_decorators.OrderByClause(sort, sort.Direction))
 
 	var lists []entities.List
 	if err = persist.SelectContext(ctx, &lists, completeQuery, params...); err != nil {
@@ -184,8 +191,10 @@ func (r *repository) UpdateList(ctx context.Context, sqlExecParams map[string]in
 	return r.GetList(ctx, listId)
 }
 
-func (*repository) UpdateListSharedWith(ctx context.Context, listId string, userId string) error {
-	log.C(ctx).Infof("adding a user collaborator with id %s to a list with id %s in list repository", userId, listId)
+// UpdateListSharedWith adds the user as a collaborator of the list with the given role, if the user already
+// collaborates on the list only the role is changed
+func (*repository) UpdateListSharedWith(ctx context.Context, listId string, userId string, role constants.CollaboratorRole) error {
+	log.C(ctx).Infof("adding a user collaborator with id %s and role %s to a list with id %s in list repository", userId, role, listId)
 
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
@@ -193,9 +202,10 @@ func (*repository) UpdateListSharedWith(ctx context.Context, listId string, user
 		return err
 	}
 
-	sqlInsertIntoUserListsTableQuery := `INSERT INTO user_lists (user_id, list_id) VALUES ($1,$2)`
+	sqlInsertIntoUserListsTableQuery := `INSERT INTO user_lists (user_id, list_id, role) VALUES ($1,$2,$3)
+ON CONFLICT (user_id, list_id) DO UPDATE SET role = EXCLUDED.role`
 
-	res, err := persist.ExecContext(ctx, sqlInsertIntoUserListsTableQuery, userId, listId)
+	res, err := persist.ExecContext(ctx, sqlInsertIntoUserListsTableQuery, userId, listId, role)
 	if err != nil {
 		log.C(ctx).Debug("failed to add a new user collaborator due to an error when executing sql query")
 		return err
@@ -244,18 +254,18 @@ func (r *repository) GetListCollaborators(ctx context.Context, listID string, f
 		return nil, err
 	}
 
-	baseQuery := `SELECT id, email, role FROM lists_collaborators`
+	baseQuery := `SELECT id, email, role, list_role FROM lists_collaborators`
 	filteringClause, params := f.BuildSQLFiltering()
 	baseQuery += filteringClause
 
-	sqlQueryBuilder, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery)
+	sqlQueryBuilder, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery, params)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get collaborators, error when calling decorator factory")
 		return nil, err
 	}
 
-	sqlQueryString := sqlQueryBuilder.DetermineCorrectSqlQuery(ctx)
-	completeSqlQuery := fmt.Sprintf(`SELECT id, email, role FROM (%s) ORDER BY id`, sqlQueryString)
+	sqlQueryString, params := sqlQueryBuilder.DetermineCorrectSqlQuery(ctx)
+	completeSqlQuery := fmt.Sprintf(`SELECT id, email, role, list_role FROM (%s) ORDER BY id`, sqlQueryString)
 
 	var collaborators []entities.User
 	if err = persist.SelectContext(ctx, &collaborators, completeSqlQuery, params...); err != nil {
@@ -275,7 +285,7 @@ func (*repository) GetListOwner(ctx context.Context, listId string) (*entities.U
 	}
 
 	sqlQueryString := `SELECT users.id,users.role,users.email FROM lists 
-    JOIN users ON lists.owner = users.id WHERE lists.id = $1`
+    JOIN users ON lists.owner = users.id WHERE lists.id = $1 AND lists.deleted_at IS NULL`
 
 	entityOwner := &entities.User{}
 	if err = persist.GetContext(ctx, entityOwner, sqlQueryString, listId); err != nil {
@@ -290,6 +300,24 @@ func (*repository) GetListOwner(ctx context.Context, listId string) (*entities.U
 	return entityOwner, nil
 }
 
+// CopyListCollaborators adds the collaborators of a list to another list with the same roles, except for the given owner
+func (*repository) CopyListCollaborators(ctx context.Context, fromListId string, toListId string, ownerId string) error {
+	log.C(ctx).Infof("copying collaborators of list with id %s to list with id %s in list repository", fromListId, toListId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, copyListCollaboratorsQuery, fromListId, toListId, ownerId); err != nil {
+		log.C(ctx).Errorf("failed to copy collaborators of list with id %s, error %s when executing sql query", fromListId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
 func (*repository) CheckWhetherUserIsCollaborator(ctx context.Context, listId string, userId string) (bool, error) {
 	log.C(ctx).Infof("checking whether user with id %s is collaborator of list with id %s", userId, listId)
 
@@ -300,7 +328,7 @@ func (*repository) CheckWhetherUserIsCollaborator(ctx context.Context, listId st
 	}
 
 	sqlQueryString := `SELECT 1 FROM lists JOIN user_lists ON lists.id = user_lists.list_id
-WHERE list_id = $1 AND user_id = $2`
+WHERE list_id = $1 AND user_id = $2 AND lists.deleted_at IS NULL`
 
 	res, err := persist.ExecContext(ctx, sqlQueryString, listId, userId)
 	if err != nil {
@@ -322,9 +350,72 @@ WHERE list_id = $1 AND user_id = $2`
 	return true, nil
 }
 
+// CheckWhetherUserIsManager reports whether the user collaborates on the list with the manager role
+func (*repository) CheckWhetherUserIsManager(ctx context.Context, listId string, userId string) (bool, error) {
+	log.C(ctx).Infof("checking whether user with id %s is manager of list with id %s", userId, listId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return false, err
+	}
+
+	sqlQueryString := `SELECT 1 FROM lists JOIN user_lists ON lists.id = user_lists.list_id
+WHERE list_id = $1 AND user_id = $2 AND user_lists.role = $3 AND lists.deleted_at IS NULL`
+
+	res, err := persist.ExecContext(ctx, sqlQueryString, listId, userId, constants.Manager)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check whether user %s is manager in list %s, error %s when executing sql query", userId, listId, err.Error())
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Errorf("failed to check whether user %s is manager in list %s, error %s when trying to check the number of rows affected", userId, listId, err.Error())
+		return false, err
+	}
+
+	return rowsAffected != 0, nil
+}
+
+// CheckWhetherUserIsEditor reports whether the user collaborates on the list with a role that allows working on its todos
+func (*repository) CheckWhetherUserIsEditor(ctx context.Context, listId string, userId string) (bool, error) {
+	log.C(ctx).Infof("checking whether user with id %s is editor of list with id %s", userId, listId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return false, err
+	}
+
+	sqlQueryString := `SELECT 1 FROM lists JOIN user_lists ON lists.id = user_lists.list_id
+WHERE list_id = $1 AND user_id = $2 AND user_lists.role IN ($3, $4) AND lists.deleted_at IS NULL`
+
+	res, err := persist.ExecContext(ctx, sqlQueryString, listId, userId, constants.Editor, constants.Manager)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check whether user %s is editor in list %s, error %s when executing sql query", userId, listId, err.Error())
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Errorf("failed to check whether user %s is editor in list %s, error %s when trying to check the number of rows affected", userId, listId, err.Error())
+		return false, err
+	}
+
+	return rowsAffected != 0, nil
+}
+
 func (r *repository) GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error) {
 	log.C(ctx).Info("getting pagination info about lists in list repo")
 
 	filteringClause, params := f.BuildSQLFiltering()
-	return r.genericRepo.GetPaginationInfo(ctx, s.GetSource(), filteringClause, params)
+
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to parse sort, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.genericRepo.GetSortedPaginationInfo(ctx, s.GetSource(), filteringClause, sql_query_decorators.SortExpression(sort.Field), sort.Direction, params)
 }
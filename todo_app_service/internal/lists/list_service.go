@@ -1,15 +1,19 @@
 package lists
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
 	"Todo-List/internProject/todo_app_service/internal/entities"
 	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
 	"Todo-List/internProject/todo_app_service/internal/source"
 	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/handler_models"
 	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
+	"database/sql"
 	"fmt"
+	"github.com/gofrs/uuid"
 	"time"
 )
 
@@ -23,14 +27,19 @@ type listRepo interface {
 	DeleteLists(context.Context) error
 	CreateList(context.Context, *entities.List) (*entities.List, error)
 	UpdateList(context.Context, map[string]interface{}, []string) (*entities.List, error)
-	UpdateListSharedWith(context.Context, string, string) error
+	UpdateListSharedWith(context.Context, string, string, constants.CollaboratorRole) error
 	DeleteCollaborator(context.Context, string, string) error
 	CheckWhetherUserIsCollaborator(context.Context, string, string) (bool, error)
+	CheckWhetherUserIsManager(ctx context.Context, listId string, userId string) (bool, error)
+	CopyListCollaborators(ctx context.Context, fromListId string, toListId string, ownerId string) error
 	GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error)
 }
 
+//go:generate mockery --name=todoRepo --exported --output=./mocks --outpkg=mocks --filename=todo_repo.go --with-expecter=true
 type todoRepo interface {
 	UnassignUserFromTodos(ctx context.Context, userId string, listId string) error
+	GetListTodosParentsFirst(ctx context.Context, listId string) ([]entities.Todo, error)
+	CreateTodo(ctx context.Context, entity *entities.Todo) (*entities.Todo, error)
 }
 
 //go:generate mockery --name=uuidGenerator --exported --output=./mocks --outpkg=mocks --filename=uuid_generator.go --with-expecter=true
@@ -47,7 +56,7 @@ type timeGenerator interface {
 type listConverter interface {
 	ToModel(list *entities.List) *models.List
 	ToEntity(list *models.List) *entities.List
-	ManyToPage(lists []entities.List, pageInfo *entities.PaginationInfo) *models.ListPage
+	ManyToPage(lists []entities.List, pageInfo *entities.PaginationInfo, sortField string) *models.ListPage
 	FromUpdateHandlerModelToModel(list *handler_models.UpdateList) *models.List
 	FromCreateHandlerModelToModel(list *handler_models.CreateList) *models.List
 }
@@ -59,15 +68,22 @@ type userConverter interface {
 	ManyToPage(users []entities.User, pageInfo *entities.PaginationInfo) *models.UserPage
 }
 
-//go:generate mockery --name=userService --exported --output=./mocks --outpkg=mocks --filename=user_service.go --with-expecter=true
+//go:generate mockery --name=userRepo --exported --output=./mocks --outpkg=mocks --filename=user_repo.go --with-expecter=true
 type userRepo interface {
+	GetUser(ctx context.Context, userId string) (*entities.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*entities.User, error)
 }
 
+//go:generate mockery --name=resourceIdentifierAdapter --exported --output=./mocks --outpkg=mocks --filename=resource_identifier_adapter.go --with-expecter=true
 type resourceIdentifierAdapter interface {
 	AdaptResourceIdentifier(rf resource_identifier.ResourceIdentifier) string
 }
 
+//go:generate mockery --name=historyRecorder --exported --output=./mocks --outpkg=mocks --filename=history_recorder.go --with-expecter=true
+type historyRecorder interface {
+	RecordActor(ctx context.Context) error
+}
+
 type service struct {
 	lRepo      listRepo
 	uRepo      userRepo
@@ -77,11 +93,12 @@ type service struct {
 	lConverter listConverter
 	uConverter userConverter
 	rfAdapter  resourceIdentifierAdapter
+	hRecorder  historyRecorder
 }
 
 func NewService(repo listRepo, uuidGen uuidGenerator, timeGen timeGenerator,
 	listConverter listConverter, uRep userRepo, tRepo todoRepo,
-	userConverter userConverter, rfAdapter resourceIdentifierAdapter) *service {
+	userConverter userConverter, rfAdapter resourceIdentifierAdapter, hRecorder historyRecorder) *service {
 	return &service{
 		lRepo:      repo,
 		uuidGen:    uuidGen,
@@ -91,6 +108,7 @@ func NewService(repo listRepo, uuidGen uuidGenerator, timeGen timeGenerator,
 		uConverter: userConverter,
 		tRepo:      tRepo,
 		rfAdapter:  rfAdapter,
+		hRecorder:  hRecorder,
 	}
 }
 
@@ -110,12 +128,23 @@ func (s *service) GetListsRecords(ctx context.Context, f filters.SqlFilters, rf
 		return nil, err
 	}
 
-	return s.lConverter.ManyToPage(listEntities, paginationInfo), nil
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to extract sort from filters, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.lConverter.ManyToPage(listEntities, paginationInfo, sort.Field), nil
 }
 
 func (s *service) DeleteListRecord(ctx context.Context, listId string) error {
 	log.C(ctx).Infof("deleting with id %s list record from list service layer", listId)
 
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
 	if err := s.lRepo.DeleteList(ctx, listId); err != nil {
 		log.C(ctx).Errorf("failed to delete list with id %s, error %s when calling list repo", listId, err.Error())
 		return fmt.Errorf("failed to delete list with id %s", listId)
@@ -140,6 +169,11 @@ func (s *service) CreateListRecord(ctx context.Context, list *handler_models.Cre
 
 	convertedEntity := s.lConverter.ToEntity(newlyCreatedList)
 
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return nil, err
+	}
+
 	if _, err := s.lRepo.CreateList(ctx, convertedEntity); err != nil {
 		log.C(ctx).Errorf("failed to create list, error %s when calling list repo", err.Error())
 		return nil, err
@@ -158,6 +192,11 @@ func (s *service) UpdateListPartiallyRecord(ctx context.Context, listId string,
 
 	determineSqlFieldsAndParamsList(modelList, sqlExecParams, &sqlFields)
 
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return nil, err
+	}
+
 	entity, err := s.lRepo.UpdateList(ctx, sqlExecParams, sqlFields)
 	if err != nil {
 		log.C(ctx).Errorf("failed to update list name %s", err.Error())
@@ -167,8 +206,8 @@ func (s *service) UpdateListPartiallyRecord(ctx context.Context, listId string,
 	return s.lConverter.ToModel(entity), nil
 }
 
-func (s *service) AddCollaborator(ctx context.Context, listId string, userEmail string) (*models.User, error) {
-	log.C(ctx).Debugf("adding collaborator with email %s in list with id %s", userEmail, listId)
+func (s *service) AddCollaborator(ctx context.Context, listId string, userEmail string, role constants.CollaboratorRole) (*models.User, error) {
+	log.C(ctx).Debugf("adding collaborator with email %s and role %s in list with id %s", userEmail, role, listId)
 
 	user, err := s.uRepo.GetUserByEmail(ctx, userEmail)
 	if err != nil {
@@ -176,12 +215,20 @@ func (s *service) AddCollaborator(ctx context.Context, listId string, userEmail
 		return nil, err
 	}
 
-	if err = s.lRepo.UpdateListSharedWith(ctx, listId, user.Id.String()); err != nil {
+	if err = s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return nil, err
+	}
+
+	if err = s.lRepo.UpdateListSharedWith(ctx, listId, user.Id.String(), role); err != nil {
 		log.C(ctx).Errorf("failed to add collaborator with email %s in list with id %s, error when calling repo function", userEmail, listId)
 		return nil, err
 	}
 
-	return s.uConverter.ToModel(user), nil
+	collaborator := s.uConverter.ToModel(user)
+	collaborator.ListRole = role
+
+	return collaborator, nil
 }
 
 func (s *service) DeleteCollaborator(ctx context.Context, listId string, userId string) error {
@@ -192,6 +239,11 @@ func (s *service) DeleteCollaborator(ctx context.Context, listId string, userId
 		return err
 	}
 
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
 	if err := s.lRepo.DeleteCollaborator(ctx, listId, userId); err != nil {
 		log.C(ctx).Errorf("failed to delete a collaborator with id %s from a list with id %s, error in list repo", userId, listId)
 		return err
@@ -205,9 +257,180 @@ func (s *service) DeleteCollaborator(ctx context.Context, listId string, userId
 	return nil
 }
 
+// TransferListOwnershipRecord makes the given user the owner of the list, the new owner stops being a collaborator
+// and the previous owner stays in the list as a manager
+func (s *service) TransferListOwnershipRecord(ctx context.Context, listId string, newOwnerId string) (*models.List, error) {
+	log.C(ctx).Infof("transferring ownership of list with id %s to user with id %s in list service", listId, newOwnerId)
+
+	list, err := s.lRepo.GetList(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to transfer list ownership, error %s when calling list repo", err.Error())
+		return nil, err
+	}
+
+	previousOwnerId := list.Owner.String()
+	if previousOwnerId == newOwnerId {
+		log.C(ctx).Errorf("failed to transfer list ownership, user with id %s already owns list with id %s", newOwnerId, listId)
+		return nil, application_errors.InvalidNewOwnerError
+	}
+
+	if _, err = s.uRepo.GetUser(ctx, newOwnerId); err != nil {
+		log.C(ctx).Errorf("failed to transfer list ownership, error %s when getting user with id %s", err.Error(), newOwnerId)
+		return nil, err
+	}
+
+	if err = s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlExecParams := map[string]interface{}{"id": listId}
+	sqlFields := make([]string, 0, 2)
+	determineSqlFieldsAndParamsList(&models.List{Owner: newOwnerId, LastUpdated: s.timeGen.Now()}, sqlExecParams, &sqlFields)
+
+	entity, err := s.lRepo.UpdateList(ctx, sqlExecParams, sqlFields)
+	if err != nil {
+		log.C(ctx).Errorf("failed to transfer ownership of list with id %s, error %s when calling list repo", listId, err.Error())
+		return nil, err
+	}
+
+	if err = s.lRepo.DeleteCollaborator(ctx, listId, newOwnerId); err != nil {
+		log.C(ctx).Errorf("failed to remove the new owner with id %s from the collaborators of list with id %s, error %s", newOwnerId, listId, err.Error())
+		return nil, err
+	}
+
+	if err = s.lRepo.UpdateListSharedWith(ctx, listId, previousOwnerId, constants.Manager); err != nil {
+		log.C(ctx).Errorf("failed to add the previous owner with id %s as a collaborator of list with id %s, error %s", previousOwnerId, listId, err.Error())
+		return nil, err
+	}
+
+	return s.lConverter.ToModel(entity), nil
+}
+
+// DuplicateListRecord creates a copy of the list owned by the caller, the todos are copied as open unassigned todos and their due dates
+// are as far from the start date as the original due dates are from the creation of the original list,
+// only administrators, the list owner and the list managers can copy the collaborators as well
+func (s *service) DuplicateListRecord(ctx context.Context, listId string, duplicate *handler_models.DuplicateList, owner *models.User) (*models.List, error) {
+	log.C(ctx).Infof("duplicating list with id %s in list service", listId)
+
+	sourceList, err := s.lRepo.GetList(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to duplicate list, error %s when calling list repo", err.Error())
+		return nil, err
+	}
+
+	sourceTodos, err := s.tRepo.GetListTodosParentsFirst(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to duplicate list, error %s when getting the todos of list with id %s", err.Error(), listId)
+		return nil, err
+	}
+
+	if duplicate.IncludeCollaborators {
+		if err = s.checkWhetherUserCanCopyCollaborators(ctx, sourceList, owner); err != nil {
+			log.C(ctx).Errorf("failed to duplicate list with id %s, error %s", listId, err.Error())
+			return nil, err
+		}
+	}
+
+	description := sourceList.Description
+	if duplicate.Description != nil {
+		description = *duplicate.Description
+	}
+
+	list, err := s.CreateListRecord(ctx, &handler_models.CreateList{Name: duplicate.Name, Description: description}, owner.Id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to duplicate list with id %s, error %s when creating the copy", listId, err.Error())
+		return nil, err
+	}
+
+	if duplicate.IncludeCollaborators {
+		if err = s.lRepo.CopyListCollaborators(ctx, listId, list.Id, owner.Id); err != nil {
+			log.C(ctx).Errorf("failed to copy collaborators of list with id %s, error %s", listId, err.Error())
+			return nil, err
+		}
+	}
+
+	startDate := list.CreatedAt
+	if duplicate.StartDate != nil {
+		startDate = *duplicate.StartDate
+	}
+	shift := startDate.Sub(sourceList.CreatedAt)
+
+	copiedTodoIds := make(map[uuid.UUID]uuid.UUID, len(sourceTodos))
+	for _, todo := range sourceTodos {
+		todoCopy := todo
+		todoCopy.Id = uuid.FromStringOrNil(s.uuidGen.Generate())
+		todoCopy.ListId = uuid.FromStringOrNil(list.Id)
+		todoCopy.CreatedAt = list.CreatedAt
+		todoCopy.LastUpdated = list.CreatedAt
+		todoCopy.Status = string(constants.Open)
+		todoCopy.AssignedTo = uuid.NullUUID{}
+
+		parentCopyId, ok := copiedTodoIds[todo.ParentId.UUID]
+		todoCopy.ParentId = uuid.NullUUID{UUID: parentCopyId, Valid: todo.ParentId.Valid && ok}
+
+		shiftTodoDates(&todoCopy, shift)
+
+		if _, err = s.tRepo.CreateTodo(ctx, &todoCopy); err != nil {
+			log.C(ctx).Errorf("failed to duplicate todo with id %s, error %s when calling todo repo", todo.Id, err.Error())
+			return nil, err
+		}
+
+		copiedTodoIds[todo.Id] = todoCopy.Id
+	}
+
+	return list, nil
+}
+
+// checkWhetherUserCanCopyCollaborators lets only administrators, the list owner and the list managers copy the collaborators,
+// since the copied collaborators join the new list without an invitation
+func (s *service) checkWhetherUserCanCopyCollaborators(ctx context.Context, list *entities.List, user *models.User) error {
+	if user.Role == constants.Admin || list.Owner.String() == user.Id {
+		return nil
+	}
+
+	isManager, err := s.lRepo.CheckWhetherUserIsManager(ctx, list.Id.String(), user.Id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check whether user with id %s is manager of list with id %s, error %s", user.Id, list.Id, err.Error())
+		return err
+	}
+
+	if !isManager {
+		return application_errors.CollaboratorsCopyForbiddenError
+	}
+
+	return nil
+}
+
+// shiftTodoDates moves the due date of the todo and the end of its recurrence by the given duration,
+// a due date which would not be after the creation of the todo is dropped together with the recurrence
+func shiftTodoDates(todo *entities.Todo, shift time.Duration) {
+	if !todo.DueDate.Valid {
+		return
+	}
+
+	todo.DueDate.Time = todo.DueDate.Time.Add(shift)
+	if todo.RecurrenceUntil.Valid {
+		todo.RecurrenceUntil.Time = todo.RecurrenceUntil.Time.Add(shift)
+	}
+
+	if !todo.DueDate.Time.After(todo.CreatedAt) {
+		todo.DueDate = sql.NullTime{}
+		todo.RecurrenceFrequency = sql.NullString{}
+		todo.RecurrenceInterval = sql.NullInt32{}
+		todo.RecurrenceUntil = sql.NullTime{}
+		todo.RecurrenceCount = sql.NullInt32{}
+	}
+}
+
 func (s *service) DeleteLists(ctx context.Context) error {
 	log.C(ctx).Info("deleting lists in list service")
 
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
 	if err := s.lRepo.DeleteLists(ctx); err != nil {
 		log.C(ctx).Errorf("failed to delete lists, error %s", err.Error())
 		return err
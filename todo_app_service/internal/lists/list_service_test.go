@@ -1,5 +1,20 @@
 package lists
 
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/lists/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+/*
 import (
 	"Todo-List/internProject/todo_app_service/internal/lists/mocks"
 	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators"
@@ -395,3 +410,330 @@ func TestService_CreateListRecord(t *testing.T) {
 		})
 	}
 }
+*/
+
+func TestService_TransferListOwnershipRecord(t *testing.T) {
+	tests := []struct {
+		testName      string
+		newOwnerId    string
+		mockListRepo  func() *mocks.ListRepo
+		mockUserRepo  func() *mocks.UserRepo
+		mockRecorder  func() *mocks.HistoryRecorder
+		mockTimeGen   func() *mocks.TimeGenerator
+		mockConverter func() *mocks.ListConverter
+		expectedList  *models.List
+		err           error
+	}{
+		{
+			testName:   "Successfully transferring list ownership and keeping the previous owner as a manager",
+			newOwnerId: newOwnerId.String(),
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(ownedListEntity, nil).Once()
+
+				mRepo.EXPECT().
+					UpdateList(context.TODO(), transferParams, transferFields).
+					Return(transferredEntity, nil).Once()
+
+				mRepo.EXPECT().
+					DeleteCollaborator(context.TODO(), listId.String(), newOwnerId.String()).
+					Return(nil).Once()
+
+				mRepo.EXPECT().
+					UpdateListSharedWith(context.TODO(), listId.String(), ownerId.String(), constants.Manager).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockUserRepo: func() *mocks.UserRepo {
+				mRepo := &mocks.UserRepo{}
+
+				mRepo.EXPECT().
+					GetUser(context.TODO(), newOwnerId.String()).
+					Return(&entities.User{Id: newOwnerId}, nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(nil).Once()
+
+				return mRecorder
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				mTimeGen := &mocks.TimeGenerator{}
+				mTimeGen.EXPECT().Now().Return(lastUpdate).Once()
+
+				return mTimeGen
+			},
+			mockConverter: func() *mocks.ListConverter {
+				mConverter := &mocks.ListConverter{}
+
+				mConverter.EXPECT().
+					ToModel(transferredEntity).
+					Return(transferredModel).Once()
+
+				return mConverter
+			},
+			expectedList: transferredModel,
+		},
+		{
+			testName:   "Failed to transfer ownership of list which does not exist",
+			newOwnerId: newOwnerId.String(),
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(nil, listNotFound).Once()
+
+				return mRepo
+			},
+			err: listNotFound,
+		},
+		{
+			testName:   "Failed to transfer ownership to the current owner",
+			newOwnerId: ownerId.String(),
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(ownedListEntity, nil).Once()
+
+				return mRepo
+			},
+			err: application_errors.InvalidNewOwnerError,
+		},
+		{
+			testName:   "Failed to transfer ownership to user who does not exist",
+			newOwnerId: newOwnerId.String(),
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(ownedListEntity, nil).Once()
+
+				return mRepo
+			},
+			mockUserRepo: func() *mocks.UserRepo {
+				mRepo := &mocks.UserRepo{}
+
+				mRepo.EXPECT().
+					GetUser(context.TODO(), newOwnerId.String()).
+					Return(nil, newOwnerNotFound).Once()
+
+				return mRepo
+			},
+			err: newOwnerNotFound,
+		},
+		{
+			testName:   "Failed to transfer ownership due to error when updating the list",
+			newOwnerId: newOwnerId.String(),
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(ownedListEntity, nil).Once()
+
+				mRepo.EXPECT().
+					UpdateList(context.TODO(), transferParams, transferFields).
+					Return(nil, dbError).Once()
+
+				return mRepo
+			},
+			mockUserRepo: func() *mocks.UserRepo {
+				mRepo := &mocks.UserRepo{}
+
+				mRepo.EXPECT().
+					GetUser(context.TODO(), newOwnerId.String()).
+					Return(&entities.User{Id: newOwnerId}, nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(nil).Once()
+
+				return mRecorder
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				mTimeGen := &mocks.TimeGenerator{}
+				mTimeGen.EXPECT().Now().Return(lastUpdate).Once()
+
+				return mTimeGen
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mListRepo := test.mockListRepo()
+
+			mUserRepo := &mocks.UserRepo{}
+			if test.mockUserRepo != nil {
+				mUserRepo = test.mockUserRepo()
+			}
+
+			mRecorder := &mocks.HistoryRecorder{}
+			if test.mockRecorder != nil {
+				mRecorder = test.mockRecorder()
+			}
+
+			mTimeGen := &mocks.TimeGenerator{}
+			if test.mockTimeGen != nil {
+				mTimeGen = test.mockTimeGen()
+			}
+
+			mConverter := &mocks.ListConverter{}
+			if test.mockConverter != nil {
+				mConverter = test.mockConverter()
+			}
+
+			lService := NewService(mListRepo, nil, mTimeGen, mConverter, mUserRepo, nil, nil, nil, mRecorder)
+			list, err := lService.TransferListOwnershipRecord(context.TODO(), listId.String(), test.newOwnerId)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, list)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedList, list)
+			}
+
+			mock.AssertExpectationsForObjects(t, mListRepo, mUserRepo, mRecorder, mTimeGen, mConverter)
+		})
+	}
+}
+
+func TestService_DuplicateListRecord(t *testing.T) {
+	sourceEntity := &entities.List{Id: listId, Name: VALID_NAME, Description: DESCRIPTION, Owner: ownerId, CreatedAt: createdAt}
+	sourceTodos := []entities.Todo{
+		{Id: parentTodoId, Name: VALID_NAME, ListId: listId, Status: string(constants.Done), AssignedTo: uuid.NullUUID{UUID: ownerId, Valid: true}},
+		{Id: subtaskId, Name: VALID_NAME, ListId: listId, Status: string(constants.InProgress),
+			AssignedTo: uuid.NullUUID{UUID: newOwnerId, Valid: true}, ParentId: uuid.NullUUID{UUID: parentTodoId, Valid: true}},
+	}
+	parentCopy := &entities.Todo{Id: parentCopyId, Name: VALID_NAME, ListId: copyListId, Status: string(constants.Open),
+		CreatedAt: createdAt, LastUpdated: createdAt}
+	subtaskCopy := &entities.Todo{Id: subtaskCopyId, Name: VALID_NAME, ListId: copyListId, Status: string(constants.Open),
+		CreatedAt: createdAt, LastUpdated: createdAt, ParentId: uuid.NullUUID{UUID: parentCopyId, Valid: true}}
+
+	tests := []struct {
+		testName             string
+		caller               *models.User
+		includeCollaborators bool
+		isManager            bool
+		todos                []entities.Todo
+		mockTodoRepo         func() *mocks.TodoRepo
+		err                  error
+	}{
+		{
+			testName: "Successfully duplicating list with its todos copied as open unassigned todos",
+			caller:   &models.User{Id: newOwnerId.String(), Role: constants.Writer},
+			todos:    sourceTodos,
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().CreateTodo(context.TODO(), parentCopy).Return(parentCopy, nil).Once()
+				mRepo.EXPECT().CreateTodo(context.TODO(), subtaskCopy).Return(subtaskCopy, nil).Once()
+
+				return mRepo
+			},
+		},
+		{
+			testName:             "Successfully duplicating list together with its collaborators as the list owner",
+			caller:               &models.User{Id: ownerId.String(), Role: constants.Writer},
+			includeCollaborators: true,
+		},
+		{
+			testName:             "Successfully duplicating list together with its collaborators as a list manager",
+			caller:               &models.User{Id: newOwnerId.String(), Role: constants.Writer},
+			includeCollaborators: true,
+			isManager:            true,
+		},
+		{
+			testName:             "Failed to duplicate list together with its collaborators as a collaborator who is not a manager",
+			caller:               &models.User{Id: newOwnerId.String(), Role: constants.Writer},
+			includeCollaborators: true,
+			err:                  application_errors.CollaboratorsCopyForbiddenError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			copyModel := &models.List{Id: copyListId.String(), Name: VALID_NAME, Description: DESCRIPTION,
+				CreatedAt: createdAt, LastUpdated: createdAt, Owner: test.caller.Id}
+			copyEntity := &entities.List{Id: copyListId, Name: VALID_NAME, Description: DESCRIPTION,
+				CreatedAt: createdAt, LastUpdated: createdAt, Owner: uuid.FromStringOrNil(test.caller.Id)}
+			canCopy := test.err == nil
+
+			mListRepo := &mocks.ListRepo{}
+			mListRepo.EXPECT().GetList(context.TODO(), listId.String()).Return(sourceEntity, nil).Once()
+			if test.includeCollaborators && test.caller.Id != ownerId.String() {
+				mListRepo.EXPECT().
+					CheckWhetherUserIsManager(context.TODO(), listId.String(), test.caller.Id).
+					Return(test.isManager, nil).Once()
+			}
+			if canCopy {
+				mListRepo.EXPECT().CreateList(context.TODO(), copyEntity).Return(copyEntity, nil).Once()
+			}
+			if canCopy && test.includeCollaborators {
+				mListRepo.EXPECT().
+					CopyListCollaborators(context.TODO(), listId.String(), copyListId.String(), test.caller.Id).
+					Return(nil).Once()
+			}
+
+			mTodoRepo := &mocks.TodoRepo{}
+			if test.mockTodoRepo != nil {
+				mTodoRepo = test.mockTodoRepo()
+			}
+			mTodoRepo.EXPECT().GetListTodosParentsFirst(context.TODO(), listId.String()).Return(test.todos, nil).Once()
+
+			mUuidGen := &mocks.UuidGenerator{}
+			mTimeGen := &mocks.TimeGenerator{}
+			mConverter := &mocks.ListConverter{}
+			mRecorder := &mocks.HistoryRecorder{}
+			if canCopy {
+				mUuidGen.EXPECT().Generate().Return(copyListId.String()).Once()
+				for _, todoCopy := range []*entities.Todo{parentCopy, subtaskCopy}[:len(test.todos)] {
+					mUuidGen.EXPECT().Generate().Return(todoCopy.Id.String()).Once()
+				}
+
+				mTimeGen.EXPECT().Now().Return(createdAt).Twice()
+
+				mConverter.EXPECT().
+					FromCreateHandlerModelToModel(&handler_models.CreateList{Name: VALID_NAME, Description: DESCRIPTION}).
+					Return(&models.List{Name: VALID_NAME, Description: DESCRIPTION}).Once()
+				mConverter.EXPECT().ToEntity(copyModel).Return(copyEntity).Once()
+
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Once()
+			}
+
+			lService := NewService(mListRepo, mUuidGen, mTimeGen, mConverter, nil, mTodoRepo, nil, nil, mRecorder)
+			duplicate := &handler_models.DuplicateList{Name: VALID_NAME, IncludeCollaborators: test.includeCollaborators}
+			list, err := lService.DuplicateListRecord(context.TODO(), listId.String(), duplicate, test.caller)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, list)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, copyModel, list)
+			}
+
+			mock.AssertExpectationsForObjects(t, mListRepo, mTodoRepo, mUuidGen, mTimeGen, mConverter, mRecorder)
+		})
+	}
+}
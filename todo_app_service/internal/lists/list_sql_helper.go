@@ -10,10 +10,22 @@ var baseListGetQuery = "SELECT id,name,created_at,last_updated,owner,description
 var baseCollaboratorsGetQuery = "WITH sorted_user_cte AS (SELECT users.id,users.email,users.role,list_id FROM users JOIN user_lists ON users.id = user_lists.user_id ORDER BY users.id) SELECT id, email, role FROM sorted_user_cte"
 
 func parseSqlUpdateListQuery(sqlFields []string) string {
-	sqlQuery := fmt.Sprintf("UPDATE lists SET %s WHERE id = :id", strings.Join(sqlFields, ", "))
+	sqlQuery := fmt.Sprintf("UPDATE lists SET %s WHERE id = :id AND deleted_at IS NULL", strings.Join(sqlFields, ", "))
 	return sqlQuery
 }
 
+// softDeleteListsQuery moves the lists matching the condition to the trash together with their todos,
+// the todos get the deletion time of their list so that they can be restored with it
+func softDeleteListsQuery(condition string) string {
+	return fmt.Sprintf(`WITH deleted_lists AS (
+    UPDATE lists SET deleted_at = NOW(), deleted_by = history_actor()
+    WHERE %s AND deleted_at IS NULL
+    RETURNING id, deleted_at, deleted_by
+)
+UPDATE todos SET deleted_at = deleted_lists.deleted_at, deleted_by = deleted_lists.deleted_by
+FROM deleted_lists WHERE todos.list_id = deleted_lists.id AND todos.deleted_at IS NULL`, condition)
+}
+
 func determineSqlFieldsAndParamsList(list *models2.List, sqlExecParams map[string]interface{}, sqlFields *[]string) {
 	if len(list.Name) != 0 {
 		sqlExecParams["name"] = list.Name
@@ -40,3 +52,10 @@ func determineSqlFieldsAndParamsList(list *models2.List, sqlExecParams map[strin
 		*sqlFields = append(*sqlFields, "owner = :owner")
 	}
 }
+
+// copyListCollaboratorsQuery gives the collaborators of a list the same roles in another list,
+// the owner of the other list is skipped because the owner of a list is never its collaborator
+const copyListCollaboratorsQuery = `INSERT INTO user_lists (user_id, list_id, role)
+SELECT user_lists.user_id, $2, user_lists.role FROM user_lists
+WHERE user_lists.list_id = $1 AND user_lists.user_id <> $3
+ON CONFLICT (user_id, list_id) DO NOTHING`
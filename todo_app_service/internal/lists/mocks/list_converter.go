@@ -4,6 +4,7 @@ package mocks
 
 import (
 	entities "Todo-List/internProject/todo_app_service/internal/entities"
+
 	handler_models "Todo-List/internProject/todo_app_service/pkg/handler_models"
 
 	mock "github.com/stretchr/testify/mock"
@@ -24,17 +25,17 @@ func (_m *ListConverter) EXPECT() *ListConverter_Expecter {
 	return &ListConverter_Expecter{mock: &_m.Mock}
 }
 
-// ConvertFromDBEntityToModel provides a mock function with given fields: _a0
-func (_m *ListConverter) ConvertFromDBEntityToModel(_a0 *entities.List) *models.List {
-	ret := _m.Called(_a0)
+// FromCreateHandlerModelToModel provides a mock function with given fields: list
+func (_m *ListConverter) FromCreateHandlerModelToModel(list *handler_models.CreateList) *models.List {
+	ret := _m.Called(list)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ConvertFromDBEntityToModel")
+		panic("no return value specified for FromCreateHandlerModelToModel")
 	}
 
 	var r0 *models.List
-	if rf, ok := ret.Get(0).(func(*entities.List) *models.List); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(*handler_models.CreateList) *models.List); ok {
+		r0 = rf(list)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.List)
@@ -44,222 +45,224 @@ func (_m *ListConverter) ConvertFromDBEntityToModel(_a0 *entities.List) *models.
 	return r0
 }
 
-// ListConverter_ConvertFromDBEntityToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertFromDBEntityToModel'
-type ListConverter_ConvertFromDBEntityToModel_Call struct {
+// ListConverter_FromCreateHandlerModelToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FromCreateHandlerModelToModel'
+type ListConverter_FromCreateHandlerModelToModel_Call struct {
 	*mock.Call
 }
 
-// ConvertFromDBEntityToModel is a helper method to define mock.On call
-//   - _a0 *entities.List
-func (_e *ListConverter_Expecter) ConvertFromDBEntityToModel(_a0 interface{}) *ListConverter_ConvertFromDBEntityToModel_Call {
-	return &ListConverter_ConvertFromDBEntityToModel_Call{Call: _e.mock.On("ConvertFromDBEntityToModel", _a0)}
+// FromCreateHandlerModelToModel is a helper method to define mock.On call
+//   - list *handler_models.CreateList
+func (_e *ListConverter_Expecter) FromCreateHandlerModelToModel(list interface{}) *ListConverter_FromCreateHandlerModelToModel_Call {
+	return &ListConverter_FromCreateHandlerModelToModel_Call{Call: _e.mock.On("FromCreateHandlerModelToModel", list)}
 }
 
-func (_c *ListConverter_ConvertFromDBEntityToModel_Call) Run(run func(_a0 *entities.List)) *ListConverter_ConvertFromDBEntityToModel_Call {
+func (_c *ListConverter_FromCreateHandlerModelToModel_Call) Run(run func(list *handler_models.CreateList)) *ListConverter_FromCreateHandlerModelToModel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entities.List))
+		run(args[0].(*handler_models.CreateList))
 	})
 	return _c
 }
 
-func (_c *ListConverter_ConvertFromDBEntityToModel_Call) Return(_a0 *models.List) *ListConverter_ConvertFromDBEntityToModel_Call {
+func (_c *ListConverter_FromCreateHandlerModelToModel_Call) Return(_a0 *models.List) *ListConverter_FromCreateHandlerModelToModel_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ListConverter_ConvertFromDBEntityToModel_Call) RunAndReturn(run func(*entities.List) *models.List) *ListConverter_ConvertFromDBEntityToModel_Call {
+func (_c *ListConverter_FromCreateHandlerModelToModel_Call) RunAndReturn(run func(*handler_models.CreateList) *models.List) *ListConverter_FromCreateHandlerModelToModel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ConvertFromModelToDBEntity provides a mock function with given fields: _a0
-func (_m *ListConverter) ConvertFromModelToDBEntity(_a0 *models.List) *entities.List {
-	ret := _m.Called(_a0)
+// FromUpdateHandlerModelToModel provides a mock function with given fields: list
+func (_m *ListConverter) FromUpdateHandlerModelToModel(list *handler_models.UpdateList) *models.List {
+	ret := _m.Called(list)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ConvertFromModelToDBEntity")
+		panic("no return value specified for FromUpdateHandlerModelToModel")
 	}
 
-	var r0 *entities.List
-	if rf, ok := ret.Get(0).(func(*models.List) *entities.List); ok {
-		r0 = rf(_a0)
+	var r0 *models.List
+	if rf, ok := ret.Get(0).(func(*handler_models.UpdateList) *models.List); ok {
+		r0 = rf(list)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.List)
+			r0 = ret.Get(0).(*models.List)
 		}
 	}
 
 	return r0
 }
 
-// ListConverter_ConvertFromModelToDBEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertFromModelToDBEntity'
-type ListConverter_ConvertFromModelToDBEntity_Call struct {
+// ListConverter_FromUpdateHandlerModelToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FromUpdateHandlerModelToModel'
+type ListConverter_FromUpdateHandlerModelToModel_Call struct {
 	*mock.Call
 }
 
-// ConvertFromModelToDBEntity is a helper method to define mock.On call
-//   - _a0 *models.List
-func (_e *ListConverter_Expecter) ConvertFromModelToDBEntity(_a0 interface{}) *ListConverter_ConvertFromModelToDBEntity_Call {
-	return &ListConverter_ConvertFromModelToDBEntity_Call{Call: _e.mock.On("ConvertFromModelToDBEntity", _a0)}
+// FromUpdateHandlerModelToModel is a helper method to define mock.On call
+//   - list *handler_models.UpdateList
+func (_e *ListConverter_Expecter) FromUpdateHandlerModelToModel(list interface{}) *ListConverter_FromUpdateHandlerModelToModel_Call {
+	return &ListConverter_FromUpdateHandlerModelToModel_Call{Call: _e.mock.On("FromUpdateHandlerModelToModel", list)}
 }
 
-func (_c *ListConverter_ConvertFromModelToDBEntity_Call) Run(run func(_a0 *models.List)) *ListConverter_ConvertFromModelToDBEntity_Call {
+func (_c *ListConverter_FromUpdateHandlerModelToModel_Call) Run(run func(list *handler_models.UpdateList)) *ListConverter_FromUpdateHandlerModelToModel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*models.List))
+		run(args[0].(*handler_models.UpdateList))
 	})
 	return _c
 }
 
-func (_c *ListConverter_ConvertFromModelToDBEntity_Call) Return(_a0 *entities.List) *ListConverter_ConvertFromModelToDBEntity_Call {
+func (_c *ListConverter_FromUpdateHandlerModelToModel_Call) Return(_a0 *models.List) *ListConverter_FromUpdateHandlerModelToModel_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ListConverter_ConvertFromModelToDBEntity_Call) RunAndReturn(run func(*models.List) *entities.List) *ListConverter_ConvertFromModelToDBEntity_Call {
+func (_c *ListConverter_FromUpdateHandlerModelToModel_Call) RunAndReturn(run func(*handler_models.UpdateList) *models.List) *ListConverter_FromUpdateHandlerModelToModel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FromCreateHandlerModelToModel provides a mock function with given fields: _a0
-func (_m *ListConverter) FromCreateHandlerModelToModel(_a0 *handler_models.CreateList) *models.List {
-	ret := _m.Called(_a0)
+// ManyToPage provides a mock function with given fields: lists, pageInfo, sortField
+func (_m *ListConverter) ManyToPage(lists []entities.List, pageInfo *entities.PaginationInfo, sortField string) *models.ListPage {
+	ret := _m.Called(lists, pageInfo, sortField)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FromCreateHandlerModelToModel")
+		panic("no return value specified for ManyToPage")
 	}
 
-	var r0 *models.List
-	if rf, ok := ret.Get(0).(func(*handler_models.CreateList) *models.List); ok {
-		r0 = rf(_a0)
+	var r0 *models.ListPage
+	if rf, ok := ret.Get(0).(func([]entities.List, *entities.PaginationInfo, string) *models.ListPage); ok {
+		r0 = rf(lists, pageInfo, sortField)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.List)
+			r0 = ret.Get(0).(*models.ListPage)
 		}
 	}
 
 	return r0
 }
 
-// ListConverter_FromCreateHandlerModelToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FromCreateHandlerModelToModel'
-type ListConverter_FromCreateHandlerModelToModel_Call struct {
+// ListConverter_ManyToPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManyToPage'
+type ListConverter_ManyToPage_Call struct {
 	*mock.Call
 }
 
-// FromCreateHandlerModelToModel is a helper method to define mock.On call
-//   - _a0 *handler_models.CreateList
-func (_e *ListConverter_Expecter) FromCreateHandlerModelToModel(_a0 interface{}) *ListConverter_FromCreateHandlerModelToModel_Call {
-	return &ListConverter_FromCreateHandlerModelToModel_Call{Call: _e.mock.On("FromCreateHandlerModelToModel", _a0)}
+// ManyToPage is a helper method to define mock.On call
+//   - lists []entities.List
+//   - pageInfo *entities.PaginationInfo
+//   - sortField string
+func (_e *ListConverter_Expecter) ManyToPage(lists interface{}, pageInfo interface{}, sortField interface{}) *ListConverter_ManyToPage_Call {
+	return &ListConverter_ManyToPage_Call{Call: _e.mock.On("ManyToPage", lists, pageInfo, sortField)}
 }
 
-func (_c *ListConverter_FromCreateHandlerModelToModel_Call) Run(run func(_a0 *handler_models.CreateList)) *ListConverter_FromCreateHandlerModelToModel_Call {
+func (_c *ListConverter_ManyToPage_Call) Run(run func(lists []entities.List, pageInfo *entities.PaginationInfo, sortField string)) *ListConverter_ManyToPage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*handler_models.CreateList))
+		run(args[0].([]entities.List), args[1].(*entities.PaginationInfo), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *ListConverter_FromCreateHandlerModelToModel_Call) Return(_a0 *models.List) *ListConverter_FromCreateHandlerModelToModel_Call {
+func (_c *ListConverter_ManyToPage_Call) Return(_a0 *models.ListPage) *ListConverter_ManyToPage_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ListConverter_FromCreateHandlerModelToModel_Call) RunAndReturn(run func(*handler_models.CreateList) *models.List) *ListConverter_FromCreateHandlerModelToModel_Call {
+func (_c *ListConverter_ManyToPage_Call) RunAndReturn(run func([]entities.List, *entities.PaginationInfo, string) *models.ListPage) *ListConverter_ManyToPage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FromUpdateHandlerModelToModel provides a mock function with given fields: _a0
-func (_m *ListConverter) FromUpdateHandlerModelToModel(_a0 *handler_models.UpdateList) *models.List {
-	ret := _m.Called(_a0)
+// ToEntity provides a mock function with given fields: list
+func (_m *ListConverter) ToEntity(list *models.List) *entities.List {
+	ret := _m.Called(list)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FromUpdateHandlerModelToModel")
+		panic("no return value specified for ToEntity")
 	}
 
-	var r0 *models.List
-	if rf, ok := ret.Get(0).(func(*handler_models.UpdateList) *models.List); ok {
-		r0 = rf(_a0)
+	var r0 *entities.List
+	if rf, ok := ret.Get(0).(func(*models.List) *entities.List); ok {
+		r0 = rf(list)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.List)
+			r0 = ret.Get(0).(*entities.List)
 		}
 	}
 
 	return r0
 }
 
-// ListConverter_FromUpdateHandlerModelToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FromUpdateHandlerModelToModel'
-type ListConverter_FromUpdateHandlerModelToModel_Call struct {
+// ListConverter_ToEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToEntity'
+type ListConverter_ToEntity_Call struct {
 	*mock.Call
 }
 
-// FromUpdateHandlerModelToModel is a helper method to define mock.On call
-//   - _a0 *handler_models.UpdateList
-func (_e *ListConverter_Expecter) FromUpdateHandlerModelToModel(_a0 interface{}) *ListConverter_FromUpdateHandlerModelToModel_Call {
-	return &ListConverter_FromUpdateHandlerModelToModel_Call{Call: _e.mock.On("FromUpdateHandlerModelToModel", _a0)}
+// ToEntity is a helper method to define mock.On call
+//   - list *models.List
+func (_e *ListConverter_Expecter) ToEntity(list interface{}) *ListConverter_ToEntity_Call {
+	return &ListConverter_ToEntity_Call{Call: _e.mock.On("ToEntity", list)}
 }
 
-func (_c *ListConverter_FromUpdateHandlerModelToModel_Call) Run(run func(_a0 *handler_models.UpdateList)) *ListConverter_FromUpdateHandlerModelToModel_Call {
+func (_c *ListConverter_ToEntity_Call) Run(run func(list *models.List)) *ListConverter_ToEntity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*handler_models.UpdateList))
+		run(args[0].(*models.List))
 	})
 	return _c
 }
 
-func (_c *ListConverter_FromUpdateHandlerModelToModel_Call) Return(_a0 *models.List) *ListConverter_FromUpdateHandlerModelToModel_Call {
+func (_c *ListConverter_ToEntity_Call) Return(_a0 *entities.List) *ListConverter_ToEntity_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ListConverter_FromUpdateHandlerModelToModel_Call) RunAndReturn(run func(*handler_models.UpdateList) *models.List) *ListConverter_FromUpdateHandlerModelToModel_Call {
+func (_c *ListConverter_ToEntity_Call) RunAndReturn(run func(*models.List) *entities.List) *ListConverter_ToEntity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ManyToModel provides a mock function with given fields: _a0
-func (_m *ListConverter) ManyToModel(_a0 []entities.List) []*models.List {
-	ret := _m.Called(_a0)
+// ToModel provides a mock function with given fields: list
+func (_m *ListConverter) ToModel(list *entities.List) *models.List {
+	ret := _m.Called(list)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ManyToModel")
+		panic("no return value specified for ToModel")
 	}
 
-	var r0 []*models.List
-	if rf, ok := ret.Get(0).(func([]entities.List) []*models.List); ok {
-		r0 = rf(_a0)
+	var r0 *models.List
+	if rf, ok := ret.Get(0).(func(*entities.List) *models.List); ok {
+		r0 = rf(list)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.List)
+			r0 = ret.Get(0).(*models.List)
 		}
 	}
 
 	return r0
 }
 
-// ListConverter_ManyToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManyToModel'
-type ListConverter_ManyToModel_Call struct {
+// ListConverter_ToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToModel'
+type ListConverter_ToModel_Call struct {
 	*mock.Call
 }
 
-// ManyToModel is a helper method to define mock.On call
-//   - _a0 []entities.List
-func (_e *ListConverter_Expecter) ManyToModel(_a0 interface{}) *ListConverter_ManyToModel_Call {
-	return &ListConverter_ManyToModel_Call{Call: _e.mock.On("ManyToModel", _a0)}
+// ToModel is a helper method to define mock.On call
+//   - list *entities.List
+func (_e *ListConverter_Expecter) ToModel(list interface{}) *ListConverter_ToModel_Call {
+	return &ListConverter_ToModel_Call{Call: _e.mock.On("ToModel", list)}
 }
 
-func (_c *ListConverter_ManyToModel_Call) Run(run func(_a0 []entities.List)) *ListConverter_ManyToModel_Call {
+func (_c *ListConverter_ToModel_Call) Run(run func(list *entities.List)) *ListConverter_ToModel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]entities.List))
+		run(args[0].(*entities.List))
 	})
 	return _c
 }
 
-func (_c *ListConverter_ManyToModel_Call) Return(_a0 []*models.List) *ListConverter_ManyToModel_Call {
+func (_c *ListConverter_ToModel_Call) Return(_a0 *models.List) *ListConverter_ToModel_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ListConverter_ManyToModel_Call) RunAndReturn(run func([]entities.List) []*models.List) *ListConverter_ManyToModel_Call {
+func (_c *ListConverter_ToModel_Call) RunAndReturn(run func(*entities.List) *models.List) *ListConverter_ToModel_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,12 +3,17 @@
 package mocks
 
 import (
-	entities "Todo-List/internProject/todo_app_service/internal/entities"
+	constants "Todo-List/internProject/todo_app_service/pkg/constants"
+
 	context "context"
 
+	entities "Todo-List/internProject/todo_app_service/internal/entities"
+
+	filters "Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+
 	mock "github.com/stretchr/testify/mock"
 
-	sql_query_decorators "Todo-List/internProject/todo_app_service/internal/sql_query_decorators"
+	source "Todo-List/internProject/todo_app_service/internal/source"
 )
 
 // ListRepo is an autogenerated mock type for the listRepo type
@@ -82,6 +87,113 @@ func (_c *ListRepo_CheckWhetherUserIsCollaborator_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// CheckWhetherUserIsManager provides a mock function with given fields: ctx, listId, userId
+func (_m *ListRepo) CheckWhetherUserIsManager(ctx context.Context, listId string, userId string) (bool, error) {
+	ret := _m.Called(ctx, listId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckWhetherUserIsManager")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, listId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, listId, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, listId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRepo_CheckWhetherUserIsManager_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckWhetherUserIsManager'
+type ListRepo_CheckWhetherUserIsManager_Call struct {
+	*mock.Call
+}
+
+// CheckWhetherUserIsManager is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId string
+//   - userId string
+func (_e *ListRepo_Expecter) CheckWhetherUserIsManager(ctx interface{}, listId interface{}, userId interface{}) *ListRepo_CheckWhetherUserIsManager_Call {
+	return &ListRepo_CheckWhetherUserIsManager_Call{Call: _e.mock.On("CheckWhetherUserIsManager", ctx, listId, userId)}
+}
+
+func (_c *ListRepo_CheckWhetherUserIsManager_Call) Run(run func(ctx context.Context, listId string, userId string)) *ListRepo_CheckWhetherUserIsManager_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ListRepo_CheckWhetherUserIsManager_Call) Return(_a0 bool, _a1 error) *ListRepo_CheckWhetherUserIsManager_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListRepo_CheckWhetherUserIsManager_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *ListRepo_CheckWhetherUserIsManager_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyListCollaborators provides a mock function with given fields: ctx, fromListId, toListId, ownerId
+func (_m *ListRepo) CopyListCollaborators(ctx context.Context, fromListId string, toListId string, ownerId string) error {
+	ret := _m.Called(ctx, fromListId, toListId, ownerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyListCollaborators")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, fromListId, toListId, ownerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListRepo_CopyListCollaborators_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyListCollaborators'
+type ListRepo_CopyListCollaborators_Call struct {
+	*mock.Call
+}
+
+// CopyListCollaborators is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromListId string
+//   - toListId string
+//   - ownerId string
+func (_e *ListRepo_Expecter) CopyListCollaborators(ctx interface{}, fromListId interface{}, toListId interface{}, ownerId interface{}) *ListRepo_CopyListCollaborators_Call {
+	return &ListRepo_CopyListCollaborators_Call{Call: _e.mock.On("CopyListCollaborators", ctx, fromListId, toListId, ownerId)}
+}
+
+func (_c *ListRepo_CopyListCollaborators_Call) Run(run func(ctx context.Context, fromListId string, toListId string, ownerId string)) *ListRepo_CopyListCollaborators_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ListRepo_CopyListCollaborators_Call) Return(_a0 error) *ListRepo_CopyListCollaborators_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ListRepo_CopyListCollaborators_Call) RunAndReturn(run func(context.Context, string, string, string) error) *ListRepo_CopyListCollaborators_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateList provides a mock function with given fields: _a0, _a1
 func (_m *ListRepo) CreateList(_a0 context.Context, _a1 *entities.List) (*entities.List, error) {
 	ret := _m.Called(_a0, _a1)
@@ -341,9 +453,9 @@ func (_c *ListRepo_GetList_Call) RunAndReturn(run func(context.Context, string)
 	return _c
 }
 
-// GetListCollaborators provides a mock function with given fields: _a0, _a1
-func (_m *ListRepo) GetListCollaborators(_a0 context.Context, _a1 sql_query_decorators.SqlQueryRetriever) ([]entities.User, error) {
-	ret := _m.Called(_a0, _a1)
+// GetListCollaborators provides a mock function with given fields: ctx, listID, f
+func (_m *ListRepo) GetListCollaborators(ctx context.Context, listID string, f filters.SqlFilters) ([]entities.User, error) {
+	ret := _m.Called(ctx, listID, f)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetListCollaborators")
@@ -351,19 +463,19 @@ func (_m *ListRepo) GetListCollaborators(_a0 context.Context, _a1 sql_query_deco
 
 	var r0 []entities.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sql_query_decorators.SqlQueryRetriever) ([]entities.User, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, filters.SqlFilters) ([]entities.User, error)); ok {
+		return rf(ctx, listID, f)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sql_query_decorators.SqlQueryRetriever) []entities.User); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, filters.SqlFilters) []entities.User); ok {
+		r0 = rf(ctx, listID, f)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entities.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sql_query_decorators.SqlQueryRetriever) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string, filters.SqlFilters) error); ok {
+		r1 = rf(ctx, listID, f)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -377,15 +489,16 @@ type ListRepo_GetListCollaborators_Call struct {
 }
 
 // GetListCollaborators is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 sql_query_decorators.SqlQueryRetriever
-func (_e *ListRepo_Expecter) GetListCollaborators(_a0 interface{}, _a1 interface{}) *ListRepo_GetListCollaborators_Call {
-	return &ListRepo_GetListCollaborators_Call{Call: _e.mock.On("GetListCollaborators", _a0, _a1)}
+//   - ctx context.Context
+//   - listID string
+//   - f filters.SqlFilters
+func (_e *ListRepo_Expecter) GetListCollaborators(ctx interface{}, listID interface{}, f interface{}) *ListRepo_GetListCollaborators_Call {
+	return &ListRepo_GetListCollaborators_Call{Call: _e.mock.On("GetListCollaborators", ctx, listID, f)}
 }
 
-func (_c *ListRepo_GetListCollaborators_Call) Run(run func(_a0 context.Context, _a1 sql_query_decorators.SqlQueryRetriever)) *ListRepo_GetListCollaborators_Call {
+func (_c *ListRepo_GetListCollaborators_Call) Run(run func(ctx context.Context, listID string, f filters.SqlFilters)) *ListRepo_GetListCollaborators_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sql_query_decorators.SqlQueryRetriever))
+		run(args[0].(context.Context), args[1].(string), args[2].(filters.SqlFilters))
 	})
 	return _c
 }
@@ -395,7 +508,7 @@ func (_c *ListRepo_GetListCollaborators_Call) Return(_a0 []entities.User, _a1 er
 	return _c
 }
 
-func (_c *ListRepo_GetListCollaborators_Call) RunAndReturn(run func(context.Context, sql_query_decorators.SqlQueryRetriever) ([]entities.User, error)) *ListRepo_GetListCollaborators_Call {
+func (_c *ListRepo_GetListCollaborators_Call) RunAndReturn(run func(context.Context, string, filters.SqlFilters) ([]entities.User, error)) *ListRepo_GetListCollaborators_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -459,9 +572,9 @@ func (_c *ListRepo_GetListOwner_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
-// GetLists provides a mock function with given fields: _a0, _a1
-func (_m *ListRepo) GetLists(_a0 context.Context, _a1 sql_query_decorators.SqlQueryRetriever) ([]entities.List, error) {
-	ret := _m.Called(_a0, _a1)
+// GetLists provides a mock function with given fields: ctx, f
+func (_m *ListRepo) GetLists(ctx context.Context, f filters.SqlFilters) ([]entities.List, error) {
+	ret := _m.Called(ctx, f)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLists")
@@ -469,19 +582,19 @@ func (_m *ListRepo) GetLists(_a0 context.Context, _a1 sql_query_decorators.SqlQu
 
 	var r0 []entities.List
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sql_query_decorators.SqlQueryRetriever) ([]entities.List, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, filters.SqlFilters) ([]entities.List, error)); ok {
+		return rf(ctx, f)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sql_query_decorators.SqlQueryRetriever) []entities.List); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, filters.SqlFilters) []entities.List); ok {
+		r0 = rf(ctx, f)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entities.List)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sql_query_decorators.SqlQueryRetriever) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, filters.SqlFilters) error); ok {
+		r1 = rf(ctx, f)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -495,15 +608,15 @@ type ListRepo_GetLists_Call struct {
 }
 
 // GetLists is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 sql_query_decorators.SqlQueryRetriever
-func (_e *ListRepo_Expecter) GetLists(_a0 interface{}, _a1 interface{}) *ListRepo_GetLists_Call {
-	return &ListRepo_GetLists_Call{Call: _e.mock.On("GetLists", _a0, _a1)}
+//   - ctx context.Context
+//   - f filters.SqlFilters
+func (_e *ListRepo_Expecter) GetLists(ctx interface{}, f interface{}) *ListRepo_GetLists_Call {
+	return &ListRepo_GetLists_Call{Call: _e.mock.On("GetLists", ctx, f)}
 }
 
-func (_c *ListRepo_GetLists_Call) Run(run func(_a0 context.Context, _a1 sql_query_decorators.SqlQueryRetriever)) *ListRepo_GetLists_Call {
+func (_c *ListRepo_GetLists_Call) Run(run func(ctx context.Context, f filters.SqlFilters)) *ListRepo_GetLists_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sql_query_decorators.SqlQueryRetriever))
+		run(args[0].(context.Context), args[1].(filters.SqlFilters))
 	})
 	return _c
 }
@@ -513,7 +626,67 @@ func (_c *ListRepo_GetLists_Call) Return(_a0 []entities.List, _a1 error) *ListRe
 	return _c
 }
 
-func (_c *ListRepo_GetLists_Call) RunAndReturn(run func(context.Context, sql_query_decorators.SqlQueryRetriever) ([]entities.List, error)) *ListRepo_GetLists_Call {
+func (_c *ListRepo_GetLists_Call) RunAndReturn(run func(context.Context, filters.SqlFilters) ([]entities.List, error)) *ListRepo_GetLists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaginationInfo provides a mock function with given fields: ctx, f, s
+func (_m *ListRepo) GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error) {
+	ret := _m.Called(ctx, f, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginationInfo")
+	}
+
+	var r0 *entities.PaginationInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filters.SqlFilters, source.Source) (*entities.PaginationInfo, error)); ok {
+		return rf(ctx, f, s)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filters.SqlFilters, source.Source) *entities.PaginationInfo); ok {
+		r0 = rf(ctx, f, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PaginationInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filters.SqlFilters, source.Source) error); ok {
+		r1 = rf(ctx, f, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRepo_GetPaginationInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginationInfo'
+type ListRepo_GetPaginationInfo_Call struct {
+	*mock.Call
+}
+
+// GetPaginationInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f filters.SqlFilters
+//   - s source.Source
+func (_e *ListRepo_Expecter) GetPaginationInfo(ctx interface{}, f interface{}, s interface{}) *ListRepo_GetPaginationInfo_Call {
+	return &ListRepo_GetPaginationInfo_Call{Call: _e.mock.On("GetPaginationInfo", ctx, f, s)}
+}
+
+func (_c *ListRepo_GetPaginationInfo_Call) Run(run func(ctx context.Context, f filters.SqlFilters, s source.Source)) *ListRepo_GetPaginationInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(filters.SqlFilters), args[2].(source.Source))
+	})
+	return _c
+}
+
+func (_c *ListRepo_GetPaginationInfo_Call) Return(_a0 *entities.PaginationInfo, _a1 error) *ListRepo_GetPaginationInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListRepo_GetPaginationInfo_Call) RunAndReturn(run func(context.Context, filters.SqlFilters, source.Source) (*entities.PaginationInfo, error)) *ListRepo_GetPaginationInfo_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -578,17 +751,17 @@ func (_c *ListRepo_UpdateList_Call) RunAndReturn(run func(context.Context, map[s
 	return _c
 }
 
-// UpdateListSharedWith provides a mock function with given fields: _a0, _a1, _a2
-func (_m *ListRepo) UpdateListSharedWith(_a0 context.Context, _a1 string, _a2 string) error {
-	ret := _m.Called(_a0, _a1, _a2)
+// UpdateListSharedWith provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ListRepo) UpdateListSharedWith(_a0 context.Context, _a1 string, _a2 string, _a3 constants.CollaboratorRole) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateListSharedWith")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, constants.CollaboratorRole) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -605,13 +778,14 @@ type ListRepo_UpdateListSharedWith_Call struct {
 //   - _a0 context.Context
 //   - _a1 string
 //   - _a2 string
-func (_e *ListRepo_Expecter) UpdateListSharedWith(_a0 interface{}, _a1 interface{}, _a2 interface{}) *ListRepo_UpdateListSharedWith_Call {
-	return &ListRepo_UpdateListSharedWith_Call{Call: _e.mock.On("UpdateListSharedWith", _a0, _a1, _a2)}
+//   - _a3 constants.CollaboratorRole
+func (_e *ListRepo_Expecter) UpdateListSharedWith(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *ListRepo_UpdateListSharedWith_Call {
+	return &ListRepo_UpdateListSharedWith_Call{Call: _e.mock.On("UpdateListSharedWith", _a0, _a1, _a2, _a3)}
 }
 
-func (_c *ListRepo_UpdateListSharedWith_Call) Run(run func(_a0 context.Context, _a1 string, _a2 string)) *ListRepo_UpdateListSharedWith_Call {
+func (_c *ListRepo_UpdateListSharedWith_Call) Run(run func(_a0 context.Context, _a1 string, _a2 string, _a3 constants.CollaboratorRole)) *ListRepo_UpdateListSharedWith_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(constants.CollaboratorRole))
 	})
 	return _c
 }
@@ -621,7 +795,7 @@ func (_c *ListRepo_UpdateListSharedWith_Call) Return(_a0 error) *ListRepo_Update
 	return _c
 }
 
-func (_c *ListRepo_UpdateListSharedWith_Call) RunAndReturn(run func(context.Context, string, string) error) *ListRepo_UpdateListSharedWith_Call {
+func (_c *ListRepo_UpdateListSharedWith_Call) RunAndReturn(run func(context.Context, string, string, constants.CollaboratorRole) error) *ListRepo_UpdateListSharedWith_Call {
 	_c.Call.Return(run)
 	return _c
 }
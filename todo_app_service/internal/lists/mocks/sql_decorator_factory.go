@@ -23,9 +23,9 @@ func (_m *SqlDecoratorFactory) EXPECT() *SqlDecoratorFactory_Expecter {
 	return &SqlDecoratorFactory_Expecter{mock: &_m.Mock}
 }
 
-// CreateSqlDecorator provides a mock function with given fields: _a0, _a1, _a2
-func (_m *SqlDecoratorFactory) CreateSqlDecorator(_a0 context.Context, _a1 sql_query_decorators.Filters, _a2 string) (sql_query_decorators.SqlQueryRetriever, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// CreateSqlDecorator provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *SqlDecoratorFactory) CreateSqlDecorator(_a0 context.Context, _a1 sql_query_decorators.Filters, _a2 string, _a3 []interface{}) (sql_query_decorators.SqlQueryRetriever, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateSqlDecorator")
@@ -33,19 +33,19 @@ func (_m *SqlDecoratorFactory) CreateSqlDecorator(_a0 context.Context, _a1 sql_q
 
 	var r0 sql_query_decorators.SqlQueryRetriever
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sql_query_decorators.Filters, string) (sql_query_decorators.SqlQueryRetriever, error)); ok {
-		return rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, sql_query_decorators.Filters, string, []interface{}) (sql_query_decorators.SqlQueryRetriever, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sql_query_decorators.Filters, string) sql_query_decorators.SqlQueryRetriever); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, sql_query_decorators.Filters, string, []interface{}) sql_query_decorators.SqlQueryRetriever); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(sql_query_decorators.SqlQueryRetriever)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sql_query_decorators.Filters, string) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, sql_query_decorators.Filters, string, []interface{}) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,13 +62,14 @@ type SqlDecoratorFactory_CreateSqlDecorator_Call struct {
 //   - _a0 context.Context
 //   - _a1 sql_query_decorators.Filters
 //   - _a2 string
-func (_e *SqlDecoratorFactory_Expecter) CreateSqlDecorator(_a0 interface{}, _a1 interface{}, _a2 interface{}) *SqlDecoratorFactory_CreateSqlDecorator_Call {
-	return &SqlDecoratorFactory_CreateSqlDecorator_Call{Call: _e.mock.On("CreateSqlDecorator", _a0, _a1, _a2)}
+//   - _a3 []interface{}
+func (_e *SqlDecoratorFactory_Expecter) CreateSqlDecorator(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *SqlDecoratorFactory_CreateSqlDecorator_Call {
+	return &SqlDecoratorFactory_CreateSqlDecorator_Call{Call: _e.mock.On("CreateSqlDecorator", _a0, _a1, _a2, _a3)}
 }
 
-func (_c *SqlDecoratorFactory_CreateSqlDecorator_Call) Run(run func(_a0 context.Context, _a1 sql_query_decorators.Filters, _a2 string)) *SqlDecoratorFactory_CreateSqlDecorator_Call {
+func (_c *SqlDecoratorFactory_CreateSqlDecorator_Call) Run(run func(_a0 context.Context, _a1 sql_query_decorators.Filters, _a2 string, _a3 []interface{})) *SqlDecoratorFactory_CreateSqlDecorator_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sql_query_decorators.Filters), args[2].(string))
+		run(args[0].(context.Context), args[1].(sql_query_decorators.Filters), args[2].(string), args[3].([]interface{}))
 	})
 	return _c
 }
@@ -78,7 +79,7 @@ func (_c *SqlDecoratorFactory_CreateSqlDecorator_Call) Return(_a0 sql_query_deco
 	return _c
 }
 
-func (_c *SqlDecoratorFactory_CreateSqlDecorator_Call) RunAndReturn(run func(context.Context, sql_query_decorators.Filters, string) (sql_query_decorators.SqlQueryRetriever, error)) *SqlDecoratorFactory_CreateSqlDecorator_Call {
+func (_c *SqlDecoratorFactory_CreateSqlDecorator_Call) RunAndReturn(run func(context.Context, sql_query_decorators.Filters, string, []interface{}) (sql_query_decorators.SqlQueryRetriever, error)) *SqlDecoratorFactory_CreateSqlDecorator_Call {
 	_c.Call.Return(run)
 	return _c
 }
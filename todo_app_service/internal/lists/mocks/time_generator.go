@@ -3,9 +3,9 @@
 package mocks
 
 import (
-	time "time"
-
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // TimeGenerator is an autogenerated mock type for the timeGenerator type
@@ -21,7 +21,7 @@ func (_m *TimeGenerator) EXPECT() *TimeGenerator_Expecter {
 	return &TimeGenerator_Expecter{mock: &_m.Mock}
 }
 
-// Now provides a mock function with no fields
+// Now provides a mock function with given fields:
 func (_m *TimeGenerator) Now() time.Time {
 	ret := _m.Called()
 
@@ -23,60 +23,61 @@ func (_m *UserConverter) EXPECT() *UserConverter_Expecter {
 	return &UserConverter_Expecter{mock: &_m.Mock}
 }
 
-// ConvertFromDBEntityToModel provides a mock function with given fields: _a0
-func (_m *UserConverter) ConvertFromDBEntityToModel(_a0 *entities.User) *models.User {
-	ret := _m.Called(_a0)
+// ManyToPage provides a mock function with given fields: users, pageInfo
+func (_m *UserConverter) ManyToPage(users []entities.User, pageInfo *entities.PaginationInfo) *models.UserPage {
+	ret := _m.Called(users, pageInfo)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ConvertFromDBEntityToModel")
+		panic("no return value specified for ManyToPage")
 	}
 
-	var r0 *models.User
-	if rf, ok := ret.Get(0).(func(*entities.User) *models.User); ok {
-		r0 = rf(_a0)
+	var r0 *models.UserPage
+	if rf, ok := ret.Get(0).(func([]entities.User, *entities.PaginationInfo) *models.UserPage); ok {
+		r0 = rf(users, pageInfo)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.User)
+			r0 = ret.Get(0).(*models.UserPage)
 		}
 	}
 
 	return r0
 }
 
-// UserConverter_ConvertFromDBEntityToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertFromDBEntityToModel'
-type UserConverter_ConvertFromDBEntityToModel_Call struct {
+// UserConverter_ManyToPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManyToPage'
+type UserConverter_ManyToPage_Call struct {
 	*mock.Call
 }
 
-// ConvertFromDBEntityToModel is a helper method to define mock.On call
-//   - _a0 *entities.User
-func (_e *UserConverter_Expecter) ConvertFromDBEntityToModel(_a0 interface{}) *UserConverter_ConvertFromDBEntityToModel_Call {
-	return &UserConverter_ConvertFromDBEntityToModel_Call{Call: _e.mock.On("ConvertFromDBEntityToModel", _a0)}
+// ManyToPage is a helper method to define mock.On call
+//   - users []entities.User
+//   - pageInfo *entities.PaginationInfo
+func (_e *UserConverter_Expecter) ManyToPage(users interface{}, pageInfo interface{}) *UserConverter_ManyToPage_Call {
+	return &UserConverter_ManyToPage_Call{Call: _e.mock.On("ManyToPage", users, pageInfo)}
 }
 
-func (_c *UserConverter_ConvertFromDBEntityToModel_Call) Run(run func(_a0 *entities.User)) *UserConverter_ConvertFromDBEntityToModel_Call {
+func (_c *UserConverter_ManyToPage_Call) Run(run func(users []entities.User, pageInfo *entities.PaginationInfo)) *UserConverter_ManyToPage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entities.User))
+		run(args[0].([]entities.User), args[1].(*entities.PaginationInfo))
 	})
 	return _c
 }
 
-func (_c *UserConverter_ConvertFromDBEntityToModel_Call) Return(_a0 *models.User) *UserConverter_ConvertFromDBEntityToModel_Call {
+func (_c *UserConverter_ManyToPage_Call) Return(_a0 *models.UserPage) *UserConverter_ManyToPage_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UserConverter_ConvertFromDBEntityToModel_Call) RunAndReturn(run func(*entities.User) *models.User) *UserConverter_ConvertFromDBEntityToModel_Call {
+func (_c *UserConverter_ManyToPage_Call) RunAndReturn(run func([]entities.User, *entities.PaginationInfo) *models.UserPage) *UserConverter_ManyToPage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ConvertFromModelToEntity provides a mock function with given fields: _a0
-func (_m *UserConverter) ConvertFromModelToEntity(_a0 *models.User) *entities.User {
+// ToEntity provides a mock function with given fields: _a0
+func (_m *UserConverter) ToEntity(_a0 *models.User) *entities.User {
 	ret := _m.Called(_a0)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ConvertFromModelToEntity")
+		panic("no return value specified for ToEntity")
 	}
 
 	var r0 *entities.User
@@ -91,78 +92,78 @@ func (_m *UserConverter) ConvertFromModelToEntity(_a0 *models.User) *entities.Us
 	return r0
 }
 
-// UserConverter_ConvertFromModelToEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertFromModelToEntity'
-type UserConverter_ConvertFromModelToEntity_Call struct {
+// UserConverter_ToEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToEntity'
+type UserConverter_ToEntity_Call struct {
 	*mock.Call
 }
 
-// ConvertFromModelToEntity is a helper method to define mock.On call
+// ToEntity is a helper method to define mock.On call
 //   - _a0 *models.User
-func (_e *UserConverter_Expecter) ConvertFromModelToEntity(_a0 interface{}) *UserConverter_ConvertFromModelToEntity_Call {
-	return &UserConverter_ConvertFromModelToEntity_Call{Call: _e.mock.On("ConvertFromModelToEntity", _a0)}
+func (_e *UserConverter_Expecter) ToEntity(_a0 interface{}) *UserConverter_ToEntity_Call {
+	return &UserConverter_ToEntity_Call{Call: _e.mock.On("ToEntity", _a0)}
 }
 
-func (_c *UserConverter_ConvertFromModelToEntity_Call) Run(run func(_a0 *models.User)) *UserConverter_ConvertFromModelToEntity_Call {
+func (_c *UserConverter_ToEntity_Call) Run(run func(_a0 *models.User)) *UserConverter_ToEntity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(*models.User))
 	})
 	return _c
 }
 
-func (_c *UserConverter_ConvertFromModelToEntity_Call) Return(_a0 *entities.User) *UserConverter_ConvertFromModelToEntity_Call {
+func (_c *UserConverter_ToEntity_Call) Return(_a0 *entities.User) *UserConverter_ToEntity_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UserConverter_ConvertFromModelToEntity_Call) RunAndReturn(run func(*models.User) *entities.User) *UserConverter_ConvertFromModelToEntity_Call {
+func (_c *UserConverter_ToEntity_Call) RunAndReturn(run func(*models.User) *entities.User) *UserConverter_ToEntity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ManyToModel provides a mock function with given fields: _a0
-func (_m *UserConverter) ManyToModel(_a0 []entities.User) []*models.User {
+// ToModel provides a mock function with given fields: _a0
+func (_m *UserConverter) ToModel(_a0 *entities.User) *models.User {
 	ret := _m.Called(_a0)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ManyToModel")
+		panic("no return value specified for ToModel")
 	}
 
-	var r0 []*models.User
-	if rf, ok := ret.Get(0).(func([]entities.User) []*models.User); ok {
+	var r0 *models.User
+	if rf, ok := ret.Get(0).(func(*entities.User) *models.User); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.User)
+			r0 = ret.Get(0).(*models.User)
 		}
 	}
 
 	return r0
 }
 
-// UserConverter_ManyToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManyToModel'
-type UserConverter_ManyToModel_Call struct {
+// UserConverter_ToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToModel'
+type UserConverter_ToModel_Call struct {
 	*mock.Call
 }
 
-// ManyToModel is a helper method to define mock.On call
-//   - _a0 []entities.User
-func (_e *UserConverter_Expecter) ManyToModel(_a0 interface{}) *UserConverter_ManyToModel_Call {
-	return &UserConverter_ManyToModel_Call{Call: _e.mock.On("ManyToModel", _a0)}
+// ToModel is a helper method to define mock.On call
+//   - _a0 *entities.User
+func (_e *UserConverter_Expecter) ToModel(_a0 interface{}) *UserConverter_ToModel_Call {
+	return &UserConverter_ToModel_Call{Call: _e.mock.On("ToModel", _a0)}
 }
 
-func (_c *UserConverter_ManyToModel_Call) Run(run func(_a0 []entities.User)) *UserConverter_ManyToModel_Call {
+func (_c *UserConverter_ToModel_Call) Run(run func(_a0 *entities.User)) *UserConverter_ToModel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]entities.User))
+		run(args[0].(*entities.User))
 	})
 	return _c
 }
 
-func (_c *UserConverter_ManyToModel_Call) Return(_a0 []*models.User) *UserConverter_ManyToModel_Call {
+func (_c *UserConverter_ToModel_Call) Return(_a0 *models.User) *UserConverter_ToModel_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UserConverter_ManyToModel_Call) RunAndReturn(run func([]entities.User) []*models.User) *UserConverter_ManyToModel_Call {
+func (_c *UserConverter_ToModel_Call) RunAndReturn(run func(*entities.User) *models.User) *UserConverter_ToModel_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2,7 +2,9 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+)
 
 // UuidGenerator is an autogenerated mock type for the uuidGenerator type
 type UuidGenerator struct {
@@ -17,7 +19,7 @@ func (_m *UuidGenerator) EXPECT() *UuidGenerator_Expecter {
 	return &UuidGenerator_Expecter{mock: &_m.Mock}
 }
 
-// Generate provides a mock function with no fields
+// Generate provides a mock function with given fields:
 func (_m *UuidGenerator) Generate() string {
 	ret := _m.Called()
 
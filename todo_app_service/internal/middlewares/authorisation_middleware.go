@@ -4,6 +4,7 @@ import (
 	"Todo-List/internProject/todo_app_service/internal/persistence"
 	"Todo-List/internProject/todo_app_service/internal/utils"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/jwt"
 	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
@@ -15,26 +16,48 @@ type ctxKeyType struct{}
 
 var UserKey = ctxKeyType{}
 
+type currentSessionKeyType struct{}
+
+// CurrentSessionId is the id of the session the access token of the request was issued for
+var CurrentSessionId = currentSessionKeyType{}
+
+//go:generate mockery --name=UserService --exported --output=./mocks --outpkg=mocks --filename=user_service.go --with-expecter=true
 type UserService interface {
 	GetUserRecordByEmail(context.Context, string) (*models.User, error)
 }
 
+//go:generate mockery --name=jwtParser --exported --output=./mocks --outpkg=mocks --filename=jwt_parser.go --with-expecter=true
 type jwtParser interface {
 	ParseJWT(context.Context, string) (*jwt.Claims, error)
 }
 
+//go:generate mockery --name=revocationChecker --exported --output=./mocks --outpkg=mocks --filename=revocation_checker.go --with-expecter=true
+type revocationChecker interface {
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+//go:generate mockery --name=sessionChecker --exported --output=./mocks --outpkg=mocks --filename=session_checker.go --with-expecter=true
+type sessionChecker interface {
+	SessionExists(ctx context.Context, sessionId string) (bool, error)
+}
+
 type authorisationMiddleware struct {
 	next        http.Handler
 	service     UserService
 	tokenParser jwtParser
+	revocations revocationChecker
+	sessions    sessionChecker
 	transact    persistence.Transactioner
 }
 
-func newAuthorisationMiddleware(next http.Handler, service UserService, tokenParser jwtParser, transact persistence.Transactioner) *authorisationMiddleware {
+func newAuthorisationMiddleware(next http.Handler, service UserService, tokenParser jwtParser, revocations revocationChecker,
+	sessions sessionChecker, transact persistence.Transactioner) *authorisationMiddleware {
 	return &authorisationMiddleware{
 		next:        next,
 		service:     service,
 		tokenParser: tokenParser,
+		revocations: revocations,
+		sessions:    sessions,
 		transact:    transact,
 	}
 }
@@ -60,6 +83,37 @@ func (a *authorisationMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Reque
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
+	// the token was logged out or its session was revoked before it expired
+	revoked, err := a.revocations.IsAccessTokenRevoked(ctx, jwtClaims.ID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check if the access token is revoked, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if revoked {
+		log.C(ctx).Errorf("access token with id %s has been revoked", jwtClaims.ID)
+		utils.EncodeError(w, constants.REVOKED_ACCESS_TOKEN, http.StatusUnauthorized)
+		return
+	}
+
+	// revoking a session ends it for every access token issued to it, not only the last one whose id was blacklisted,
+	// access tokens issued before sessions existed carry no session id and are only checked against the blacklist
+	if jwtClaims.SessionId != "" {
+		exists, err := a.sessions.SessionExists(ctx, jwtClaims.SessionId)
+		if err != nil {
+			log.C(ctx).Errorf("failed to check if the session of the access token exists, error %s", err.Error())
+			utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if !exists {
+			log.C(ctx).Errorf("session with id %s of the access token has been revoked", jwtClaims.SessionId)
+			utils.EncodeError(w, constants.REVOKED_ACCESS_TOKEN, http.StatusUnauthorized)
+			return
+		}
+	}
+
 	user, err := a.service.GetUserRecordByEmail(ctx, jwtClaims.Email)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get user by email, internal server error... %s", err.Error())
@@ -74,11 +128,13 @@ func (a *authorisationMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 
 	ctx = context.WithValue(ctx, UserKey, user)
+	ctx = context.WithValue(ctx, CurrentSessionId, jwtClaims.SessionId)
 	a.next.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func AuthorisationMiddlewareFunc(service UserService, tokenParser jwtParser, transact persistence.Transactioner) mux.MiddlewareFunc {
+func AuthorisationMiddlewareFunc(service UserService, tokenParser jwtParser, revocations revocationChecker, sessions sessionChecker,
+	transact persistence.Transactioner) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
-		return newAuthorisationMiddleware(next, service, tokenParser, transact)
+		return newAuthorisationMiddleware(next, service, tokenParser, revocations, sessions, transact)
 	}
 }
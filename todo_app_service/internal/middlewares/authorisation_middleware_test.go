@@ -1,5 +1,21 @@
 package middlewares
 
+import (
+	"Todo-List/internProject/todo_app_service/internal/middlewares/mocks"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/jwt"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"errors"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
 /*
 import (
 	"github.com/stretchr/testify/require"
@@ -115,3 +131,162 @@ func initAuthorizedUsers() map[string]models.User {
 	}
 }
 */
+
+func TestAuthorisationMiddleware_RevokedAccessTokens(t *testing.T) {
+	const (
+		authHeader = "signed access token"
+		tokenId    = "access token id"
+		sessionId  = "session id"
+	)
+
+	user := &models.User{Id: "user id", Email: testEmail, Role: constants.Writer}
+	claims := &jwt.Claims{
+		Email:            testEmail,
+		SessionId:        sessionId,
+		RegisteredClaims: jwtv5.RegisteredClaims{ID: tokenId},
+	}
+	legacyClaims := &jwt.Claims{
+		Email:            testEmail,
+		RegisteredClaims: jwtv5.RegisteredClaims{ID: tokenId},
+	}
+	errDb := errors.New("database error")
+
+	tests := []struct {
+		testName           string
+		claims             *jwt.Claims
+		revoked            bool
+		revocationErr      error
+		sessionCheck       func() *mocks.SessionChecker
+		dbMock             func(mck sqlmock.Sqlmock)
+		shouldCallNext     bool
+		expectedHttpCode   int
+		expectedErrMessage string
+	}{
+		{
+			testName: "Access token is not revoked and its session exists so the middleware calls next",
+			claims:   claims,
+			sessionCheck: func() *mocks.SessionChecker {
+				mck := &mocks.SessionChecker{}
+				mck.EXPECT().SessionExists(mock.Anything, sessionId).Return(true, nil).Once()
+				return mck
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			shouldCallNext:   true,
+			expectedHttpCode: http.StatusOK,
+		},
+		{
+			testName: "Access token was logged out so the middleware encodes httpStatusUnauthorized without checking its session",
+			claims:   claims,
+			revoked:  true,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedHttpCode:   http.StatusUnauthorized,
+			expectedErrMessage: constants.REVOKED_ACCESS_TOKEN,
+		},
+		{
+			testName: "Session of the access token was revoked so the middleware encodes httpStatusUnauthorized",
+			claims:   claims,
+			sessionCheck: func() *mocks.SessionChecker {
+				mck := &mocks.SessionChecker{}
+				mck.EXPECT().SessionExists(mock.Anything, sessionId).Return(false, nil).Once()
+				return mck
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedHttpCode:   http.StatusUnauthorized,
+			expectedErrMessage: constants.REVOKED_ACCESS_TOKEN,
+		},
+		{
+			testName: "Access token issued before sessions existed is only checked against the revoked tokens",
+			claims:   legacyClaims,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			shouldCallNext:   true,
+			expectedHttpCode: http.StatusOK,
+		},
+		{
+			testName:      "Failed to check if the access token is revoked so the middleware encodes httpStatusInternalServerError",
+			claims:        claims,
+			revocationErr: errDb,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedHttpCode:   http.StatusInternalServerError,
+			expectedErrMessage: errDb.Error(),
+		},
+		{
+			testName: "Failed to check if the session exists so the middleware encodes httpStatusInternalServerError",
+			claims:   claims,
+			sessionCheck: func() *mocks.SessionChecker {
+				mck := &mocks.SessionChecker{}
+				mck.EXPECT().SessionExists(mock.Anything, sessionId).Return(false, errDb).Once()
+				return mck
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedHttpCode:   http.StatusInternalServerError,
+			expectedErrMessage: errDb.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			parserMock := &mocks.JwtParser{}
+			parserMock.EXPECT().ParseJWT(mock.Anything, authHeader).Return(test.claims, nil).Once()
+
+			revocationMock := &mocks.RevocationChecker{}
+			revocationMock.EXPECT().IsAccessTokenRevoked(mock.Anything, tokenId).Return(test.revoked, test.revocationErr).Once()
+
+			sessionMock := &mocks.SessionChecker{}
+			if test.sessionCheck != nil {
+				sessionMock = test.sessionCheck()
+			}
+
+			userServiceMock := &mocks.UserService{}
+			if test.shouldCallNext {
+				userServiceMock.EXPECT().GetUserRecordByEmail(mock.Anything, testEmail).Return(user, nil).Once()
+			}
+
+			isNextCalled := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				isNextCalled = true
+				require.Equal(t, user, r.Context().Value(UserKey))
+				require.Equal(t, test.claims.SessionId, r.Context().Value(CurrentSessionId))
+			})
+
+			middleware := newAuthorisationMiddleware(next, userServiceMock, parserMock, revocationMock, sessionMock, persistence.NewSqlDb(db))
+			rr := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", authHeader)
+
+			middleware.ServeHTTP(rr, req)
+
+			if test.expectedErrMessage != "" {
+				require.JSONEq(t, `{"error":"`+test.expectedErrMessage+`"}`, rr.Body.String())
+			}
+
+			require.Equal(t, test.expectedHttpCode, rr.Code)
+			require.Equal(t, test.shouldCallNext, isNextCalled)
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, parserMock, revocationMock, sessionMock, userServiceMock)
+		})
+	}
+}
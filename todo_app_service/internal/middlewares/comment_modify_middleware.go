@@ -0,0 +1,91 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type commentService interface {
+	GetCommentRecord(ctx context.Context, todoId string, commentId string) (*models.Comment, error)
+}
+
+type commentModifyMiddleware struct {
+	next     http.Handler
+	serv     commentService
+	transact persistence.Transactioner
+}
+
+func newCommentModifyMiddleware(next http.Handler, serv commentService, transact persistence.Transactioner) *commentModifyMiddleware {
+	return &commentModifyMiddleware{
+		next:     next,
+		serv:     serv,
+		transact: transact,
+	}
+}
+
+func (c *commentModifyMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value(UserKey).(*models.User)
+	if !ok {
+		log.C(ctx).Error("failed to serve http, empty user in context...")
+		utils.EncodeError(w, "nil value in context", http.StatusInternalServerError)
+		return
+	}
+
+	todoId, ok := ctx.Value(TodoId).(string)
+	if !ok {
+		log.C(ctx).Error("failed to serve http, empty todo_id in context...")
+		utils.EncodeError(w, "nil value in context", http.StatusInternalServerError)
+		return
+	}
+
+	commentId, ok := ctx.Value(CommentId).(string)
+	if !ok {
+		log.C(ctx).Error("failed to serve http, empty comment_id in context...")
+		utils.EncodeError(w, "nil value in context", http.StatusInternalServerError)
+		return
+	}
+
+	tx, err := c.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in comment modify middleware, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer c.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	comment, err := c.serv.GetCommentRecord(ctx, todoId, commentId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to serve http, error %s when trying to get comment with id %s", err.Error(), commentId)
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction in comment modify middleware, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if user.Role != constants.Admin && user.Id != comment.Author {
+		utils.EncodeError(w, "access forbidden: only administrators or the comment author may modify comment", http.StatusForbidden)
+		return
+	}
+
+	c.next.ServeHTTP(w, r)
+}
+
+func CommentModifyMiddlewareFunc(serv commentService, transact persistence.Transactioner) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return newCommentModifyMiddleware(next, serv, transact)
+	}
+}
@@ -1,8 +1,8 @@
 package middlewares
 
 import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"github.com/stretchr/testify/require"
-	"internProject/todo_app_service/pkg/constants"
 	"net/http"
 	"net/http/httptest"
 	"testing"
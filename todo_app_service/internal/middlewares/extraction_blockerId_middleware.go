@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type blockerIdKey struct{}
+
+var BlockerId = blockerIdKey{}
+
+type extractionBlockerIdMiddleware struct {
+	next http.Handler
+}
+
+func newExtractionBlockerIdMiddleware(next http.Handler) *extractionBlockerIdMiddleware {
+	return &extractionBlockerIdMiddleware{next: next}
+}
+
+func (e *extractionBlockerIdMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	blockerId, ok := params["blocker_id"]
+	if !ok {
+		utils.EncodeError(w, errors.New("invalid request: missing blocker_id").Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, BlockerId, blockerId)
+	e.next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func ExtractionBlockerIdMiddlewareFunc(next http.Handler) http.Handler {
+	return newExtractionBlockerIdMiddleware(next)
+}
@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type columnIdKey struct{}
+
+var ColumnId = columnIdKey{}
+
+type extractionColumnIdMiddleware struct {
+	next http.Handler
+}
+
+func newExtractionColumnIdMiddleware(next http.Handler) *extractionColumnIdMiddleware {
+	return &extractionColumnIdMiddleware{next: next}
+}
+
+func (e *extractionColumnIdMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	columnId, ok := params["column_id"]
+	if !ok {
+		utils.EncodeError(w, errors.New("invalid request: missing column_id").Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, ColumnId, columnId)
+	e.next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func ExtractionColumnIdMiddlewareFunc(next http.Handler) http.Handler {
+	return newExtractionColumnIdMiddleware(next)
+}
@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type commentIdKey struct{}
+
+var CommentId = commentIdKey{}
+
+type extractionCommentIdMiddleware struct {
+	next http.Handler
+}
+
+func newExtractionCommentIdMiddleware(next http.Handler) *extractionCommentIdMiddleware {
+	return &extractionCommentIdMiddleware{next: next}
+}
+
+func (e *extractionCommentIdMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	commentId, ok := params["comment_id"]
+	if !ok {
+		utils.EncodeError(w, errors.New("invalid request: missing comment_id").Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, CommentId, commentId)
+	e.next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func ExtractionCommentIdMiddlewareFunc(next http.Handler) http.Handler {
+	return newExtractionCommentIdMiddleware(next)
+}
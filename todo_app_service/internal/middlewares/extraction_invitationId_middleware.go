@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type invitationIdKey struct{}
+
+var InvitationId = invitationIdKey{}
+
+type extractionInvitationIdMiddleware struct {
+	next http.Handler
+}
+
+func newExtractionInvitationIdMiddleware(next http.Handler) *extractionInvitationIdMiddleware {
+	return &extractionInvitationIdMiddleware{next: next}
+}
+
+func (e *extractionInvitationIdMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	invitationId, ok := params["invitation_id"]
+	if !ok {
+		utils.EncodeError(w, errors.New("invalid request: missing invitation_id").Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, InvitationId, invitationId)
+	e.next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func ExtractionInvitationIdMiddlewareFunc(next http.Handler) http.Handler {
+	return newExtractionInvitationIdMiddleware(next)
+}
@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type labelIdKey struct{}
+
+var LabelId = labelIdKey{}
+
+type extractionLabelIdMiddleware struct {
+	next http.Handler
+}
+
+func newExtractionLabelIdMiddleware(next http.Handler) *extractionLabelIdMiddleware {
+	return &extractionLabelIdMiddleware{next: next}
+}
+
+func (e *extractionLabelIdMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	labelId, ok := params["label_id"]
+	if !ok {
+		utils.EncodeError(w, errors.New("invalid request: missing label_id").Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, LabelId, labelId)
+	e.next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func ExtractionLabelIdMiddlewareFunc(next http.Handler) http.Handler {
+	return newExtractionLabelIdMiddleware(next)
+}
@@ -0,0 +1,46 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type providerKey struct{}
+
+var Provider = providerKey{}
+
+type extractionProviderMiddleware struct {
+	next http.Handler
+}
+
+func newExtractionProviderMiddleware(next http.Handler) *extractionProviderMiddleware {
+	return &extractionProviderMiddleware{next: next}
+}
+
+func (e *extractionProviderMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	provider, ok := params["provider"]
+	if !ok {
+		utils.EncodeError(w, errors.New("invalid request: missing provider").Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, Provider, provider)
+	e.next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func ExtractionProviderMiddlewareFunc(next http.Handler) http.Handler {
+	return newExtractionProviderMiddleware(next)
+}
+
+// FixedProviderMiddlewareFunc sets the provider for routes which do not carry it in the path
+func FixedProviderMiddlewareFunc(provider string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), Provider, provider)))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type sessionIdKey struct{}
+
+var SessionId = sessionIdKey{}
+
+type extractionSessionIdMiddleware struct {
+	next http.Handler
+}
+
+func newExtractionSessionIdMiddleware(next http.Handler) *extractionSessionIdMiddleware {
+	return &extractionSessionIdMiddleware{next: next}
+}
+
+func (e *extractionSessionIdMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	sessionId, ok := params["session_id"]
+	if !ok {
+		utils.EncodeError(w, errors.New("invalid request: missing session_id").Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, SessionId, sessionId)
+	e.next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func ExtractionSessionIdMiddlewareFunc(next http.Handler) http.Handler {
+	return newExtractionSessionIdMiddleware(next)
+}
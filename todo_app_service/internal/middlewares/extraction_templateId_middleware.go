@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type templateIdKey struct{}
+
+var TemplateId = templateIdKey{}
+
+type extractionTemplateIdMiddleware struct {
+	next http.Handler
+}
+
+func newExtractionTemplateIdMiddleware(next http.Handler) *extractionTemplateIdMiddleware {
+	return &extractionTemplateIdMiddleware{next: next}
+}
+
+func (e *extractionTemplateIdMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	templateId, ok := params["template_id"]
+	if !ok {
+		utils.EncodeError(w, errors.New("invalid request: missing template_id").Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, TemplateId, templateId)
+	e.next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func ExtractionTemplateIdMiddlewareFunc(next http.Handler) http.Handler {
+	return newExtractionTemplateIdMiddleware(next)
+}
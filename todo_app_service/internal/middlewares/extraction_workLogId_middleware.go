@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type workLogIdKey struct{}
+
+var WorkLogId = workLogIdKey{}
+
+type extractionWorkLogIdMiddleware struct {
+	next http.Handler
+}
+
+func newExtractionWorkLogIdMiddleware(next http.Handler) *extractionWorkLogIdMiddleware {
+	return &extractionWorkLogIdMiddleware{next: next}
+}
+
+func (e *extractionWorkLogIdMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	workLogId, ok := params["work_log_id"]
+	if !ok {
+		utils.EncodeError(w, errors.New("invalid request: missing work_log_id").Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, WorkLogId, workLogId)
+	e.next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func ExtractionWorkLogIdMiddlewareFunc(next http.Handler) http.Handler {
+	return newExtractionWorkLogIdMiddleware(next)
+}
@@ -0,0 +1,84 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type labelService interface {
+	GetLabelRecord(ctx context.Context, labelId string) (*models.Label, error)
+}
+
+type labelAccessMiddleware struct {
+	next     http.Handler
+	serv     labelService
+	transact persistence.Transactioner
+}
+
+func newLabelAccessMiddleware(next http.Handler, serv labelService, transact persistence.Transactioner) *labelAccessMiddleware {
+	return &labelAccessMiddleware{
+		next:     next,
+		serv:     serv,
+		transact: transact,
+	}
+}
+
+func (l *labelAccessMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value(UserKey).(*models.User)
+	if !ok {
+		log.C(ctx).Error("failed to serve http, empty user in context...")
+		utils.EncodeError(w, "nil value in context", http.StatusInternalServerError)
+		return
+	}
+
+	labelId, ok := ctx.Value(LabelId).(string)
+	if !ok {
+		log.C(ctx).Error("failed to serve http, empty label_id in context...")
+		utils.EncodeError(w, "nil value in context", http.StatusInternalServerError)
+		return
+	}
+
+	tx, err := l.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in label access middleware, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer l.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	label, err := l.serv.GetLabelRecord(ctx, labelId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to serve http, error %s when trying to get label with id %s", err.Error(), labelId)
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction in label access middleware, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if user.Role != constants.Admin && user.Id != label.Owner {
+		utils.EncodeError(w, "access forbidden: only administrators or the label owner may access/modify label", http.StatusForbidden)
+		return
+	}
+
+	l.next.ServeHTTP(w, r)
+}
+
+func LabelAccessMiddlewareFunc(serv labelService, transact persistence.Transactioner) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return newLabelAccessMiddleware(next, serv, transact)
+	}
+}
@@ -25,7 +25,7 @@ func (l *listDeletionMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	}
 
 	if userRole.role != constants.Admin && !userRole.isOwner {
-		utils.EncodeError(w, "only admins and list owner can delete list", http.StatusForbidden)
+		utils.EncodeError(w, "only admins and list owner can delete list or transfer its ownership", http.StatusForbidden)
 		return
 	}
 	l.next.ServeHTTP(w, r)
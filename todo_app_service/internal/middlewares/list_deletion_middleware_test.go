@@ -40,15 +40,15 @@ func TestListDeletionMiddleware_ServeHTTP(t *testing.T) {
 			http.StatusOK,
 		},
 		{
-			"User from the context is unauthorized so the middlewares encodes httpStatusUnauthorized",
+			"User from the context is neither an admin nor the owner so the middlewares encodes httpStatusForbidden",
 			false,
 			true,
-			`{"error":"unauthorized"}` + "\n",
+			`{"error":"only admins and list owner can delete list or transfer its ownership"}` + "\n",
 			userRoleKey{
 				role:    "writer",
 				isOwner: false,
 			},
-			http.StatusUnauthorized,
+			http.StatusForbidden,
 		},
 	}
 
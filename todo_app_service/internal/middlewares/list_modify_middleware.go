@@ -14,8 +14,9 @@ import (
 )
 
 type userRoleKey struct {
-	role    constants.UserRole
-	isOwner bool
+	role     constants.UserRole
+	isOwner  bool
+	listRole constants.CollaboratorRole
 }
 
 var UserRoleKey = userRoleKey{}
@@ -74,12 +75,12 @@ func (a *listModifyMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	if ctxUser.Id == list.Owner {
-		configUserRoleKey(ctxUser.Role, true, a.next, w, r)
+		configUserRoleKey(ctxUser.Role, true, "", a.next, w, r)
 		return
 	}
 
 	if ctxUser.Role == constants.Admin {
-		configUserRoleKey(ctxUser.Role, false, a.next, w, r)
+		configUserRoleKey(ctxUser.Role, false, "", a.next, w, r)
 		return
 	}
 
@@ -101,7 +102,7 @@ func (a *listModifyMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	authUsers := authUsersPage.Data
 	for _, user := range authUsers {
 		if ctxUser.Id == user.Id {
-			configUserRoleKey(ctxUser.Role, false, a.next, w, r)
+			configUserRoleKey(ctxUser.Role, false, user.ListRole, a.next, w, r)
 			return
 		}
 	}
@@ -115,10 +116,11 @@ func ListAccessPermissionMiddlewareFunc(serv listService, transact persistence.T
 	}
 }
 
-func configUserRoleKey(userRole constants.UserRole, isOwner bool, next http.Handler, w http.ResponseWriter, r *http.Request) {
+func configUserRoleKey(userRole constants.UserRole, isOwner bool, listRole constants.CollaboratorRole, next http.Handler, w http.ResponseWriter, r *http.Request) {
 	uRole := userRoleKey{
-		role:    userRole,
-		isOwner: isOwner,
+		role:     userRole,
+		isOwner:  isOwner,
+		listRole: listRole,
 	}
 	ctx := context.WithValue(r.Context(), UserRoleKey, uRole)
 	next.ServeHTTP(w, r.WithContext(ctx))
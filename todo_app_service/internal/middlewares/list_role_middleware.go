@@ -0,0 +1,57 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"fmt"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+var collaboratorRoleRanks = map[constants.CollaboratorRole]int{
+	constants.Viewer:  1,
+	constants.Editor:  2,
+	constants.Manager: 3,
+}
+
+type listRoleMiddleware struct {
+	next        http.Handler
+	minimumRole constants.CollaboratorRole
+}
+
+func newListRoleMiddleware(next http.Handler, minimumRole constants.CollaboratorRole) *listRoleMiddleware {
+	return &listRoleMiddleware{
+		next:        next,
+		minimumRole: minimumRole,
+	}
+}
+
+func (l *listRoleMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userRole, ok := r.Context().Value(UserRoleKey).(userRoleKey)
+	if !ok {
+		log.C(ctx).Error("failed to retrieve userRole from context...")
+		utils.EncodeError(w, "nil value in context", http.StatusInternalServerError)
+		return
+	}
+
+	if userRole.role != constants.Admin && !userRole.isOwner && !hasCollaboratorRole(userRole.listRole, l.minimumRole) {
+		utils.EncodeError(w, fmt.Sprintf("access forbidden: only administrators, the list owner or collaborators with at least %s role may perform this action", l.minimumRole), http.StatusForbidden)
+		return
+	}
+
+	l.next.ServeHTTP(w, r)
+}
+
+// ListRoleMiddlewareFunc lets through only the administrators, the list owner and the collaborators whose role
+// in the list is at least the given one, it has to run after ListAccessPermissionMiddlewareFunc
+func ListRoleMiddlewareFunc(minimumRole constants.CollaboratorRole) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return newListRoleMiddleware(next, minimumRole)
+	}
+}
+
+func hasCollaboratorRole(role constants.CollaboratorRole, minimumRole constants.CollaboratorRole) bool {
+	return collaboratorRoleRanks[role] >= collaboratorRoleRanks[minimumRole]
+}
@@ -0,0 +1,91 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListRoleMiddleware_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		testName         string
+		minimumRole      constants.CollaboratorRole
+		shouldCallNext   bool
+		requestContext   userRoleKey
+		expectedHttpCode int
+	}{
+		{
+			"User from the context is an admin so the middleware successfully calls next",
+			constants.Manager,
+			true,
+			userRoleKey{
+				role:    constants.Admin,
+				isOwner: false,
+			},
+			http.StatusOK,
+		},
+		{
+			"User from the context is the list owner so the middleware successfully calls next",
+			constants.Manager,
+			true,
+			userRoleKey{
+				role:    constants.Writer,
+				isOwner: true,
+			},
+			http.StatusOK,
+		},
+		{
+			"User from the context is a manager so the middleware successfully calls next for editor actions",
+			constants.Editor,
+			true,
+			userRoleKey{
+				role:     constants.Writer,
+				listRole: constants.Manager,
+			},
+			http.StatusOK,
+		},
+		{
+			"User from the context is an editor so the middleware encodes httpStatusForbidden for manager actions",
+			constants.Manager,
+			false,
+			userRoleKey{
+				role:     constants.Writer,
+				listRole: constants.Editor,
+			},
+			http.StatusForbidden,
+		},
+		{
+			"User from the context is a viewer so the middleware encodes httpStatusForbidden for editor actions",
+			constants.Editor,
+			false,
+			userRoleKey{
+				role:     constants.Writer,
+				listRole: constants.Viewer,
+			},
+			http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			isNextCalled := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				isNextCalled = true
+			})
+
+			rr := httptest.NewRecorder()
+			ctx := context.Background()
+			ctx = context.WithValue(ctx, UserRoleKey, test.requestContext)
+			req := httptest.NewRequestWithContext(ctx, http.MethodPatch, "/", nil)
+
+			middleware := ListRoleMiddlewareFunc(test.minimumRole)(next)
+			middleware.ServeHTTP(rr, req)
+
+			require.Equal(t, test.shouldCallNext, isNextCalled)
+			require.Equal(t, test.expectedHttpCode, rr.Code)
+		})
+	}
+}
@@ -3,13 +3,14 @@
 package mocks
 
 import (
-	models "Todo-List/internProject/todo_app_service/pkg/models"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	models "Todo-List/internProject/todo_app_service/pkg/models"
 )
 
-// UserService is an autogenerated mock type for the userService type
+// UserService is an autogenerated mock type for the UserService type
 type UserService struct {
 	mock.Mock
 }
@@ -1,9 +1,9 @@
 package middlewares
 
 import (
+	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
 	"github.com/stretchr/testify/require"
-	"internProject/todo_app_service/pkg/models"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -43,15 +43,15 @@ func TestObjectCreationMiddleware_ServeHTTP(t *testing.T) {
 			http.StatusOK,
 		},
 		{
-			"User from the context is not an admin or writer so the middleware enocodes httpStatusUnauthorized",
+			"User from the context is not an admin or writer so the middleware enocodes httpStatusForbidden",
 			true,
-			`{"error":"unauthorized user"}` + "\n",
+			`{"error":"only admins and writers can create or modify entities"}` + "\n",
 			false,
 			&models.User{
 				Email: "email",
 				Role:  "role",
 			},
-			http.StatusUnauthorized,
+			http.StatusForbidden,
 		},
 	}
 
@@ -60,7 +60,7 @@ func TestObjectCreationMiddleware_ServeHTTP(t *testing.T) {
 			isNextCalled := false
 			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				isNextCalled = true
-				user := r.Context().Value(UserKey).(models.User)
+				user := r.Context().Value(UserKey).(*models.User)
 				require.Equal(t, test.contextValue, user)
 			})
 
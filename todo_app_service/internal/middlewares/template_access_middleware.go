@@ -0,0 +1,84 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type templateService interface {
+	GetTemplateRecord(ctx context.Context, templateId string) (*models.Template, error)
+}
+
+type templateAccessMiddleware struct {
+	next     http.Handler
+	serv     templateService
+	transact persistence.Transactioner
+}
+
+func newTemplateAccessMiddleware(next http.Handler, serv templateService, transact persistence.Transactioner) *templateAccessMiddleware {
+	return &templateAccessMiddleware{
+		next:     next,
+		serv:     serv,
+		transact: transact,
+	}
+}
+
+func (t *templateAccessMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value(UserKey).(*models.User)
+	if !ok {
+		log.C(ctx).Error("failed to serve http, empty user in context...")
+		utils.EncodeError(w, "nil value in context", http.StatusInternalServerError)
+		return
+	}
+
+	templateId, ok := ctx.Value(TemplateId).(string)
+	if !ok {
+		log.C(ctx).Error("failed to serve http, empty template_id in context...")
+		utils.EncodeError(w, "nil value in context", http.StatusInternalServerError)
+		return
+	}
+
+	tx, err := t.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in template access middleware, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer t.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	template, err := t.serv.GetTemplateRecord(ctx, templateId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to serve http, error %s when trying to get template with id %s", err.Error(), templateId)
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction in template access middleware, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if user.Role != constants.Admin && user.Id != template.Owner {
+		utils.EncodeError(w, "access forbidden: only administrators or the template owner may access/modify template", http.StatusForbidden)
+		return
+	}
+
+	t.next.ServeHTTP(w, r)
+}
+
+func TemplateAccessMiddlewareFunc(serv templateService, transact persistence.Transactioner) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return newTemplateAccessMiddleware(next, serv, transact)
+	}
+}
@@ -13,11 +13,14 @@ import (
 	"net/http"
 )
 
+//go:generate mockery --name=tService --exported --output=./mocks --outpkg=mocks --filename=t_service.go --with-expecter=true
 type tService interface {
 	GetTodoRecord(context.Context, string) (*models.Todo, error)
 	GetTodoAssigneeToRecord(context.Context, string) (*models.User, error)
+	IsTodoAssigneeRecord(context.Context, string, string) (bool, error)
 }
 
+//go:generate mockery --name=lService --exported --output=./mocks --outpkg=mocks --filename=l_service.go --with-expecter=true
 type lService interface {
 	GetListOwnerRecord(context.Context, string) (*models.User, error)
 	GetCollaborators(ctx context.Context, listId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.UserPage, error)
@@ -98,10 +101,28 @@ func (t *todoModifyMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if !determineWhetherUserHasAccess(assignee, listOwner, user, collaboratorsPage.Data) {
+	// the viewers of the list may only read the todo, changing it needs at least the editor role
+	minimumRole := constants.Editor
+	if r.Method == http.MethodGet {
+		minimumRole = constants.Viewer
+	}
+
+	hasAccess := determineWhetherUserHasAccess(assignee, listOwner, user, collaboratorsPage.Data, minimumRole)
+	if !hasAccess {
+		// the extra assignees of the todo have the same rights as the user the todo is assigned to
+		hasAccess, err = t.todoService.IsTodoAssigneeRecord(ctx, todoId, user.Id)
+		if err != nil {
+			log.C(ctx).Errorf("failed to serve http, error %s when trying to check whether user is todo assignee", err.Error())
+
+			utils.EncodeErrorWithCorrectStatusCode(w, err)
+			return
+		}
+	}
+
+	if !hasAccess {
 		utils.EncodeError(
 			w,
-			"forbidden: only administrators, collaborators, list owners, or the assignee may access todo",
+			"forbidden: only administrators, collaborators with sufficient role, list owners, or the assignees may access todo",
 			http.StatusForbidden,
 		)
 		return
@@ -122,10 +143,10 @@ func NewTodoModifyMiddlewareFunc(todoService tService, listService lService, tra
 	}
 }
 
-func isCollaborator(collaborators []*models.User, user *models.User) bool {
+func isCollaborator(collaborators []*models.User, user *models.User, minimumRole constants.CollaboratorRole) bool {
 	for _, collab := range collaborators {
 		if collab.Id == user.Id {
-			return true
+			return hasCollaboratorRole(collab.ListRole, minimumRole)
 		}
 	}
 	return false
@@ -145,10 +166,10 @@ func determineAssigneeFlag(assignee *models.User, candidate *models.User) bool {
 	return flag
 }
 
-func determineWhetherUserHasAccess(assignee *models.User, listOwner *models.User, user *models.User, collaborators []*models.User) bool {
+func determineWhetherUserHasAccess(assignee *models.User, listOwner *models.User, user *models.User, collaborators []*models.User, minimumRole constants.CollaboratorRole) bool {
 	flag := determineAssigneeFlag(assignee, user)
 
-	if !flag && user.Role != constants.Admin && user.Id != listOwner.Id && !isCollaborator(collaborators, user) {
+	if !flag && user.Role != constants.Admin && user.Id != listOwner.Id && !isCollaborator(collaborators, user, minimumRole) {
 		return false
 	}
 
@@ -0,0 +1,130 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/middlewares/mocks"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTodoModifyMiddleware_ServeHTTP(t *testing.T) {
+	const todoId = "todo1"
+
+	owner := &models.User{Id: "owner id", Email: ownerEmail, Role: constants.Writer}
+	caller := &models.User{Id: "caller id", Email: testEmail, Role: constants.Writer}
+	todo := &models.Todo{Id: todoId, ListId: listId}
+	errDb := errors.New("database error")
+
+	tests := []struct {
+		testName           string
+		collaborators      []*models.User
+		isTodoAssignee     func(mck *mocks.TService)
+		dbMock             func(mck sqlmock.Sqlmock)
+		shouldCallNext     bool
+		expectedHttpCode   int
+		expectedErrMessage string
+	}{
+		{
+			testName:      "User is an editor of the list so the middleware calls next without checking the todo assignees",
+			collaborators: []*models.User{{Id: caller.Id, ListRole: constants.Editor}},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			shouldCallNext:   true,
+			expectedHttpCode: http.StatusOK,
+		},
+		{
+			testName:      "User is a viewer of the list but one of the todo assignees so the middleware calls next",
+			collaborators: []*models.User{{Id: caller.Id, ListRole: constants.Viewer}},
+			isTodoAssignee: func(mck *mocks.TService) {
+				mck.EXPECT().IsTodoAssigneeRecord(mock.Anything, todoId, caller.Id).Return(true, nil).Once()
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			shouldCallNext:   true,
+			expectedHttpCode: http.StatusOK,
+		},
+		{
+			testName:      "User is a viewer of the list and not one of the todo assignees so the middleware encodes httpStatusForbidden",
+			collaborators: []*models.User{{Id: caller.Id, ListRole: constants.Viewer}},
+			isTodoAssignee: func(mck *mocks.TService) {
+				mck.EXPECT().IsTodoAssigneeRecord(mock.Anything, todoId, caller.Id).Return(false, nil).Once()
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedHttpCode:   http.StatusForbidden,
+			expectedErrMessage: "forbidden: only administrators, collaborators with sufficient role, list owners, or the assignees may access todo",
+		},
+		{
+			testName: "Failed to check the todo assignees so the middleware encodes httpStatusInternalServerError",
+			isTodoAssignee: func(mck *mocks.TService) {
+				mck.EXPECT().IsTodoAssigneeRecord(mock.Anything, todoId, caller.Id).Return(false, errDb).Once()
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedHttpCode:   http.StatusInternalServerError,
+			expectedErrMessage: errDb.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			todoServiceMock := &mocks.TService{}
+			todoServiceMock.EXPECT().GetTodoRecord(mock.Anything, todoId).Return(todo, nil).Once()
+			todoServiceMock.EXPECT().GetTodoAssigneeToRecord(mock.Anything, todoId).Return(nil, nil).Once()
+			if test.isTodoAssignee != nil {
+				test.isTodoAssignee(todoServiceMock)
+			}
+
+			listServiceMock := &mocks.LService{}
+			listServiceMock.EXPECT().GetListOwnerRecord(mock.Anything, listId).Return(owner, nil).Once()
+			listServiceMock.EXPECT().
+				GetCollaborators(mock.Anything, listId, &filters.UserFilters{ListID: listId}, mock.Anything).
+				Return(&models.UserPage{Data: test.collaborators}, nil).Once()
+
+			isNextCalled := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				isNextCalled = true
+			})
+
+			middleware := newTodoModifyMiddleware(next, todoServiceMock, listServiceMock, persistence.NewSqlDb(db))
+			rr := httptest.NewRecorder()
+			ctx := context.WithValue(context.Background(), TodoId, todoId)
+			ctx = context.WithValue(ctx, UserKey, caller)
+			req := httptest.NewRequestWithContext(ctx, http.MethodPatch, "/", nil)
+
+			middleware.ServeHTTP(rr, req)
+
+			if test.expectedErrMessage != "" {
+				require.JSONEq(t, `{"error":"`+test.expectedErrMessage+`"}`, rr.Body.String())
+			}
+
+			require.Equal(t, test.expectedHttpCode, rr.Code)
+			require.Equal(t, test.shouldCallNext, isNextCalled)
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, todoServiceMock, listServiceMock)
+		})
+	}
+}
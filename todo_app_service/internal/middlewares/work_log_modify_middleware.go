@@ -0,0 +1,91 @@
+package middlewares
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type workLogService interface {
+	GetWorkLogRecord(ctx context.Context, todoId string, workLogId string) (*models.WorkLog, error)
+}
+
+type workLogModifyMiddleware struct {
+	next     http.Handler
+	serv     workLogService
+	transact persistence.Transactioner
+}
+
+func newWorkLogModifyMiddleware(next http.Handler, serv workLogService, transact persistence.Transactioner) *workLogModifyMiddleware {
+	return &workLogModifyMiddleware{
+		next:     next,
+		serv:     serv,
+		transact: transact,
+	}
+}
+
+func (m *workLogModifyMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value(UserKey).(*models.User)
+	if !ok {
+		log.C(ctx).Error("failed to serve http, empty user in context...")
+		utils.EncodeError(w, "nil value in context", http.StatusInternalServerError)
+		return
+	}
+
+	todoId, ok := ctx.Value(TodoId).(string)
+	if !ok {
+		log.C(ctx).Error("failed to serve http, empty todo_id in context...")
+		utils.EncodeError(w, "nil value in context", http.StatusInternalServerError)
+		return
+	}
+
+	workLogId, ok := ctx.Value(WorkLogId).(string)
+	if !ok {
+		log.C(ctx).Error("failed to serve http, empty work_log_id in context...")
+		utils.EncodeError(w, "nil value in context", http.StatusInternalServerError)
+		return
+	}
+
+	tx, err := m.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in work log modify middleware, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer m.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	workLog, err := m.serv.GetWorkLogRecord(ctx, todoId, workLogId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to serve http, error %s when trying to get work log with id %s", err.Error(), workLogId)
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction in work log modify middleware, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if user.Role != constants.Admin && user.Id != workLog.UserId {
+		utils.EncodeError(w, "access forbidden: only administrators or the user who logged the work may modify work log", http.StatusForbidden)
+		return
+	}
+
+	m.next.ServeHTTP(w, r)
+}
+
+func WorkLogModifyMiddlewareFunc(serv workLogService, transact persistence.Transactioner) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return newWorkLogModifyMiddleware(next, serv, transact)
+	}
+}
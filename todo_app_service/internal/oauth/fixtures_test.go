@@ -1,13 +1,16 @@
 package oauth
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
 	"Todo-List/internProject/todo_app_service/internal/oauth/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
 	"encoding/json"
 	"errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -17,24 +20,41 @@ import (
 var (
 	emptyOauthState    = ""
 	expectedOauthState = "expected ready state"
+	expectedOauthNonce = "expected ready nonce"
 	expectedOauthUrl   = "expectedUrl.com"
 	authCode           = "valid auth code"
 	accessToken        = "valid access token"
+	providerName       = "github"
+	unknownProvider    = "unknown provider"
 )
 
+var userIdentity = &models.UserIdentity{
+	Email: "user@example.com",
+	Memberships: models.Memberships{
+		Organizations: []string{"todo-org"},
+		Teams:         []string{"todo-org/maintainers"},
+	},
+}
+
 var (
-	errZeroLengthState                                 = errors.New("zero length state generated")
-	errWhenGeneratingState                             = errors.New("error when generating state")
-	errWhenExchangingToken                             = errors.New("error when trying to exchange token")
-	errEmptyAuthCode                                   = errors.New("empty authorization code")
-	errMissingAuthCodeInUrl                            = errors.New("missing auth code in callback url")
-	errWhenCallingOauthServiceWithExchangeCodeForToken = errors.New("error when trying to exchange auth code for access token")
-	errWhenCallingJwtIssuerGetTokens                   = errors.New("error when trying to get tokens from jwt issuer")
+	errZeroLengthState                         = errors.New("zero length state generated")
+	errZeroLengthNonce                         = errors.New("zero length nonce generated")
+	errInvalidOauthState                       = errors.New("invalid oauth state in callback url")
+	errWhenGeneratingState                     = errors.New("error when generating state")
+	errWhenExchangingToken                     = errors.New("error when trying to exchange token")
+	errEmptyAuthCode                           = errors.New("empty authorization code")
+	errMissingAuthCodeInUrl                    = errors.New("missing auth code in callback url")
+	errWhenCallingOauthServiceWithAuthenticate = errors.New("error when trying to authenticate user with provider")
+	errWhenGettingUserIdentity                 = errors.New("error when trying to get user identity")
+	errUnknownProvider                         = errors.New("oauth provider with id unknown provider not found")
+	errWhenCallingJwtIssuerGetTokens           = errors.New("error when trying to get tokens from jwt issuer")
+	errWhenRevokingTokens                      = errors.New("error when trying to revoke tokens")
 )
 
 var (
-	cookieName = "oauth_state"
-	cookiePath = "/"
+	cookiePath      = "/"
+	frontendUrl     = "http://localhost:3000"
+	nonceAuthOption = oauth2.SetAuthURLParam("nonce", expectedOauthNonce)
 )
 
 var (
@@ -42,14 +62,23 @@ var (
 	refreshToken = "refresh token"
 )
 
-func MatchHttpCookie(cookie *http.Cookie) bool {
-	return cookie.HttpOnly && cookie.Secure &&
-		cookie.Name == cookieName && cookie.Path == cookiePath &&
-		cookie.SameSite == http.SameSiteLaxMode
+func loginCookie(name string, value string) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     cookiePath,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+func withProvider(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), middlewares.Provider, providerName))
 }
 
 func setUpHttpRequestForLogin() *http.Request {
-	return httptest.NewRequestWithContext(context.TODO(), http.MethodGet, "/", nil)
+	return withProvider(httptest.NewRequestWithContext(context.TODO(), http.MethodGet, "/", nil))
 }
 
 func extractErrorFromResponseRecorder(tb testing.TB, rr *httptest.ResponseRecorder, err error) {
@@ -62,28 +91,30 @@ func extractErrorFromResponseRecorder(tb testing.TB, rr *httptest.ResponseRecord
 	require.Equal(tb, expect, got)
 }
 
-func extractCallbackResponseFromResponseRecorder(t *testing.T, rr *httptest.ResponseRecorder) *models.CallbackResponse {
-	var callback models.CallbackResponse
-	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &callback))
-
-	return &callback
-}
-
 func getHttpRecorder() *httptest.ResponseRecorder {
 	return httptest.NewRecorder()
 }
 
-func setUpCorrectRequestMock() *http.Request {
-	target := "/?code=" + url.QueryEscape(authCode)
+func setUpCallbackRequest(state string, stateCookie string) *http.Request {
+	target := "/?code=" + url.QueryEscape(authCode) + "&state=" + url.QueryEscape(state)
 	req := httptest.NewRequest(http.MethodGet, target, nil)
-	return req
+	if stateCookie != "" {
+		req.AddCookie(&http.Cookie{Name: constants.OAUTH_STATE_COOKIE, Value: stateCookie})
+	}
+	req.AddCookie(&http.Cookie{Name: constants.OAUTH_NONCE_COOKIE, Value: expectedOauthNonce})
+
+	return withProvider(req)
+}
+
+func setUpCorrectRequestMock() *http.Request {
+	return setUpCallbackRequest(expectedOauthState, expectedOauthState)
 }
 
 func setUpCorrectOauthServiceMock() *mocks.OauthService {
 	mck := &mocks.OauthService{}
 
-	mck.EXPECT().ExchangeCodeForToken(mock.Anything, authCode).
-		Return(accessToken, nil).Once()
+	mck.EXPECT().Authenticate(mock.Anything, providerName, authCode, expectedOauthNonce).
+		Return(userIdentity, nil).Once()
 
 	return mck
 }
@@ -0,0 +1,39 @@
+package oauth
+
+import (
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"golang.org/x/oauth2"
+)
+
+//go:generate mockery --name=userInfoAggregator --exported --output=./mocks --outpkg=mocks --filename=user_info_aggregator.go --with-expecter=true
+type userInfoAggregator interface {
+	AggregateUserInfo(context.Context, string) (*models.UserIdentity, error)
+}
+
+// gitHubProvider resolves the identity through the GitHub API, including the organizations and teams of the user
+type gitHubProvider struct {
+	oauthConfigurator
+	aggregator userInfoAggregator
+}
+
+func NewGitHubProvider(config oauthConfigurator, aggregator userInfoAggregator) *gitHubProvider {
+	return &gitHubProvider{
+		oauthConfigurator: config,
+		aggregator:        aggregator,
+	}
+}
+
+// UserIdentity ignores the nonce, GitHub does not issue ID tokens the nonce could be checked against
+func (g *gitHubProvider) UserIdentity(ctx context.Context, token *oauth2.Token, _ string) (*models.UserIdentity, error) {
+	log.C(ctx).Info("getting user identity from github")
+
+	identity, err := g.aggregator.AggregateUserInfo(ctx, token.AccessToken)
+	if err != nil {
+		log.C(ctx).Errorf("failed to aggregate github user info, error %s", err.Error())
+		return nil, err
+	}
+
+	return identity, nil
+}
@@ -0,0 +1,72 @@
+package oauth
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/oauth/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"testing"
+)
+
+func TestGitHubProvider_UserIdentity(t *testing.T) {
+	tests := []struct {
+		testName         string
+		aggregatorMock   func() *mocks.UserInfoAggregator
+		err              error
+		expectedIdentity *models.UserIdentity
+	}{
+		{
+			testName: "Successfully getting user identity from github",
+
+			aggregatorMock: func() *mocks.UserInfoAggregator {
+				mck := &mocks.UserInfoAggregator{}
+
+				mck.EXPECT().
+					AggregateUserInfo(context.TODO(), accessToken).
+					Return(userIdentity, nil).
+					Once()
+
+				return mck
+			},
+
+			expectedIdentity: userIdentity,
+		},
+
+		{
+			testName: "Failed to get user identity due to error when aggregating user info",
+
+			aggregatorMock: func() *mocks.UserInfoAggregator {
+				mck := &mocks.UserInfoAggregator{}
+
+				mck.EXPECT().
+					AggregateUserInfo(context.TODO(), accessToken).
+					Return(nil, errWhenGettingUserIdentity).
+					Once()
+
+				return mck
+			},
+
+			err: errWhenGettingUserIdentity,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			aggregatorMock := test.aggregatorMock()
+
+			gProvider := NewGitHubProvider(&mocks.OauthConfigurator{}, aggregatorMock)
+
+			receivedIdentity, err := gProvider.UserIdentity(context.TODO(), &oauth2.Token{AccessToken: accessToken}, expectedOauthNonce)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedIdentity, receivedIdentity)
+			mock.AssertExpectationsForObjects(t, aggregatorMock)
+		})
+	}
+}
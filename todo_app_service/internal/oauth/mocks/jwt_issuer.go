@@ -3,10 +3,11 @@
 package mocks
 
 import (
-	models "Todo-List/internProject/todo_app_service/pkg/models"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	models "Todo-List/internProject/todo_app_service/pkg/models"
 )
 
 // JwtIssuer is an autogenerated mock type for the jwtIssuer type
@@ -22,9 +23,9 @@ func (_m *JwtIssuer) EXPECT() *JwtIssuer_Expecter {
 	return &JwtIssuer_Expecter{mock: &_m.Mock}
 }
 
-// GetTokens provides a mock function with given fields: _a0, _a1
-func (_m *JwtIssuer) GetTokens(_a0 context.Context, _a1 string) (*models.CallbackResponse, error) {
-	ret := _m.Called(_a0, _a1)
+// GetTokens provides a mock function with given fields: _a0, _a1, _a2
+func (_m *JwtIssuer) GetTokens(_a0 context.Context, _a1 *models.UserIdentity, _a2 *models.SessionDevice) (*models.CallbackResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTokens")
@@ -32,19 +33,19 @@ func (_m *JwtIssuer) GetTokens(_a0 context.Context, _a1 string) (*models.Callbac
 
 	var r0 *models.CallbackResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.CallbackResponse, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.UserIdentity, *models.SessionDevice) (*models.CallbackResponse, error)); ok {
+		return rf(_a0, _a1, _a2)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.CallbackResponse); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.UserIdentity, *models.SessionDevice) *models.CallbackResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.CallbackResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.UserIdentity, *models.SessionDevice) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,14 +60,15 @@ type JwtIssuer_GetTokens_Call struct {
 
 // GetTokens is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 string
-func (_e *JwtIssuer_Expecter) GetTokens(_a0 interface{}, _a1 interface{}) *JwtIssuer_GetTokens_Call {
-	return &JwtIssuer_GetTokens_Call{Call: _e.mock.On("GetTokens", _a0, _a1)}
+//   - _a1 *models.UserIdentity
+//   - _a2 *models.SessionDevice
+func (_e *JwtIssuer_Expecter) GetTokens(_a0 interface{}, _a1 interface{}, _a2 interface{}) *JwtIssuer_GetTokens_Call {
+	return &JwtIssuer_GetTokens_Call{Call: _e.mock.On("GetTokens", _a0, _a1, _a2)}
 }
 
-func (_c *JwtIssuer_GetTokens_Call) Run(run func(_a0 context.Context, _a1 string)) *JwtIssuer_GetTokens_Call {
+func (_c *JwtIssuer_GetTokens_Call) Run(run func(_a0 context.Context, _a1 *models.UserIdentity, _a2 *models.SessionDevice)) *JwtIssuer_GetTokens_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(*models.UserIdentity), args[2].(*models.SessionDevice))
 	})
 	return _c
 }
@@ -76,7 +78,55 @@ func (_c *JwtIssuer_GetTokens_Call) Return(_a0 *models.CallbackResponse, _a1 err
 	return _c
 }
 
-func (_c *JwtIssuer_GetTokens_Call) RunAndReturn(run func(context.Context, string) (*models.CallbackResponse, error)) *JwtIssuer_GetTokens_Call {
+func (_c *JwtIssuer_GetTokens_Call) RunAndReturn(run func(context.Context, *models.UserIdentity, *models.SessionDevice) (*models.CallbackResponse, error)) *JwtIssuer_GetTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeTokens provides a mock function with given fields: ctx, accessToken, refreshToken
+func (_m *JwtIssuer) RevokeTokens(ctx context.Context, accessToken string, refreshToken string) error {
+	ret := _m.Called(ctx, accessToken, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, accessToken, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JwtIssuer_RevokeTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeTokens'
+type JwtIssuer_RevokeTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+//   - refreshToken string
+func (_e *JwtIssuer_Expecter) RevokeTokens(ctx interface{}, accessToken interface{}, refreshToken interface{}) *JwtIssuer_RevokeTokens_Call {
+	return &JwtIssuer_RevokeTokens_Call{Call: _e.mock.On("RevokeTokens", ctx, accessToken, refreshToken)}
+}
+
+func (_c *JwtIssuer_RevokeTokens_Call) Run(run func(ctx context.Context, accessToken string, refreshToken string)) *JwtIssuer_RevokeTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *JwtIssuer_RevokeTokens_Call) Return(_a0 error) *JwtIssuer_RevokeTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JwtIssuer_RevokeTokens_Call) RunAndReturn(run func(context.Context, string, string) error) *JwtIssuer_RevokeTokens_Call {
 	_c.Call.Return(run)
 	return _c
 }
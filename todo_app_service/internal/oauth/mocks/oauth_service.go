@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	models "Todo-List/internProject/todo_app_service/pkg/models"
 )
 
 // OauthService is an autogenerated mock type for the oauthService type
@@ -21,27 +23,29 @@ func (_m *OauthService) EXPECT() *OauthService_Expecter {
 	return &OauthService_Expecter{mock: &_m.Mock}
 }
 
-// ExchangeCodeForToken provides a mock function with given fields: _a0, _a1
-func (_m *OauthService) ExchangeCodeForToken(_a0 context.Context, _a1 string) (string, error) {
-	ret := _m.Called(_a0, _a1)
+// Authenticate provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *OauthService) Authenticate(_a0 context.Context, _a1 string, _a2 string, _a3 string) (*models.UserIdentity, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExchangeCodeForToken")
+		panic("no return value specified for Authenticate")
 	}
 
-	var r0 string
+	var r0 *models.UserIdentity
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*models.UserIdentity, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *models.UserIdentity); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserIdentity)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -49,68 +53,65 @@ func (_m *OauthService) ExchangeCodeForToken(_a0 context.Context, _a1 string) (s
 	return r0, r1
 }
 
-// OauthService_ExchangeCodeForToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeCodeForToken'
-type OauthService_ExchangeCodeForToken_Call struct {
+// OauthService_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type OauthService_Authenticate_Call struct {
 	*mock.Call
 }
 
-// ExchangeCodeForToken is a helper method to define mock.On call
+// Authenticate is a helper method to define mock.On call
 //   - _a0 context.Context
 //   - _a1 string
-func (_e *OauthService_Expecter) ExchangeCodeForToken(_a0 interface{}, _a1 interface{}) *OauthService_ExchangeCodeForToken_Call {
-	return &OauthService_ExchangeCodeForToken_Call{Call: _e.mock.On("ExchangeCodeForToken", _a0, _a1)}
+//   - _a2 string
+//   - _a3 string
+func (_e *OauthService_Expecter) Authenticate(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *OauthService_Authenticate_Call {
+	return &OauthService_Authenticate_Call{Call: _e.mock.On("Authenticate", _a0, _a1, _a2, _a3)}
 }
 
-func (_c *OauthService_ExchangeCodeForToken_Call) Run(run func(_a0 context.Context, _a1 string)) *OauthService_ExchangeCodeForToken_Call {
+func (_c *OauthService_Authenticate_Call) Run(run func(_a0 context.Context, _a1 string, _a2 string, _a3 string)) *OauthService_Authenticate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *OauthService_ExchangeCodeForToken_Call) Return(_a0 string, _a1 error) *OauthService_ExchangeCodeForToken_Call {
+func (_c *OauthService_Authenticate_Call) Return(_a0 *models.UserIdentity, _a1 error) *OauthService_Authenticate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *OauthService_ExchangeCodeForToken_Call) RunAndReturn(run func(context.Context, string) (string, error)) *OauthService_ExchangeCodeForToken_Call {
+func (_c *OauthService_Authenticate_Call) RunAndReturn(run func(context.Context, string, string, string) (*models.UserIdentity, error)) *OauthService_Authenticate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoginUrl provides a mock function with given fields: _a0
-func (_m *OauthService) LoginUrl(_a0 context.Context) (string, string, error) {
-	ret := _m.Called(_a0)
+// LoginUrl provides a mock function with given fields: _a0, _a1
+func (_m *OauthService) LoginUrl(_a0 context.Context, _a1 string) (*models.OauthLogin, error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for LoginUrl")
 	}
 
-	var r0 string
-	var r1 string
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context) (string, string, error)); ok {
-		return rf(_a0)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
-		r0 = rf(_a0)
-	} else {
-		r0 = ret.Get(0).(string)
+	var r0 *models.OauthLogin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OauthLogin, error)); ok {
+		return rf(_a0, _a1)
 	}
-
-	if rf, ok := ret.Get(1).(func(context.Context) string); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OauthLogin); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r1 = ret.Get(1).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OauthLogin)
+		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
-		r2 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
 // OauthService_LoginUrl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginUrl'
@@ -120,23 +121,24 @@ type OauthService_LoginUrl_Call struct {
 
 // LoginUrl is a helper method to define mock.On call
 //   - _a0 context.Context
-func (_e *OauthService_Expecter) LoginUrl(_a0 interface{}) *OauthService_LoginUrl_Call {
-	return &OauthService_LoginUrl_Call{Call: _e.mock.On("LoginUrl", _a0)}
+//   - _a1 string
+func (_e *OauthService_Expecter) LoginUrl(_a0 interface{}, _a1 interface{}) *OauthService_LoginUrl_Call {
+	return &OauthService_LoginUrl_Call{Call: _e.mock.On("LoginUrl", _a0, _a1)}
 }
 
-func (_c *OauthService_LoginUrl_Call) Run(run func(_a0 context.Context)) *OauthService_LoginUrl_Call {
+func (_c *OauthService_LoginUrl_Call) Run(run func(_a0 context.Context, _a1 string)) *OauthService_LoginUrl_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *OauthService_LoginUrl_Call) Return(_a0 string, _a1 string, _a2 error) *OauthService_LoginUrl_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *OauthService_LoginUrl_Call) Return(_a0 *models.OauthLogin, _a1 error) *OauthService_LoginUrl_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *OauthService_LoginUrl_Call) RunAndReturn(run func(context.Context) (string, string, error)) *OauthService_LoginUrl_Call {
+func (_c *OauthService_LoginUrl_Call) RunAndReturn(run func(context.Context, string) (*models.OauthLogin, error)) *OauthService_LoginUrl_Call {
 	_c.Call.Return(run)
 	return _c
 }
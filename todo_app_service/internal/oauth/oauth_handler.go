@@ -1,26 +1,30 @@
 package oauth
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
 	"Todo-List/internProject/todo_app_service/internal/persistence"
 	"Todo-List/internProject/todo_app_service/internal/utils"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
 	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
 //go:generate mockery --name=oauthService --exported --output=./mocks --outpkg=mocks --filename=oauth_service.go --with-expecter=true
 type oauthService interface {
-	LoginUrl(context.Context) (string, string, error)
-	ExchangeCodeForToken(context.Context, string) (string, error)
+	LoginUrl(context.Context, string) (*models.OauthLogin, error)
+	Authenticate(context.Context, string, string, string) (*models.UserIdentity, error)
 }
 
 //go:generate mockery --name=jwtIssuer --exported --output=./mocks --outpkg=mocks --filename=jwt_issuer.go --with-expecter=true
 type jwtIssuer interface {
-	GetTokens(context.Context, string) (*models.CallbackResponse, error)
+	GetTokens(context.Context, *models.UserIdentity, *models.SessionDevice) (*models.CallbackResponse, error)
+	RevokeTokens(ctx context.Context, accessToken string, refreshToken string) error
 }
 
 //go:generate mockery --name=httpService --exported --output=./mocks --outpkg=mocks --filename=http_service.go --with-expecter=true
@@ -60,23 +64,51 @@ func (h *Handler) HandleLogin(w http.ResponseWriter, r *http.Request) {
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	url, state, err := h.service.LoginUrl(ctx)
+	provider, err := utils.GetValueFromContext[string](r.Context(), middlewares.Provider)
 	if err != nil {
-		log.C(ctx).Errorf("failed to handle login, error %s", err.Error())
-		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		log.C(ctx).Error("failed to get provider from the context in oauth handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_PROVIDER, http.StatusBadRequest)
 		return
 	}
 
+	login, err := h.service.LoginUrl(ctx, provider)
+	if err != nil {
+		log.C(ctx).Errorf("failed to handle login with provider %s, error %s", provider, err.Error())
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	h.httpService.SetCookie(w, &http.Cookie{
+		Name:     constants.OAUTH_STATE_COOKIE,
+		Value:    login.State,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
 	h.httpService.SetCookie(w, &http.Cookie{
-		Name:     "oauth_state",
-		Value:    state,
+		Name:     constants.OAUTH_NONCE_COOKIE,
+		Value:    login.Nonce,
 		Path:     "/",
 		HttpOnly: true,
 		Secure:   true,
 		SameSite: http.SameSiteLaxMode,
 	})
 
-	h.httpService.Redirect(w, r, url, http.StatusTemporaryRedirect)
+	// the label of the device the session is created for has to survive the round trip to the provider
+	if deviceLabel := r.URL.Query().Get(constants.DEVICE_LABEL); deviceLabel != "" {
+		h.httpService.SetCookie(w, &http.Cookie{
+			Name:     constants.OAUTH_DEVICE_COOKIE,
+			Value:    url.QueryEscape(deviceLabel),
+			Path:     "/",
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+
+	h.httpService.Redirect(w, r, login.Url, http.StatusTemporaryRedirect)
 }
 
 func (h *Handler) HandleCallback(w http.ResponseWriter, r *http.Request) {
@@ -94,6 +126,13 @@ func (h *Handler) HandleCallback(w http.ResponseWriter, r *http.Request) {
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
+	provider, err := utils.GetValueFromContext[string](r.Context(), middlewares.Provider)
+	if err != nil {
+		log.C(ctx).Error("failed to get provider from the context in oauth handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_PROVIDER, http.StatusBadRequest)
+		return
+	}
+
 	authCode := r.URL.Query().Get("code")
 	if len(authCode) == 0 {
 		log.C(ctx).Error("failed to handle callback, empty auth code in callback url...")
@@ -101,14 +140,37 @@ func (h *Handler) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	accessToken, err := h.service.ExchangeCodeForToken(ctx, authCode)
+	// the state sent back by the provider has to be the one stored in the browser which started the login
+	stateCookie, err := r.Cookie(constants.OAUTH_STATE_COOKIE)
+	state := r.URL.Query().Get("state")
+	if err != nil || len(state) == 0 || subtle.ConstantTimeCompare([]byte(state), []byte(stateCookie.Value)) != 1 {
+		log.C(ctx).Error("failed to handle callback, state in callback url does not match the state cookie...")
+		utils.EncodeError(w, "invalid oauth state in callback url", http.StatusBadRequest)
+		return
+	}
+
+	var nonce string
+	if cookie, err := r.Cookie(constants.OAUTH_NONCE_COOKIE); err == nil {
+		nonce = cookie.Value
+	}
+
+	h.clearCookie(w, constants.OAUTH_STATE_COOKIE)
+	h.clearCookie(w, constants.OAUTH_NONCE_COOKIE)
+
+	identity, err := h.service.Authenticate(ctx, provider, authCode, nonce)
 	if err != nil {
-		log.C(ctx).Errorf("failed to handle callback, error %s when trying to get access token", err.Error())
-		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		log.C(ctx).Errorf("failed to handle callback of provider %s, error %s when trying to authenticate user", provider, err.Error())
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
 		return
 	}
 
-	tokens, err := h.issuer.GetTokens(ctx, accessToken)
+	var deviceLabel string
+	if cookie, err := r.Cookie(constants.OAUTH_DEVICE_COOKIE); err == nil {
+		deviceLabel, _ = url.QueryUnescape(cookie.Value)
+		h.clearCookie(w, constants.OAUTH_DEVICE_COOKIE)
+	}
+
+	tokens, err := h.issuer.GetTokens(ctx, identity, utils.ExtractSessionDevice(r, deviceLabel))
 	if err != nil {
 		log.C(ctx).Errorf("failed to handle callback, error %s when trying to get jwt", err.Error())
 		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
@@ -116,14 +178,14 @@ func (h *Handler) HandleCallback(w http.ResponseWriter, r *http.Request) {
 	}
 
 	h.httpService.SetCookie(w, &http.Cookie{
-		Name:   "access-token",
+		Name:   constants.ACCESS_TOKEN_COOKIE,
 		Value:  tokens.JwtToken,
 		Path:   "/",
 		MaxAge: constants.HTTP_COOKIES_MAX_AGE,
 	})
 
 	h.httpService.SetCookie(w, &http.Cookie{
-		Name:   "refresh-token",
+		Name:   constants.REFRESH_TOKEN_COOKIE,
 		Value:  tokens.RefreshToken,
 		Path:   "/",
 		MaxAge: constants.HTTP_COOKIES_MAX_AGE,
@@ -135,26 +197,69 @@ func (h *Handler) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	url := fmt.Sprintf("%s/index.html", h.frontendUrl)
-	h.httpService.Redirect(w, r, url, http.StatusTemporaryRedirect)
+	redirectUrl := fmt.Sprintf("%s/index.html", h.frontendUrl)
+	h.httpService.Redirect(w, r, redirectUrl, http.StatusTemporaryRedirect)
 }
 
+// HandleLogout ends the session of the caller, the access token is revoked and the refresh token can no longer be used
 func (h *Handler) HandleLogout(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log.C(ctx).Info("handling logout in oauth handler")
 
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in oauth handler when handling logout, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	// api clients send the access token in the auth header, the frontend only has the cookies
+	accessToken := r.Header.Get("Authorization")
+	if cookie, err := r.Cookie(constants.ACCESS_TOKEN_COOKIE); accessToken == "" && err == nil {
+		accessToken = cookie.Value
+	}
+
+	var refreshToken string
+	if cookie, err := r.Cookie(constants.REFRESH_TOKEN_COOKIE); err == nil {
+		refreshToken = cookie.Value
+	}
+
+	if err = h.issuer.RevokeTokens(ctx, accessToken, refreshToken); err != nil {
+		log.C(ctx).Errorf("failed to handle logout, error %s when trying to revoke the tokens", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction in oauth handler, error %s when trying to handle logout", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	h.httpService.SetCookie(w, &http.Cookie{
-		Name:  "access-token",
+		Name:  constants.ACCESS_TOKEN_COOKIE,
 		Value: "",
 		Path:  "/",
 	})
 
 	h.httpService.SetCookie(w, &http.Cookie{
-		Name:  "refresh-token",
+		Name:  constants.REFRESH_TOKEN_COOKIE,
 		Value: "",
 		Path:  "/",
 	})
 
-	url := fmt.Sprintf("%s/index.html#/login", h.frontendUrl)
-	h.httpService.Redirect(w, r, url, http.StatusTemporaryRedirect)
+	redirectUrl := fmt.Sprintf("%s/index.html#/login", h.frontendUrl)
+	h.httpService.Redirect(w, r, redirectUrl, http.StatusTemporaryRedirect)
+}
+
+func (h *Handler) clearCookie(w http.ResponseWriter, name string) {
+	h.httpService.SetCookie(w, &http.Cookie{
+		Name:   name,
+		Value:  "",
+		Path:   "/",
+		MaxAge: -1,
+	})
 }
@@ -2,25 +2,24 @@ package oauth
 
 import (
 	"Todo-List/internProject/todo_app_service/internal/oauth/mocks"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/models"
-	"context"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 )
 
 func TestHandler_HandleLogin(t *testing.T) {
-	globalRequest := setUpHttpRequestForLogin()
-	httpRecorder := getHttpRecorder()
-
 	tests := []struct {
 		testName         string
 		oauthServiceMock func() *mocks.OauthService
-		httpServiceMock  func() *mocks.HttpService
+		httpServiceMock  func(rr *httptest.ResponseRecorder, req *http.Request) *mocks.HttpService
+		err              error
 		expectedHttpCode int
-		expectHttpError  bool
 	}{
 		{
 			testName: "Successfully handling login",
@@ -29,28 +28,30 @@ func TestHandler_HandleLogin(t *testing.T) {
 				mck := &mocks.OauthService{}
 
 				mck.EXPECT().
-					LoginUrl(context.TODO()).
-					Return(expectedOauthUrl, expectedOauthState, nil).
+					LoginUrl(mock.Anything, providerName).
+					Return(&models.OauthLogin{
+						Url:   expectedOauthUrl,
+						State: expectedOauthState,
+						Nonce: expectedOauthNonce,
+					}, nil).
 					Once()
 
 				return mck
 			},
 
-			httpServiceMock: func() *mocks.HttpService {
+			httpServiceMock: func(rr *httptest.ResponseRecorder, req *http.Request) *mocks.HttpService {
 				mck := &mocks.HttpService{}
 
-				mck.EXPECT().SetCookie(httpRecorder, &http.Cookie{
-					Name:     cookieName,
-					Path:     cookiePath,
-					HttpOnly: true,
-					SameSite: http.SameSiteLaxMode,
-					Value:    expectedOauthState,
-					Secure:   true,
-				}).
+				mck.EXPECT().
+					SetCookie(rr, loginCookie(constants.OAUTH_STATE_COOKIE, expectedOauthState)).
 					Once()
 
 				mck.EXPECT().
-					Redirect(httpRecorder, globalRequest, expectedOauthUrl, http.StatusTemporaryRedirect).
+					SetCookie(rr, loginCookie(constants.OAUTH_NONCE_COOKIE, expectedOauthNonce)).
+					Once()
+
+				mck.EXPECT().
+					Redirect(rr, req, expectedOauthUrl, http.StatusTemporaryRedirect).
 					Once()
 
 				return mck
@@ -60,20 +61,20 @@ func TestHandler_HandleLogin(t *testing.T) {
 		},
 
 		{
-			testName: "Failed to handle callback error when calling oauth service",
+			testName: "Failed to handle login error when calling oauth service",
 
 			oauthServiceMock: func() *mocks.OauthService {
 				mck := &mocks.OauthService{}
 
 				mck.EXPECT().
-					LoginUrl(context.TODO()).
-					Return("", "", errWhenGeneratingState).
+					LoginUrl(mock.Anything, providerName).
+					Return(nil, errWhenGeneratingState).
 					Once()
 
 				return mck
 			},
 
-			expectHttpError: true,
+			err: errWhenGeneratingState,
 
 			expectedHttpCode: http.StatusInternalServerError,
 		},
@@ -81,39 +82,47 @@ func TestHandler_HandleLogin(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			oauthServiceMock := &mocks.OauthService{}
-			if test.oauthServiceMock != nil {
-				oauthServiceMock = test.oauthServiceMock()
-			}
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mck.ExpectBegin()
+			mck.ExpectRollback()
+
+			httpRecorder := getHttpRecorder()
+			req := setUpHttpRequestForLogin()
+
+			oauthServiceMock := test.oauthServiceMock()
 
 			httpServiceMock := &mocks.HttpService{}
 			if test.httpServiceMock != nil {
-				httpServiceMock = test.httpServiceMock()
+				httpServiceMock = test.httpServiceMock(httpRecorder, req)
 			}
 
-			oauthHandler := NewHandler(oauthServiceMock, nil, httpServiceMock)
-			oauthHandler.HandleLogin(httpRecorder, globalRequest)
+			oauthHandler := NewHandler(oauthServiceMock, &mocks.JwtIssuer{}, httpServiceMock, persistence.NewSqlDb(db), frontendUrl)
+			oauthHandler.HandleLogin(httpRecorder, req)
 
-			if test.expectHttpError {
-				extractErrorFromResponseRecorder(t, httpRecorder, errWhenGeneratingState)
+			if test.err != nil {
+				extractErrorFromResponseRecorder(t, httpRecorder, test.err)
 			}
 
 			require.Equal(t, test.expectedHttpCode, httpRecorder.Code)
+			require.NoError(t, mck.ExpectationsWereMet())
 			mock.AssertExpectationsForObjects(t, oauthServiceMock, httpServiceMock)
 		})
 	}
 }
 
 func TestHandler_HandleCallback(t *testing.T) {
-
 	tests := []struct {
 		testName         string
 		oauthServiceMock func() *mocks.OauthService
 		jwtIssuerMock    func() *mocks.JwtIssuer
+		httpServiceMock  func(rr *httptest.ResponseRecorder, req *http.Request) *mocks.HttpService
 		requestMock      func() *http.Request
+		dbMock           func(mck sqlmock.Sqlmock)
 		err              error
 		expectedHttpCode int
-		expectedTokens   *models.CallbackResponse
 	}{
 		{
 			testName: "Successfully handling callback",
@@ -125,7 +134,7 @@ func TestHandler_HandleCallback(t *testing.T) {
 			jwtIssuerMock: func() *mocks.JwtIssuer {
 				mck := &mocks.JwtIssuer{}
 
-				mck.EXPECT().GetTokens(mock.Anything, accessToken).Return(&models.CallbackResponse{
+				mck.EXPECT().GetTokens(mock.Anything, userIdentity, mock.Anything).Return(&models.CallbackResponse{
 					JwtToken:     jwtToken,
 					RefreshToken: refreshToken,
 				}, nil).Once()
@@ -133,16 +142,50 @@ func TestHandler_HandleCallback(t *testing.T) {
 				return mck
 			},
 
+			httpServiceMock: func(rr *httptest.ResponseRecorder, req *http.Request) *mocks.HttpService {
+				mck := &mocks.HttpService{}
+
+				for _, name := range []string{constants.OAUTH_STATE_COOKIE, constants.OAUTH_NONCE_COOKIE} {
+					mck.EXPECT().
+						SetCookie(rr, &http.Cookie{Name: name, Value: "", Path: cookiePath, MaxAge: -1}).
+						Once()
+				}
+
+				mck.EXPECT().
+					SetCookie(rr, &http.Cookie{
+						Name:   constants.ACCESS_TOKEN_COOKIE,
+						Value:  jwtToken,
+						Path:   cookiePath,
+						MaxAge: constants.HTTP_COOKIES_MAX_AGE,
+					}).
+					Once()
+
+				mck.EXPECT().
+					SetCookie(rr, &http.Cookie{
+						Name:   constants.REFRESH_TOKEN_COOKIE,
+						Value:  refreshToken,
+						Path:   cookiePath,
+						MaxAge: constants.HTTP_COOKIES_MAX_AGE,
+					}).
+					Once()
+
+				mck.EXPECT().
+					Redirect(rr, req, frontendUrl+"/index.html", http.StatusTemporaryRedirect).
+					Once()
+
+				return mck
+			},
+
 			requestMock: func() *http.Request {
 				return setUpCorrectRequestMock()
 			},
 
-			expectedHttpCode: http.StatusOK,
-
-			expectedTokens: &models.CallbackResponse{
-				JwtToken:     jwtToken,
-				RefreshToken: refreshToken,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
 			},
+
+			expectedHttpCode: http.StatusOK,
 		},
 
 		{
@@ -150,7 +193,7 @@ func TestHandler_HandleCallback(t *testing.T) {
 
 			requestMock: func() *http.Request {
 				req := httptest.NewRequest(http.MethodGet, "/", nil)
-				return req
+				return withProvider(req)
 			},
 
 			expectedHttpCode: http.StatusBadRequest,
@@ -158,6 +201,42 @@ func TestHandler_HandleCallback(t *testing.T) {
 			err: errMissingAuthCodeInUrl,
 		},
 
+		{
+			testName: "Failed to handle callback, state in url does not match the state cookie",
+
+			requestMock: func() *http.Request {
+				return setUpCallbackRequest("state of another login", expectedOauthState)
+			},
+
+			expectedHttpCode: http.StatusBadRequest,
+
+			err: errInvalidOauthState,
+		},
+
+		{
+			testName: "Failed to handle callback, missing state cookie",
+
+			requestMock: func() *http.Request {
+				return setUpCallbackRequest(expectedOauthState, "")
+			},
+
+			expectedHttpCode: http.StatusBadRequest,
+
+			err: errInvalidOauthState,
+		},
+
+		{
+			testName: "Failed to handle callback, missing state in url",
+
+			requestMock: func() *http.Request {
+				return setUpCallbackRequest("", expectedOauthState)
+			},
+
+			expectedHttpCode: http.StatusBadRequest,
+
+			err: errInvalidOauthState,
+		},
+
 		{
 			testName: "Failed to handle callback, error when calling oauth service",
 
@@ -165,18 +244,26 @@ func TestHandler_HandleCallback(t *testing.T) {
 				mck := &mocks.OauthService{}
 
 				mck.EXPECT().
-					ExchangeCodeForToken(mock.Anything, authCode).
-					Return("", errWhenCallingOauthServiceWithExchangeCodeForToken).
+					Authenticate(mock.Anything, providerName, authCode, expectedOauthNonce).
+					Return(nil, errWhenCallingOauthServiceWithAuthenticate).
 					Once()
 
 				return mck
 			},
 
+			httpServiceMock: func(rr *httptest.ResponseRecorder, req *http.Request) *mocks.HttpService {
+				mck := &mocks.HttpService{}
+
+				mck.EXPECT().SetCookie(rr, mock.Anything).Twice()
+
+				return mck
+			},
+
 			requestMock: func() *http.Request {
 				return setUpCorrectRequestMock()
 			},
 
-			err: errWhenCallingOauthServiceWithExchangeCodeForToken,
+			err: errWhenCallingOauthServiceWithAuthenticate,
 
 			expectedHttpCode: http.StatusInternalServerError,
 		},
@@ -192,13 +279,21 @@ func TestHandler_HandleCallback(t *testing.T) {
 				mck := &mocks.JwtIssuer{}
 
 				mck.EXPECT().
-					GetTokens(mock.Anything, accessToken).
+					GetTokens(mock.Anything, userIdentity, mock.Anything).
 					Return(nil, errWhenCallingJwtIssuerGetTokens).
 					Once()
 
 				return mck
 			},
 
+			httpServiceMock: func(rr *httptest.ResponseRecorder, req *http.Request) *mocks.HttpService {
+				mck := &mocks.HttpService{}
+
+				mck.EXPECT().SetCookie(rr, mock.Anything).Twice()
+
+				return mck
+			},
+
 			requestMock: func() *http.Request {
 				return setUpCorrectRequestMock()
 			},
@@ -211,8 +306,19 @@ func TestHandler_HandleCallback(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			if test.dbMock != nil {
+				test.dbMock(mck)
+			} else {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			}
 
 			httpRecorder := getHttpRecorder()
+			req := test.requestMock()
 
 			oauthServiceMock := &mocks.OauthService{}
 			if test.oauthServiceMock != nil {
@@ -224,19 +330,131 @@ func TestHandler_HandleCallback(t *testing.T) {
 				jwtIssuerMock = test.jwtIssuerMock()
 			}
 
-			oauthHandler := NewHandler(oauthServiceMock, jwtIssuerMock, nil)
-			oauthHandler.HandleCallback(httpRecorder, test.requestMock())
+			httpServiceMock := &mocks.HttpService{}
+			if test.httpServiceMock != nil {
+				httpServiceMock = test.httpServiceMock(httpRecorder, req)
+			}
+
+			oauthHandler := NewHandler(oauthServiceMock, jwtIssuerMock, httpServiceMock, persistence.NewSqlDb(db), frontendUrl)
+			oauthHandler.HandleCallback(httpRecorder, req)
 
 			if test.err != nil {
 				extractErrorFromResponseRecorder(t, httpRecorder, test.err)
-			} else {
-				receivedTokens := extractCallbackResponseFromResponseRecorder(t, httpRecorder)
-				require.Equal(t, test.expectedTokens, receivedTokens)
 			}
 
 			require.Equal(t, test.expectedHttpCode, httpRecorder.Code)
-			mock.AssertExpectationsForObjects(t, oauthServiceMock, jwtIssuerMock)
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, oauthServiceMock, jwtIssuerMock, httpServiceMock)
 		})
 	}
+}
+
+func TestHandler_HandleLogout(t *testing.T) {
+	tests := []struct {
+		testName         string
+		requestMock      func() *http.Request
+		expectedAccess   string
+		expectedRefresh  string
+		revokeErr        error
+		expectedHttpCode int
+	}{
+		{
+			testName: "Successfully logging out api client which sends the access token in the auth header",
+
+			requestMock: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/logout", nil)
+				req.Header.Set("Authorization", jwtToken)
+				req.AddCookie(&http.Cookie{Name: constants.ACCESS_TOKEN_COOKIE, Value: "access token of the cookie"})
+				req.AddCookie(&http.Cookie{Name: constants.REFRESH_TOKEN_COOKIE, Value: refreshToken})
+				return req
+			},
+
+			expectedAccess: jwtToken,
+
+			expectedRefresh: refreshToken,
+
+			expectedHttpCode: http.StatusOK,
+		},
+
+		{
+			testName: "Successfully logging out frontend which only has the cookies",
+
+			requestMock: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/logout", nil)
+				req.AddCookie(&http.Cookie{Name: constants.ACCESS_TOKEN_COOKIE, Value: jwtToken})
+				req.AddCookie(&http.Cookie{Name: constants.REFRESH_TOKEN_COOKIE, Value: refreshToken})
+				return req
+			},
+
+			expectedAccess: jwtToken,
+
+			expectedRefresh: refreshToken,
+
+			expectedHttpCode: http.StatusOK,
+		},
+
+		{
+			testName: "Failed to log out, error when revoking the tokens",
+
+			requestMock: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/logout", nil)
+				req.Header.Set("Authorization", jwtToken)
+				return req
+			},
+
+			expectedAccess: jwtToken,
+
+			revokeErr: errWhenRevokingTokens,
+
+			expectedHttpCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mck.ExpectBegin()
+			if test.revokeErr != nil {
+				mck.ExpectRollback()
+			} else {
+				mck.ExpectCommit()
+			}
+
+			httpRecorder := getHttpRecorder()
+			req := test.requestMock()
+
+			jwtIssuerMock := &mocks.JwtIssuer{}
+			jwtIssuerMock.EXPECT().
+				RevokeTokens(mock.Anything, test.expectedAccess, test.expectedRefresh).
+				Return(test.revokeErr).
+				Once()
+
+			httpServiceMock := &mocks.HttpService{}
+			if test.revokeErr == nil {
+				for _, name := range []string{constants.ACCESS_TOKEN_COOKIE, constants.REFRESH_TOKEN_COOKIE} {
+					httpServiceMock.EXPECT().
+						SetCookie(httpRecorder, &http.Cookie{Name: name, Value: "", Path: cookiePath}).
+						Once()
+				}
+
+				httpServiceMock.EXPECT().
+					Redirect(httpRecorder, req, frontendUrl+"/index.html#/login", http.StatusTemporaryRedirect).
+					Once()
+			}
 
+			oauthHandler := NewHandler(&mocks.OauthService{}, jwtIssuerMock, httpServiceMock, persistence.NewSqlDb(db), frontendUrl)
+			oauthHandler.HandleLogout(httpRecorder, req)
+
+			if test.revokeErr != nil {
+				extractErrorFromResponseRecorder(t, httpRecorder, test.revokeErr)
+			}
+
+			require.Equal(t, test.expectedHttpCode, httpRecorder.Code)
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, jwtIssuerMock, httpServiceMock)
+		})
+	}
 }
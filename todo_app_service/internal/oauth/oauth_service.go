@@ -1,7 +1,10 @@
 package oauth
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
 	"errors"
 	"golang.org/x/oauth2"
@@ -18,48 +21,103 @@ type oauthConfigurator interface {
 	Exchange(ctx context.Context, code string, opts ...oauth2.AuthCodeOption) (*oauth2.Token, error)
 }
 
+// provider is an identity provider users can log in with, it resolves who the user is from the exchanged token,
+// providers issuing ID tokens have to check that the nonce of the token is the one sent with the login
+//
+//go:generate mockery --name=provider --exported --output=./mocks --outpkg=mocks --filename=provider.go --with-expecter=true
+type provider interface {
+	oauthConfigurator
+	UserIdentity(ctx context.Context, token *oauth2.Token, nonce string) (*models.UserIdentity, error)
+}
+
 type service struct {
-	sGenerator  stateGenerator
-	oauthConfig oauthConfigurator
+	sGenerator stateGenerator
+	providers  map[string]provider
 }
 
-func NewService(sGenerator stateGenerator, oauthConfig oauthConfigurator) *service {
+func NewService(sGenerator stateGenerator) *service {
 	return &service{
-		sGenerator:  sGenerator,
-		oauthConfig: oauthConfig,
+		sGenerator: sGenerator,
+		providers:  make(map[string]provider),
 	}
 }
 
-func (s *service) LoginUrl(ctx context.Context) (string, string, error) {
-	log.C(ctx).Info("getting url where user should be redirected when trying to log in")
+// RegisterProvider makes the provider available under /{name}/login and /{name}/callback
+func (s *service) RegisterProvider(name string, p provider) {
+	s.providers[name] = p
+}
+
+func (s *service) LoginUrl(ctx context.Context, providerName string) (*models.OauthLogin, error) {
+	log.C(ctx).Infof("getting url where user should be redirected when trying to log in with provider %s", providerName)
+
+	p, err := s.getProvider(ctx, providerName)
+	if err != nil {
+		return nil, err
+	}
 
 	state, err := s.sGenerator.GenerateState()
 	if err != nil {
 		log.C(ctx).Errorf("failed to login user, error %s when generating state", err.Error())
-		return "", "", err
+		return nil, err
 	}
 
 	if len(state) == 0 {
 		log.C(ctx).Warn("length of state is 0...")
-		return "", "", errors.New("zero length state generated")
+		return nil, errors.New("zero length state generated")
+	}
+
+	nonce, err := s.sGenerator.GenerateState()
+	if err != nil {
+		log.C(ctx).Errorf("failed to login user, error %s when generating nonce", err.Error())
+		return nil, err
+	}
+
+	if len(nonce) == 0 {
+		log.C(ctx).Warn("length of nonce is 0...")
+		return nil, errors.New("zero length nonce generated")
 	}
 
-	return s.oauthConfig.AuthCodeURL(state), state, nil
+	return &models.OauthLogin{
+		Url:   p.AuthCodeURL(state, oauth2.SetAuthURLParam(constants.OAUTH_NONCE_PARAM, nonce)),
+		State: state,
+		Nonce: nonce,
+	}, nil
 }
 
-func (s *service) ExchangeCodeForToken(ctx context.Context, authCode string) (string, error) {
-	log.C(ctx).Info("exchanging auth code for access token in oauth service")
+func (s *service) Authenticate(ctx context.Context, providerName string, authCode string, nonce string) (*models.UserIdentity, error) {
+	log.C(ctx).Infof("exchanging auth code for user identity with provider %s in oauth service", providerName)
+
+	p, err := s.getProvider(ctx, providerName)
+	if err != nil {
+		return nil, err
+	}
 
 	if len(authCode) == 0 {
 		log.C(ctx).Warn("length og auth code is 0...")
-		return "", errors.New("empty authorization code")
+		return nil, errors.New("empty authorization code")
 	}
 
-	token, err := s.oauthConfig.Exchange(ctx, authCode)
+	token, err := p.Exchange(ctx, authCode)
 	if err != nil {
 		log.C(ctx).Errorf("failed to exchange auth code for access token, error %s", err.Error())
-		return "", err
+		return nil, err
+	}
+
+	identity, err := p.UserIdentity(ctx, token, nonce)
+	if err != nil {
+		log.C(ctx).Errorf("failed to determine user identity with provider %s, error %s", providerName, err.Error())
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+func (s *service) getProvider(ctx context.Context, providerName string) (provider, error) {
+	p, ok := s.providers[providerName]
+	if !ok {
+		log.C(ctx).Errorf("oauth provider %s is not registered", providerName)
+		return nil, application_errors.NewNotFoundError(constants.OAUTH_PROVIDER_TARGET, providerName)
 	}
 
-	return token.AccessToken, nil
+	return p, nil
 }
@@ -2,6 +2,7 @@ package oauth
 
 import (
 	"Todo-List/internProject/todo_app_service/internal/oauth/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -11,41 +12,60 @@ import (
 
 func TestOauthService_LoginUrl(t *testing.T) {
 	tests := []struct {
-		testName              string
-		stateGeneratorMock    func() *mocks.StateGenerator
-		oauthConfiguratorMock func() *mocks.OauthConfigurator
-		err                   error
-		expectedState         string
-		expectedUrl           string
+		testName           string
+		providerName       string
+		stateGeneratorMock func() *mocks.StateGenerator
+		providerMock       func() *mocks.Provider
+		err                error
+		expectedLogin      *models.OauthLogin
 	}{
 		{
 			testName: "Successfully getting state and url",
 
+			providerName: providerName,
+
 			stateGeneratorMock: func() *mocks.StateGenerator {
 				mck := &mocks.StateGenerator{}
 				mck.EXPECT().
 					GenerateState().
 					Return(expectedOauthState, nil).
 					Once()
+				mck.EXPECT().
+					GenerateState().
+					Return(expectedOauthNonce, nil).
+					Once()
 				return mck
 			},
 
-			oauthConfiguratorMock: func() *mocks.OauthConfigurator {
-				mck := &mocks.OauthConfigurator{}
+			providerMock: func() *mocks.Provider {
+				mck := &mocks.Provider{}
 				mck.EXPECT().
-					AuthCodeURL(expectedOauthState).
+					AuthCodeURL(expectedOauthState, nonceAuthOption).
 					Return(expectedOauthUrl).
 					Once()
 				return mck
 			},
-			expectedState: expectedOauthState,
 
-			expectedUrl: expectedOauthUrl,
+			expectedLogin: &models.OauthLogin{
+				Url:   expectedOauthUrl,
+				State: expectedOauthState,
+				Nonce: expectedOauthNonce,
+			},
+		},
+
+		{
+			testName: "Failed to get state and url because provider is not registered",
+
+			providerName: unknownProvider,
+
+			err: errUnknownProvider,
 		},
 
 		{
 			testName: "Failed to get state and url because generated state is empty",
 
+			providerName: providerName,
+
 			stateGeneratorMock: func() *mocks.StateGenerator {
 				mck := &mocks.StateGenerator{}
 
@@ -60,9 +80,35 @@ func TestOauthService_LoginUrl(t *testing.T) {
 			err: errZeroLengthState,
 		},
 
+		{
+			testName: "Failed to get state and url because generated nonce is empty",
+
+			providerName: providerName,
+
+			stateGeneratorMock: func() *mocks.StateGenerator {
+				mck := &mocks.StateGenerator{}
+
+				mck.EXPECT().
+					GenerateState().
+					Return(expectedOauthState, nil).
+					Once()
+
+				mck.EXPECT().
+					GenerateState().
+					Return(emptyOauthState, nil).
+					Once()
+
+				return mck
+			},
+
+			err: errZeroLengthNonce,
+		},
+
 		{
 			testName: "Failed to get state and url because state generator returned error",
 
+			providerName: providerName,
+
 			stateGeneratorMock: func() *mocks.StateGenerator {
 				mck := &mocks.StateGenerator{}
 
@@ -85,62 +131,82 @@ func TestOauthService_LoginUrl(t *testing.T) {
 				stateGeneratorMock = test.stateGeneratorMock()
 			}
 
-			oauthConfiguratorMock := &mocks.OauthConfigurator{}
-			if test.oauthConfiguratorMock != nil {
-				oauthConfiguratorMock = test.oauthConfiguratorMock()
+			providerMock := &mocks.Provider{}
+			if test.providerMock != nil {
+				providerMock = test.providerMock()
 			}
 
-			oaService := NewService(stateGeneratorMock, oauthConfiguratorMock)
+			oaService := NewService(stateGeneratorMock)
+			oaService.RegisterProvider(providerName, providerMock)
 
-			receivedUrl, receivedState, err := oaService.LoginUrl(context.TODO())
+			receivedLogin, err := oaService.LoginUrl(context.TODO(), test.providerName)
 			if test.err != nil {
 				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
 
-			require.Equal(t, test.expectedUrl, receivedUrl)
-			require.Equal(t, test.expectedState, receivedState)
-			mock.AssertExpectationsForObjects(t, stateGeneratorMock, oauthConfiguratorMock)
+			require.Equal(t, test.expectedLogin, receivedLogin)
+			mock.AssertExpectationsForObjects(t, stateGeneratorMock, providerMock)
 		})
 	}
 
 }
 
-func TestService_ExchangeCodeForToken(t *testing.T) {
+func TestService_Authenticate(t *testing.T) {
+	token := &oauth2.Token{
+		AccessToken: accessToken,
+	}
+
 	tests := []struct {
-		testName              string
-		authCode              string
-		oauthConfiguratorMock func() *mocks.OauthConfigurator
-		err                   error
-		expectedAccessToken   string
+		testName         string
+		providerName     string
+		authCode         string
+		providerMock     func() *mocks.Provider
+		err              error
+		expectedIdentity *models.UserIdentity
 	}{
 		{
-			testName: "Successfully receiving expected access token",
+			testName: "Successfully receiving expected user identity",
+
+			providerName: providerName,
 
 			authCode: authCode,
 
-			oauthConfiguratorMock: func() *mocks.OauthConfigurator {
-				mck := &mocks.OauthConfigurator{}
+			providerMock: func() *mocks.Provider {
+				mck := &mocks.Provider{}
 
-				mck.EXPECT().Exchange(context.TODO(), authCode).Return(&oauth2.Token{
-					AccessToken: accessToken,
-				}, nil).
+				mck.EXPECT().Exchange(context.TODO(), authCode).Return(token, nil).
+					Once()
+
+				mck.EXPECT().UserIdentity(context.TODO(), token, expectedOauthNonce).Return(userIdentity, nil).
 					Once()
 
 				return mck
 			},
 
-			expectedAccessToken: accessToken,
+			expectedIdentity: userIdentity,
+		},
+
+		{
+			testName: "Failed to receive user identity because provider is not registered",
+
+			providerName: unknownProvider,
+
+			authCode: authCode,
+
+			err: errUnknownProvider,
 		},
 
 		{
-			testName: "Failed to receive access token due to error when performing exchange",
+			testName: "Failed to receive user identity due to error when performing exchange",
+
+			providerName: providerName,
 
 			authCode: authCode,
 
-			oauthConfiguratorMock: func() *mocks.OauthConfigurator {
-				mck := &mocks.OauthConfigurator{}
+			providerMock: func() *mocks.Provider {
+				mck := &mocks.Provider{}
 
 				mck.EXPECT().
 					Exchange(context.TODO(), authCode).
@@ -154,7 +220,33 @@ func TestService_ExchangeCodeForToken(t *testing.T) {
 		},
 
 		{
-			testName: "Failed to receive access token because passed auth code was empty",
+			testName: "Failed to receive user identity due to error when provider resolves it",
+
+			providerName: providerName,
+
+			authCode: authCode,
+
+			providerMock: func() *mocks.Provider {
+				mck := &mocks.Provider{}
+
+				mck.EXPECT().Exchange(context.TODO(), authCode).Return(token, nil).
+					Once()
+
+				mck.EXPECT().
+					UserIdentity(context.TODO(), token, expectedOauthNonce).
+					Return(nil, errWhenGettingUserIdentity).
+					Once()
+
+				return mck
+			},
+
+			err: errWhenGettingUserIdentity,
+		},
+
+		{
+			testName: "Failed to receive user identity because passed auth code was empty",
+
+			providerName: providerName,
 
 			err: errEmptyAuthCode,
 		},
@@ -162,23 +254,23 @@ func TestService_ExchangeCodeForToken(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			oauthConfiguratorMock := &mocks.OauthConfigurator{}
-
-			if test.oauthConfiguratorMock != nil {
-				oauthConfiguratorMock = test.oauthConfiguratorMock()
+			providerMock := &mocks.Provider{}
+			if test.providerMock != nil {
+				providerMock = test.providerMock()
 			}
 
-			oaService := NewService(nil, oauthConfiguratorMock)
+			oaService := NewService(nil)
+			oaService.RegisterProvider(providerName, providerMock)
 
-			receivedAccessToken, err := oaService.ExchangeCodeForToken(context.TODO(), test.authCode)
+			receivedIdentity, err := oaService.Authenticate(context.TODO(), test.providerName, test.authCode, expectedOauthNonce)
 			if test.err != nil {
 				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
 
-			require.Equal(t, test.expectedAccessToken, receivedAccessToken)
-			mock.AssertExpectationsForObjects(t, oauthConfiguratorMock)
+			require.Equal(t, test.expectedIdentity, receivedIdentity)
+			mock.AssertExpectationsForObjects(t, providerMock)
 		})
 	}
 
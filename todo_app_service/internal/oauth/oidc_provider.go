@@ -0,0 +1,66 @@
+package oauth
+
+import (
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"Todo-List/internProject/todo_app_service/pkg/oidc"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"golang.org/x/oauth2"
+)
+
+//go:generate mockery --name=idTokenVerifier --exported --output=./mocks --outpkg=mocks --filename=id_token_verifier.go --with-expecter=true
+type idTokenVerifier interface {
+	Verify(ctx context.Context, rawIdToken string) (*oidc.Claims, error)
+}
+
+// oidcProvider resolves the identity from the verified ID token, it carries no memberships
+// so the role of its users comes from the email overrides or the default role of the role mapping
+type oidcProvider struct {
+	oauthConfigurator
+	verifier idTokenVerifier
+}
+
+func NewOidcProvider(config oauthConfigurator, verifier idTokenVerifier) *oidcProvider {
+	return &oidcProvider{
+		oauthConfigurator: config,
+		verifier:          verifier,
+	}
+}
+
+func (o *oidcProvider) UserIdentity(ctx context.Context, token *oauth2.Token, nonce string) (*models.UserIdentity, error) {
+	log.C(ctx).Info("getting user identity from oidc id token")
+
+	rawIdToken, ok := token.Extra("id_token").(string)
+	if !ok || len(rawIdToken) == 0 {
+		log.C(ctx).Error("failed to get user identity, token response does not contain an id token")
+		return nil, errors.New("token response does not contain an id token")
+	}
+
+	claims, err := o.verifier.Verify(ctx, rawIdToken)
+	if err != nil {
+		log.C(ctx).Errorf("failed to verify id token, error %s", err.Error())
+		return nil, err
+	}
+
+	// the nonce ties the id token to the login that was started by this browser, so a token issued for another login is refused
+	if len(nonce) == 0 || subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		log.C(ctx).Error("failed to get user identity, nonce of the id token does not match the nonce of the login")
+		return nil, errors.New("nonce of the id token does not match")
+	}
+
+	if len(claims.Email) == 0 {
+		log.C(ctx).Error("failed to get user identity, id token does not contain an email claim")
+		return nil, errors.New("id token does not contain an email claim")
+	}
+
+	if claims.EmailVerified == nil || !*claims.EmailVerified {
+		log.C(ctx).Errorf("failed to get user identity, email %s is not verified by the provider", claims.Email)
+		return nil, errors.New("email is not verified by the oidc provider")
+	}
+
+	return &models.UserIdentity{
+		Email: claims.Email,
+	}, nil
+}
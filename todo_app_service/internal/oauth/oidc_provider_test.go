@@ -0,0 +1,159 @@
+package oauth
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"Todo-List/internProject/todo_app_service/pkg/oidc"
+	"Todo-List/internProject/todo_app_service/pkg/oidc/oidctest"
+	"context"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"net/http"
+	"testing"
+)
+
+const oidcClientId = "todo-app"
+
+func setUpOidcProvider(t *testing.T) (*oidcProvider, *oidctest.Server) {
+	t.Helper()
+
+	server, err := oidctest.NewServer(oidcClientId)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	metadata, err := oidc.Discover(context.TODO(), http.DefaultClient, server.URL)
+	require.NoError(t, err)
+
+	config := &oauth2.Config{
+		ClientID:     oidcClientId,
+		ClientSecret: "secret",
+		Scopes:       []string{"openid", "email"},
+		Endpoint:     metadata.Endpoint(),
+		RedirectURL:  "http://localhost:3434/oidc/callback",
+	}
+
+	return NewOidcProvider(config, oidc.NewVerifier(http.DefaultClient, metadata, oidcClientId)), server
+}
+
+func TestOidcProvider_Authenticate(t *testing.T) {
+	tests := []struct {
+		testName         string
+		claims           func(server *oidctest.Server) jwt.MapClaims
+		loginNonce       string
+		expectErr        bool
+		expectedIdentity *models.UserIdentity
+	}{
+		{
+			testName: "Successfully authenticating user with stand-in provider",
+
+			loginNonce: oidctest.DefaultNonce,
+
+			expectedIdentity: &models.UserIdentity{
+				Email: oidctest.DefaultEmail,
+			},
+		},
+
+		{
+			testName: "Failed to authenticate user because id token has no email claim",
+
+			claims: func(server *oidctest.Server) jwt.MapClaims {
+				claims := server.DefaultClaims(oidctest.DefaultEmail)
+				delete(claims, "email")
+				return claims
+			},
+
+			loginNonce: oidctest.DefaultNonce,
+
+			expectErr: true,
+		},
+
+		{
+			testName: "Failed to authenticate user because email is not verified",
+
+			claims: func(server *oidctest.Server) jwt.MapClaims {
+				claims := server.DefaultClaims(oidctest.DefaultEmail)
+				claims["email_verified"] = false
+				return claims
+			},
+
+			loginNonce: oidctest.DefaultNonce,
+
+			expectErr: true,
+		},
+
+		{
+			testName: "Failed to authenticate user because id token was issued for another client",
+
+			claims: func(server *oidctest.Server) jwt.MapClaims {
+				claims := server.DefaultClaims(oidctest.DefaultEmail)
+				claims["aud"] = "another-client"
+				return claims
+			},
+
+			loginNonce: oidctest.DefaultNonce,
+
+			expectErr: true,
+		},
+
+		{
+			testName: "Failed to authenticate user because id token does not say whether email is verified",
+
+			claims: func(server *oidctest.Server) jwt.MapClaims {
+				claims := server.DefaultClaims(oidctest.DefaultEmail)
+				delete(claims, "email_verified")
+				return claims
+			},
+
+			loginNonce: oidctest.DefaultNonce,
+
+			expectErr: true,
+		},
+
+		{
+			testName: "Failed to authenticate user because id token was issued for another login",
+
+			claims: func(server *oidctest.Server) jwt.MapClaims {
+				claims := server.DefaultClaims(oidctest.DefaultEmail)
+				claims["nonce"] = "nonce of another login"
+				return claims
+			},
+
+			loginNonce: oidctest.DefaultNonce,
+
+			expectErr: true,
+		},
+
+		{
+			testName: "Failed to authenticate user because neither the login nor the id token carry a nonce",
+
+			claims: func(server *oidctest.Server) jwt.MapClaims {
+				claims := server.DefaultClaims(oidctest.DefaultEmail)
+				delete(claims, "nonce")
+				return claims
+			},
+
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			p, server := setUpOidcProvider(t)
+			if test.claims != nil {
+				server.SetIdTokenClaims(test.claims(server))
+			}
+
+			oaService := NewService(nil)
+			oaService.RegisterProvider("oidc", p)
+
+			identity, err := oaService.Authenticate(context.TODO(), "oidc", authCode, test.loginNonce)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expectedIdentity, identity)
+		})
+	}
+}
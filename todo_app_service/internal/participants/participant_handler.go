@@ -0,0 +1,241 @@
+package participants
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type participantService interface {
+	GetTodoAssigneesRecords(ctx context.Context, todoId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.UserPage, error)
+	GetTodoWatchersRecords(ctx context.Context, todoId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.UserPage, error)
+	AddTodoAssigneeRecord(ctx context.Context, todoId string, userId string) (*models.User, error)
+	AddTodoWatcherRecord(ctx context.Context, todoId string, userId string) (*models.User, error)
+	RemoveTodoAssigneeRecord(ctx context.Context, todoId string, userId string) error
+	RemoveTodoWatcherRecord(ctx context.Context, todoId string, userId string) error
+}
+
+type fieldValidator interface {
+	Struct(interface{}) error
+}
+
+type Handler struct {
+	serv       participantService
+	fValidator fieldValidator
+	transact   persistence.Transactioner
+}
+
+func NewHandler(service participantService, fValidator fieldValidator, transact persistence.Transactioner) *Handler {
+	return &Handler{
+		serv:       service,
+		fValidator: fValidator,
+		transact:   transact,
+	}
+}
+
+func (h *Handler) HandleGetTodoAssignees(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("getting todo's assignees in participant handler")
+	h.handleGetTodoParticipants(w, r, h.serv.GetTodoAssigneesRecords, constants.TodosAssigneesIdentifier)
+}
+
+func (h *Handler) HandleGetTodoWatchers(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("getting todo's watchers in participant handler")
+	h.handleGetTodoParticipants(w, r, h.serv.GetTodoWatchersRecords, constants.TodosWatchersIdentifier)
+}
+
+func (h *Handler) HandleAddTodoAssignee(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("assigning user to todo in participant handler")
+	h.handleAddTodoParticipant(w, r, h.serv.AddTodoAssigneeRecord)
+}
+
+func (h *Handler) HandleAddTodoWatcher(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("adding watcher to todo in participant handler")
+	h.handleAddTodoParticipant(w, r, h.serv.AddTodoWatcherRecord)
+}
+
+func (h *Handler) HandleRemoveTodoAssignee(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("unassigning user from todo in participant handler")
+	h.handleRemoveTodoParticipant(w, r, h.serv.RemoveTodoAssigneeRecord)
+}
+
+func (h *Handler) HandleRemoveTodoWatcher(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("removing watcher from todo in participant handler")
+	h.handleRemoveTodoParticipant(w, r, h.serv.RemoveTodoWatcherRecord)
+}
+
+type getParticipantsRecordsFunc func(ctx context.Context, todoId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.UserPage, error)
+
+func (h *Handler) handleGetTodoParticipants(w http.ResponseWriter, r *http.Request, getParticipants getParticipantsRecordsFunc, identifier string) {
+	ctx := r.Context()
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in participant handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in participant handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	first := utils.GetContentFromUrl(r, constants.FIRST)
+	after := utils.GetContentFromUrl(r, constants.AFTER)
+	before := utils.GetContentFromUrl(r, constants.BEFORE)
+	last := utils.GetContentFromUrl(r, constants.LAST)
+
+	if len(first) == 0 && len(last) == 0 {
+		first = constants.DEFAULT_LIMIT_VALUE
+	}
+
+	f := &filters.UserFilters{
+		PaginationFilters: filters.PaginationFilters{
+			First:  first,
+			Last:   last,
+			After:  after,
+			Before: before,
+		},
+		TodoID: todoId,
+	}
+
+	rf := &resource_identifier.GenericResourceIdentifier{}
+	rf.SetResourceIdentifier(identifier)
+
+	participants, err := getParticipants(ctx, todoId, f, rf)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get %s of todo with id %s, error %s when calling participant service", identifier, todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(participants); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get %s of todo with id %s, error %s", identifier, todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+type addParticipantRecordFunc func(ctx context.Context, todoId string, userId string) (*models.User, error)
+
+func (h *Handler) handleAddTodoParticipant(w http.ResponseWriter, r *http.Request, addParticipant addParticipantRecordFunc) {
+	ctx := r.Context()
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in participant handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in participant handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	var participant handler_models.AddParticipant
+	if err = json.NewDecoder(r.Body).Decode(&participant); err != nil {
+		log.C(ctx).Errorf("failed to decode add participant handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, participant)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add participant, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	user, err := addParticipant(ctx, todoId, participant.UserId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add user with id %s to todo with id %s, error %s when calling participant service", participant.UserId, todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to add participant to todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(user); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		return
+	}
+}
+
+type removeParticipantRecordFunc func(ctx context.Context, todoId string, userId string) error
+
+func (h *Handler) handleRemoveTodoParticipant(w http.ResponseWriter, r *http.Request, removeParticipant removeParticipantRecordFunc) {
+	ctx := r.Context()
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in participant handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in participant handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	userId, err := utils.GetValueFromContext[string](r.Context(), middlewares.UserId)
+	if err != nil {
+		log.C(ctx).Error("failed to get user_id from the context in participant handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER_ID, http.StatusBadRequest)
+		return
+	}
+
+	if err = removeParticipant(ctx, todoId, userId); err != nil {
+		log.C(ctx).Errorf("failed to remove user with id %s from todo with id %s, error %s when calling participant service", userId, todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to remove participant from todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,169 @@
+package participants
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/source"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
+	"fmt"
+	"time"
+)
+
+type genericRepository interface {
+	GetPaginationInfo(ctx context.Context, sourceName string, filter string, params []interface{}) (*entities.PaginationInfo, error)
+}
+
+type sqlDecoratorFactory interface {
+	CreateSqlDecorator(context.Context, sql_query_decorators.Filters, string, []interface{}) (sql_query_decorators.SqlQueryRetriever, error)
+}
+
+type repository struct {
+	genericRepo genericRepository
+	factory     sqlDecoratorFactory
+}
+
+func NewRepo(genericRepo genericRepository, factory sqlDecoratorFactory) *repository {
+	return &repository{
+		genericRepo: genericRepo,
+		factory:     factory,
+	}
+}
+
+func (r *repository) GetTodoAssignees(ctx context.Context, f filters.SqlFilters) ([]entities.User, error) {
+	log.C(ctx).Info("getting assignees of a todo from participant repository")
+
+	return r.getUsersFromSource(ctx, constants.TodosAssigneesViewName, f)
+}
+
+func (r *repository) GetTodoWatchers(ctx context.Context, f filters.SqlFilters) ([]entities.User, error) {
+	log.C(ctx).Info("getting watchers of a todo from participant repository")
+
+	return r.getUsersFromSource(ctx, constants.TodosWatchersViewName, f)
+}
+
+func (*repository) AddTodoAssignee(ctx context.Context, todoId string, userId string, createdAt time.Time) error {
+	log.C(ctx).Infof("assigning user with id %s to todo with id %s in participant repository", userId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, addTodoAssigneeQuery, todoId, userId, createdAt); err != nil {
+		log.C(ctx).Errorf("failed to assign user with id %s to todo with id %s, error %s when executing sql query", userId, todoId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (*repository) AddTodoWatcher(ctx context.Context, todoId string, userId string, createdAt time.Time) error {
+	log.C(ctx).Infof("adding user with id %s as watcher of todo with id %s in participant repository", userId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, addTodoWatcherQuery, todoId, userId, createdAt); err != nil {
+		log.C(ctx).Errorf("failed to add user with id %s as watcher of todo with id %s, error %s when executing sql query", userId, todoId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (*repository) RemoveTodoAssignee(ctx context.Context, todoId string, userId string, lastUpdated time.Time) error {
+	log.C(ctx).Infof("unassigning user with id %s from todo with id %s in participant repository", userId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	var removed int
+	if err = persist.GetContext(ctx, &removed, removeTodoAssigneeQuery, todoId, userId, lastUpdated); err != nil {
+		log.C(ctx).Errorf("failed to unassign user with id %s from todo with id %s, error %s when executing sql query", userId, todoId, err.Error())
+		return err
+	}
+
+	if removed == 0 {
+		log.C(ctx).Debugf("user with id %s is not assigned to todo with id %s", userId, todoId)
+		return application_errors.NewNotFoundError(constants.ASSIGNEE_TARGET, userId)
+	}
+
+	return nil
+}
+
+func (*repository) RemoveTodoWatcher(ctx context.Context, todoId string, userId string) error {
+	log.C(ctx).Infof("removing user with id %s from the watchers of todo with id %s in participant repository", userId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	res, err := persist.ExecContext(ctx, removeTodoWatcherQuery, todoId, userId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to remove watcher with id %s from todo with id %s, error %s when executing sql query", userId, todoId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Errorf("failed to remove watcher with id %s from todo with id %s, error when trying to get the number of rows affected", userId, todoId)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Debugf("user with id %s does not watch todo with id %s", userId, todoId)
+		return application_errors.NewNotFoundError(constants.WATCHER_TARGET, userId)
+	}
+
+	return nil
+}
+
+func (r *repository) GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error) {
+	log.C(ctx).Info("getting pagination info about todo participants in participant repo")
+
+	filteringClause, params := f.BuildSQLFiltering()
+	return r.genericRepo.GetPaginationInfo(ctx, s.GetSource(), filteringClause, params)
+}
+
+func (r *repository) getUsersFromSource(ctx context.Context, sourceName string, f filters.SqlFilters) ([]entities.User, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	baseQuery := fmt.Sprintf(`SELECT id, email, role FROM %s`, sourceName)
+	filteringClause, params := f.BuildSQLFiltering()
+	baseQuery += filteringClause
+
+	retriever, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery, params)
+	if err != nil {
+		log.C(ctx).Error("failed to determine sql query, error when calling decorator factory")
+		return nil, err
+	}
+
+	sqlQueryString, params := retriever.DetermineCorrectSqlQuery(ctx)
+	completeQuery := fmt.Sprintf(`SELECT id, email, role FROM (%s) ORDER BY id`, sqlQueryString)
+
+	var users []entities.User
+	if err = persist.SelectContext(ctx, &users, completeQuery, params...); err != nil {
+		log.C(ctx).Errorf("failed to get users from %s due to a failure in the execution of the sql query %s", sourceName, err.Error())
+		return nil, err
+	}
+
+	return users, nil
+}
@@ -0,0 +1,205 @@
+package participants
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
+	"Todo-List/internProject/todo_app_service/internal/source"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"time"
+)
+
+type participantRepo interface {
+	GetTodoAssignees(ctx context.Context, f filters.SqlFilters) ([]entities.User, error)
+	GetTodoWatchers(ctx context.Context, f filters.SqlFilters) ([]entities.User, error)
+	AddTodoAssignee(ctx context.Context, todoId string, userId string, createdAt time.Time) error
+	AddTodoWatcher(ctx context.Context, todoId string, userId string, createdAt time.Time) error
+	RemoveTodoAssignee(ctx context.Context, todoId string, userId string, lastUpdated time.Time) error
+	RemoveTodoWatcher(ctx context.Context, todoId string, userId string) error
+	GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error)
+}
+
+type todoRepo interface {
+	GetTodo(ctx context.Context, todoId string) (*entities.Todo, error)
+}
+
+type listRepo interface {
+	GetListOwner(ctx context.Context, listId string) (*entities.User, error)
+	CheckWhetherUserIsCollaborator(ctx context.Context, listId string, userId string) (bool, error)
+}
+
+type userRepo interface {
+	GetUser(ctx context.Context, userId string) (*entities.User, error)
+}
+
+type timeGenerator interface {
+	Now() time.Time
+}
+
+type userConverter interface {
+	ToModel(user *entities.User) *models.User
+	ManyToPage(users []entities.User, pageInfo *entities.PaginationInfo) *models.UserPage
+}
+
+type resourceIdentifierAdapter interface {
+	AdaptResourceIdentifier(rf resource_identifier.ResourceIdentifier) string
+}
+
+type historyRecorder interface {
+	RecordActor(ctx context.Context) error
+}
+
+type service struct {
+	pRepo      participantRepo
+	tRepo      todoRepo
+	lRepo      listRepo
+	uRepo      userRepo
+	timeGen    timeGenerator
+	uConverter userConverter
+	rfAdapter  resourceIdentifierAdapter
+	hRecorder  historyRecorder
+}
+
+func NewService(pRepo participantRepo, tRepo todoRepo, lRepo listRepo, uRepo userRepo, timeGen timeGenerator,
+	uConverter userConverter, rfAdapter resourceIdentifierAdapter, hRecorder historyRecorder) *service {
+	return &service{
+		pRepo:      pRepo,
+		tRepo:      tRepo,
+		lRepo:      lRepo,
+		uRepo:      uRepo,
+		timeGen:    timeGen,
+		uConverter: uConverter,
+		rfAdapter:  rfAdapter,
+		hRecorder:  hRecorder,
+	}
+}
+
+func (s *service) GetTodoAssigneesRecords(ctx context.Context, todoId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.UserPage, error) {
+	log.C(ctx).Infof("getting assignees of todo with id %s in participant service", todoId)
+
+	return s.getTodoParticipants(ctx, todoId, f, rf, s.pRepo.GetTodoAssignees)
+}
+
+func (s *service) GetTodoWatchersRecords(ctx context.Context, todoId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.UserPage, error) {
+	log.C(ctx).Infof("getting watchers of todo with id %s in participant service", todoId)
+
+	return s.getTodoParticipants(ctx, todoId, f, rf, s.pRepo.GetTodoWatchers)
+}
+
+func (s *service) AddTodoAssigneeRecord(ctx context.Context, todoId string, userId string) (*models.User, error) {
+	log.C(ctx).Infof("assigning user with id %s to todo with id %s in participant service", userId, todoId)
+
+	return s.addTodoParticipant(ctx, todoId, userId, s.pRepo.AddTodoAssignee)
+}
+
+func (s *service) AddTodoWatcherRecord(ctx context.Context, todoId string, userId string) (*models.User, error) {
+	log.C(ctx).Infof("adding user with id %s as watcher of todo with id %s in participant service", userId, todoId)
+
+	return s.addTodoParticipant(ctx, todoId, userId, s.pRepo.AddTodoWatcher)
+}
+
+// RemoveTodoAssigneeRecord may clear the assigned_to of the todo, so the actor is recorded for the history of the todo
+func (s *service) RemoveTodoAssigneeRecord(ctx context.Context, todoId string, userId string) error {
+	log.C(ctx).Infof("unassigning user with id %s from todo with id %s in participant service", userId, todoId)
+
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
+	if err := s.pRepo.RemoveTodoAssignee(ctx, todoId, userId, s.timeGen.Now()); err != nil {
+		log.C(ctx).Errorf("failed to unassign user with id %s from todo with id %s, error %s when calling participant repo", userId, todoId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) RemoveTodoWatcherRecord(ctx context.Context, todoId string, userId string) error {
+	log.C(ctx).Infof("removing user with id %s from the watchers of todo with id %s in participant service", userId, todoId)
+
+	if err := s.pRepo.RemoveTodoWatcher(ctx, todoId, userId); err != nil {
+		log.C(ctx).Errorf("failed to remove watcher with id %s from todo with id %s, error %s when calling participant repo", userId, todoId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+type getParticipantsFunc func(ctx context.Context, f filters.SqlFilters) ([]entities.User, error)
+
+func (s *service) getTodoParticipants(ctx context.Context, todoId string, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier,
+	getParticipants getParticipantsFunc) (*models.UserPage, error) {
+	if _, err := s.tRepo.GetTodo(ctx, todoId); err != nil {
+		log.C(ctx).Errorf("failed to get participants of todo with id %s, error when calling todo repo", todoId)
+		return nil, err
+	}
+
+	participants, err := getParticipants(ctx, f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get participants of todo with id %s, error %s when calling participant repo", todoId, err.Error())
+		return nil, err
+	}
+
+	sqlSource := prepareSqlSource(s.rfAdapter, rf)
+	paginationInfo, err := s.pRepo.GetPaginationInfo(ctx, f, sqlSource)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get first and last ids of todo participants, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.uConverter.ManyToPage(participants, paginationInfo), nil
+}
+
+type addParticipantFunc func(ctx context.Context, todoId string, userId string, createdAt time.Time) error
+
+// addTodoParticipant allows only the users who can see the todo to be assigned to it or to watch it
+func (s *service) addTodoParticipant(ctx context.Context, todoId string, userId string, addParticipant addParticipantFunc) (*models.User, error) {
+	todo, err := s.tRepo.GetTodo(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add participant to todo with id %s, error when calling todo repo", todoId)
+		return nil, err
+	}
+
+	user, err := s.uRepo.GetUser(ctx, userId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add participant with id %s, error when calling user repo", userId)
+		return nil, err
+	}
+
+	listOwner, err := s.lRepo.GetListOwner(ctx, todo.ListId.String())
+	if err != nil {
+		log.C(ctx).Errorf("failed to get owner of list with id %s, error %s when calling list repo", todo.ListId, err.Error())
+		return nil, err
+	}
+
+	isCollaborator, err := s.lRepo.CheckWhetherUserIsCollaborator(ctx, todo.ListId.String(), userId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check whether user with id %s is collaborator, error %s when calling list repo", userId, err.Error())
+		return nil, err
+	}
+
+	if listOwner.Id != user.Id && !isCollaborator {
+		log.C(ctx).Errorf("failed to add participant with id %s to todo with id %s, user has no access to the list", userId, todoId)
+		return nil, application_errors.ParticipantOutOfScopeError
+	}
+
+	if err = addParticipant(ctx, todoId, userId, s.timeGen.Now()); err != nil {
+		log.C(ctx).Errorf("failed to add participant with id %s to todo with id %s, error %s when calling participant repo", userId, todoId, err.Error())
+		return nil, err
+	}
+
+	return s.uConverter.ToModel(user), nil
+}
+
+func prepareSqlSource(adapter resourceIdentifierAdapter, rf resource_identifier.ResourceIdentifier) source.Source {
+	adaptedRf := adapter.AdaptResourceIdentifier(rf)
+
+	sqlSource := &source.SqlSource{}
+	sqlSource.SetSource(adaptedRf)
+
+	return sqlSource
+}
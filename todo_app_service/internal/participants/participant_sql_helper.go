@@ -0,0 +1,16 @@
+package participants
+
+const addTodoAssigneeQuery = `INSERT INTO todo_assignees (todo_id, user_id, created_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+
+const addTodoWatcherQuery = `INSERT INTO todo_watchers (todo_id, user_id, created_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+
+const removeTodoWatcherQuery = `DELETE FROM todo_watchers WHERE todo_id = $1 AND user_id = $2`
+
+// removeTodoAssigneeQuery removes the user from both sources of assignees, the user may be the assigned_to of the todo,
+// one of its assignees or both
+const removeTodoAssigneeQuery = `WITH removed_assignees AS (
+    DELETE FROM todo_assignees WHERE todo_id = $1 AND user_id = $2 RETURNING todo_id
+), unassigned_todos AS (
+    UPDATE todos SET assigned_to = NULL, last_updated = $3 WHERE id = $1 AND assigned_to = $2 RETURNING id
+)
+SELECT (SELECT COUNT(*) FROM removed_assignees) + (SELECT COUNT(*) FROM unassigned_todos)`
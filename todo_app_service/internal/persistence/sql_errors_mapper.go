@@ -63,7 +63,7 @@ func MapPostgresUserError(err error, user *entities.User) error {
 	return errors.New("unexpected database error")
 }
 
-func MapPostgresNonExistingUserInUserTable(err error, refresh *entities.Refresh) error {
+func MapPostgresSessionError(err error, session *entities.Session) error {
 	var pqErr *pq.Error
 	if !errors.As(err, &pqErr) {
 		return errors.New("unexpected database error")
@@ -72,15 +72,63 @@ func MapPostgresNonExistingUserInUserTable(err error, refresh *entities.Refresh)
 	switch pqErr.Code {
 	case "23505":
 		switch pqErr.Constraint {
-		case "user_refresh_tokens_pkey":
-			return application_errors.NewAlreadyExistError(constants.USER_TARGET, refresh.UserId.String())
-		case "users_refresh_tokens_refresh_token_key":
-			return application_errors.NewAlreadyExistError(constants.REFRESH_TARGET, refresh.RefreshToken)
+		case "user_sessions_pkey":
+			return application_errors.NewAlreadyExistError(constants.SESSION_TARGET, session.Id.String())
+		case "user_sessions_refresh_token_hash_key":
+			return application_errors.NewAlreadyExistError(constants.REFRESH_TARGET, session.Id.String())
 		}
 	case "23503":
-		return application_errors.NewNotFoundError(constants.USER_TARGET, refresh.UserId.String())
+		return application_errors.NewNotFoundError(constants.USER_TARGET, session.UserId.String())
 	}
 
 	return errors.New("unexpected database error")
 
 }
+
+func MapPostgresLabelError(err error, label *entities.Label) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code {
+	case "23505":
+		return application_errors.NewAlreadyExistError(constants.LABEL_TARGET, label.Name)
+	case "23503":
+		return application_errors.NewNotFoundError(constants.USER_TARGET, label.Owner.String())
+	}
+	return err
+}
+
+func MapPostgresCommentError(err error, comment *entities.Comment) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code {
+	case "23503":
+		switch pqErr.Constraint {
+		case "comments_todo_id_fkey":
+			return application_errors.NewNotFoundError(constants.TODO_TARGET, comment.TodoId.String())
+		case "comments_author_fkey":
+			return application_errors.NewNotFoundError(constants.USER_TARGET, comment.Author.String())
+		}
+	}
+	return err
+}
+
+func MapPostgresColumnError(err error, column *entities.Column) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code {
+	case "23505":
+		return application_errors.NewAlreadyExistError(constants.COLUMN_TARGET, column.Name)
+	case "23503":
+		return application_errors.NewNotFoundError(constants.LIST_TARGET, column.ListId.String())
+	}
+	return err
+}
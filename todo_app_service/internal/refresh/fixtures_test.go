@@ -3,15 +3,20 @@ package refresh
 import (
 	"Todo-List/internProject/todo_app_service/internal/application_errors"
 	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
 	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/handler_models"
 	"Todo-List/internProject/todo_app_service/pkg/models"
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -28,7 +33,22 @@ const (
 )
 
 var (
-	sqlQueryCreateRefreshToken = "INSERT INTO user_refresh_tokens(refresh_token, user_id) VALUES (?, ?) ON CONFLICT (user_id) DO UPDATE SET refresh_token = EXCLUDED.refresh_token"
+	sqlQueryCreateSession   = "INSERT INTO user_sessions (id, user_id, refresh_token_hash, device_label, user_agent, ip_address, created_at, last_used_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	sqlQueryGetUserSessions = `SELECT id, user_id, refresh_token_hash, device_label, user_agent, ip_address, created_at, last_used_at,
+access_token_id, access_token_expires_at FROM user_sessions WHERE user_id = $1 ORDER BY last_used_at DESC, id`
+	sqlQueryDeleteSession = `DELETE FROM user_sessions WHERE id = $1 RETURNING id, user_id, refresh_token_hash, device_label, user_agent, ip_address, created_at, last_used_at,
+access_token_id, access_token_expires_at`
+	sqlQueryDeleteUserSession = `DELETE FROM user_sessions WHERE id = $1 AND user_id = $2 RETURNING id, user_id, refresh_token_hash, device_label, user_agent, ip_address, created_at, last_used_at,
+access_token_id, access_token_expires_at`
+	sqlQueryDeleteAllUserSessions = `DELETE FROM user_sessions WHERE user_id = $1 RETURNING id, user_id, refresh_token_hash, device_label, user_agent, ip_address, created_at, last_used_at,
+access_token_id, access_token_expires_at`
+	sqlQueryRotateSessionToken = `UPDATE user_sessions SET refresh_token_hash = $1, user_agent = $2, ip_address = $3, last_used_at = $4,
+access_token_id = $5, access_token_expires_at = $6
+WHERE id = $7 AND refresh_token_hash = $8`
+	sqlQueryCreateConsumedToken = `INSERT INTO consumed_refresh_tokens (token_hash, session_id, consumed_at) VALUES ($1, $2, $3)`
+	sqlQuerySessionExists       = `SELECT EXISTS(SELECT 1 FROM user_sessions WHERE id = $1)`
+	sessionColumnNames          = []string{"id", "user_id", "refresh_token_hash", "device_label", "user_agent", "ip_address", "created_at",
+		"last_used_at", "access_token_id", "access_token_expires_at"}
 )
 
 var (
@@ -37,7 +57,7 @@ var (
 	errByUserService                    = application_errors.NewNotFoundError(constants.USER_TARGET, INVALID_USER_EMAIL)
 	errWhenCreatingRefreshToken         = errors.New("error when trying to create refresh token")
 	errByRefreshRepo                    = application_errors.NewNotFoundError(constants.USER_TARGET, invalidUserId.String())
-	errByRefreshRepoInvalidRefreshToken = fmt.Errorf("invalid refresh token %s", INVALID_REFRESH_TOKEN)
+	errByRefreshRepoInvalidRefreshToken = application_errors.InvalidRefreshTokenError
 	errInvalidJwtKeyType                = errors.New("invalid key type passed when trying to sign JWT")
 	mockIssuedTime                      = time.Now()
 	mockExpiryTime                      = time.Now().Add(150 * time.Hour)
@@ -50,6 +70,19 @@ var (
 var (
 	errInvalidRequestBody   = errors.New(constants.INVALID_REQUEST_BODY)
 	errWhenCallingJwtIssuer = errors.New("error when calling jwt issuer")
+	errDb                   = errors.New("database error")
+)
+
+var (
+	sessionId                  = uuid.Must(uuid.NewV4())
+	otherSessionId             = uuid.Must(uuid.NewV4())
+	sessionOwnerId             = uuid.Must(uuid.NewV4())
+	otherUserId                = uuid.Must(uuid.NewV4())
+	accessTokenId              = "access token id"
+	accessTokenExpiresAt       = time.Date(2026, time.January, 10, 12, 0, 0, 0, time.UTC)
+	sessionLastUsedAt          = time.Date(2026, time.January, 10, 11, 45, 0, 0, time.UTC)
+	errSessionNotFound         = application_errors.NewNotFoundError(constants.SESSION_TARGET, sessionId.String())
+	errWhenRevokingAccessToken = errors.New("error when trying to revoke access token")
 )
 
 func initUser(userId string, email string) *models.User {
@@ -60,34 +93,75 @@ func initUser(userId string, email string) *models.User {
 	}
 }
 
-func initRefresh(refreshToken string, userId string) *models.Refresh {
-	return &models.Refresh{
-		RefreshToken: refreshToken,
-		UserId:       userId,
+func initSession(sessionId string, userId string) *models.Session {
+	return &models.Session{
+		Id:     sessionId,
+		UserId: userId,
 	}
 }
 
-func initRefreshEntity(refreshToken string, userId uuid.UUID) *entities.Refresh {
-	return &entities.Refresh{
-		RefreshToken: refreshToken,
-		UserId:       userId,
+func initSessionEntity(sessionId uuid.UUID, refreshTokenHash string, userId uuid.UUID) *entities.Session {
+	return &entities.Session{
+		Id:               sessionId,
+		RefreshTokenHash: refreshTokenHash,
+		UserId:           userId,
 	}
 }
 
-func initRefreshEntityFromModel(refresh *models.Refresh) *entities.Refresh {
-	return &entities.Refresh{
-		RefreshToken: refresh.RefreshToken,
-		UserId:       uuid.FromStringOrNil(refresh.UserId),
+func initSessionEntityFromModel(session *models.Session, refreshTokenHash string) *entities.Session {
+	return &entities.Session{
+		Id:               uuid.FromStringOrNil(session.Id),
+		RefreshTokenHash: refreshTokenHash,
+		UserId:           uuid.FromStringOrNil(session.UserId),
 	}
 }
 
-func initRefreshModelFromEntity(refresh *entities.Refresh) *models.Refresh {
-	return &models.Refresh{
-		RefreshToken: refresh.RefreshToken,
-		UserId:       refresh.UserId.String(),
+func initSessionModelFromEntity(session *entities.Session) *models.Session {
+	return &models.Session{
+		Id:     session.Id.String(),
+		UserId: session.UserId.String(),
 	}
 }
 
+// initRevocableSessionEntity returns a session whose last issued access token has not expired yet
+func initRevocableSessionEntity(id uuid.UUID, userId uuid.UUID) entities.Session {
+	return entities.Session{
+		Id:                   id,
+		UserId:               userId,
+		RefreshTokenHash:     hashRefreshToken(refreshToken),
+		DeviceLabel:          "laptop",
+		CreatedAt:            sessionLastUsedAt,
+		LastUsedAt:           sessionLastUsedAt,
+		AccessTokenId:        accessTokenId,
+		AccessTokenExpiresAt: sql.NullTime{Time: accessTokenExpiresAt, Valid: true},
+	}
+}
+
+func addSessionRow(rows *sqlmock.Rows, session entities.Session) *sqlmock.Rows {
+	return rows.AddRow(session.Id, session.UserId, session.RefreshTokenHash, session.DeviceLabel, session.UserAgent, session.IpAddress,
+		session.CreatedAt, session.LastUsedAt, session.AccessTokenId, session.AccessTokenExpiresAt)
+}
+
+func containsReuseEvent(hook *logtest.Hook) bool {
+	for _, entry := range hook.AllEntries() {
+		if entry.Level == logrus.WarnLevel && entry.Data[constants.SECURITY_EVENT] == constants.REFRESH_TOKEN_REUSE_EVENT &&
+			entry.Data["session_id"] == sessionId.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func withUser(req *http.Request, user *models.User) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), middlewares.UserKey, user))
+}
+
+func setUpRevokeSessionRequest(user *models.User) *http.Request {
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/sessions/"+sessionId.String(), nil)
+	req = req.WithContext(context.WithValue(req.Context(), middlewares.SessionId, sessionId.String()))
+	return withUser(req, user)
+}
+
 func initUserEntity(userId uuid.UUID, userEmail string) *entities.User {
 	return &entities.User{
 		Id:    userId,
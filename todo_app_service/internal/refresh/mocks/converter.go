@@ -4,10 +4,9 @@ package mocks
 
 import (
 	entities "Todo-List/internProject/todo_app_service/internal/entities"
+	models "Todo-List/internProject/todo_app_service/pkg/models"
 
 	mock "github.com/stretchr/testify/mock"
-
-	models "Todo-List/internProject/todo_app_service/pkg/models"
 )
 
 // Converter is an autogenerated mock type for the converter type
@@ -23,20 +22,68 @@ func (_m *Converter) EXPECT() *Converter_Expecter {
 	return &Converter_Expecter{mock: &_m.Mock}
 }
 
-// ToEntity provides a mock function with given fields: _a0
-func (_m *Converter) ToEntity(_a0 *models.Refresh) *entities.Refresh {
-	ret := _m.Called(_a0)
+// ManyToModel provides a mock function with given fields: sessions
+func (_m *Converter) ManyToModel(sessions []entities.Session) []*models.Session {
+	ret := _m.Called(sessions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ManyToModel")
+	}
+
+	var r0 []*models.Session
+	if rf, ok := ret.Get(0).(func([]entities.Session) []*models.Session); ok {
+		r0 = rf(sessions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Session)
+		}
+	}
+
+	return r0
+}
+
+// Converter_ManyToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManyToModel'
+type Converter_ManyToModel_Call struct {
+	*mock.Call
+}
+
+// ManyToModel is a helper method to define mock.On call
+//   - sessions []entities.Session
+func (_e *Converter_Expecter) ManyToModel(sessions interface{}) *Converter_ManyToModel_Call {
+	return &Converter_ManyToModel_Call{Call: _e.mock.On("ManyToModel", sessions)}
+}
+
+func (_c *Converter_ManyToModel_Call) Run(run func(sessions []entities.Session)) *Converter_ManyToModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entities.Session))
+	})
+	return _c
+}
+
+func (_c *Converter_ManyToModel_Call) Return(_a0 []*models.Session) *Converter_ManyToModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Converter_ManyToModel_Call) RunAndReturn(run func([]entities.Session) []*models.Session) *Converter_ManyToModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ToEntity provides a mock function with given fields: session
+func (_m *Converter) ToEntity(session *models.Session) *entities.Session {
+	ret := _m.Called(session)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ToEntity")
 	}
 
-	var r0 *entities.Refresh
-	if rf, ok := ret.Get(0).(func(*models.Refresh) *entities.Refresh); ok {
-		r0 = rf(_a0)
+	var r0 *entities.Session
+	if rf, ok := ret.Get(0).(func(*models.Session) *entities.Session); ok {
+		r0 = rf(session)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.Refresh)
+			r0 = ret.Get(0).(*entities.Session)
 		}
 	}
 
@@ -49,42 +96,42 @@ type Converter_ToEntity_Call struct {
 }
 
 // ToEntity is a helper method to define mock.On call
-//   - _a0 *models.Refresh
-func (_e *Converter_Expecter) ToEntity(_a0 interface{}) *Converter_ToEntity_Call {
-	return &Converter_ToEntity_Call{Call: _e.mock.On("ToEntity", _a0)}
+//   - session *models.Session
+func (_e *Converter_Expecter) ToEntity(session interface{}) *Converter_ToEntity_Call {
+	return &Converter_ToEntity_Call{Call: _e.mock.On("ToEntity", session)}
 }
 
-func (_c *Converter_ToEntity_Call) Run(run func(_a0 *models.Refresh)) *Converter_ToEntity_Call {
+func (_c *Converter_ToEntity_Call) Run(run func(session *models.Session)) *Converter_ToEntity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*models.Refresh))
+		run(args[0].(*models.Session))
 	})
 	return _c
 }
 
-func (_c *Converter_ToEntity_Call) Return(_a0 *entities.Refresh) *Converter_ToEntity_Call {
+func (_c *Converter_ToEntity_Call) Return(_a0 *entities.Session) *Converter_ToEntity_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Converter_ToEntity_Call) RunAndReturn(run func(*models.Refresh) *entities.Refresh) *Converter_ToEntity_Call {
+func (_c *Converter_ToEntity_Call) RunAndReturn(run func(*models.Session) *entities.Session) *Converter_ToEntity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ToModel provides a mock function with given fields: _a0
-func (_m *Converter) ToModel(_a0 *entities.Refresh) *models.Refresh {
-	ret := _m.Called(_a0)
+// ToModel provides a mock function with given fields: session
+func (_m *Converter) ToModel(session *entities.Session) *models.Session {
+	ret := _m.Called(session)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ToModel")
 	}
 
-	var r0 *models.Refresh
-	if rf, ok := ret.Get(0).(func(*entities.Refresh) *models.Refresh); ok {
-		r0 = rf(_a0)
+	var r0 *models.Session
+	if rf, ok := ret.Get(0).(func(*entities.Session) *models.Session); ok {
+		r0 = rf(session)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Refresh)
+			r0 = ret.Get(0).(*models.Session)
 		}
 	}
 
@@ -97,24 +144,24 @@ type Converter_ToModel_Call struct {
 }
 
 // ToModel is a helper method to define mock.On call
-//   - _a0 *entities.Refresh
-func (_e *Converter_Expecter) ToModel(_a0 interface{}) *Converter_ToModel_Call {
-	return &Converter_ToModel_Call{Call: _e.mock.On("ToModel", _a0)}
+//   - session *entities.Session
+func (_e *Converter_Expecter) ToModel(session interface{}) *Converter_ToModel_Call {
+	return &Converter_ToModel_Call{Call: _e.mock.On("ToModel", session)}
 }
 
-func (_c *Converter_ToModel_Call) Run(run func(_a0 *entities.Refresh)) *Converter_ToModel_Call {
+func (_c *Converter_ToModel_Call) Run(run func(session *entities.Session)) *Converter_ToModel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entities.Refresh))
+		run(args[0].(*entities.Session))
 	})
 	return _c
 }
 
-func (_c *Converter_ToModel_Call) Return(_a0 *models.Refresh) *Converter_ToModel_Call {
+func (_c *Converter_ToModel_Call) Return(_a0 *models.Session) *Converter_ToModel_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Converter_ToModel_Call) RunAndReturn(run func(*entities.Refresh) *models.Refresh) *Converter_ToModel_Call {
+func (_c *Converter_ToModel_Call) RunAndReturn(run func(*entities.Session) *models.Session) *Converter_ToModel_Call {
 	_c.Call.Return(run)
 	return _c
 }
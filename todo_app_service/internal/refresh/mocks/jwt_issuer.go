@@ -24,9 +24,9 @@ func (_m *JwtIssuer) EXPECT() *JwtIssuer_Expecter {
 	return &JwtIssuer_Expecter{mock: &_m.Mock}
 }
 
-// GetRenewedTokens provides a mock function with given fields: _a0, _a1
-func (_m *JwtIssuer) GetRenewedTokens(_a0 context.Context, _a1 *handler_models.Refresh) (*models.CallbackResponse, error) {
-	ret := _m.Called(_a0, _a1)
+// GetRenewedTokens provides a mock function with given fields: ctx, refresh, device
+func (_m *JwtIssuer) GetRenewedTokens(ctx context.Context, refresh *handler_models.Refresh, device *models.SessionDevice) (*models.CallbackResponse, error) {
+	ret := _m.Called(ctx, refresh, device)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetRenewedTokens")
@@ -34,19 +34,19 @@ func (_m *JwtIssuer) GetRenewedTokens(_a0 context.Context, _a1 *handler_models.R
 
 	var r0 *models.CallbackResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *handler_models.Refresh) (*models.CallbackResponse, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, *handler_models.Refresh, *models.SessionDevice) (*models.CallbackResponse, error)); ok {
+		return rf(ctx, refresh, device)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *handler_models.Refresh) *models.CallbackResponse); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, *handler_models.Refresh, *models.SessionDevice) *models.CallbackResponse); ok {
+		r0 = rf(ctx, refresh, device)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.CallbackResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *handler_models.Refresh) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, *handler_models.Refresh, *models.SessionDevice) error); ok {
+		r1 = rf(ctx, refresh, device)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,15 +60,16 @@ type JwtIssuer_GetRenewedTokens_Call struct {
 }
 
 // GetRenewedTokens is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 *handler_models.Refresh
-func (_e *JwtIssuer_Expecter) GetRenewedTokens(_a0 interface{}, _a1 interface{}) *JwtIssuer_GetRenewedTokens_Call {
-	return &JwtIssuer_GetRenewedTokens_Call{Call: _e.mock.On("GetRenewedTokens", _a0, _a1)}
+//   - ctx context.Context
+//   - refresh *handler_models.Refresh
+//   - device *models.SessionDevice
+func (_e *JwtIssuer_Expecter) GetRenewedTokens(ctx interface{}, refresh interface{}, device interface{}) *JwtIssuer_GetRenewedTokens_Call {
+	return &JwtIssuer_GetRenewedTokens_Call{Call: _e.mock.On("GetRenewedTokens", ctx, refresh, device)}
 }
 
-func (_c *JwtIssuer_GetRenewedTokens_Call) Run(run func(_a0 context.Context, _a1 *handler_models.Refresh)) *JwtIssuer_GetRenewedTokens_Call {
+func (_c *JwtIssuer_GetRenewedTokens_Call) Run(run func(ctx context.Context, refresh *handler_models.Refresh, device *models.SessionDevice)) *JwtIssuer_GetRenewedTokens_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*handler_models.Refresh))
+		run(args[0].(context.Context), args[1].(*handler_models.Refresh), args[2].(*models.SessionDevice))
 	})
 	return _c
 }
@@ -78,7 +79,7 @@ func (_c *JwtIssuer_GetRenewedTokens_Call) Return(_a0 *models.CallbackResponse,
 	return _c
 }
 
-func (_c *JwtIssuer_GetRenewedTokens_Call) RunAndReturn(run func(context.Context, *handler_models.Refresh) (*models.CallbackResponse, error)) *JwtIssuer_GetRenewedTokens_Call {
+func (_c *JwtIssuer_GetRenewedTokens_Call) RunAndReturn(run func(context.Context, *handler_models.Refresh, *models.SessionDevice) (*models.CallbackResponse, error)) *JwtIssuer_GetRenewedTokens_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,10 +3,13 @@
 package mocks
 
 import (
-	entities "Todo-List/internProject/todo_app_service/internal/entities"
 	context "context"
 
+	entities "Todo-List/internProject/todo_app_service/internal/entities"
+
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // RefreshRepository is an autogenerated mock type for the refreshRepository type
@@ -22,29 +25,314 @@ func (_m *RefreshRepository) EXPECT() *RefreshRepository_Expecter {
 	return &RefreshRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateRefreshToken provides a mock function with given fields: _a0, _a1
-func (_m *RefreshRepository) CreateRefreshToken(_a0 context.Context, _a1 *entities.Refresh) (*entities.Refresh, error) {
-	ret := _m.Called(_a0, _a1)
+// CreateSession provides a mock function with given fields: ctx, session
+func (_m *RefreshRepository) CreateSession(ctx context.Context, session *entities.Session) (*entities.Session, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 *entities.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Session) (*entities.Session, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Session) *entities.Session); ok {
+		r0 = rf(ctx, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.Session) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshRepository_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type RefreshRepository_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *entities.Session
+func (_e *RefreshRepository_Expecter) CreateSession(ctx interface{}, session interface{}) *RefreshRepository_CreateSession_Call {
+	return &RefreshRepository_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, session)}
+}
+
+func (_c *RefreshRepository_CreateSession_Call) Run(run func(ctx context.Context, session *entities.Session)) *RefreshRepository_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.Session))
+	})
+	return _c
+}
+
+func (_c *RefreshRepository_CreateSession_Call) Return(_a0 *entities.Session, _a1 error) *RefreshRepository_CreateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshRepository_CreateSession_Call) RunAndReturn(run func(context.Context, *entities.Session) (*entities.Session, error)) *RefreshRepository_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredConsumedTokens provides a mock function with given fields: ctx, sessionId, consumedBefore
+func (_m *RefreshRepository) DeleteExpiredConsumedTokens(ctx context.Context, sessionId string, consumedBefore time.Time) error {
+	ret := _m.Called(ctx, sessionId, consumedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredConsumedTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, sessionId, consumedBefore)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshRepository_DeleteExpiredConsumedTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredConsumedTokens'
+type RefreshRepository_DeleteExpiredConsumedTokens_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredConsumedTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionId string
+//   - consumedBefore time.Time
+func (_e *RefreshRepository_Expecter) DeleteExpiredConsumedTokens(ctx interface{}, sessionId interface{}, consumedBefore interface{}) *RefreshRepository_DeleteExpiredConsumedTokens_Call {
+	return &RefreshRepository_DeleteExpiredConsumedTokens_Call{Call: _e.mock.On("DeleteExpiredConsumedTokens", ctx, sessionId, consumedBefore)}
+}
+
+func (_c *RefreshRepository_DeleteExpiredConsumedTokens_Call) Run(run func(ctx context.Context, sessionId string, consumedBefore time.Time)) *RefreshRepository_DeleteExpiredConsumedTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RefreshRepository_DeleteExpiredConsumedTokens_Call) Return(_a0 error) *RefreshRepository_DeleteExpiredConsumedTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshRepository_DeleteExpiredConsumedTokens_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *RefreshRepository_DeleteExpiredConsumedTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSession provides a mock function with given fields: ctx, sessionId, userId
+func (_m *RefreshRepository) DeleteSession(ctx context.Context, sessionId string, userId string) (*entities.Session, error) {
+	ret := _m.Called(ctx, sessionId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSession")
+	}
+
+	var r0 *entities.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.Session, error)); ok {
+		return rf(ctx, sessionId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.Session); ok {
+		r0 = rf(ctx, sessionId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, sessionId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshRepository_DeleteSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSession'
+type RefreshRepository_DeleteSession_Call struct {
+	*mock.Call
+}
+
+// DeleteSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionId string
+//   - userId string
+func (_e *RefreshRepository_Expecter) DeleteSession(ctx interface{}, sessionId interface{}, userId interface{}) *RefreshRepository_DeleteSession_Call {
+	return &RefreshRepository_DeleteSession_Call{Call: _e.mock.On("DeleteSession", ctx, sessionId, userId)}
+}
+
+func (_c *RefreshRepository_DeleteSession_Call) Run(run func(ctx context.Context, sessionId string, userId string)) *RefreshRepository_DeleteSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshRepository_DeleteSession_Call) Return(_a0 *entities.Session, _a1 error) *RefreshRepository_DeleteSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshRepository_DeleteSession_Call) RunAndReturn(run func(context.Context, string, string) (*entities.Session, error)) *RefreshRepository_DeleteSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserSessions provides a mock function with given fields: ctx, userId
+func (_m *RefreshRepository) DeleteUserSessions(ctx context.Context, userId string) ([]entities.Session, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserSessions")
+	}
+
+	var r0 []entities.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entities.Session, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entities.Session); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshRepository_DeleteUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserSessions'
+type RefreshRepository_DeleteUserSessions_Call struct {
+	*mock.Call
+}
+
+// DeleteUserSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *RefreshRepository_Expecter) DeleteUserSessions(ctx interface{}, userId interface{}) *RefreshRepository_DeleteUserSessions_Call {
+	return &RefreshRepository_DeleteUserSessions_Call{Call: _e.mock.On("DeleteUserSessions", ctx, userId)}
+}
+
+func (_c *RefreshRepository_DeleteUserSessions_Call) Run(run func(ctx context.Context, userId string)) *RefreshRepository_DeleteUserSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshRepository_DeleteUserSessions_Call) Return(_a0 []entities.Session, _a1 error) *RefreshRepository_DeleteUserSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshRepository_DeleteUserSessions_Call) RunAndReturn(run func(context.Context, string) ([]entities.Session, error)) *RefreshRepository_DeleteUserSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConsumedToken provides a mock function with given fields: ctx, tokenHash
+func (_m *RefreshRepository) GetConsumedToken(ctx context.Context, tokenHash string) (*entities.ConsumedRefreshToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsumedToken")
+	}
+
+	var r0 *entities.ConsumedRefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.ConsumedRefreshToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.ConsumedRefreshToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ConsumedRefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshRepository_GetConsumedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConsumedToken'
+type RefreshRepository_GetConsumedToken_Call struct {
+	*mock.Call
+}
+
+// GetConsumedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *RefreshRepository_Expecter) GetConsumedToken(ctx interface{}, tokenHash interface{}) *RefreshRepository_GetConsumedToken_Call {
+	return &RefreshRepository_GetConsumedToken_Call{Call: _e.mock.On("GetConsumedToken", ctx, tokenHash)}
+}
+
+func (_c *RefreshRepository_GetConsumedToken_Call) Run(run func(ctx context.Context, tokenHash string)) *RefreshRepository_GetConsumedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshRepository_GetConsumedToken_Call) Return(_a0 *entities.ConsumedRefreshToken, _a1 error) *RefreshRepository_GetConsumedToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshRepository_GetConsumedToken_Call) RunAndReturn(run func(context.Context, string) (*entities.ConsumedRefreshToken, error)) *RefreshRepository_GetConsumedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessionByRefreshTokenHash provides a mock function with given fields: ctx, refreshTokenHash
+func (_m *RefreshRepository) GetSessionByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*entities.Session, error) {
+	ret := _m.Called(ctx, refreshTokenHash)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateRefreshToken")
+		panic("no return value specified for GetSessionByRefreshTokenHash")
 	}
 
-	var r0 *entities.Refresh
+	var r0 *entities.Session
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entities.Refresh) (*entities.Refresh, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.Session, error)); ok {
+		return rf(ctx, refreshTokenHash)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *entities.Refresh) *entities.Refresh); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.Session); ok {
+		r0 = rf(ctx, refreshTokenHash)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.Refresh)
+			r0 = ret.Get(0).(*entities.Session)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *entities.Refresh) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshTokenHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,38 +340,38 @@ func (_m *RefreshRepository) CreateRefreshToken(_a0 context.Context, _a1 *entiti
 	return r0, r1
 }
 
-// RefreshRepository_CreateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRefreshToken'
-type RefreshRepository_CreateRefreshToken_Call struct {
+// RefreshRepository_GetSessionByRefreshTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionByRefreshTokenHash'
+type RefreshRepository_GetSessionByRefreshTokenHash_Call struct {
 	*mock.Call
 }
 
-// CreateRefreshToken is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 *entities.Refresh
-func (_e *RefreshRepository_Expecter) CreateRefreshToken(_a0 interface{}, _a1 interface{}) *RefreshRepository_CreateRefreshToken_Call {
-	return &RefreshRepository_CreateRefreshToken_Call{Call: _e.mock.On("CreateRefreshToken", _a0, _a1)}
+// GetSessionByRefreshTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshTokenHash string
+func (_e *RefreshRepository_Expecter) GetSessionByRefreshTokenHash(ctx interface{}, refreshTokenHash interface{}) *RefreshRepository_GetSessionByRefreshTokenHash_Call {
+	return &RefreshRepository_GetSessionByRefreshTokenHash_Call{Call: _e.mock.On("GetSessionByRefreshTokenHash", ctx, refreshTokenHash)}
 }
 
-func (_c *RefreshRepository_CreateRefreshToken_Call) Run(run func(_a0 context.Context, _a1 *entities.Refresh)) *RefreshRepository_CreateRefreshToken_Call {
+func (_c *RefreshRepository_GetSessionByRefreshTokenHash_Call) Run(run func(ctx context.Context, refreshTokenHash string)) *RefreshRepository_GetSessionByRefreshTokenHash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*entities.Refresh))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *RefreshRepository_CreateRefreshToken_Call) Return(_a0 *entities.Refresh, _a1 error) *RefreshRepository_CreateRefreshToken_Call {
+func (_c *RefreshRepository_GetSessionByRefreshTokenHash_Call) Return(_a0 *entities.Session, _a1 error) *RefreshRepository_GetSessionByRefreshTokenHash_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RefreshRepository_CreateRefreshToken_Call) RunAndReturn(run func(context.Context, *entities.Refresh) (*entities.Refresh, error)) *RefreshRepository_CreateRefreshToken_Call {
+func (_c *RefreshRepository_GetSessionByRefreshTokenHash_Call) RunAndReturn(run func(context.Context, string) (*entities.Session, error)) *RefreshRepository_GetSessionByRefreshTokenHash_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTokenOwner provides a mock function with given fields: _a0, _a1
-func (_m *RefreshRepository) GetTokenOwner(_a0 context.Context, _a1 string) (*entities.User, error) {
-	ret := _m.Called(_a0, _a1)
+// GetTokenOwner provides a mock function with given fields: ctx, refreshTokenHash
+func (_m *RefreshRepository) GetTokenOwner(ctx context.Context, refreshTokenHash string) (*entities.User, error) {
+	ret := _m.Called(ctx, refreshTokenHash)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTokenOwner")
@@ -92,10 +380,10 @@ func (_m *RefreshRepository) GetTokenOwner(_a0 context.Context, _a1 string) (*en
 	var r0 *entities.User
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.User, error)); ok {
-		return rf(_a0, _a1)
+		return rf(ctx, refreshTokenHash)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.User); ok {
-		r0 = rf(_a0, _a1)
+		r0 = rf(ctx, refreshTokenHash)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.User)
@@ -103,7 +391,7 @@ func (_m *RefreshRepository) GetTokenOwner(_a0 context.Context, _a1 string) (*en
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(_a0, _a1)
+		r1 = rf(ctx, refreshTokenHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -117,13 +405,13 @@ type RefreshRepository_GetTokenOwner_Call struct {
 }
 
 // GetTokenOwner is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 string
-func (_e *RefreshRepository_Expecter) GetTokenOwner(_a0 interface{}, _a1 interface{}) *RefreshRepository_GetTokenOwner_Call {
-	return &RefreshRepository_GetTokenOwner_Call{Call: _e.mock.On("GetTokenOwner", _a0, _a1)}
+//   - ctx context.Context
+//   - refreshTokenHash string
+func (_e *RefreshRepository_Expecter) GetTokenOwner(ctx interface{}, refreshTokenHash interface{}) *RefreshRepository_GetTokenOwner_Call {
+	return &RefreshRepository_GetTokenOwner_Call{Call: _e.mock.On("GetTokenOwner", ctx, refreshTokenHash)}
 }
 
-func (_c *RefreshRepository_GetTokenOwner_Call) Run(run func(_a0 context.Context, _a1 string)) *RefreshRepository_GetTokenOwner_Call {
+func (_c *RefreshRepository_GetTokenOwner_Call) Run(run func(ctx context.Context, refreshTokenHash string)) *RefreshRepository_GetTokenOwner_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
@@ -140,29 +428,29 @@ func (_c *RefreshRepository_GetTokenOwner_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
-// UpdateRefreshToken provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RefreshRepository) UpdateRefreshToken(_a0 context.Context, _a1 string, _a2 string) (*entities.Refresh, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// GetUserSessions provides a mock function with given fields: ctx, userId
+func (_m *RefreshRepository) GetUserSessions(ctx context.Context, userId string) ([]entities.Session, error) {
+	ret := _m.Called(ctx, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateRefreshToken")
+		panic("no return value specified for GetUserSessions")
 	}
 
-	var r0 *entities.Refresh
+	var r0 []entities.Session
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.Refresh, error)); ok {
-		return rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entities.Session, error)); ok {
+		return rf(ctx, userId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.Refresh); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entities.Session); ok {
+		r0 = rf(ctx, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.Refresh)
+			r0 = ret.Get(0).([]entities.Session)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -170,32 +458,136 @@ func (_m *RefreshRepository) UpdateRefreshToken(_a0 context.Context, _a1 string,
 	return r0, r1
 }
 
-// RefreshRepository_UpdateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRefreshToken'
-type RefreshRepository_UpdateRefreshToken_Call struct {
+// RefreshRepository_GetUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserSessions'
+type RefreshRepository_GetUserSessions_Call struct {
 	*mock.Call
 }
 
-// UpdateRefreshToken is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 string
-//   - _a2 string
-func (_e *RefreshRepository_Expecter) UpdateRefreshToken(_a0 interface{}, _a1 interface{}, _a2 interface{}) *RefreshRepository_UpdateRefreshToken_Call {
-	return &RefreshRepository_UpdateRefreshToken_Call{Call: _e.mock.On("UpdateRefreshToken", _a0, _a1, _a2)}
+// GetUserSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *RefreshRepository_Expecter) GetUserSessions(ctx interface{}, userId interface{}) *RefreshRepository_GetUserSessions_Call {
+	return &RefreshRepository_GetUserSessions_Call{Call: _e.mock.On("GetUserSessions", ctx, userId)}
 }
 
-func (_c *RefreshRepository_UpdateRefreshToken_Call) Run(run func(_a0 context.Context, _a1 string, _a2 string)) *RefreshRepository_UpdateRefreshToken_Call {
+func (_c *RefreshRepository_GetUserSessions_Call) Run(run func(ctx context.Context, userId string)) *RefreshRepository_GetUserSessions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshRepository_GetUserSessions_Call) Return(_a0 []entities.Session, _a1 error) *RefreshRepository_GetUserSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshRepository_GetUserSessions_Call) RunAndReturn(run func(context.Context, string) ([]entities.Session, error)) *RefreshRepository_GetUserSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSessionToken provides a mock function with given fields: ctx, session, oldTokenHash
+func (_m *RefreshRepository) RotateSessionToken(ctx context.Context, session *entities.Session, oldTokenHash string) error {
+	ret := _m.Called(ctx, session, oldTokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSessionToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Session, string) error); ok {
+		r0 = rf(ctx, session, oldTokenHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshRepository_RotateSessionToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSessionToken'
+type RefreshRepository_RotateSessionToken_Call struct {
+	*mock.Call
+}
+
+// RotateSessionToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *entities.Session
+//   - oldTokenHash string
+func (_e *RefreshRepository_Expecter) RotateSessionToken(ctx interface{}, session interface{}, oldTokenHash interface{}) *RefreshRepository_RotateSessionToken_Call {
+	return &RefreshRepository_RotateSessionToken_Call{Call: _e.mock.On("RotateSessionToken", ctx, session, oldTokenHash)}
+}
+
+func (_c *RefreshRepository_RotateSessionToken_Call) Run(run func(ctx context.Context, session *entities.Session, oldTokenHash string)) *RefreshRepository_RotateSessionToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.Session), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshRepository_RotateSessionToken_Call) Return(_a0 error) *RefreshRepository_RotateSessionToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshRepository_RotateSessionToken_Call) RunAndReturn(run func(context.Context, *entities.Session, string) error) *RefreshRepository_RotateSessionToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SessionExists provides a mock function with given fields: ctx, sessionId
+func (_m *RefreshRepository) SessionExists(ctx context.Context, sessionId string) (bool, error) {
+	ret := _m.Called(ctx, sessionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SessionExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, sessionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, sessionId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sessionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshRepository_SessionExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SessionExists'
+type RefreshRepository_SessionExists_Call struct {
+	*mock.Call
+}
+
+// SessionExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionId string
+func (_e *RefreshRepository_Expecter) SessionExists(ctx interface{}, sessionId interface{}) *RefreshRepository_SessionExists_Call {
+	return &RefreshRepository_SessionExists_Call{Call: _e.mock.On("SessionExists", ctx, sessionId)}
+}
+
+func (_c *RefreshRepository_SessionExists_Call) Run(run func(ctx context.Context, sessionId string)) *RefreshRepository_SessionExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *RefreshRepository_UpdateRefreshToken_Call) Return(_a0 *entities.Refresh, _a1 error) *RefreshRepository_UpdateRefreshToken_Call {
+func (_c *RefreshRepository_SessionExists_Call) Return(_a0 bool, _a1 error) *RefreshRepository_SessionExists_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RefreshRepository_UpdateRefreshToken_Call) RunAndReturn(run func(context.Context, string, string) (*entities.Refresh, error)) *RefreshRepository_UpdateRefreshToken_Call {
+func (_c *RefreshRepository_SessionExists_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *RefreshRepository_SessionExists_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -23,17 +23,17 @@ func (_m *UserConverter) EXPECT() *UserConverter_Expecter {
 	return &UserConverter_Expecter{mock: &_m.Mock}
 }
 
-// ConvertFromDBEntityToModel provides a mock function with given fields: _a0
-func (_m *UserConverter) ConvertFromDBEntityToModel(_a0 *entities.User) *models.User {
-	ret := _m.Called(_a0)
+// ToModel provides a mock function with given fields: user
+func (_m *UserConverter) ToModel(user *entities.User) *models.User {
+	ret := _m.Called(user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ConvertFromDBEntityToModel")
+		panic("no return value specified for ToModel")
 	}
 
 	var r0 *models.User
 	if rf, ok := ret.Get(0).(func(*entities.User) *models.User); ok {
-		r0 = rf(_a0)
+		r0 = rf(user)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.User)
@@ -43,30 +43,30 @@ func (_m *UserConverter) ConvertFromDBEntityToModel(_a0 *entities.User) *models.
 	return r0
 }
 
-// UserConverter_ConvertFromDBEntityToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertFromDBEntityToModel'
-type UserConverter_ConvertFromDBEntityToModel_Call struct {
+// UserConverter_ToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToModel'
+type UserConverter_ToModel_Call struct {
 	*mock.Call
 }
 
-// ConvertFromDBEntityToModel is a helper method to define mock.On call
-//   - _a0 *entities.User
-func (_e *UserConverter_Expecter) ConvertFromDBEntityToModel(_a0 interface{}) *UserConverter_ConvertFromDBEntityToModel_Call {
-	return &UserConverter_ConvertFromDBEntityToModel_Call{Call: _e.mock.On("ConvertFromDBEntityToModel", _a0)}
+// ToModel is a helper method to define mock.On call
+//   - user *entities.User
+func (_e *UserConverter_Expecter) ToModel(user interface{}) *UserConverter_ToModel_Call {
+	return &UserConverter_ToModel_Call{Call: _e.mock.On("ToModel", user)}
 }
 
-func (_c *UserConverter_ConvertFromDBEntityToModel_Call) Run(run func(_a0 *entities.User)) *UserConverter_ConvertFromDBEntityToModel_Call {
+func (_c *UserConverter_ToModel_Call) Run(run func(user *entities.User)) *UserConverter_ToModel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(*entities.User))
 	})
 	return _c
 }
 
-func (_c *UserConverter_ConvertFromDBEntityToModel_Call) Return(_a0 *models.User) *UserConverter_ConvertFromDBEntityToModel_Call {
+func (_c *UserConverter_ToModel_Call) Return(_a0 *models.User) *UserConverter_ToModel_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UserConverter_ConvertFromDBEntityToModel_Call) RunAndReturn(run func(*entities.User) *models.User) *UserConverter_ConvertFromDBEntityToModel_Call {
+func (_c *UserConverter_ToModel_Call) RunAndReturn(run func(*entities.User) *models.User) *UserConverter_ToModel_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,6 +1,8 @@
 package refresh
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
 	"Todo-List/internProject/todo_app_service/internal/persistence"
 	"Todo-List/internProject/todo_app_service/internal/utils"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
@@ -9,27 +11,38 @@ import (
 	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 )
 
 //go:generate mockery --name=jwtIssuer --exported --output=./mocks --outpkg=mocks --filename=jwt_issuer.go --with-expecter=true
 type jwtIssuer interface {
-	GetRenewedTokens(ctx context.Context, refresh *handler_models.Refresh) (*models.CallbackResponse, error)
+	GetRenewedTokens(ctx context.Context, refresh *handler_models.Refresh, device *models.SessionDevice) (*models.CallbackResponse, error)
 }
 
+//go:generate mockery --name=sessionService --exported --output=./mocks --outpkg=mocks --filename=session_service.go --with-expecter=true
+type sessionService interface {
+	GetUserSessionsRecords(ctx context.Context, userId string, currentSessionId string) ([]*models.Session, error)
+	RevokeSessionRecord(ctx context.Context, sessionId string, user *models.User) error
+	RevokeUserSessionsRecords(ctx context.Context, userId string) error
+}
+
+//go:generate mockery --name=httpService --exported --output=./mocks --outpkg=mocks --filename=http_service.go --with-expecter=true
 type httpService interface {
 	SetCookie(w http.ResponseWriter, cookie *http.Cookie)
 }
 
 type Handler struct {
 	issuer   jwtIssuer
+	sessions sessionService
 	service  httpService
 	transact persistence.Transactioner
 }
 
-func NewHandler(issuer jwtIssuer, service httpService, transact persistence.Transactioner) *Handler {
+func NewHandler(issuer jwtIssuer, sessions sessionService, service httpService, transact persistence.Transactioner) *Handler {
 	return &Handler{
 		issuer:   issuer,
+		sessions: sessions,
 		service:  service,
 		transact: transact,
 	}
@@ -55,10 +68,20 @@ func (h *Handler) HandleRefresh(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	renewedTokens, err := h.issuer.GetRenewedTokens(ctx, &refresh)
+	renewedTokens, err := h.issuer.GetRenewedTokens(ctx, &refresh, utils.ExtractSessionDevice(r, ""))
 	if err != nil {
 		log.C(ctx).Errorf("failed to refresh tokens, error %s when calling service method", err.Error())
-		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+
+		// the revocation of the session whose refresh token was reused has to outlive the failed request
+		if errors.Is(err, application_errors.RefreshTokenReuseError) {
+			if err := tx.Commit(); err != nil {
+				log.C(ctx).Errorf("failed to commit revocation of session in refresh handler, error %s", err.Error())
+				utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
 		return
 	}
 
@@ -88,3 +111,155 @@ func (h *Handler) HandleRefresh(w http.ResponseWriter, r *http.Request) {
 	}
 	w.WriteHeader(http.StatusOK)
 }
+
+func (h *Handler) HandleGetUserSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting user sessions in refresh handler")
+
+	userId, err := utils.GetValueFromContext[string](r.Context(), middlewares.UserId)
+	if err != nil {
+		log.C(ctx).Error("failed to get user_id from the context in refresh handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER_ID, http.StatusBadRequest)
+		return
+	}
+
+	h.getSessions(w, r, userId)
+}
+
+func (h *Handler) HandleGetMySessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting sessions of the current user in refresh handler")
+
+	user, err := utils.GetValueFromContext[*models.User](r.Context(), middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get user due to an error %s when trying to get value from context in refresh handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	h.getSessions(w, r, user.Id)
+}
+
+func (h *Handler) getSessions(w http.ResponseWriter, r *http.Request, userId string) {
+	ctx := r.Context()
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in refresh handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	// access tokens issued before sessions existed carry no session id, none of the sessions is marked as current for them
+	currentSessionId, _ := utils.GetValueFromContext[string](r.Context(), middlewares.CurrentSessionId)
+
+	sessions, err := h.sessions.GetUserSessionsRecords(ctx, userId, currentSessionId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get sessions of user with id %s, error %s when calling session service", userId, err.Error())
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(sessions); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get sessions, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleRevokeSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("revoking session in refresh handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in refresh handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	sessionId, err := utils.GetValueFromContext[string](r.Context(), middlewares.SessionId)
+	if err != nil {
+		log.C(ctx).Error("failed to get session_id from the context in refresh handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_SESSION_ID, http.StatusBadRequest)
+		return
+	}
+
+	user, err := utils.GetValueFromContext[*models.User](r.Context(), middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get user due to an error %s when trying to get value from context in refresh handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	if err = h.sessions.RevokeSessionRecord(ctx, sessionId, user); err != nil {
+		log.C(ctx).Errorf("failed to revoke session with id %s, error %s when calling session service", sessionId, err.Error())
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to revoke session, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleRevokeUserSessions force-logs out the user from every device, only admins can call it
+func (h *Handler) HandleRevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("revoking sessions of user in refresh handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in refresh handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	userId, err := utils.GetValueFromContext[string](r.Context(), middlewares.UserId)
+	if err != nil {
+		log.C(ctx).Error("failed to get user_id from the context in refresh handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER_ID, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.sessions.RevokeUserSessionsRecords(ctx, userId); err != nil {
+		log.C(ctx).Errorf("failed to revoke sessions of user with id %s, error %s when calling session service", userId, err.Error())
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to revoke sessions of user, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleVerifyToken lets other services check that an access token has not been revoked,
+// the authorisation middleware has already done the check when the request gets here
+func (h *Handler) HandleVerifyToken(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("access token verified in refresh handler")
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -1,10 +1,18 @@
 package refresh
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
 	"Todo-List/internProject/todo_app_service/internal/refresh/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -14,7 +22,9 @@ func TestHandler_HandleRefresh(t *testing.T) {
 	tests := []struct {
 		testName              string
 		jwtIssuerMock         func() *mocks.JwtIssuer
+		httpServiceMock       func() *mocks.HttpService
 		httpRequestMock       func(t *testing.T) *http.Request
+		dbMock                func(mck sqlmock.Sqlmock)
 		expectedHttpCode      int
 		err                   error
 		expectedRenewedTokens *models.CallbackResponse
@@ -26,17 +36,40 @@ func TestHandler_HandleRefresh(t *testing.T) {
 				mck := &mocks.JwtIssuer{}
 
 				mck.EXPECT().
-					GetRenewedTokens(mock.Anything, getInjectedHandlerModelRefreshInRequestBody()).
+					GetRenewedTokens(mock.Anything, getInjectedHandlerModelRefreshInRequestBody(), mock.Anything).
 					Return(getExpectedCallbackResponse(), nil).
 					Once()
 
 				return mck
 			},
 
+			httpServiceMock: func() *mocks.HttpService {
+				mck := &mocks.HttpService{}
+
+				mck.EXPECT().
+					SetCookie(mock.Anything, mock.MatchedBy(func(cookie *http.Cookie) bool {
+						return cookie.Name == constants.ACCESS_TOKEN_COOKIE && cookie.Value == jwtToken
+					})).
+					Once()
+
+				mck.EXPECT().
+					SetCookie(mock.Anything, mock.MatchedBy(func(cookie *http.Cookie) bool {
+						return cookie.Name == constants.REFRESH_TOKEN_COOKIE && cookie.Value == refreshToken
+					})).
+					Once()
+
+				return mck
+			},
+
 			httpRequestMock: func(t *testing.T) *http.Request {
 				return getValidHttpRequestWithInjectedHandlerModel(t)
 			},
 
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+
 			expectedHttpCode: http.StatusOK,
 
 			expectedRenewedTokens: getExpectedCallbackResponse(),
@@ -64,7 +97,7 @@ func TestHandler_HandleRefresh(t *testing.T) {
 				mck := &mocks.JwtIssuer{}
 
 				mck.EXPECT().
-					GetRenewedTokens(mock.Anything, getInjectedHandlerModelRefreshInRequestBody()).
+					GetRenewedTokens(mock.Anything, getInjectedHandlerModelRefreshInRequestBody(), mock.Anything).
 					Return(nil, errWhenCallingJwtIssuer).
 					Once()
 
@@ -81,10 +114,51 @@ func TestHandler_HandleRefresh(t *testing.T) {
 
 			expectedRenewedTokens: getEmptyCallbackResponse(),
 		},
+
+		{
+			testName: "Failed to handle refresh because refresh token was reused, the revocation of the session is committed",
+
+			jwtIssuerMock: func() *mocks.JwtIssuer {
+				mck := &mocks.JwtIssuer{}
+
+				mck.EXPECT().
+					GetRenewedTokens(mock.Anything, getInjectedHandlerModelRefreshInRequestBody(), mock.Anything).
+					Return(nil, application_errors.RefreshTokenReuseError).
+					Once()
+
+				return mck
+			},
+
+			httpRequestMock: func(t *testing.T) *http.Request {
+				return getValidHttpRequestWithInjectedHandlerModel(t)
+			},
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+
+			expectedHttpCode: http.StatusUnauthorized,
+
+			err: application_errors.RefreshTokenReuseError,
+
+			expectedRenewedTokens: getEmptyCallbackResponse(),
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			if test.dbMock != nil {
+				test.dbMock(mck)
+			} else {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			}
+
 			httpRecorder := httptest.NewRecorder()
 
 			jwtIssuerMock := &mocks.JwtIssuer{}
@@ -92,9 +166,14 @@ func TestHandler_HandleRefresh(t *testing.T) {
 				jwtIssuerMock = test.jwtIssuerMock()
 			}
 
+			httpServiceMock := &mocks.HttpService{}
+			if test.httpServiceMock != nil {
+				httpServiceMock = test.httpServiceMock()
+			}
+
 			req := test.httpRequestMock(t)
 
-			refreshHandler := NewHandler(jwtIssuerMock)
+			refreshHandler := NewHandler(jwtIssuerMock, &mocks.SessionService{}, httpServiceMock, persistence.NewSqlDb(db))
 			refreshHandler.HandleRefresh(httpRecorder, req)
 
 			if test.err != nil {
@@ -105,8 +184,361 @@ func TestHandler_HandleRefresh(t *testing.T) {
 			require.Equal(t, test.expectedRenewedTokens, receivedRenewedTokens)
 			require.Equal(t, test.expectedHttpCode, httpRecorder.Code)
 
-			mock.AssertExpectationsForObjects(t, jwtIssuerMock)
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, jwtIssuerMock, httpServiceMock)
 		})
 	}
 
 }
+
+func TestHandler_HandleGetMySessions(t *testing.T) {
+	sessions := []*models.Session{
+		{Id: sessionId.String(), UserId: sessionOwnerId.String(), Current: true},
+		{Id: otherSessionId.String(), UserId: sessionOwnerId.String()},
+	}
+
+	tests := []struct {
+		testName           string
+		sessionServiceMock func() *mocks.SessionService
+		dbMock             func(mck sqlmock.Sqlmock)
+		err                error
+		expectedHttpCode   int
+		expectedSessions   []*models.Session
+	}{
+		{
+			testName: "Successfully getting the sessions of the current user",
+
+			sessionServiceMock: func() *mocks.SessionService {
+				mck := &mocks.SessionService{}
+
+				mck.EXPECT().
+					GetUserSessionsRecords(mock.Anything, sessionOwnerId.String(), sessionId.String()).
+					Return(sessions, nil).
+					Once()
+
+				return mck
+			},
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+
+			expectedHttpCode: http.StatusOK,
+
+			expectedSessions: sessions,
+		},
+
+		{
+			testName: "Failed to get the sessions of the current user due to error by session service",
+
+			sessionServiceMock: func() *mocks.SessionService {
+				mck := &mocks.SessionService{}
+
+				mck.EXPECT().
+					GetUserSessionsRecords(mock.Anything, sessionOwnerId.String(), sessionId.String()).
+					Return(nil, errDb).
+					Once()
+
+				return mck
+			},
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+
+			err: errDb,
+
+			expectedHttpCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			sessionServiceMock := test.sessionServiceMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/users/me/sessions", nil)
+			req = withUser(req, &models.User{Id: sessionOwnerId.String(), Role: constants.Writer})
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.CurrentSessionId, sessionId.String()))
+			rr := httptest.NewRecorder()
+
+			refreshHandler := NewHandler(&mocks.JwtIssuer{}, sessionServiceMock, &mocks.HttpService{}, persistence.NewSqlDb(db))
+			refreshHandler.HandleGetMySessions(rr, req)
+
+			require.Equal(t, test.expectedHttpCode, rr.Code)
+			if test.err != nil {
+				extractErrorFromResponseRecorder(t, rr, test.err)
+			} else {
+				var receivedSessions []*models.Session
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &receivedSessions))
+				require.Equal(t, test.expectedSessions, receivedSessions)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, sessionServiceMock)
+		})
+	}
+}
+
+func TestHandler_HandleGetUserSessions(t *testing.T) {
+	tests := []struct {
+		testName           string
+		sessionServiceMock func() *mocks.SessionService
+		request            func() *http.Request
+		dbMock             func(mck sqlmock.Sqlmock)
+		err                error
+		expectedHttpCode   int
+	}{
+		{
+			testName: "Successfully getting the sessions of the user",
+
+			sessionServiceMock: func() *mocks.SessionService {
+				mck := &mocks.SessionService{}
+
+				mck.EXPECT().
+					GetUserSessionsRecords(mock.Anything, sessionOwnerId.String(), "").
+					Return([]*models.Session{}, nil).
+					Once()
+
+				return mck
+			},
+
+			request: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/users/"+sessionOwnerId.String()+"/sessions", nil)
+				return req.WithContext(context.WithValue(req.Context(), middlewares.UserId, sessionOwnerId.String()))
+			},
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+
+			expectedHttpCode: http.StatusOK,
+		},
+
+		{
+			testName: "Failed to get the sessions of the user because user id is missing in the context",
+
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/users/sessions", nil)
+			},
+
+			dbMock: func(mck sqlmock.Sqlmock) {},
+
+			err: errors.New(constants.CONTEXT_NOT_CONTAINING_VALID_USER_ID),
+
+			expectedHttpCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			sessionServiceMock := &mocks.SessionService{}
+			if test.sessionServiceMock != nil {
+				sessionServiceMock = test.sessionServiceMock()
+			}
+
+			rr := httptest.NewRecorder()
+
+			refreshHandler := NewHandler(&mocks.JwtIssuer{}, sessionServiceMock, &mocks.HttpService{}, persistence.NewSqlDb(db))
+			refreshHandler.HandleGetUserSessions(rr, test.request())
+
+			require.Equal(t, test.expectedHttpCode, rr.Code)
+			if test.err != nil {
+				extractErrorFromResponseRecorder(t, rr, test.err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, sessionServiceMock)
+		})
+	}
+}
+
+func TestHandler_HandleRevokeSession(t *testing.T) {
+	user := &models.User{Id: sessionOwnerId.String(), Role: constants.Writer}
+
+	tests := []struct {
+		testName           string
+		sessionServiceMock func() *mocks.SessionService
+		request            func() *http.Request
+		dbMock             func(mck sqlmock.Sqlmock)
+		err                error
+		expectedHttpCode   int
+	}{
+		{
+			testName: "Successfully revoking session",
+
+			sessionServiceMock: func() *mocks.SessionService {
+				mck := &mocks.SessionService{}
+
+				mck.EXPECT().
+					RevokeSessionRecord(mock.Anything, sessionId.String(), user).
+					Return(nil).
+					Once()
+
+				return mck
+			},
+
+			request: func() *http.Request {
+				return setUpRevokeSessionRequest(user)
+			},
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+
+			expectedHttpCode: http.StatusNoContent,
+		},
+
+		{
+			testName: "Failed to revoke session which does not exist or belongs to another user",
+
+			sessionServiceMock: func() *mocks.SessionService {
+				mck := &mocks.SessionService{}
+
+				mck.EXPECT().
+					RevokeSessionRecord(mock.Anything, sessionId.String(), user).
+					Return(errSessionNotFound).
+					Once()
+
+				return mck
+			},
+
+			request: func() *http.Request {
+				return setUpRevokeSessionRequest(user)
+			},
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+
+			err: errSessionNotFound,
+
+			expectedHttpCode: http.StatusNotFound,
+		},
+
+		{
+			testName: "Failed to revoke session because session id is missing in the context",
+
+			request: func() *http.Request {
+				return withUser(httptest.NewRequest(http.MethodDelete, "/users/me/sessions", nil), user)
+			},
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+
+			err: errors.New(constants.CONTEXT_NOT_CONTAINING_VALID_SESSION_ID),
+
+			expectedHttpCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			sessionServiceMock := &mocks.SessionService{}
+			if test.sessionServiceMock != nil {
+				sessionServiceMock = test.sessionServiceMock()
+			}
+
+			rr := httptest.NewRecorder()
+
+			refreshHandler := NewHandler(&mocks.JwtIssuer{}, sessionServiceMock, &mocks.HttpService{}, persistence.NewSqlDb(db))
+			refreshHandler.HandleRevokeSession(rr, test.request())
+
+			require.Equal(t, test.expectedHttpCode, rr.Code)
+			if test.err != nil {
+				extractErrorFromResponseRecorder(t, rr, test.err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, sessionServiceMock)
+		})
+	}
+}
+
+func TestHandler_HandleRevokeUserSessions(t *testing.T) {
+	tests := []struct {
+		testName         string
+		serviceErr       error
+		dbMock           func(mck sqlmock.Sqlmock)
+		expectedHttpCode int
+	}{
+		{
+			testName: "Successfully revoking the sessions of the user",
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+
+			expectedHttpCode: http.StatusNoContent,
+		},
+
+		{
+			testName: "Failed to revoke the sessions of the user due to error by session service",
+
+			serviceErr: errWhenRevokingAccessToken,
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+
+			expectedHttpCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			sessionServiceMock := &mocks.SessionService{}
+			sessionServiceMock.EXPECT().
+				RevokeUserSessionsRecords(mock.Anything, sessionOwnerId.String()).
+				Return(test.serviceErr).
+				Once()
+
+			req := httptest.NewRequest(http.MethodDelete, "/users/"+sessionOwnerId.String()+"/sessions", nil)
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserId, sessionOwnerId.String()))
+			rr := httptest.NewRecorder()
+
+			refreshHandler := NewHandler(&mocks.JwtIssuer{}, sessionServiceMock, &mocks.HttpService{}, persistence.NewSqlDb(db))
+			refreshHandler.HandleRevokeUserSessions(rr, req)
+
+			require.Equal(t, test.expectedHttpCode, rr.Code)
+			if test.serviceErr != nil {
+				extractErrorFromResponseRecorder(t, rr, test.serviceErr)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, sessionServiceMock)
+		})
+	}
+}
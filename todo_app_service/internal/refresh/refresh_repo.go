@@ -9,8 +9,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
-	"github.com/gofrs/uuid"
+	"time"
 )
 
 type repository struct{}
@@ -19,8 +18,8 @@ func NewRepo() *repository {
 	return &repository{}
 }
 
-func (*repository) CreateRefreshToken(ctx context.Context, refresh *entities.Refresh) (*entities.Refresh, error) {
-	log.C(ctx).Infof("creating refresh token for user with id %s", refresh.UserId)
+func (*repository) CreateSession(ctx context.Context, session *entities.Session) (*entities.Session, error) {
+	log.C(ctx).Infof("creating session for user with id %s", session.UserId)
 
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
@@ -28,19 +27,38 @@ func (*repository) CreateRefreshToken(ctx context.Context, refresh *entities.Ref
 		return nil, err
 	}
 
-	sqlQueryString := `INSERT INTO user_refresh_tokens(refresh_token, user_id) 
-VALUES (:refresh_token, :user_id)`
+	if _, err = persist.NamedExecContext(ctx, createSessionQuery, session); err != nil {
+		log.C(ctx).Errorf("failed to create session, error %s", err.Error())
+		return nil, persistence.MapPostgresSessionError(err, session)
+	}
+
+	return session, nil
+}
 
-	if _, err = persist.NamedExecContext(ctx, sqlQueryString, refresh); err != nil {
-		log.C(ctx).Errorf("failed to create refresh token, error %s", err.Error())
-		return nil, persistence.MapPostgresNonExistingUserInUserTable(err, refresh)
+func (*repository) GetSessionByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*entities.Session, error) {
+	log.C(ctx).Info("getting session by refresh token in refresh repo")
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistance from context in refresh repo, error %s", err.Error())
+		return nil, err
 	}
 
-	return refresh, nil
+	session := &entities.Session{}
+	if err = persist.GetContext(ctx, session, getSessionByRefreshTokenHashQuery, refreshTokenHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("failed to get session, error %s when executing sql query", err.Error())
+			return nil, application_errors.InvalidRefreshTokenError
+		}
+		log.C(ctx).Error("failed to get session, db error...")
+		return nil, err
+	}
+
+	return session, nil
 }
 
-func (*repository) UpdateRefreshToken(ctx context.Context, refreshToken string, userId string) (*entities.Refresh, error) {
-	log.C(ctx).Infof("updating refresh token of user with id %s", userId)
+func (*repository) GetUserSessions(ctx context.Context, userId string) ([]entities.Session, error) {
+	log.C(ctx).Infof("getting sessions of user with id %s in refresh repo", userId)
 
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
@@ -48,45 +66,106 @@ func (*repository) UpdateRefreshToken(ctx context.Context, refreshToken string,
 		return nil, err
 	}
 
-	sqlQueryString := `UPDATE user_refresh_tokens SET refresh_token = $1 WHERE user_id = $2
-RETURNING refresh_token, user_id`
+	var sessions []entities.Session
+	if err = persist.SelectContext(ctx, &sessions, getUserSessionsQuery, userId); err != nil {
+		log.C(ctx).Errorf("failed to get sessions of user due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return sessions, nil
+}
 
-	var returnedToken string
-	var id uuid.UUID
+// RotateSessionToken replaces the refresh token of the session and keeps the replaced token as consumed,
+// the session is updated with the device and the access token of the renewal
+func (*repository) RotateSessionToken(ctx context.Context, session *entities.Session, oldTokenHash string) error {
+	sessionId := session.Id.String()
+	log.C(ctx).Infof("rotating refresh token of session with id %s in refresh repo", sessionId)
 
-	row := persist.QueryRowContext(ctx, sqlQueryString, refreshToken, userId)
-	if err = row.Scan(&returnedToken, &id); err != nil {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistance from context in refresh repo, error %s", err.Error())
+		return err
+	}
+
+	res, err := persist.ExecContext(ctx, rotateSessionTokenQuery, session.RefreshTokenHash, session.UserAgent, session.IpAddress,
+		session.LastUsedAt, session.AccessTokenId, session.AccessTokenExpiresAt, sessionId, oldTokenHash)
+	if err != nil {
+		log.C(ctx).Errorf("failed to rotate refresh token of session with id %s, error %s", sessionId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to rotate refresh token, error when trying to get the number of rows affected")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to rotate refresh token, session with id %s does not hold the token anymore", sessionId)
+		return application_errors.InvalidRefreshTokenError
+	}
+
+	if _, err = persist.ExecContext(ctx, createConsumedTokenQuery, oldTokenHash, sessionId, session.LastUsedAt); err != nil {
+		log.C(ctx).Errorf("failed to mark refresh token of session with id %s as consumed, error %s", sessionId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (*repository) GetConsumedToken(ctx context.Context, tokenHash string) (*entities.ConsumedRefreshToken, error) {
+	log.C(ctx).Info("getting consumed refresh token in refresh repo")
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistance from context in refresh repo, error %s", err.Error())
+		return nil, err
+	}
+
+	consumedToken := &entities.ConsumedRefreshToken{}
+	if err = persist.GetContext(ctx, consumedToken, getConsumedTokenQuery, tokenHash); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			log.C(ctx).Errorf("no rows updated for user_id=%s", userId)
-			return nil, application_errors.NewNotFoundError(constants.USER_TARGET, userId)
+			log.C(ctx).Debug("refresh token was never consumed")
+			return nil, application_errors.NewNotFoundError(constants.REFRESH_TARGET, tokenHash)
 		}
-		log.C(ctx).Errorf("failed to scan returning, error %s", err.Error())
+		log.C(ctx).Errorf("failed to get consumed refresh token, error %s", err.Error())
 		return nil, err
 	}
 
-	return &entities.Refresh{
-		RefreshToken: returnedToken,
-		UserId:       id,
-	}, nil
+	return consumedToken, nil
 }
 
-func (*repository) GetTokenOwner(ctx context.Context, refreshToken string) (*entities.User, error) {
-	log.C(ctx).Infof("getting token %s owner", refreshToken)
+func (*repository) DeleteExpiredConsumedTokens(ctx context.Context, sessionId string, consumedBefore time.Time) error {
+	log.C(ctx).Infof("deleting expired consumed refresh tokens of session with id %s in refresh repo", sessionId)
 
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get persistance from context in refresh repo, error %s", err.Error())
-		return nil, err
+		return err
 	}
 
-	sqlQueryString := `SELECT id, email, role FROM users JOIN user_refresh_tokens ON users.id = user_refresh_tokens.user_id
-WHERE refresh_token = $1`
+	if _, err = persist.ExecContext(ctx, deleteExpiredConsumedTokensQuery, sessionId, consumedBefore); err != nil {
+		log.C(ctx).Errorf("failed to delete expired consumed refresh tokens, error %s", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (*repository) GetTokenOwner(ctx context.Context, refreshTokenHash string) (*entities.User, error) {
+	log.C(ctx).Info("getting refresh token owner in refresh repo")
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistance from context in refresh repo, error %s", err.Error())
+		return nil, err
+	}
 
 	tokenOwner := &entities.User{}
-	if err = persist.GetContext(ctx, tokenOwner, sqlQueryString, refreshToken); err != nil {
+	if err = persist.GetContext(ctx, tokenOwner, getTokenOwnerQuery, refreshTokenHash); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			log.C(ctx).Errorf("failed to get token owner, error %s when executing sql query", err.Error())
-			return nil, fmt.Errorf("invalid refresh token %s", refreshToken)
+			return nil, application_errors.InvalidRefreshTokenError
 		}
 		log.C(ctx).Error("failed to get token owner, db error...")
 		return nil, err
@@ -94,3 +173,68 @@ WHERE refresh_token = $1`
 
 	return tokenOwner, nil
 }
+
+// DeleteSession deletes the session with the given id and returns it, when userId is not empty the session must also belong to that user
+func (*repository) DeleteSession(ctx context.Context, sessionId string, userId string) (*entities.Session, error) {
+	log.C(ctx).Infof("deleting session with id %s in refresh repo", sessionId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistance from context in refresh repo, error %s", err.Error())
+		return nil, err
+	}
+
+	session := &entities.Session{}
+	if userId == "" {
+		err = persist.GetContext(ctx, session, deleteSessionQuery, sessionId)
+	} else {
+		err = persist.GetContext(ctx, session, deleteUserSessionQuery, sessionId, userId)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("failed to delete session, invalid session_id provided %s", sessionId)
+			return nil, application_errors.NewNotFoundError(constants.SESSION_TARGET, sessionId)
+		}
+		log.C(ctx).Errorf("failed to delete session with id %s, error %s", sessionId, err.Error())
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// DeleteUserSessions deletes every session of the user and returns the deleted sessions
+func (*repository) DeleteUserSessions(ctx context.Context, userId string) ([]entities.Session, error) {
+	log.C(ctx).Infof("deleting sessions of user with id %s in refresh repo", userId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistance from context in refresh repo, error %s", err.Error())
+		return nil, err
+	}
+
+	var sessions []entities.Session
+	if err = persist.SelectContext(ctx, &sessions, deleteAllUserSessionsQuery, userId); err != nil {
+		log.C(ctx).Errorf("failed to delete sessions of user with id %s, error %s", userId, err.Error())
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (*repository) SessionExists(ctx context.Context, sessionId string) (bool, error) {
+	log.C(ctx).Debugf("checking if session with id %s exists in refresh repo", sessionId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistance from context in refresh repo, error %s", err.Error())
+		return false, err
+	}
+
+	var exists bool
+	if err = persist.GetContext(ctx, &exists, sessionExistsQuery, sessionId); err != nil {
+		log.C(ctx).Errorf("failed to check if session with id %s exists, error %s", sessionId, err.Error())
+		return false, err
+	}
+
+	return exists, nil
+}
@@ -1 +1,315 @@
 package refresh
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"regexp"
+	"testing"
+)
+
+func TestRepository_GetUserSessions(t *testing.T) {
+	session := initRevocableSessionEntity(sessionId, sessionOwnerId)
+	otherSession := initRevocableSessionEntity(otherSessionId, sessionOwnerId)
+
+	tests := []struct {
+		testName         string
+		dbMock           func(mck sqlmock.Sqlmock)
+		expectedSessions []entities.Session
+		err              error
+	}{
+		{
+			testName: "Successfully getting the sessions of the user",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				rows := addSessionRow(addSessionRow(sqlmock.NewRows(sessionColumnNames), session), otherSession)
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetUserSessions)).
+					WithArgs(sessionOwnerId.String()).
+					WillReturnRows(rows)
+			},
+			expectedSessions: []entities.Session{session, otherSession},
+		},
+		{
+			testName: "Failed to get the sessions of the user due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetUserSessions)).
+					WithArgs(sessionOwnerId.String()).
+					WillReturnError(errDb)
+			},
+			err: errDb,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			sessions, err := NewRepo().GetUserSessions(ctx, sessionOwnerId.String())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedSessions, sessions)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_DeleteSession(t *testing.T) {
+	session := initRevocableSessionEntity(sessionId, sessionOwnerId)
+
+	tests := []struct {
+		testName        string
+		userId          string
+		dbMock          func(mck sqlmock.Sqlmock)
+		expectedSession *entities.Session
+		err             error
+	}{
+		{
+			testName: "Successfully deleting session of any user",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryDeleteSession)).
+					WithArgs(sessionId.String()).
+					WillReturnRows(addSessionRow(sqlmock.NewRows(sessionColumnNames), session))
+			},
+			expectedSession: &session,
+		},
+		{
+			testName: "Successfully deleting session of the given user",
+			userId:   sessionOwnerId.String(),
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryDeleteUserSession)).
+					WithArgs(sessionId.String(), sessionOwnerId.String()).
+					WillReturnRows(addSessionRow(sqlmock.NewRows(sessionColumnNames), session))
+			},
+			expectedSession: &session,
+		},
+		{
+			testName: "Failed to delete session which belongs to another user",
+			userId:   otherUserId.String(),
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryDeleteUserSession)).
+					WithArgs(sessionId.String(), otherUserId.String()).
+					WillReturnError(sql.ErrNoRows)
+			},
+			err: errSessionNotFound,
+		},
+		{
+			testName: "Failed to delete session due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryDeleteSession)).
+					WithArgs(sessionId.String()).
+					WillReturnError(errDb)
+			},
+			err: errDb,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			deletedSession, err := NewRepo().DeleteSession(ctx, sessionId.String(), test.userId)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedSession, deletedSession)
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_DeleteUserSessions(t *testing.T) {
+	session := initRevocableSessionEntity(sessionId, sessionOwnerId)
+	otherSession := initRevocableSessionEntity(otherSessionId, sessionOwnerId)
+
+	tests := []struct {
+		testName         string
+		dbMock           func(mck sqlmock.Sqlmock)
+		expectedSessions []entities.Session
+		err              error
+	}{
+		{
+			testName: "Successfully deleting the sessions of the user",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				rows := addSessionRow(addSessionRow(sqlmock.NewRows(sessionColumnNames), session), otherSession)
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryDeleteAllUserSessions)).
+					WithArgs(sessionOwnerId.String()).
+					WillReturnRows(rows)
+			},
+			expectedSessions: []entities.Session{session, otherSession},
+		},
+		{
+			testName: "Failed to delete the sessions of the user due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryDeleteAllUserSessions)).
+					WithArgs(sessionOwnerId.String()).
+					WillReturnError(errDb)
+			},
+			err: errDb,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			sessions, err := NewRepo().DeleteUserSessions(ctx, sessionOwnerId.String())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedSessions, sessions)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_RotateSessionToken(t *testing.T) {
+	session := initRevocableSessionEntity(sessionId, sessionOwnerId)
+	session.RefreshTokenHash = hashRefreshToken(VALID_REFRESH_TOKEN)
+	oldTokenHash := hashRefreshToken(refreshToken)
+
+	expectRotation := func(mck sqlmock.Sqlmock) *sqlmock.ExpectedExec {
+		return mck.ExpectExec(regexp.QuoteMeta(sqlQueryRotateSessionToken)).
+			WithArgs(session.RefreshTokenHash, session.UserAgent, session.IpAddress, session.LastUsedAt,
+				session.AccessTokenId, session.AccessTokenExpiresAt, sessionId.String(), oldTokenHash)
+	}
+
+	tests := []struct {
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
+	}{
+		{
+			testName: "Successfully rotating the token held by the session, the old token is kept as consumed",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				expectRotation(mck).WillReturnResult(sqlmock.NewResult(0, 1))
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryCreateConsumedToken)).
+					WithArgs(oldTokenHash, sessionId.String(), session.LastUsedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			testName: "Failed to rotate token which the session does not hold anymore, it is not marked as consumed again",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				expectRotation(mck).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			err: application_errors.InvalidRefreshTokenError,
+		},
+		{
+			testName: "Failed to rotate token due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				expectRotation(mck).WillReturnError(errDb)
+			},
+			err: errDb,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo().RotateSessionToken(ctx, &session, oldTokenHash)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_SessionExists(t *testing.T) {
+	tests := []struct {
+		testName       string
+		dbMock         func(mck sqlmock.Sqlmock)
+		expectedExists bool
+		err            error
+	}{
+		{
+			testName: "Session has not been ended",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQuerySessionExists)).
+					WithArgs(sessionId.String()).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+			expectedExists: true,
+		},
+		{
+			testName: "Session has been ended",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQuerySessionExists)).
+					WithArgs(sessionId.String()).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+		},
+		{
+			testName: "Failed to check if session exists due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQuerySessionExists)).
+					WithArgs(sessionId.String()).
+					WillReturnError(errDb)
+			},
+			err: errDb,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			exists, err := NewRepo().SessionExists(ctx, sessionId.String())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedExists, exists)
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
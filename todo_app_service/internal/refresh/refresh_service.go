@@ -1,29 +1,39 @@
 package refresh
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
 	"Todo-List/internProject/todo_app_service/internal/entities"
 	"Todo-List/internProject/todo_app_service/internal/utils"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/sirupsen/logrus"
+	"time"
 )
 
 //go:generate mockery --name=refreshRepository --exported --output=./mocks --outpkg=mocks --filename=refresh_repository.go --with-expecter=true
 type refreshRepository interface {
-	CreateRefreshToken(ctx context.Context, refresh *entities.Refresh) (*entities.Refresh, error)
-	UpdateRefreshToken(ctx context.Context, refreshToken string, userId string) (*entities.Refresh, error)
-	GetTokenOwner(ctx context.Context, refreshToken string) (*entities.User, error)
+	CreateSession(ctx context.Context, session *entities.Session) (*entities.Session, error)
+	GetSessionByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*entities.Session, error)
+	GetUserSessions(ctx context.Context, userId string) ([]entities.Session, error)
+	RotateSessionToken(ctx context.Context, session *entities.Session, oldTokenHash string) error
+	GetConsumedToken(ctx context.Context, tokenHash string) (*entities.ConsumedRefreshToken, error)
+	DeleteExpiredConsumedTokens(ctx context.Context, sessionId string, consumedBefore time.Time) error
+	GetTokenOwner(ctx context.Context, refreshTokenHash string) (*entities.User, error)
+	DeleteSession(ctx context.Context, sessionId string, userId string) (*entities.Session, error)
+	DeleteUserSessions(ctx context.Context, userId string) ([]entities.Session, error)
+	SessionExists(ctx context.Context, sessionId string) (bool, error)
 }
 
 //go:generate mockery --name=converter --exported --output=./mocks --outpkg=mocks --filename=converter.go --with-expecter=true
 type converter interface {
-	ToEntity(refresh *models.Refresh) *entities.Refresh
-	ToModel(refresh *entities.Refresh) *models.Refresh
-}
-
-//go:generate mockery --name=userService --exported --output=./mocks --outpkg=mocks --filename=user_service.go --with-expecter=true
-type userRepo interface {
-	GetUserByEmail(ctx context.Context, email string) (*entities.User, error)
+	ToEntity(session *models.Session) *entities.Session
+	ToModel(session *entities.Session) *models.Session
+	ManyToModel(sessions []entities.Session) []*models.Session
 }
 
 //go:generate mockery --name=userConverter --exported --output=./mocks --outpkg=mocks --filename=user_converter.go --with-expecter=true
@@ -31,83 +41,137 @@ type userConverter interface {
 	ToModel(user *entities.User) *models.User
 }
 
+//go:generate mockery --name=timeGenerator --exported --output=./mocks --outpkg=mocks --filename=time_generator.go --with-expecter=true
+type timeGenerator interface {
+	Now() time.Time
+}
+
+//go:generate mockery --name=accessTokenRevoker --exported --output=./mocks --outpkg=mocks --filename=access_token_revoker.go --with-expecter=true
+type accessTokenRevoker interface {
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+}
+
 type service struct {
-	uRepo      userRepo
 	repo       refreshRepository
 	conv       converter
 	uConverter userConverter
+	revoker    accessTokenRevoker
+	timeGen    timeGenerator
 }
 
-func NewService(repo refreshRepository, uRepo userRepo, conv converter, uConverter userConverter) *service {
+func NewService(repo refreshRepository, conv converter, uConverter userConverter, revoker accessTokenRevoker, timeGen timeGenerator) *service {
 	return &service{
 		repo:       repo,
-		uRepo:      uRepo,
 		conv:       conv,
 		uConverter: uConverter,
+		revoker:    revoker,
+		timeGen:    timeGen,
 	}
 }
 
-func (s *service) CreateRefreshToken(ctx context.Context, email string, refreshToken string) (*models.Refresh, error) {
-	log.C(ctx).Info("creating refresh token in refresh service")
+func (s *service) CreateSession(ctx context.Context, session *models.Session, refreshToken string) (*models.Session, error) {
+	log.C(ctx).Infof("creating session of user with id %s in refresh service", session.UserId)
 
-	user, err := s.uRepo.GetUserByEmail(ctx, email)
+	now := s.timeGen.Now()
+	session.CreatedAt = now
+	session.LastUsedAt = now
+
+	entity := s.conv.ToEntity(session)
+	entity.RefreshTokenHash = hashRefreshToken(refreshToken)
+
+	createdEntity, err := s.repo.CreateSession(ctx, entity)
 	if err != nil {
-		log.C(ctx).Errorf("failed to create refresh token, error %s when trying to get user by email %s", err.Error(), email)
+		log.C(ctx).Errorf("failed to create session, error %s", err.Error())
 		return nil, err
 	}
 
-	refreshModel := &models.Refresh{
-		UserId:       user.Id.String(),
-		RefreshToken: refreshToken,
-	}
+	return s.conv.ToModel(createdEntity), nil
+}
+
+// GetSession returns the session currently holding the refresh token, presenting a token the session has already
+// rotated away from revokes the whole session
+func (s *service) GetSession(ctx context.Context, refreshToken string) (*models.Session, error) {
+	log.C(ctx).Info("getting session of refresh token in refresh service")
 
-	convertedEntity := s.conv.ToEntity(refreshModel)
+	tokenHash := hashRefreshToken(refreshToken)
 
-	if _, err = s.repo.CreateRefreshToken(ctx, convertedEntity); err != nil {
-		log.C(ctx).Errorf("failed to create refresh token, error %s", err.Error())
+	entity, err := s.repo.GetSessionByRefreshTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, application_errors.InvalidRefreshTokenError) {
+			return nil, s.checkForTokenReuse(ctx, tokenHash)
+		}
+
+		log.C(ctx).Errorf("failed to get session, error %s in refresh service", err.Error())
 		return nil, err
 	}
 
-	return refreshModel, nil
-}
+	// the token held by the session was issued when the session was last used
+	if s.timeGen.Now().After(entity.LastUsedAt.Add(constants.REFRESH_TOKEN_LIFETIME_IN_HOURS * time.Hour)) {
+		log.C(ctx).Infof("refresh token of session with id %s has expired", entity.Id)
+		return nil, application_errors.InvalidRefreshTokenError
+	}
 
-func (s *service) UpdateRefreshToken(ctx context.Context, refreshToken string, userId string) (*models.Refresh, error) {
-	log.C(ctx).Infof("updating refresh token %s of user with id %s", refreshToken, userId)
+	return s.conv.ToModel(entity), nil
+}
 
-	refreshEntity, err := s.repo.UpdateRefreshToken(ctx, refreshToken, userId)
+func (s *service) checkForTokenReuse(ctx context.Context, tokenHash string) error {
+	consumedToken, err := s.repo.GetConsumedToken(ctx, tokenHash)
 	if err != nil {
-		log.C(ctx).Errorf("failed to update refresh token, error %s when callin refresh repo", err.Error())
-		return nil, err
+		if utils.CheckForNotFoundError(err) {
+			log.C(ctx).Info("refresh token does not belong to any session")
+			return application_errors.InvalidRefreshTokenError
+		}
+
+		log.C(ctx).Errorf("failed to check refresh token for reuse, error %s", err.Error())
+		return err
 	}
 
-	return s.conv.ToModel(refreshEntity), nil
-}
+	sessionId := consumedToken.SessionId.String()
+	log.C(ctx).WithFields(logrus.Fields{
+		constants.SECURITY_EVENT: constants.REFRESH_TOKEN_REUSE_EVENT,
+		"session_id":             sessionId,
+		"consumed_at":            consumedToken.ConsumedAt,
+	}).Warn("refresh token presented again after it was rotated, revoking the session")
 
-func (s *service) UpsertRefreshToken(ctx context.Context, refresh *models.Refresh, userEmail string) error {
-	log.C(ctx).Infof("upserting refresh token of user with email %s in refresh service", userEmail)
+	if err = s.RevokeSession(ctx, sessionId); err != nil {
+		log.C(ctx).Errorf("failed to revoke session with id %s after refresh token reuse, error %s", sessionId, err.Error())
+		return err
+	}
 
-	if _, err := s.repo.UpdateRefreshToken(ctx, refresh.RefreshToken, refresh.UserId); err != nil {
-		if utils.CheckForNotFoundError(err) {
+	return application_errors.RefreshTokenReuseError
+}
 
-			if _, err = s.CreateRefreshToken(ctx, userEmail, refresh.RefreshToken); err != nil {
-				log.C(ctx).Errorf("faile to create refresh token in jwt issuer, error %s", err.Error())
-				return err
-			}
+// RotateSessionToken replaces the refresh token of the session, the replaced token is kept as consumed
+// for the lifetime of refresh tokens so that its reuse can be detected
+func (s *service) RotateSessionToken(ctx context.Context, session *models.Session, oldRefreshToken string, newRefreshToken string, device *models.SessionDevice) error {
+	log.C(ctx).Infof("rotating refresh token of session with id %s in refresh service", session.Id)
 
-		} else {
+	now := s.timeGen.Now()
+	session.UserAgent = device.UserAgent
+	session.IpAddress = device.IpAddress
+	session.LastUsedAt = now
 
-			log.C(ctx).Errorf("failed to update refresh token, error %s when generating...", err.Error())
-			return err
+	entity := s.conv.ToEntity(session)
+	entity.RefreshTokenHash = hashRefreshToken(newRefreshToken)
 
-		}
+	if err := s.repo.RotateSessionToken(ctx, entity, hashRefreshToken(oldRefreshToken)); err != nil {
+		log.C(ctx).Errorf("failed to rotate refresh token, error %s when calling refresh repo", err.Error())
+		return err
+	}
+
+	expiredBefore := now.Add(-constants.REFRESH_TOKEN_LIFETIME_IN_HOURS * time.Hour)
+	if err := s.repo.DeleteExpiredConsumedTokens(ctx, session.Id, expiredBefore); err != nil {
+		log.C(ctx).Errorf("failed to delete expired consumed refresh tokens, error %s when calling refresh repo", err.Error())
+		return err
 	}
+
 	return nil
 }
 
 func (s *service) GetTokenOwner(ctx context.Context, refreshToken string) (*models.User, error) {
 	log.C(ctx).Info("getting refresh token owner in refresh service")
 
-	ownerEntity, err := s.repo.GetTokenOwner(ctx, refreshToken)
+	ownerEntity, err := s.repo.GetTokenOwner(ctx, hashRefreshToken(refreshToken))
 	if err != nil {
 		log.C(ctx).Errorf("failed to get token owner, error %s in refresh service", err.Error())
 		return nil, err
@@ -115,3 +179,125 @@ func (s *service) GetTokenOwner(ctx context.Context, refreshToken string) (*mode
 
 	return s.uConverter.ToModel(ownerEntity), nil
 }
+
+func (s *service) GetUserSessionsRecords(ctx context.Context, userId string, currentSessionId string) ([]*models.Session, error) {
+	log.C(ctx).Infof("getting sessions of user with id %s in refresh service", userId)
+
+	sessionEntities, err := s.repo.GetUserSessions(ctx, userId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get sessions of user, error %s when calling refresh repo", err.Error())
+		return nil, err
+	}
+
+	sessions := s.conv.ManyToModel(sessionEntities)
+	for _, session := range sessions {
+		session.Current = session.Id == currentSessionId
+	}
+
+	return sessions, nil
+}
+
+// RevokeSessionRecord logs out the device of the session, admins can revoke the sessions of every user
+func (s *service) RevokeSessionRecord(ctx context.Context, sessionId string, user *models.User) error {
+	log.C(ctx).Infof("revoking session with id %s in refresh service", sessionId)
+
+	ownerId := user.Id
+	if user.Role == constants.Admin {
+		ownerId = ""
+	}
+
+	// sessions of other users are reported as not found so that their ids are not disclosed
+	entity, err := s.repo.DeleteSession(ctx, sessionId, ownerId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to revoke session, error %s when calling refresh repo", err.Error())
+		return err
+	}
+
+	return s.revokeSessionAccessToken(ctx, entity)
+}
+
+// RevokeUserSessionsRecords logs the user out of every device, the access tokens issued for the sessions stop working as well
+func (s *service) RevokeUserSessionsRecords(ctx context.Context, userId string) error {
+	log.C(ctx).Infof("revoking sessions of user with id %s in refresh service", userId)
+
+	sessionEntities, err := s.repo.DeleteUserSessions(ctx, userId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to revoke sessions of user, error %s when calling refresh repo", err.Error())
+		return err
+	}
+
+	for i := range sessionEntities {
+		if err = s.revokeSessionAccessToken(ctx, &sessionEntities[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SessionExists reports whether the session has not been ended, the access tokens of ended sessions are rejected
+func (s *service) SessionExists(ctx context.Context, sessionId string) (bool, error) {
+	exists, err := s.repo.SessionExists(ctx, sessionId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check if session with id %s exists, error %s when calling refresh repo", sessionId, err.Error())
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// RevokeSession ends the session with the given id, a session which does not exist anymore has already been ended
+func (s *service) RevokeSession(ctx context.Context, sessionId string) error {
+	log.C(ctx).Infof("ending session with id %s in refresh service", sessionId)
+
+	entity, err := s.repo.DeleteSession(ctx, sessionId, "")
+	if err != nil {
+		if utils.CheckForNotFoundError(err) {
+			log.C(ctx).Infof("session with id %s has already been ended", sessionId)
+			return nil
+		}
+
+		log.C(ctx).Errorf("failed to end session, error %s when calling refresh repo", err.Error())
+		return err
+	}
+
+	return s.revokeSessionAccessToken(ctx, entity)
+}
+
+// RevokeRefreshTokenSession ends the session currently holding the refresh token, tokens not held by any session are ignored
+func (s *service) RevokeRefreshTokenSession(ctx context.Context, refreshToken string) error {
+	log.C(ctx).Info("ending session of refresh token in refresh service")
+
+	entity, err := s.repo.GetSessionByRefreshTokenHash(ctx, hashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, application_errors.InvalidRefreshTokenError) {
+			log.C(ctx).Info("refresh token does not belong to any session, there is nothing to end")
+			return nil
+		}
+
+		log.C(ctx).Errorf("failed to get session of refresh token, error %s when calling refresh repo", err.Error())
+		return err
+	}
+
+	return s.RevokeSession(ctx, entity.Id.String())
+}
+
+func (s *service) revokeSessionAccessToken(ctx context.Context, entity *entities.Session) error {
+	if entity.AccessTokenId == "" || !entity.AccessTokenExpiresAt.Valid {
+		return nil
+	}
+
+	if err := s.revoker.RevokeAccessToken(ctx, entity.AccessTokenId, entity.AccessTokenExpiresAt.Time); err != nil {
+		log.C(ctx).Errorf("failed to revoke access token of session with id %s, error %s", entity.Id, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// hashRefreshToken returns the hex encoded sha256 digest of the token, refresh tokens are random enough
+// not to need a salt and only their digests are stored
+func hashRefreshToken(refreshToken string) string {
+	digest := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(digest[:])
+}
@@ -1,343 +1,794 @@
 package refresh
 
-/*
-func TestService_CreateRefreshToken(t *testing.T) {
-	validUser := initUser(validUserId.String(), VALID_USER_EMAIL)
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/refresh/mocks"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestHashRefreshToken(t *testing.T) {
+	// sha256 test vector of "abc"
+	require.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", hashRefreshToken("abc"))
+
+	require.Equal(t, hashRefreshToken(VALID_REFRESH_TOKEN), hashRefreshToken(VALID_REFRESH_TOKEN))
+	require.NotEqual(t, hashRefreshToken(VALID_REFRESH_TOKEN), hashRefreshToken(INVALID_REFRESH_TOKEN))
+	require.NotContains(t, hashRefreshToken(VALID_REFRESH_TOKEN), VALID_REFRESH_TOKEN)
+}
+
+func TestService_CreateSession(t *testing.T) {
+	session := initSession(sessionId.String(), sessionOwnerId.String())
+	sessionEntity := initSessionEntityFromModel(session, "")
 
 	tests := []struct {
 		testName        string
-		email           string
-		refreshToken    string
-		mockUserService func() *mocks2.UserService
-		mockConverter   func() *mocks2.Converter
-		mockRefreshRepo func() *mocks2.RefreshRepository
-		expectedOutput  *models.Refresh
+		mockRepo        func() *mocks.RefreshRepository
+		expectedSession *models.Session
 		err             error
 	}{
 		{
-			testName: "Successfully creating refresh token",
+			testName: "Successfully creating session, only the digest of the refresh token is stored",
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					CreateSession(context.TODO(), mock.MatchedBy(func(entity *entities.Session) bool {
+						return entity.RefreshTokenHash == hashRefreshToken(VALID_REFRESH_TOKEN)
+					})).
+					Return(sessionEntity, nil).Once()
 
-			email: VALID_USER_EMAIL,
+				return mRepo
+			},
 
-			refreshToken: VALID_REFRESH_TOKEN,
+			expectedSession: initSessionModelFromEntity(sessionEntity),
+		},
 
-			mockUserService: func() *mocks2.UserService {
-				mUserService := &mocks2.UserService{}
+		{
+			testName: "Failed to create session, error by refresh repository",
 
-				mUserService.EXPECT().
-					GetUserRecordByEmail(context.TODO(), VALID_USER_EMAIL).
-					Return(validUser, nil).Once()
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
 
-				return mUserService
+				mRepo.EXPECT().
+					CreateSession(context.TODO(), sessionEntity).
+					Return(nil, errWhenCreatingRefreshToken).Once()
+
+				return mRepo
 			},
-			mockConverter: func() *mocks2.Converter {
-				mConverter := &mocks2.Converter{}
 
-				refresh := initRefresh(VALID_REFRESH_TOKEN, validUserId.String())
-				refreshEntity := initRefreshEntityFromModel(refresh)
+			err: errWhenCreatingRefreshToken,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockRepo()
+
+			mConverter := &mocks.Converter{}
+			mConverter.EXPECT().ToEntity(session).Return(sessionEntity).Once()
+			if test.expectedSession != nil {
+				mConverter.EXPECT().ToModel(sessionEntity).Return(test.expectedSession).Once()
+			}
+
+			mTimeGen := &mocks.TimeGenerator{}
+			mTimeGen.EXPECT().Now().Return(sessionLastUsedAt).Once()
+
+			rService := NewService(mRepo, mConverter, nil, nil, mTimeGen)
+
+			gotSession, err := rService.CreateSession(context.TODO(), session, VALID_REFRESH_TOKEN)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedSession, gotSession)
+			mock.AssertExpectationsForObjects(t, mRepo, mConverter, mTimeGen)
+		})
+	}
+}
+
+func TestService_GetSession(t *testing.T) {
+	sessionEntity := initRevocableSessionEntity(sessionId, sessionOwnerId)
+	consumedToken := &entities.ConsumedRefreshToken{
+		TokenHash:  hashRefreshToken(refreshToken),
+		SessionId:  sessionId,
+		ConsumedAt: sessionLastUsedAt,
+	}
+
+	tests := []struct {
+		testName            string
+		mockRepo            func() *mocks.RefreshRepository
+		mockConverter       func() *mocks.Converter
+		mockRevoker         func() *mocks.AccessTokenRevoker
+		now                 time.Time
+		expectedSession     *models.Session
+		expectSecurityEvent bool
+		err                 error
+	}{
+		{
+			testName: "Successfully getting the session holding the refresh token",
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					GetSessionByRefreshTokenHash(mock.Anything, hashRefreshToken(refreshToken)).
+					Return(&sessionEntity, nil).Once()
+
+				return mRepo
+			},
+
+			mockConverter: func() *mocks.Converter {
+				mConverter := &mocks.Converter{}
 
 				mConverter.EXPECT().
-					ToEntity(refresh).
-					Return(refreshEntity).Once()
+					ToModel(&sessionEntity).
+					Return(initSessionModelFromEntity(&sessionEntity)).Once()
 
 				return mConverter
 			},
 
-			mockRefreshRepo: func() *mocks2.RefreshRepository {
-				mRefreshRepo := &mocks2.RefreshRepository{}
+			now: sessionLastUsedAt.Add(time.Hour),
 
-				refresh := initRefresh(VALID_REFRESH_TOKEN, validUserId.String())
-				refreshEntity := initRefreshEntityFromModel(refresh)
+			expectedSession: initSessionModelFromEntity(&sessionEntity),
+		},
+
+		{
+			testName: "Failed to get session because its refresh token has expired",
 
-				mRefreshRepo.EXPECT().
-					CreateRefreshToken(context.TODO(), refreshEntity).
-					Return(refreshEntity, nil).Once()
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
 
-				return mRefreshRepo
+				mRepo.EXPECT().
+					GetSessionByRefreshTokenHash(mock.Anything, hashRefreshToken(refreshToken)).
+					Return(&sessionEntity, nil).Once()
+
+				return mRepo
 			},
 
-			expectedOutput: initRefresh(VALID_REFRESH_TOKEN, validUserId.String()),
+			now: sessionLastUsedAt.Add((constants.REFRESH_TOKEN_LIFETIME_IN_HOURS + 1) * time.Hour),
+
+			err: application_errors.InvalidRefreshTokenError,
 		},
+
 		{
-			testName: "Failed to create refresh token, user not registered in system",
+			testName: "Failed to get session because refresh token was never issued",
 
-			email: INVALID_USER_EMAIL,
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
 
-			mockUserService: func() *mocks2.UserService {
-				mUserService := &mocks2.UserService{}
+				mRepo.EXPECT().
+					GetSessionByRefreshTokenHash(mock.Anything, hashRefreshToken(refreshToken)).
+					Return(nil, application_errors.InvalidRefreshTokenError).Once()
+
+				mRepo.EXPECT().
+					GetConsumedToken(mock.Anything, hashRefreshToken(refreshToken)).
+					Return(nil, application_errors.NewNotFoundError(constants.REFRESH_TARGET, hashRefreshToken(refreshToken))).Once()
 
-				mUserService.EXPECT().
-					GetUserRecordByEmail(context.TODO(), INVALID_USER_EMAIL).
-					Return(nil, errByUserService).Once()
-				return mUserService
+				return mRepo
 			},
 
-			err: errByUserService,
+			err: application_errors.InvalidRefreshTokenError,
 		},
+
 		{
-			testName: "Failed to create refresh token, error by refresh repository",
+			testName: "Failed to get session because rotated refresh token was reused, the session is revoked",
 
-			email: VALID_USER_EMAIL,
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
 
-			refreshToken: INVALID_REFRESH_TOKEN,
+				mRepo.EXPECT().
+					GetSessionByRefreshTokenHash(mock.Anything, hashRefreshToken(refreshToken)).
+					Return(nil, application_errors.InvalidRefreshTokenError).Once()
 
-			mockUserService: func() *mocks2.UserService {
-				mUserService := &mocks2.UserService{}
+				mRepo.EXPECT().
+					GetConsumedToken(mock.Anything, hashRefreshToken(refreshToken)).
+					Return(consumedToken, nil).Once()
 
-				mUserService.EXPECT().
-					GetUserRecordByEmail(context.TODO(), VALID_USER_EMAIL).
-					Return(validUser, nil).Once()
+				mRepo.EXPECT().
+					DeleteSession(mock.Anything, sessionId.String(), "").
+					Return(&sessionEntity, nil).Once()
 
-				return mUserService
+				return mRepo
 			},
-			mockConverter: func() *mocks2.Converter {
-				mConverter := &mocks2.Converter{}
 
-				refresh := initRefresh(INVALID_REFRESH_TOKEN, validUserId.String())
-				entityRefresh := initRefreshEntityFromModel(refresh)
+			mockRevoker: func() *mocks.AccessTokenRevoker {
+				mRevoker := &mocks.AccessTokenRevoker{}
 
-				mConverter.EXPECT().
-					ToEntity(refresh).
-					Return(entityRefresh).Once()
+				mRevoker.EXPECT().
+					RevokeAccessToken(mock.Anything, accessTokenId, accessTokenExpiresAt).
+					Return(nil).Once()
 
-				return mConverter
+				return mRevoker
 			},
 
-			mockRefreshRepo: func() *mocks2.RefreshRepository {
-				mRefreshRepo := &mocks2.RefreshRepository{}
+			expectSecurityEvent: true,
 
-				refresh := initRefresh(INVALID_REFRESH_TOKEN, validUserId.String())
-				entityRefresh := initRefreshEntityFromModel(refresh)
+			err: application_errors.RefreshTokenReuseError,
+		},
 
-				mRefreshRepo.EXPECT().
-					CreateRefreshToken(context.TODO(), entityRefresh).
-					Return(nil, errWhenCreatingRefreshToken).Once()
+		{
+			testName: "Failed to get session because rotated refresh token was reused after the session had already ended",
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					GetSessionByRefreshTokenHash(mock.Anything, hashRefreshToken(refreshToken)).
+					Return(nil, application_errors.InvalidRefreshTokenError).Once()
+
+				mRepo.EXPECT().
+					GetConsumedToken(mock.Anything, hashRefreshToken(refreshToken)).
+					Return(consumedToken, nil).Once()
+
+				mRepo.EXPECT().
+					DeleteSession(mock.Anything, sessionId.String(), "").
+					Return(nil, errSessionNotFound).Once()
+
+				return mRepo
+			},
+
+			expectSecurityEvent: true,
+
+			err: application_errors.RefreshTokenReuseError,
+		},
+
+		{
+			testName: "Failed to get session, error when checking refresh token for reuse",
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					GetSessionByRefreshTokenHash(mock.Anything, hashRefreshToken(refreshToken)).
+					Return(nil, application_errors.InvalidRefreshTokenError).Once()
 
-				return mRefreshRepo
+				mRepo.EXPECT().
+					GetConsumedToken(mock.Anything, hashRefreshToken(refreshToken)).
+					Return(nil, errDb).Once()
+
+				return mRepo
 			},
 
-			err: errWhenCreatingRefreshToken,
+			err: errDb,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			mUserService := &mocks2.UserService{}
-			if test.mockUserService != nil {
-				mUserService = test.mockUserService()
-			}
+			logger, hook := logtest.NewNullLogger()
+			ctx := log.ContextWithLogger(context.TODO(), logrus.NewEntry(logger))
 
-			mConverter := &mocks2.Converter{}
+			mRepo := test.mockRepo()
+
+			mConverter := &mocks.Converter{}
 			if test.mockConverter != nil {
 				mConverter = test.mockConverter()
 			}
 
-			mRefreshRepo := &mocks2.RefreshRepository{}
-			if test.mockRefreshRepo != nil {
-				mRefreshRepo = test.mockRefreshRepo()
+			mRevoker := &mocks.AccessTokenRevoker{}
+			if test.mockRevoker != nil {
+				mRevoker = test.mockRevoker()
 			}
 
-			rService := NewService(mRefreshRepo, mUserService, mConverter, nil)
+			mTimeGen := &mocks.TimeGenerator{}
+			if !test.now.IsZero() {
+				mTimeGen.EXPECT().Now().Return(test.now).Once()
+			}
 
-			gotRefresh, err := rService.CreateRefreshToken(context.TODO(), test.email, test.refreshToken)
+			rService := NewService(mRepo, mConverter, nil, mRevoker, mTimeGen)
 
+			gotSession, err := rService.GetSession(ctx, refreshToken)
 			if test.err != nil {
-				require.EqualError(t, test.err, err.Error())
+				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
 
-			require.Equal(t, test.expectedOutput, gotRefresh)
-			mock.AssertExpectationsForObjects(t, mUserService, mConverter, mRefreshRepo)
+			require.Equal(t, test.expectedSession, gotSession)
+			require.Equal(t, test.expectSecurityEvent, containsReuseEvent(hook))
+			mock.AssertExpectationsForObjects(t, mRepo, mConverter, mRevoker, mTimeGen)
 		})
 	}
 }
 
-func TestService_UpdateRefreshToken(t *testing.T) {
+func TestService_RotateSessionToken(t *testing.T) {
+	now := sessionLastUsedAt.Add(time.Hour)
+	device := &models.SessionDevice{UserAgent: "curl/8.0", IpAddress: "10.0.0.1"}
+	session := initSession(sessionId.String(), sessionOwnerId.String())
+	sessionEntity := initSessionEntityFromModel(session, "")
+
+	hasNewTokenHash := mock.MatchedBy(func(entity *entities.Session) bool {
+		return entity.RefreshTokenHash == hashRefreshToken(VALID_REFRESH_TOKEN)
+	})
+
 	tests := []struct {
-		testName       string
-		refreshToken   string
-		userId         string
-		mockRepo       func() *mocks2.RefreshRepository
-		mockConverter  func() *mocks2.Converter
-		expectedOutput *models.Refresh
-		err            error
+		testName string
+		mockRepo func() *mocks.RefreshRepository
+		err      error
 	}{
 		{
-			testName: "Successfully updating refresh token",
+			testName: "Successfully rotating refresh token, expired consumed tokens are deleted",
 
-			refreshToken: VALID_REFRESH_TOKEN,
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
 
-			userId: validUserId.String(),
+				mRepo.EXPECT().
+					RotateSessionToken(context.TODO(), hasNewTokenHash, hashRefreshToken(refreshToken)).
+					Return(nil).Once()
+
+				mRepo.EXPECT().
+					DeleteExpiredConsumedTokens(context.TODO(), sessionId.String(), now.Add(-constants.REFRESH_TOKEN_LIFETIME_IN_HOURS*time.Hour)).
+					Return(nil).Once()
+
+				return mRepo
+			},
+		},
 
-			mockRepo: func() *mocks2.RefreshRepository {
-				mRepo := &mocks2.RefreshRepository{}
+		{
+			testName: "Failed to rotate refresh token which the session does not hold anymore",
 
-				entityRefresh := &entities.Refresh{
-					RefreshToken: VALID_REFRESH_TOKEN,
-					UserId:       validUserId,
-				}
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
 
 				mRepo.EXPECT().
-					UpdateRefreshToken(context.TODO(), VALID_REFRESH_TOKEN, validUserId.String()).
-					Return(entityRefresh, nil).Once()
+					RotateSessionToken(context.TODO(), hasNewTokenHash, hashRefreshToken(refreshToken)).
+					Return(application_errors.InvalidRefreshTokenError).Once()
 
 				return mRepo
 			},
 
-			mockConverter: func() *mocks2.Converter {
-				mConverter := &mocks2.Converter{}
+			err: application_errors.InvalidRefreshTokenError,
+		},
+	}
 
-				entityRefresh := initRefreshEntity(VALID_REFRESH_TOKEN, validUserId)
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockRepo()
 
-				modelRefresh := initRefreshModelFromEntity(entityRefresh)
+			mConverter := &mocks.Converter{}
+			mConverter.EXPECT().ToEntity(session).Return(sessionEntity).Once()
 
-				mConverter.EXPECT().
-					ToModel(entityRefresh).
-					Return(modelRefresh).Once()
+			mTimeGen := &mocks.TimeGenerator{}
+			mTimeGen.EXPECT().Now().Return(now).Once()
 
-				return mConverter
+			rService := NewService(mRepo, mConverter, nil, nil, mTimeGen)
+
+			err := rService.RotateSessionToken(context.TODO(), session, refreshToken, VALID_REFRESH_TOKEN, device)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, device.UserAgent, session.UserAgent)
+			require.Equal(t, now, session.LastUsedAt)
+			mock.AssertExpectationsForObjects(t, mRepo, mConverter, mTimeGen)
+		})
+	}
+}
+
+func TestService_GetTokenOwner(t *testing.T) {
+	tests := []struct {
+		testName          string
+		mockRepo          func() *mocks.RefreshRepository
+		mockUserConverter func() *mocks.UserConverter
+		expectedOutput    *models.User
+		err               error
+	}{
+		{
+			testName: "Successfully getting token owner",
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					GetTokenOwner(context.TODO(), hashRefreshToken(VALID_REFRESH_TOKEN)).
+					Return(initUserEntity(sessionOwnerId, VALID_USER_EMAIL), nil).Once()
+
+				return mRepo
 			},
 
-			expectedOutput: initRefresh(VALID_REFRESH_TOKEN, validUserId.String()),
+			mockUserConverter: func() *mocks.UserConverter {
+				mUserConverter := &mocks.UserConverter{}
+
+				mUserConverter.EXPECT().
+					ToModel(initUserEntity(sessionOwnerId, VALID_USER_EMAIL)).
+					Return(initUser(sessionOwnerId.String(), VALID_USER_EMAIL)).Once()
+
+				return mUserConverter
+			},
+
+			expectedOutput: initUser(sessionOwnerId.String(), VALID_USER_EMAIL),
+		},
+
+		{
+			testName: "Failed to get token owner error by refresh repository",
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					GetTokenOwner(context.TODO(), hashRefreshToken(VALID_REFRESH_TOKEN)).
+					Return(nil, errByRefreshRepoInvalidRefreshToken).Once()
+
+				return mRepo
+			},
+
+			err: errByRefreshRepoInvalidRefreshToken,
 		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockRepo()
+
+			mUserConverter := &mocks.UserConverter{}
+			if test.mockUserConverter != nil {
+				mUserConverter = test.mockUserConverter()
+			}
+
+			rService := NewService(mRepo, nil, mUserConverter, nil, nil)
+
+			gotOutput, err := rService.GetTokenOwner(context.TODO(), VALID_REFRESH_TOKEN)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedOutput, gotOutput)
+			mock.AssertExpectationsForObjects(t, mRepo, mUserConverter)
+		})
+	}
+}
+
+func TestService_GetUserSessionsRecords(t *testing.T) {
+	sessionEntities := []entities.Session{
+		initRevocableSessionEntity(sessionId, sessionOwnerId),
+		initRevocableSessionEntity(otherSessionId, sessionOwnerId),
+	}
 
+	tests := []struct {
+		testName         string
+		mockRepo         func() *mocks.RefreshRepository
+		mockConverter    func() *mocks.Converter
+		expectedSessions []*models.Session
+		err              error
+	}{
 		{
-			testName: "Failed to update refresh token, error by refresh repository",
+			testName: "Successfully getting the sessions of the user, the session of the request is marked as current",
 
-			refreshToken: VALID_REFRESH_TOKEN,
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					GetUserSessions(context.TODO(), sessionOwnerId.String()).
+					Return(sessionEntities, nil).Once()
+
+				return mRepo
+			},
 
-			userId: invalidUserId.String(),
+			mockConverter: func() *mocks.Converter {
+				mConverter := &mocks.Converter{}
 
-			mockRepo: func() *mocks2.RefreshRepository {
-				mRepo := &mocks2.RefreshRepository{}
+				mConverter.EXPECT().
+					ManyToModel(sessionEntities).
+					Return([]*models.Session{
+						initSession(sessionId.String(), sessionOwnerId.String()),
+						initSession(otherSessionId.String(), sessionOwnerId.String()),
+					}).Once()
+
+				return mConverter
+			},
+
+			expectedSessions: []*models.Session{
+				{Id: sessionId.String(), UserId: sessionOwnerId.String(), Current: true},
+				{Id: otherSessionId.String(), UserId: sessionOwnerId.String()},
+			},
+		},
+
+		{
+			testName: "Failed to get the sessions of the user, error by refresh repository",
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
 
 				mRepo.EXPECT().
-					UpdateRefreshToken(context.TODO(), VALID_REFRESH_TOKEN, invalidUserId.String()).
-					Return(nil, errByRefreshRepo).Once()
+					GetUserSessions(context.TODO(), sessionOwnerId.String()).
+					Return(nil, errDb).Once()
 
 				return mRepo
 			},
 
-			err: errByRefreshRepo,
+			err: errDb,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			mRepo := &mocks2.RefreshRepository{}
-			if test.mockRepo != nil {
-				mRepo = test.mockRepo()
-			}
+			mRepo := test.mockRepo()
 
-			mConverter := &mocks2.Converter{}
+			mConverter := &mocks.Converter{}
 			if test.mockConverter != nil {
 				mConverter = test.mockConverter()
 			}
 
-			rService := NewService(mRepo, nil, mConverter, nil)
-
-			gotOutput, err := rService.UpdateRefreshToken(context.TODO(), test.refreshToken, test.userId)
+			rService := NewService(mRepo, mConverter, nil, nil, nil)
 
+			gotSessions, err := rService.GetUserSessionsRecords(context.TODO(), sessionOwnerId.String(), sessionId.String())
 			if test.err != nil {
-				require.EqualError(t, test.err, err.Error())
+				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
 
-			require.Equal(t, test.expectedOutput, gotOutput)
+			require.Equal(t, test.expectedSessions, gotSessions)
 			mock.AssertExpectationsForObjects(t, mRepo, mConverter)
 		})
 	}
 }
 
-func TestService_GetTokenOwner(t *testing.T) {
+func TestService_RevokeSessionRecord(t *testing.T) {
+	owner := &models.User{Id: sessionOwnerId.String(), Role: constants.Writer}
+	admin := &models.User{Id: otherUserId.String(), Role: constants.Admin}
+
+	sessionEntity := initRevocableSessionEntity(sessionId, sessionOwnerId)
+
 	tests := []struct {
-		testName          string
-		refreshToken      string
-		mockRepo          func() *mocks2.RefreshRepository
-		mockUserConverter func() *mocks2.UserConverter
-		expectedOutput    *models.User
-		err               error
+		testName    string
+		user        *models.User
+		mockRepo    func() *mocks.RefreshRepository
+		mockRevoker func() *mocks.AccessTokenRevoker
+		err         error
 	}{
 		{
-			testName: "Successfully getting token owner",
+			testName: "Successfully revoking own session, its access token is revoked as well",
+
+			user: owner,
 
-			refreshToken: VALID_REFRESH_TOKEN,
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
 
-			mockRepo: func() *mocks2.RefreshRepository {
-				mRepo := &mocks2.RefreshRepository{}
+				mRepo.EXPECT().
+					DeleteSession(context.TODO(), sessionId.String(), sessionOwnerId.String()).
+					Return(&sessionEntity, nil).Once()
 
-				entityUser := initUserEntity(validUserId, VALID_USER_EMAIL)
+				return mRepo
+			},
+
+			mockRevoker: func() *mocks.AccessTokenRevoker {
+				mRevoker := &mocks.AccessTokenRevoker{}
+
+				mRevoker.EXPECT().
+					RevokeAccessToken(context.TODO(), accessTokenId, accessTokenExpiresAt).
+					Return(nil).Once()
+
+				return mRevoker
+			},
+		},
+
+		{
+			testName: "Successfully revoking session of another user as admin",
+
+			user: admin,
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
 
 				mRepo.EXPECT().
-					GetTokenOwner(context.TODO(), VALID_REFRESH_TOKEN).
-					Return(entityUser, nil).Once()
+					DeleteSession(context.TODO(), sessionId.String(), "").
+					Return(&sessionEntity, nil).Once()
 
 				return mRepo
 			},
 
-			mockUserConverter: func() *mocks2.UserConverter {
-				mUserConverter := &mocks2.UserConverter{}
+			mockRevoker: func() *mocks.AccessTokenRevoker {
+				mRevoker := &mocks.AccessTokenRevoker{}
 
-				entityUser := initUserEntity(validUserId, VALID_USER_EMAIL)
-				userModel := initUser(validUserId.String(), VALID_USER_EMAIL)
+				mRevoker.EXPECT().
+					RevokeAccessToken(context.TODO(), accessTokenId, accessTokenExpiresAt).
+					Return(nil).Once()
 
-				mUserConverter.EXPECT().
-					ConvertFromDBEntityToModel(entityUser).
-					Return(userModel).Once()
+				return mRevoker
+			},
+		},
 
-				return mUserConverter
+		{
+			testName: "Successfully revoking session which was never issued an access token",
+
+			user: owner,
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					DeleteSession(context.TODO(), sessionId.String(), sessionOwnerId.String()).
+					Return(initSessionEntity(sessionId, hashRefreshToken(refreshToken), sessionOwnerId), nil).Once()
+
+				return mRepo
+			},
+		},
+
+		{
+			testName: "Failed to revoke session which does not exist or belongs to another user",
+
+			user: owner,
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					DeleteSession(context.TODO(), sessionId.String(), sessionOwnerId.String()).
+					Return(nil, errSessionNotFound).Once()
+
+				return mRepo
 			},
 
-			expectedOutput: initUser(validUserId.String(), VALID_USER_EMAIL),
+			err: errSessionNotFound,
 		},
+
 		{
-			testName: "Failed to get token owner error by refresh repository",
+			testName: "Failed to revoke session, error when revoking its access token",
 
-			refreshToken: INVALID_REFRESH_TOKEN,
+			user: owner,
 
-			mockRepo: func() *mocks2.RefreshRepository {
-				mRepo := &mocks2.RefreshRepository{}
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
 
 				mRepo.EXPECT().
-					GetTokenOwner(context.TODO(), INVALID_REFRESH_TOKEN).
-					Return(nil, errByRefreshRepoInvalidRefreshToken).Once()
+					DeleteSession(context.TODO(), sessionId.String(), sessionOwnerId.String()).
+					Return(&sessionEntity, nil).Once()
 
 				return mRepo
 			},
 
-			err: errByRefreshRepoInvalidRefreshToken,
+			mockRevoker: func() *mocks.AccessTokenRevoker {
+				mRevoker := &mocks.AccessTokenRevoker{}
+
+				mRevoker.EXPECT().
+					RevokeAccessToken(context.TODO(), accessTokenId, accessTokenExpiresAt).
+					Return(errWhenRevokingAccessToken).Once()
+
+				return mRevoker
+			},
+
+			err: errWhenRevokingAccessToken,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			mRepo := &mocks2.RefreshRepository{}
+			mRepo := test.mockRepo()
 
-			if test.mockRepo != nil {
-				mRepo = test.mockRepo()
+			mRevoker := &mocks.AccessTokenRevoker{}
+			if test.mockRevoker != nil {
+				mRevoker = test.mockRevoker()
 			}
 
-			mUserConverter := &mocks2.UserConverter{}
+			rService := NewService(mRepo, nil, nil, mRevoker, nil)
 
-			if test.mockUserConverter != nil {
-				mUserConverter = test.mockUserConverter()
+			err := rService.RevokeSessionRecord(context.TODO(), sessionId.String(), test.user)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
 			}
 
-			rService := NewService(mRepo, nil, nil, mUserConverter)
+			mock.AssertExpectationsForObjects(t, mRepo, mRevoker)
+		})
+	}
+}
+
+func TestService_RevokeUserSessionsRecords(t *testing.T) {
+	sessionEntities := []entities.Session{
+		initRevocableSessionEntity(sessionId, sessionOwnerId),
+		initRevocableSessionEntity(otherSessionId, sessionOwnerId),
+	}
+
+	tests := []struct {
+		testName    string
+		mockRepo    func() *mocks.RefreshRepository
+		mockRevoker func() *mocks.AccessTokenRevoker
+		err         error
+	}{
+		{
+			testName: "Successfully revoking the sessions of the user together with their access tokens",
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					DeleteUserSessions(context.TODO(), sessionOwnerId.String()).
+					Return(sessionEntities, nil).Once()
+
+				return mRepo
+			},
+
+			mockRevoker: func() *mocks.AccessTokenRevoker {
+				mRevoker := &mocks.AccessTokenRevoker{}
+
+				mRevoker.EXPECT().
+					RevokeAccessToken(context.TODO(), accessTokenId, accessTokenExpiresAt).
+					Return(nil).Twice()
 
-			gotOutput, err := rService.GetTokenOwner(context.TODO(), test.refreshToken)
+				return mRevoker
+			},
+		},
+
+		{
+			testName: "Failed to revoke the sessions of the user, error by refresh repository",
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					DeleteUserSessions(context.TODO(), sessionOwnerId.String()).
+					Return(nil, errDb).Once()
+
+				return mRepo
+			},
+
+			err: errDb,
+		},
+
+		{
+			testName: "Failed to revoke the sessions of the user, error when revoking an access token",
+
+			mockRepo: func() *mocks.RefreshRepository {
+				mRepo := &mocks.RefreshRepository{}
+
+				mRepo.EXPECT().
+					DeleteUserSessions(context.TODO(), sessionOwnerId.String()).
+					Return(sessionEntities, nil).Once()
+
+				return mRepo
+			},
+
+			mockRevoker: func() *mocks.AccessTokenRevoker {
+				mRevoker := &mocks.AccessTokenRevoker{}
+
+				mRevoker.EXPECT().
+					RevokeAccessToken(context.TODO(), accessTokenId, accessTokenExpiresAt).
+					Return(errWhenRevokingAccessToken).Once()
+
+				return mRevoker
+			},
+
+			err: errWhenRevokingAccessToken,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockRepo()
+
+			mRevoker := &mocks.AccessTokenRevoker{}
+			if test.mockRevoker != nil {
+				mRevoker = test.mockRevoker()
+			}
+
+			rService := NewService(mRepo, nil, nil, mRevoker, nil)
+
+			err := rService.RevokeUserSessionsRecords(context.TODO(), sessionOwnerId.String())
 			if test.err != nil {
-				require.EqualError(t, test.err, err.Error())
+				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
 
-			require.Equal(t, test.expectedOutput, gotOutput)
-			mock.AssertExpectationsForObjects(t, mRepo, mUserConverter)
+			mock.AssertExpectationsForObjects(t, mRepo, mRevoker)
 		})
 	}
 }
-
-*/
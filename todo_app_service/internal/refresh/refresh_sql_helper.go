@@ -0,0 +1,35 @@
+package refresh
+
+const sessionColumns = `id, user_id, refresh_token_hash, device_label, user_agent, ip_address, created_at, last_used_at,
+access_token_id, access_token_expires_at`
+
+var createSessionQuery = `INSERT INTO user_sessions (` + sessionColumns + `)
+VALUES (:id, :user_id, :refresh_token_hash, :device_label, :user_agent, :ip_address, :created_at, :last_used_at,
+:access_token_id, :access_token_expires_at)`
+
+var getSessionByRefreshTokenHashQuery = `SELECT ` + sessionColumns + ` FROM user_sessions WHERE refresh_token_hash = $1`
+
+var getUserSessionsQuery = `SELECT ` + sessionColumns + ` FROM user_sessions WHERE user_id = $1 ORDER BY last_used_at DESC, id`
+
+// rotateSessionTokenQuery only rotates the token the session currently holds, so when the same token is presented
+// concurrently only one of the requests can rotate it, the device label given at login is kept
+const rotateSessionTokenQuery = `UPDATE user_sessions SET refresh_token_hash = $1, user_agent = $2, ip_address = $3, last_used_at = $4,
+access_token_id = $5, access_token_expires_at = $6
+WHERE id = $7 AND refresh_token_hash = $8`
+
+const createConsumedTokenQuery = `INSERT INTO consumed_refresh_tokens (token_hash, session_id, consumed_at) VALUES ($1, $2, $3)`
+
+const getConsumedTokenQuery = `SELECT token_hash, session_id, consumed_at FROM consumed_refresh_tokens WHERE token_hash = $1`
+
+const deleteExpiredConsumedTokensQuery = `DELETE FROM consumed_refresh_tokens WHERE session_id = $1 AND consumed_at < $2`
+
+const getTokenOwnerQuery = `SELECT users.id, users.email, users.role FROM users JOIN user_sessions ON users.id = user_sessions.user_id
+WHERE user_sessions.refresh_token_hash = $1`
+
+var deleteSessionQuery = `DELETE FROM user_sessions WHERE id = $1 RETURNING ` + sessionColumns
+
+var deleteUserSessionQuery = `DELETE FROM user_sessions WHERE id = $1 AND user_id = $2 RETURNING ` + sessionColumns
+
+var deleteAllUserSessionsQuery = `DELETE FROM user_sessions WHERE user_id = $1 RETURNING ` + sessionColumns
+
+const sessionExistsQuery = `SELECT EXISTS(SELECT 1 FROM user_sessions WHERE id = $1)`
@@ -0,0 +1,18 @@
+package creators
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+)
+
+func init() {
+	resource_identifier.GetAdapterInstance().Register(&commentsRfCreator{})
+}
+
+type commentsRfCreator struct{}
+
+func (*commentsRfCreator) Create(rf resource_identifier.ResourceIdentifier) resource_identifier.ResourceIdentifier {
+	adaptResourceIdentifierIfNeeded(rf, constants.CommentsIdentifier, constants.CommentsSQLTableName)
+
+	return rf
+}
@@ -0,0 +1,18 @@
+package creators
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+)
+
+func init() {
+	resource_identifier.GetAdapterInstance().Register(&todosAssigneesRfCreator{})
+}
+
+type todosAssigneesRfCreator struct{}
+
+func (*todosAssigneesRfCreator) Create(rf resource_identifier.ResourceIdentifier) resource_identifier.ResourceIdentifier {
+	adaptResourceIdentifierIfNeeded(rf, constants.TodosAssigneesIdentifier, constants.TodosAssigneesViewName)
+
+	return rf
+}
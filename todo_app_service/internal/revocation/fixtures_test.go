@@ -0,0 +1,19 @@
+package revocation
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	accessTokenId = "access token id"
+	pruneInterval = time.Hour
+)
+
+var (
+	now                  = time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	accessTokenExpiresAt = now.Add(15 * time.Minute)
+	errWhenPruningTokens = errors.New("error when pruning revoked access tokens")
+	errWhenRevokingToken = errors.New("error when revoking access token")
+	errWhenBeginningTx   = errors.New("error when beginning transaction")
+)
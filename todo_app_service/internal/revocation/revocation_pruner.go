@@ -0,0 +1,61 @@
+package revocation
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"context"
+	"time"
+)
+
+//go:generate mockery --name=pruneService --exported --output=./mocks --outpkg=mocks --filename=prune_service.go --with-expecter=true
+type pruneService interface {
+	PruneExpiredRecords(ctx context.Context) error
+}
+
+// Pruner periodically removes the revoked access tokens which have expired
+type Pruner struct {
+	serv     pruneService
+	transact persistence.Transactioner
+	interval time.Duration
+}
+
+func NewPruner(service pruneService, transact persistence.Transactioner, interval time.Duration) *Pruner {
+	return &Pruner{
+		serv:     service,
+		transact: transact,
+		interval: interval,
+	}
+}
+
+// Run prunes the revoked access tokens right away and then once every interval until the context is done
+func (p *Pruner) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.prune(ctx); err != nil {
+			log.C(ctx).Errorf("failed to prune revoked access tokens, error %s", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Pruner) prune(ctx context.Context) error {
+	tx, err := p.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in revocation pruner, error %s", err.Error())
+		return err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	if err = p.serv.PruneExpiredRecords(persistence.SaveToContext(ctx, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
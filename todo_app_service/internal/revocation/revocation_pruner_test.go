@@ -0,0 +1,129 @@
+package revocation
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/revocation/mocks"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestPruner_Prune(t *testing.T) {
+	tests := []struct {
+		testName    string
+		serviceMock func() *mocks.PruneService
+		dbMock      func(mck sqlmock.Sqlmock)
+		err         error
+	}{
+		{
+			testName: "Successfully pruning the expired revoked access tokens",
+
+			serviceMock: func() *mocks.PruneService {
+				mck := &mocks.PruneService{}
+
+				mck.EXPECT().
+					PruneExpiredRecords(mock.Anything).
+					Return(nil).
+					Once()
+
+				return mck
+			},
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+		},
+
+		{
+			testName: "Failed to prune, error when calling the service rolls back the transaction",
+
+			serviceMock: func() *mocks.PruneService {
+				mck := &mocks.PruneService{}
+
+				mck.EXPECT().
+					PruneExpiredRecords(mock.Anything).
+					Return(errWhenPruningTokens).
+					Once()
+
+				return mck
+			},
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+
+			err: errWhenPruningTokens,
+		},
+
+		{
+			testName: "Failed to prune, error when beginning the transaction",
+
+			serviceMock: func() *mocks.PruneService {
+				return &mocks.PruneService{}
+			},
+
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin().WillReturnError(errWhenBeginningTx)
+			},
+
+			err: errWhenBeginningTx,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			serviceMock := test.serviceMock()
+
+			err = NewPruner(serviceMock, persistence.NewSqlDb(db), pruneInterval).prune(context.TODO())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, serviceMock)
+		})
+	}
+}
+
+func TestPruner_Run(t *testing.T) {
+	db, mck, err := sqlmock.Newx()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mck.ExpectBegin()
+	mck.ExpectCommit()
+
+	serviceMock := &mocks.PruneService{}
+	serviceMock.EXPECT().
+		PruneExpiredRecords(mock.Anything).
+		Return(nil).
+		Once()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	stopped := make(chan struct{})
+
+	go func() {
+		NewPruner(serviceMock, persistence.NewSqlDb(db), pruneInterval).Run(ctx)
+		close(stopped)
+	}()
+
+	// the tokens are pruned right away, the pruner stops once the context is done without waiting for the next tick
+	require.Eventually(t, func() bool { return mck.ExpectationsWereMet() == nil }, time.Second, time.Millisecond)
+	cancel()
+	<-stopped
+
+	mock.AssertExpectationsForObjects(t, serviceMock)
+}
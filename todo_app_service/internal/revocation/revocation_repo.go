@@ -0,0 +1,67 @@
+package revocation
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"context"
+	"time"
+)
+
+type repository struct{}
+
+func NewRepo() *repository {
+	return &repository{}
+}
+
+func (*repository) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	log.C(ctx).Infof("revoking access token with id %s in revocation repo", jti)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistance from context in revocation repo, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, revokeAccessTokenQuery, jti, expiresAt); err != nil {
+		log.C(ctx).Errorf("failed to revoke access token with id %s, error %s", jti, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (*repository) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	log.C(ctx).Debugf("checking if access token with id %s is revoked in revocation repo", jti)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistance from context in revocation repo, error %s", err.Error())
+		return false, err
+	}
+
+	var revoked bool
+	if err = persist.GetContext(ctx, &revoked, isAccessTokenRevokedQuery, jti); err != nil {
+		log.C(ctx).Errorf("failed to check if access token with id %s is revoked, error %s", jti, err.Error())
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+// DeleteExpiredRevokedTokens removes the revoked tokens which expired before the given time, they are rejected anyway
+func (*repository) DeleteExpiredRevokedTokens(ctx context.Context, expiredBefore time.Time) error {
+	log.C(ctx).Infof("deleting revoked access tokens expired before %s in revocation repo", expiredBefore.Format(time.RFC3339))
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistance from context in revocation repo, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, deleteExpiredRevokedTokensQuery, expiredBefore); err != nil {
+		log.C(ctx).Errorf("failed to delete expired revoked access tokens due to a database error %s", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package revocation
+
+import (
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"context"
+	"time"
+)
+
+//go:generate mockery --name=revocationRepository --exported --output=./mocks --outpkg=mocks --filename=revocation_repository.go --with-expecter=true
+type revocationRepository interface {
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+	DeleteExpiredRevokedTokens(ctx context.Context, expiredBefore time.Time) error
+}
+
+//go:generate mockery --name=timeGenerator --exported --output=./mocks --outpkg=mocks --filename=time_generator.go --with-expecter=true
+type timeGenerator interface {
+	Now() time.Time
+}
+
+type service struct {
+	repo    revocationRepository
+	timeGen timeGenerator
+}
+
+func NewService(repo revocationRepository, timeGen timeGenerator) *service {
+	return &service{
+		repo:    repo,
+		timeGen: timeGen,
+	}
+}
+
+// RevokeAccessToken rejects the access token with the given id until it expires, tokens without an id
+// and tokens which have already expired are skipped
+func (s *service) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if jti == "" || !expiresAt.After(s.timeGen.Now()) {
+		log.C(ctx).Debug("access token has no id or has already expired, there is nothing to revoke")
+		return nil
+	}
+
+	log.C(ctx).Infof("revoking access token with id %s in revocation service", jti)
+
+	if err := s.repo.RevokeAccessToken(ctx, jti, expiresAt); err != nil {
+		log.C(ctx).Errorf("failed to revoke access token, error %s when calling revocation repo", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	if jti == "" {
+		return false, nil
+	}
+
+	revoked, err := s.repo.IsAccessTokenRevoked(ctx, jti)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check if access token is revoked, error %s when calling revocation repo", err.Error())
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+// PruneExpiredRecords removes the revoked access tokens which have expired in the meantime
+func (s *service) PruneExpiredRecords(ctx context.Context) error {
+	now := s.timeGen.Now()
+	log.C(ctx).Infof("pruning access tokens expired before %s in revocation service", now.Format(time.RFC3339))
+
+	if err := s.repo.DeleteExpiredRevokedTokens(ctx, now); err != nil {
+		log.C(ctx).Errorf("failed to prune expired access tokens, error %s when calling revocation repo", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package revocation
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/revocation/mocks"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestService_RevokeAccessToken(t *testing.T) {
+	tests := []struct {
+		testName  string
+		jti       string
+		expiresAt time.Time
+		repoMock  func() *mocks.RevocationRepository
+		err       error
+	}{
+		{
+			testName: "Successfully revoking access token which has not expired yet",
+
+			jti: accessTokenId,
+
+			expiresAt: accessTokenExpiresAt,
+
+			repoMock: func() *mocks.RevocationRepository {
+				mck := &mocks.RevocationRepository{}
+
+				mck.EXPECT().
+					RevokeAccessToken(context.TODO(), accessTokenId, accessTokenExpiresAt).
+					Return(nil).
+					Once()
+
+				return mck
+			},
+		},
+
+		{
+			testName: "Successfully skipping access token which has already expired",
+
+			jti: accessTokenId,
+
+			expiresAt: now.Add(-time.Minute),
+		},
+
+		{
+			testName: "Successfully skipping access token without an id",
+
+			expiresAt: accessTokenExpiresAt,
+		},
+
+		{
+			testName: "Failed to revoke access token, error when calling repo",
+
+			jti: accessTokenId,
+
+			expiresAt: accessTokenExpiresAt,
+
+			repoMock: func() *mocks.RevocationRepository {
+				mck := &mocks.RevocationRepository{}
+
+				mck.EXPECT().
+					RevokeAccessToken(context.TODO(), accessTokenId, accessTokenExpiresAt).
+					Return(errWhenRevokingToken).
+					Once()
+
+				return mck
+			},
+
+			err: errWhenRevokingToken,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			repoMock := &mocks.RevocationRepository{}
+			if test.repoMock != nil {
+				repoMock = test.repoMock()
+			}
+
+			timeMock := &mocks.TimeGenerator{}
+			timeMock.EXPECT().Now().Return(now).Maybe()
+
+			err := NewService(repoMock, timeMock).RevokeAccessToken(context.TODO(), test.jti, test.expiresAt)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repoMock)
+		})
+	}
+}
+
+func TestService_PruneExpiredRecords(t *testing.T) {
+	tests := []struct {
+		testName string
+		repoErr  error
+	}{
+		{
+			testName: "Successfully pruning the access tokens which expired before now",
+		},
+
+		{
+			testName: "Failed to prune access tokens, error when calling repo",
+
+			repoErr: errWhenPruningTokens,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			repoMock := &mocks.RevocationRepository{}
+			repoMock.EXPECT().
+				DeleteExpiredRevokedTokens(context.TODO(), now).
+				Return(test.repoErr).
+				Once()
+
+			timeMock := &mocks.TimeGenerator{}
+			timeMock.EXPECT().Now().Return(now).Once()
+
+			err := NewService(repoMock, timeMock).PruneExpiredRecords(context.TODO())
+			if test.repoErr != nil {
+				require.EqualError(t, err, test.repoErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repoMock, timeMock)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package revocation
+
+// revokeAccessTokenQuery ignores tokens that are already revoked, logging out twice with the same token is not an error
+const revokeAccessTokenQuery = `INSERT INTO revoked_access_tokens (jti, expires_at) VALUES ($1, $2) ON CONFLICT (jti) DO NOTHING`
+
+const isAccessTokenRevokedQuery = `SELECT EXISTS(SELECT 1 FROM revoked_access_tokens WHERE jti = $1)`
+
+const deleteExpiredRevokedTokensQuery = `DELETE FROM revoked_access_tokens WHERE expires_at < $1`
@@ -0,0 +1,97 @@
+package roles
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Rule grants its role to the members of any of the organizations or teams, teams are written as "org/team-slug"
+type Rule struct {
+	Role          constants.UserRole
+	Organizations []string
+	Teams         []string
+}
+
+type policy struct {
+	rules          []Rule
+	emailOverrides map[string]constants.UserRole
+	defaultRole    constants.UserRole
+}
+
+// NewPolicy validates the role mapping, the rules are evaluated in the given order so the most privileged one should come first.
+// Organization, team and email names are compared case-insensitively as GitHub and mail servers do.
+func NewPolicy(rules []Rule, emailOverrides map[string]string, defaultRole string) (*policy, error) {
+	p := &policy{
+		rules:          make([]Rule, 0, len(rules)),
+		emailOverrides: make(map[string]constants.UserRole, len(emailOverrides)),
+		defaultRole:    constants.UserRole(defaultRole),
+	}
+
+	if !isValidRole(p.defaultRole) {
+		return nil, fmt.Errorf("invalid default role %q", defaultRole)
+	}
+
+	for _, rule := range rules {
+		if !isValidRole(rule.Role) {
+			return nil, fmt.Errorf("invalid role %q in role mapping", rule.Role)
+		}
+
+		p.rules = append(p.rules, Rule{
+			Role:          rule.Role,
+			Organizations: normalize(rule.Organizations),
+			Teams:         normalize(rule.Teams),
+		})
+	}
+
+	for email, role := range emailOverrides {
+		if !isValidRole(constants.UserRole(role)) {
+			return nil, fmt.Errorf("invalid role %q for email %s in role mapping", role, email)
+		}
+
+		p.emailOverrides[strings.ToLower(email)] = constants.UserRole(role)
+	}
+
+	return p, nil
+}
+
+// DetermineRole gives precedence to the email overrides, then to the first rule the memberships match and falls back to the default role
+func (p *policy) DetermineRole(email string, memberships *models.Memberships) constants.UserRole {
+	if role, ok := p.emailOverrides[strings.ToLower(email)]; ok {
+		return role
+	}
+
+	organizations := normalize(memberships.Organizations)
+	teams := normalize(memberships.Teams)
+
+	for _, rule := range p.rules {
+		if containsAny(rule.Organizations, organizations) || containsAny(rule.Teams, teams) {
+			return rule.Role
+		}
+	}
+
+	return p.defaultRole
+}
+
+func isValidRole(role constants.UserRole) bool {
+	return role == constants.Admin || role == constants.Writer || role == constants.Reader
+}
+
+func normalize(names []string) []string {
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		if name = strings.ToLower(strings.TrimSpace(name)); len(name) != 0 {
+			normalized = append(normalized, name)
+		}
+	}
+
+	return normalized
+}
+
+func containsAny(granted []string, names []string) bool {
+	return slices.ContainsFunc(names, func(name string) bool {
+		return slices.Contains(granted, name)
+	})
+}
@@ -0,0 +1,132 @@
+package roles
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var mappingRules = []Rule{
+	{
+		Role:          constants.Admin,
+		Organizations: []string{"TodoAppAdmins"},
+		Teams:         []string{"todo-org/maintainers"},
+	},
+	{
+		Role:          constants.Writer,
+		Organizations: []string{"TodoAppWriters"},
+		Teams:         []string{"todo-org/contributors"},
+	},
+}
+
+var emailOverrides = map[string]string{
+	"Lead@Example.com": "admin",
+}
+
+func TestPolicy_DetermineRole(t *testing.T) {
+	p, err := NewPolicy(mappingRules, emailOverrides, string(constants.Reader))
+	require.NoError(t, err)
+
+	tests := []struct {
+		testName     string
+		email        string
+		memberships  *models.Memberships
+		expectedRole constants.UserRole
+	}{
+		{
+			testName:     "Granting admin role to member of admin organization",
+			email:        "user@example.com",
+			memberships:  &models.Memberships{Organizations: []string{"other", "todoappadmins"}},
+			expectedRole: constants.Admin,
+		},
+
+		{
+			testName:     "Granting admin role to member of admin team",
+			email:        "user@example.com",
+			memberships:  &models.Memberships{Organizations: []string{"todo-org"}, Teams: []string{"todo-org/maintainers"}},
+			expectedRole: constants.Admin,
+		},
+
+		{
+			testName:     "Granting most privileged role when user matches several rules",
+			email:        "user@example.com",
+			memberships:  &models.Memberships{Organizations: []string{"TodoAppWriters", "TodoAppAdmins"}},
+			expectedRole: constants.Admin,
+		},
+
+		{
+			testName:     "Granting writer role to member of writer team",
+			email:        "user@example.com",
+			memberships:  &models.Memberships{Teams: []string{"todo-org/contributors"}},
+			expectedRole: constants.Writer,
+		},
+
+		{
+			testName:     "Not granting admin role to member of organization only containing admin organization name",
+			email:        "user@example.com",
+			memberships:  &models.Memberships{Organizations: []string{"NotTodoAppAdmins"}},
+			expectedRole: constants.Reader,
+		},
+
+		{
+			testName:     "Not granting admin role to member of team with same name in another organization",
+			email:        "user@example.com",
+			memberships:  &models.Memberships{Teams: []string{"other-org/maintainers"}},
+			expectedRole: constants.Reader,
+		},
+
+		{
+			testName:     "Granting role of email override regardless of memberships",
+			email:        "lead@example.com",
+			memberships:  &models.Memberships{},
+			expectedRole: constants.Admin,
+		},
+
+		{
+			testName:     "Granting default role to user without matching memberships",
+			email:        "user@example.com",
+			memberships:  &models.Memberships{},
+			expectedRole: constants.Reader,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			require.Equal(t, test.expectedRole, p.DetermineRole(test.email, test.memberships))
+		})
+	}
+}
+
+func TestNewPolicy_InvalidMapping(t *testing.T) {
+	tests := []struct {
+		testName       string
+		rules          []Rule
+		emailOverrides map[string]string
+		defaultRole    string
+	}{
+		{
+			testName:    "Failed to create policy because default role is invalid",
+			defaultRole: "invalid role",
+		},
+
+		{
+			testName:    "Failed to create policy because rule role is invalid",
+			rules:       []Rule{{Role: "owner", Organizations: []string{"TodoAppAdmins"}}},
+			defaultRole: string(constants.Reader),
+		},
+
+		{
+			testName:       "Failed to create policy because email override role is invalid",
+			emailOverrides: map[string]string{"lead@example.com": "owner"},
+			defaultRole:    string(constants.Reader),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			_, err := NewPolicy(test.rules, test.emailOverrides, test.defaultRole)
+			require.Error(t, err)
+		})
+	}
+}
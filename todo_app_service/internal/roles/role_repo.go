@@ -0,0 +1,58 @@
+package roles
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
+	"database/sql"
+	"errors"
+)
+
+type repository struct{}
+
+func NewRepo() *repository {
+	return &repository{}
+}
+
+func (*repository) GetMemberships(ctx context.Context, userId string) (*entities.Membership, error) {
+	log.C(ctx).Infof("getting memberships of user with id %s from role repository", userId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	entity := &entities.Membership{}
+	if err = persist.GetContext(ctx, entity, getMembershipsQuery, userId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Infof("no memberships stored for user with id %s", userId)
+			return nil, application_errors.NewNotFoundError(constants.MEMBERSHIPS_TARGET, userId)
+		}
+
+		log.C(ctx).Errorf("failed to get memberships of user with id %s because of a database error %s", userId, err.Error())
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (*repository) UpsertMemberships(ctx context.Context, membership *entities.Membership) error {
+	log.C(ctx).Infof("saving memberships of user with id %s in role repository", membership.UserId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.NamedExecContext(ctx, upsertMembershipsQuery, membership); err != nil {
+		log.C(ctx).Errorf("failed to save memberships of user with id %s, error %s", membership.UserId, err.Error())
+		return err
+	}
+
+	return nil
+}
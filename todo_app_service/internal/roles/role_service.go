@@ -0,0 +1,76 @@
+package roles
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+)
+
+type membershipRepo interface {
+	GetMemberships(ctx context.Context, userId string) (*entities.Membership, error)
+	UpsertMemberships(ctx context.Context, membership *entities.Membership) error
+}
+
+type rolePolicy interface {
+	DetermineRole(email string, memberships *models.Memberships) constants.UserRole
+}
+
+type membershipConverter interface {
+	ToModel(membership *entities.Membership) *models.Memberships
+	ToEntity(userId string, memberships *models.Memberships) *entities.Membership
+}
+
+type service struct {
+	repo      membershipRepo
+	policy    rolePolicy
+	converter membershipConverter
+}
+
+func NewService(repo membershipRepo, policy rolePolicy, converter membershipConverter) *service {
+	return &service{
+		repo:      repo,
+		policy:    policy,
+		converter: converter,
+	}
+}
+
+func (s *service) DetermineUserRole(ctx context.Context, identity *models.UserIdentity) string {
+	role := s.policy.DetermineRole(identity.Email, &identity.Memberships)
+	log.C(ctx).Infof("role mapping granted role %s to user with email %s", role, identity.Email)
+
+	return string(role)
+}
+
+func (s *service) SaveUserMemberships(ctx context.Context, userId string, memberships *models.Memberships) error {
+	log.C(ctx).Infof("saving memberships of user with id %s in role service", userId)
+
+	return s.repo.UpsertMemberships(ctx, s.converter.ToEntity(userId, memberships))
+}
+
+// ReevaluateUserRole applies the current role mapping to the memberships the user had at their last login
+func (s *service) ReevaluateUserRole(ctx context.Context, user *models.User) (string, error) {
+	log.C(ctx).Infof("reevaluating role of user with id %s in role service", user.Id)
+
+	memberships := &models.Memberships{}
+
+	entity, err := s.repo.GetMemberships(ctx, user.Id)
+	if err != nil {
+		// users who have not logged in since memberships are stored only get email overrides and the default role
+		if !utils.CheckForNotFoundError(err) {
+			log.C(ctx).Errorf("failed to reevaluate role of user with id %s, error %s when getting memberships", user.Id, err.Error())
+			return "", err
+		}
+	} else {
+		memberships = s.converter.ToModel(entity)
+	}
+
+	role := s.policy.DetermineRole(user.Email, memberships)
+	if role != user.Role {
+		log.C(ctx).Infof("role of user with id %s changed from %s to %s", user.Id, user.Role, role)
+	}
+
+	return string(role), nil
+}
@@ -0,0 +1,6 @@
+package roles
+
+const getMembershipsQuery = `SELECT user_id, organizations, teams FROM user_memberships WHERE user_id = $1`
+
+const upsertMembershipsQuery = `INSERT INTO user_memberships (user_id, organizations, teams) VALUES (:user_id, :organizations, :teams)
+ON CONFLICT (user_id) DO UPDATE SET organizations = EXCLUDED.organizations, teams = EXCLUDED.teams`
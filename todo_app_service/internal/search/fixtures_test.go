@@ -0,0 +1,53 @@
+package search
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"encoding/json"
+	"errors"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	searchQuery = "groceries"
+	searchLimit = 2
+)
+
+var (
+	callerId   = uuid.Must(uuid.NewV4()).String()
+	todoId     = uuid.Must(uuid.NewV4())
+	listId     = uuid.Must(uuid.NewV4())
+	secondList = uuid.Must(uuid.NewV4())
+	dbError    = errors.New("database error")
+
+	writer = &models.User{Id: callerId, Role: constants.Writer}
+	admin  = &models.User{Id: callerId, Role: constants.Admin}
+
+	todoEntity       = entities.Todo{Id: todoId, Name: searchQuery, ListId: listId}
+	listEntity       = entities.List{Id: listId, Name: searchQuery}
+	secondListEntity = entities.List{Id: secondList, Name: searchQuery}
+	todoModel        = &models.Todo{Id: todoId.String(), Name: searchQuery, ListId: listId.String()}
+	listModel        = &models.List{Id: listId.String(), Name: searchQuery}
+	secondListModel  = &models.List{Id: secondList.String(), Name: searchQuery}
+)
+
+func initSearchHit(resultType string, id uuid.UUID, rank float64, position int, totalCount int) entities.SearchHit {
+	return entities.SearchHit{
+		ResultType: resultType,
+		Id:         id,
+		Rank:       rank,
+		Position:   position,
+		TotalCount: totalCount,
+	}
+}
+
+func extractErrorFromResponseRecorder(tb testing.TB, rr *httptest.ResponseRecorder, errMessage string) {
+	tb.Helper()
+	var got map[string]string
+	require.NoError(tb, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Equal(tb, map[string]string{"error": errMessage}, got)
+}
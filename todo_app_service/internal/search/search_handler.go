@@ -0,0 +1,95 @@
+package search
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+//go:generate mockery --name=searchService --exported --output=./mocks --outpkg=mocks --filename=search_service.go --with-expecter=true
+type searchService interface {
+	Search(ctx context.Context, query string, caller *models.User, after string, limit int) (*models.SearchResultPage, error)
+}
+
+type Handler struct {
+	serv     searchService
+	transact persistence.Transactioner
+}
+
+func NewHandler(service searchService, transact persistence.Transactioner) *Handler {
+	return &Handler{
+		serv:     service,
+		transact: transact,
+	}
+}
+
+func (h *Handler) HandleSearch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("searching in search handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in search handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	query := strings.TrimSpace(utils.GetContentFromUrl(r, constants.SEARCH_QUERY))
+	if len(query) == 0 {
+		log.C(ctx).Error("failed to search, error because the search query is missing")
+		utils.EncodeError(w, constants.MISSING_SEARCH_QUERY, http.StatusBadRequest)
+		return
+	}
+
+	first := utils.GetContentFromUrl(r, constants.FIRST)
+	after := utils.GetContentFromUrl(r, constants.AFTER)
+
+	if len(first) == 0 {
+		first = constants.DEFAULT_LIMIT_VALUE
+	}
+
+	limit, err := strconv.Atoi(first)
+	if err != nil || limit <= 0 {
+		log.C(ctx).Errorf("failed to search, error because first has an invalid value %s", first)
+		utils.EncodeError(w, constants.INVALID_FIRST_VALUE, http.StatusBadRequest)
+		return
+	}
+
+	caller, err := utils.GetValueFromContext[*models.User](r.Context(), middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get caller due to an error %s when trying to get value from context in search handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	results, err := h.serv.Search(ctx, query, caller, after, limit)
+	if err != nil {
+		log.C(ctx).Errorf("failed to search, error %s when calling search service", err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(results); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to search, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,131 @@
+package search
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/search/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_HandleSearch(t *testing.T) {
+	resultPage := &models.SearchResultPage{
+		Data:       []*models.SearchResult{{Type: constants.LIST_SEARCH_RESULT, Rank: 0.9, List: listModel}},
+		TotalCount: 1,
+		PageInfo:   &pagination.Page{},
+	}
+
+	tests := []struct {
+		testName           string
+		url                string
+		mockSearchService  func() *mocks.SearchService
+		dbMock             func(mck sqlmock.Sqlmock)
+		expectedStatus     int
+		expectedResultPage *models.SearchResultPage
+		errMessage         string
+	}{
+		{
+			testName: "Successfully searching with the default limit",
+			url:      "/search?q=%20" + searchQuery + "%20",
+			mockSearchService: func() *mocks.SearchService {
+				mService := &mocks.SearchService{}
+
+				mService.EXPECT().
+					Search(mock.Anything, searchQuery, writer, "", 100).
+					Return(resultPage, nil).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			expectedStatus:     http.StatusOK,
+			expectedResultPage: resultPage,
+		},
+		{
+			testName: "Failed to search without a search query",
+			url:      "/search?q=%20",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusBadRequest,
+			errMessage:     constants.MISSING_SEARCH_QUERY,
+		},
+		{
+			testName: "Failed to search with a limit which is not positive",
+			url:      "/search?q=" + searchQuery + "&first=0",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusBadRequest,
+			errMessage:     constants.INVALID_FIRST_VALUE,
+		},
+		{
+			testName: "Failed to search with an invalid cursor",
+			url:      "/search?q=" + searchQuery + "&first=2&after=malformed",
+			mockSearchService: func() *mocks.SearchService {
+				mService := &mocks.SearchService{}
+
+				mService.EXPECT().
+					Search(mock.Anything, searchQuery, writer, "malformed", searchLimit).
+					Return(nil, application_errors.NewInvalidCursorError("malformed")).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusBadRequest,
+			errMessage:     application_errors.NewInvalidCursorError("malformed").Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			mService := &mocks.SearchService{}
+			if test.mockSearchService != nil {
+				mService = test.mockSearchService()
+			}
+
+			handler := NewHandler(mService, persistence.NewSqlDb(db))
+
+			req := httptest.NewRequest(http.MethodGet, test.url, nil)
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserKey, writer))
+			rr := httptest.NewRecorder()
+
+			handler.HandleSearch(rr, req)
+
+			require.Equal(t, test.expectedStatus, rr.Code)
+			if len(test.errMessage) != 0 {
+				extractErrorFromResponseRecorder(t, rr, test.errMessage)
+			} else {
+				var received models.SearchResultPage
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &received))
+				require.Equal(t, test.expectedResultPage, &received)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, mService)
+		})
+	}
+}
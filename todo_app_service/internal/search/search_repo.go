@@ -0,0 +1,69 @@
+package search
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"context"
+	"github.com/lib/pq"
+)
+
+type repository struct{}
+
+func NewRepo() *repository {
+	return &repository{}
+}
+
+func (*repository) SearchHits(ctx context.Context, query string, userId string, isAdmin bool, afterRank float64, afterId string, limit int) ([]entities.SearchHit, error) {
+	log.C(ctx).Info("searching todos and lists in search repository")
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var hits []entities.SearchHit
+	if err = persist.SelectContext(ctx, &hits, searchHitsQuery, query, userId, isAdmin, afterRank, afterId, limit); err != nil {
+		log.C(ctx).Errorf("failed to search todos and lists due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+func (*repository) GetTodosByIds(ctx context.Context, ids []string) ([]entities.Todo, error) {
+	log.C(ctx).Infof("getting %d todos by id in search repository", len(ids))
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var todos []entities.Todo
+	if err = persist.SelectContext(ctx, &todos, todosByIdsQuery, pq.Array(ids)); err != nil {
+		log.C(ctx).Errorf("failed to get todos by id, error %s", err.Error())
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+func (*repository) GetListsByIds(ctx context.Context, ids []string) ([]entities.List, error) {
+	log.C(ctx).Infof("getting %d lists by id in search repository", len(ids))
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var lists []entities.List
+	if err = persist.SelectContext(ctx, &lists, listsByIdsQuery, pq.Array(ids)); err != nil {
+		log.C(ctx).Errorf("failed to get lists by id, error %s", err.Error())
+		return nil, err
+	}
+
+	return lists, nil
+}
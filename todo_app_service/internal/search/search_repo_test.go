@@ -0,0 +1,108 @@
+package search
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"regexp"
+	"testing"
+)
+
+func TestRepository_SearchHits(t *testing.T) {
+	tests := []struct {
+		testName     string
+		isAdmin      bool
+		afterRank    float64
+		afterId      string
+		dbMock       func(mck sqlmock.Sqlmock)
+		err          error
+		expectedHits []entities.SearchHit
+	}{
+		{
+			testName: "Successfully searching hits visible to the caller",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"result_type", "id", "rank", "position", "total_count"}).
+					AddRow(constants.TODO_SEARCH_RESULT, todoId, 0.5, 1, 2).
+					AddRow(constants.LIST_SEARCH_RESULT, listId, 0.2, 2, 2)
+				mck.ExpectQuery(regexp.QuoteMeta(searchHitsQuery)).
+					WithArgs(searchQuery, callerId, false, float64(0), "", searchLimit).
+					WillReturnRows(rows)
+			},
+			expectedHits: []entities.SearchHit{
+				initSearchHit(constants.TODO_SEARCH_RESULT, todoId, 0.5, 1, 2),
+				initSearchHit(constants.LIST_SEARCH_RESULT, listId, 0.2, 2, 2),
+			},
+		},
+		{
+			testName:  "Successfully searching the hits ranked after the cursor",
+			afterRank: 0.5,
+			afterId:   todoId.String(),
+			dbMock: func(mck sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"result_type", "id", "rank", "position", "total_count"}).
+					AddRow(constants.LIST_SEARCH_RESULT, listId, 0.2, 2, 2)
+				mck.ExpectQuery(regexp.QuoteMeta(searchHitsQuery)).
+					WithArgs(searchQuery, callerId, false, 0.5, todoId.String(), searchLimit).
+					WillReturnRows(rows)
+			},
+			expectedHits: []entities.SearchHit{
+				initSearchHit(constants.LIST_SEARCH_RESULT, listId, 0.2, 2, 2),
+			},
+		},
+		{
+			testName: "Failed to search hits due to database error",
+			isAdmin:  true,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(searchHitsQuery)).
+					WithArgs(searchQuery, callerId, true, float64(0), "", searchLimit).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			hits, err := NewRepo().SearchHits(ctx, searchQuery, callerId, test.isAdmin, test.afterRank, test.afterId, searchLimit)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedHits, hits)
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetListsByIds(t *testing.T) {
+	db, mck, err := sqlmock.Newx()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ids := []string{listId.String(), secondList.String()}
+	rows := sqlmock.NewRows([]string{"id", "name"}).
+		AddRow(listId, searchQuery).
+		AddRow(secondList, searchQuery)
+	mck.ExpectQuery(regexp.QuoteMeta(listsByIdsQuery)).
+		WithArgs(pq.Array(ids)).
+		WillReturnRows(rows)
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	lists, err := NewRepo().GetListsByIds(ctx, ids)
+	require.NoError(t, err)
+
+	require.Equal(t, []entities.List{listEntity, secondListEntity}, lists)
+	require.NoError(t, mck.ExpectationsWereMet())
+}
@@ -0,0 +1,168 @@
+package search
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"context"
+	"strconv"
+)
+
+//go:generate mockery --name=searchRepo --exported --output=./mocks --outpkg=mocks --filename=search_repo.go --with-expecter=true
+type searchRepo interface {
+	SearchHits(ctx context.Context, query string, userId string, isAdmin bool, afterRank float64, afterId string, limit int) ([]entities.SearchHit, error)
+	GetTodosByIds(ctx context.Context, ids []string) ([]entities.Todo, error)
+	GetListsByIds(ctx context.Context, ids []string) ([]entities.List, error)
+}
+
+//go:generate mockery --name=todoConverter --exported --output=./mocks --outpkg=mocks --filename=todo_converter.go --with-expecter=true
+type todoConverter interface {
+	ToModel(todo *entities.Todo) *models.Todo
+}
+
+//go:generate mockery --name=listConverter --exported --output=./mocks --outpkg=mocks --filename=list_converter.go --with-expecter=true
+type listConverter interface {
+	ToModel(list *entities.List) *models.List
+}
+
+type service struct {
+	repo       searchRepo
+	tConverter todoConverter
+	lConverter listConverter
+}
+
+func NewService(repo searchRepo, tConverter todoConverter, lConverter listConverter) *service {
+	return &service{
+		repo:       repo,
+		tConverter: tConverter,
+		lConverter: lConverter,
+	}
+}
+
+func (s *service) Search(ctx context.Context, query string, caller *models.User, after string, limit int) (*models.SearchResultPage, error) {
+	log.C(ctx).Infof("searching for %q in search service", query)
+
+	var afterRank float64
+	var afterId string
+	if len(after) != 0 {
+		cursor, err := pagination.ParseCursor(after)
+		if err != nil || !cursor.HasSortValue() {
+			log.C(ctx).Errorf("failed to search, cursor %s is not valid", after)
+			return nil, application_errors.NewInvalidCursorError(after)
+		}
+
+		// the cursor carries the rank of its hit, the next page is sought by rank and id
+		// so it does not depend on the hit of the cursor still matching
+		if afterRank, err = strconv.ParseFloat(cursor.SortValue, 64); err != nil {
+			log.C(ctx).Errorf("failed to search, cursor %s does not contain a valid rank", after)
+			return nil, application_errors.NewInvalidCursorError(after)
+		}
+		afterId = cursor.Id
+	}
+
+	hits, err := s.repo.SearchHits(ctx, query, caller.Id, caller.Role == constants.Admin, afterRank, afterId, limit)
+	if err != nil {
+		log.C(ctx).Errorf("failed to search, error %s when calling search repo", err.Error())
+		return nil, err
+	}
+
+	if len(hits) == 0 {
+		return &models.SearchResultPage{
+			Data:     make([]*models.SearchResult, 0),
+			PageInfo: &pagination.Page{},
+		}, nil
+	}
+
+	todoIds := make([]string, 0, len(hits))
+	listIds := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		if hit.ResultType == constants.TODO_SEARCH_RESULT {
+			todoIds = append(todoIds, hit.Id.String())
+		} else {
+			listIds = append(listIds, hit.Id.String())
+		}
+	}
+
+	todos, err := s.getTodosById(ctx, todoIds)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := s.getListsById(ctx, listIds)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*models.SearchResult, 0, len(hits))
+	for _, hit := range hits {
+		result := &models.SearchResult{Type: hit.ResultType, Rank: hit.Rank}
+		if hit.ResultType == constants.TODO_SEARCH_RESULT {
+			result.Todo = todos[hit.Id.String()]
+		} else {
+			result.List = lists[hit.Id.String()]
+		}
+
+		results = append(results, result)
+	}
+
+	firstHit := hits[0]
+	lastHit := hits[len(hits)-1]
+
+	return &models.SearchResultPage{
+		Data:       results,
+		TotalCount: firstHit.TotalCount,
+		PageInfo: &pagination.Page{
+			StartCursor: hitCursor(firstHit),
+			EndCursor:   hitCursor(lastHit),
+			HasNextPage: lastHit.Position < lastHit.TotalCount,
+			HasPrevPage: firstHit.Position > 1,
+		},
+	}, nil
+}
+
+func (s *service) getTodosById(ctx context.Context, ids []string) (map[string]*models.Todo, error) {
+	todos := make(map[string]*models.Todo, len(ids))
+	if len(ids) == 0 {
+		return todos, nil
+	}
+
+	todoEntities, err := s.repo.GetTodosByIds(ctx, ids)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get matching todos, error %s when calling search repo", err.Error())
+		return nil, err
+	}
+
+	for index := range todoEntities {
+		todo := s.tConverter.ToModel(&todoEntities[index])
+		todos[todo.Id] = todo
+	}
+
+	return todos, nil
+}
+
+func (s *service) getListsById(ctx context.Context, ids []string) (map[string]*models.List, error) {
+	lists := make(map[string]*models.List, len(ids))
+	if len(ids) == 0 {
+		return lists, nil
+	}
+
+	listEntities, err := s.repo.GetListsByIds(ctx, ids)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get matching lists, error %s when calling search repo", err.Error())
+		return nil, err
+	}
+
+	for index := range listEntities {
+		list := s.lConverter.ToModel(&listEntities[index])
+		lists[list.Id] = list
+	}
+
+	return lists, nil
+}
+
+func hitCursor(hit entities.SearchHit) string {
+	return pagination.NewCursor(strconv.FormatFloat(hit.Rank, 'g', -1, 64), hit.Id.String()).String()
+}
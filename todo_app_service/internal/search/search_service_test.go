@@ -0,0 +1,203 @@
+package search
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/search/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestService_Search(t *testing.T) {
+	hits := []entities.SearchHit{
+		initSearchHit(constants.LIST_SEARCH_RESULT, listId, 0.9, 2, 4),
+		initSearchHit(constants.TODO_SEARCH_RESULT, todoId, 0.5, 3, 4),
+	}
+	cursorWithoutRank := pagination.NewCursor("", todoId.String()).String()
+	cursorWithInvalidRank := pagination.NewCursor("high", todoId.String()).String()
+
+	tests := []struct {
+		testName           string
+		caller             *models.User
+		after              string
+		mockSearchRepo     func() *mocks.SearchRepo
+		mockTodoConverter  func() *mocks.TodoConverter
+		mockListConverter  func() *mocks.ListConverter
+		expectedResultPage *models.SearchResultPage
+		err                error
+	}{
+		{
+			testName: "Successfully searching todos and lists in the order of their rank after the cursor",
+			caller:   writer,
+			after:    pagination.NewCursor("0.95", secondList.String()).String(),
+			mockSearchRepo: func() *mocks.SearchRepo {
+				mRepo := &mocks.SearchRepo{}
+
+				mRepo.EXPECT().
+					SearchHits(context.TODO(), searchQuery, callerId, false, 0.95, secondList.String(), searchLimit).
+					Return(hits, nil).Once()
+				mRepo.EXPECT().
+					GetTodosByIds(context.TODO(), []string{todoId.String()}).
+					Return([]entities.Todo{todoEntity}, nil).Once()
+				mRepo.EXPECT().
+					GetListsByIds(context.TODO(), []string{listId.String()}).
+					Return([]entities.List{listEntity}, nil).Once()
+
+				return mRepo
+			},
+			mockTodoConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+
+				mConverter.EXPECT().ToModel(&todoEntity).Return(todoModel).Once()
+
+				return mConverter
+			},
+			mockListConverter: func() *mocks.ListConverter {
+				mConverter := &mocks.ListConverter{}
+
+				mConverter.EXPECT().ToModel(&listEntity).Return(listModel).Once()
+
+				return mConverter
+			},
+			expectedResultPage: &models.SearchResultPage{
+				Data: []*models.SearchResult{
+					{Type: constants.LIST_SEARCH_RESULT, Rank: 0.9, List: listModel},
+					{Type: constants.TODO_SEARCH_RESULT, Rank: 0.5, Todo: todoModel},
+				},
+				TotalCount: 4,
+				PageInfo: &pagination.Page{
+					StartCursor: pagination.NewCursor("0.9", listId.String()).String(),
+					EndCursor:   pagination.NewCursor("0.5", todoId.String()).String(),
+					HasNextPage: true,
+					HasPrevPage: true,
+				},
+			},
+		},
+		{
+			testName: "Successfully searching as admin without getting todos when only lists match",
+			caller:   admin,
+			mockSearchRepo: func() *mocks.SearchRepo {
+				mRepo := &mocks.SearchRepo{}
+
+				mRepo.EXPECT().
+					SearchHits(context.TODO(), searchQuery, callerId, true, float64(0), "", searchLimit).
+					Return([]entities.SearchHit{
+						initSearchHit(constants.LIST_SEARCH_RESULT, listId, 0.9, 1, 2),
+						initSearchHit(constants.LIST_SEARCH_RESULT, secondList, 0.4, 2, 2),
+					}, nil).Once()
+				mRepo.EXPECT().
+					GetListsByIds(context.TODO(), []string{listId.String(), secondList.String()}).
+					Return([]entities.List{secondListEntity, listEntity}, nil).Once()
+
+				return mRepo
+			},
+			mockListConverter: func() *mocks.ListConverter {
+				mConverter := &mocks.ListConverter{}
+
+				mConverter.EXPECT().ToModel(&secondListEntity).Return(secondListModel).Once()
+				mConverter.EXPECT().ToModel(&listEntity).Return(listModel).Once()
+
+				return mConverter
+			},
+			expectedResultPage: &models.SearchResultPage{
+				Data: []*models.SearchResult{
+					{Type: constants.LIST_SEARCH_RESULT, Rank: 0.9, List: listModel},
+					{Type: constants.LIST_SEARCH_RESULT, Rank: 0.4, List: secondListModel},
+				},
+				TotalCount: 2,
+				PageInfo: &pagination.Page{
+					StartCursor: pagination.NewCursor("0.9", listId.String()).String(),
+					EndCursor:   pagination.NewCursor("0.4", secondList.String()).String(),
+				},
+			},
+		},
+		{
+			testName: "Successfully returning empty page when nothing matches",
+			caller:   writer,
+			mockSearchRepo: func() *mocks.SearchRepo {
+				mRepo := &mocks.SearchRepo{}
+
+				mRepo.EXPECT().
+					SearchHits(context.TODO(), searchQuery, callerId, false, float64(0), "", searchLimit).
+					Return(nil, nil).Once()
+
+				return mRepo
+			},
+			expectedResultPage: &models.SearchResultPage{
+				Data:     make([]*models.SearchResult, 0),
+				PageInfo: &pagination.Page{},
+			},
+		},
+		{
+			testName: "Failed to search with a malformed cursor",
+			caller:   writer,
+			after:    "malformed",
+			err:      application_errors.NewInvalidCursorError("malformed"),
+		},
+		{
+			testName: "Failed to search with a cursor which does not carry the rank of its hit",
+			caller:   writer,
+			after:    cursorWithoutRank,
+			err:      application_errors.NewInvalidCursorError(cursorWithoutRank),
+		},
+		{
+			testName: "Failed to search with a cursor whose rank is not a number",
+			caller:   writer,
+			after:    cursorWithInvalidRank,
+			err:      application_errors.NewInvalidCursorError(cursorWithInvalidRank),
+		},
+		{
+			testName: "Failed to search due to error when getting matching todos",
+			caller:   writer,
+			mockSearchRepo: func() *mocks.SearchRepo {
+				mRepo := &mocks.SearchRepo{}
+
+				mRepo.EXPECT().
+					SearchHits(context.TODO(), searchQuery, callerId, false, float64(0), "", searchLimit).
+					Return(hits, nil).Once()
+				mRepo.EXPECT().
+					GetTodosByIds(context.TODO(), []string{todoId.String()}).
+					Return(nil, dbError).Once()
+
+				return mRepo
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := &mocks.SearchRepo{}
+			if test.mockSearchRepo != nil {
+				mRepo = test.mockSearchRepo()
+			}
+
+			mTodoConverter := &mocks.TodoConverter{}
+			if test.mockTodoConverter != nil {
+				mTodoConverter = test.mockTodoConverter()
+			}
+
+			mListConverter := &mocks.ListConverter{}
+			if test.mockListConverter != nil {
+				mListConverter = test.mockListConverter()
+			}
+
+			sService := NewService(mRepo, mTodoConverter, mListConverter)
+
+			resultPage, err := sService.Search(context.TODO(), searchQuery, test.caller, test.after, searchLimit)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedResultPage, resultPage)
+			mock.AssertExpectationsForObjects(t, mRepo, mTodoConverter, mListConverter)
+		})
+	}
+}
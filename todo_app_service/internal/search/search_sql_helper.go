@@ -0,0 +1,49 @@
+package search
+
+// searchHitsQuery ranks the todos and lists visible to the caller against the search query. A todo also matches
+// through the content of its comments. Results are ordered by rank and paginated by seeking past the rank and id of the
+// after cursor, so a page still follows on from its cursor when the hit of the cursor no longer matches or was re-ranked.
+// Todos and lists in the trash are never returned.
+//
+// $1 - search query, $2 - caller id, $3 - whether the caller is an admin, $4 - rank of the after cursor,
+// $5 - id of the after cursor, empty for the first page, $6 - limit
+const searchHitsQuery = `WITH search_query AS (
+    SELECT websearch_to_tsquery('english', $1) AS query
+), visible_lists AS (
+    SELECT lists.id FROM lists
+    WHERE lists.deleted_at IS NULL
+      AND ($3 OR lists.owner = $2 OR lists.id IN (SELECT list_id FROM user_lists WHERE user_id = $2))
+), comment_ranks AS (
+    SELECT comments.todo_id, MAX(ts_rank(comments.search_vector, search_query.query)) AS rank
+    FROM comments, search_query
+    WHERE comments.search_vector @@ search_query.query
+    GROUP BY comments.todo_id
+), hits AS (
+    SELECT 'todo' AS result_type, todos.id,
+           GREATEST(ts_rank(todos.search_vector, search_query.query), COALESCE(comment_ranks.rank, 0)) AS rank
+    FROM todos
+    CROSS JOIN search_query
+    LEFT JOIN comment_ranks ON comment_ranks.todo_id = todos.id
+    WHERE todos.list_id IN (SELECT id FROM visible_lists) AND todos.deleted_at IS NULL
+      AND (todos.search_vector @@ search_query.query OR comment_ranks.todo_id IS NOT NULL)
+    UNION ALL
+    SELECT 'list' AS result_type, lists.id, ts_rank(lists.search_vector, search_query.query) AS rank
+    FROM lists
+    CROSS JOIN search_query
+    WHERE lists.id IN (SELECT id FROM visible_lists)
+      AND lists.search_vector @@ search_query.query
+), ranked AS (
+    SELECT result_type, id, rank::float8 AS rank,
+           ROW_NUMBER() OVER (ORDER BY rank DESC, id) AS position,
+           COUNT(*) OVER () AS total_count
+    FROM hits
+)
+SELECT result_type, id, rank, position, total_count FROM ranked
+WHERE $5 = '' OR rank < $4 OR (rank = $4 AND id > NULLIF($5, '')::uuid)
+ORDER BY position
+LIMIT $6`
+
+const todosByIdsQuery = `SELECT id, name, description, list_id, status, created_at, last_updated, assigned_to, due_date, priority, parent_id,
+recurrence_frequency, recurrence_interval, recurrence_until, recurrence_count, position, column_id, estimate FROM todos WHERE id = ANY($1)`
+
+const listsByIdsQuery = `SELECT id, name, created_at, last_updated, owner, description FROM lists WHERE id = ANY($1)`
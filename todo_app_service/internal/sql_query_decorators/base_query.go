@@ -6,23 +6,29 @@ import (
 )
 
 // the idea of this abstract component is to dynamically build sql query in order to extract todos!
+// every component returns the query together with the values bound to its placeholders, a decorator that needs
+// a value appends it to the params and refers to it by its position, so user input never ends up in the sql itself
 
 //go:generate mockery --name=SqlQueryRetriever --output=./mocks --outpkg=mocks --filename=sql_retriever.go --with-expecter=true
 type SqlQueryRetriever interface {
-	DetermineCorrectSqlQuery(ctx context.Context) string
+	DetermineCorrectSqlQuery(ctx context.Context) (string, []interface{})
 }
 
 // concrete component!
 type baseQuery struct {
-	initialQuery string
+	initialQuery  string
+	initialParams []interface{}
 }
 
-func NewBaseQuery(initialQuery string) SqlQueryRetriever {
-	return &baseQuery{initialQuery: initialQuery}
+func NewBaseQuery(initialQuery string, initialParams []interface{}) SqlQueryRetriever {
+	return &baseQuery{initialQuery: initialQuery, initialParams: initialParams}
 }
 
-func (b *baseQuery) DetermineCorrectSqlQuery(ctx context.Context) string {
+func (b *baseQuery) DetermineCorrectSqlQuery(ctx context.Context) (string, []interface{}) {
 	log.C(ctx).Info("determining correct sql query in base query")
 
-	return b.initialQuery
+	params := make([]interface{}, len(b.initialParams))
+	copy(params, b.initialParams)
+
+	return b.initialQuery, params
 }
@@ -7,9 +7,22 @@ import (
 )
 
 func TestBaseQuery_DetermineCorrectSqlQuery(t *testing.T) {
-	t.Run("returning correct query", func(t *testing.T) {
-		base := newBaseQuery(baseQueryString)
-		returnedQuery := base.DetermineCorrectSqlQuery(context.Background())
-		require.Equal(t, baseQueryString, returnedQuery)
+	t.Run("returning correct query and params", func(t *testing.T) {
+		base := NewBaseQuery(baseQueryStringWithWhere, []interface{}{filteringParam})
+
+		returnedQuery, returnedParams := base.DetermineCorrectSqlQuery(context.TODO())
+
+		require.Equal(t, baseQueryStringWithWhere, returnedQuery)
+		require.Equal(t, []interface{}{filteringParam}, returnedParams)
+	})
+
+	t.Run("returned params do not share state with the base query", func(t *testing.T) {
+		base := NewBaseQuery(baseQueryStringWithWhere, []interface{}{filteringParam})
+
+		_, returnedParams := base.DetermineCorrectSqlQuery(context.TODO())
+		returnedParams[0] = maliciousSqlValue
+
+		_, paramsOnSecondCall := base.DetermineCorrectSqlQuery(context.TODO())
+		require.Equal(t, []interface{}{filteringParam}, paramsOnSecondCall)
 	})
 }
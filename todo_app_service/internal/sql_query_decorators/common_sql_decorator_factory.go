@@ -37,10 +37,10 @@ func (c *decoratorFactory) RegisterCreator(creator SqlDecoratorCreator) {
 	c.creators = append(c.creators, creator)
 }
 
-func (c *decoratorFactory) CreateSqlDecorator(ctx context.Context, f Filters, initialQuery string) (SqlQueryRetriever, error) {
+func (c *decoratorFactory) CreateSqlDecorator(ctx context.Context, f Filters, initialQuery string, initialParams []interface{}) (SqlQueryRetriever, error) {
 	log.C(ctx).Info("creating common decorator in common decorator factory")
 
-	retriever := NewBaseQuery(initialQuery)
+	retriever := NewBaseQuery(initialQuery, initialParams)
 
 	c.creators = SortCreators(c.creators)
 
@@ -1,61 +1,77 @@
-package sql_query_decorators
+package sql_query_decorators_test
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators"
 	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	_ "Todo-List/internProject/todo_app_service/internal/sql_query_decorators/sql_decorators_creators"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
 	"context"
-	"fmt"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
 
-func TestCommonDecoratorFactory_CreateCommonDecorator(t *testing.T) {
-	passedBaseDecorator := newBaseQuery(baseQueryString)
+const (
+	baseQuery         = "SELECT id FROM todos"
+	maliciousSqlValue = "' OR 1=1; DROP TABLE todos; --"
+	statusValue       = "done"
+)
+
+func TestDecoratorFactory_CreateSqlDecorator(t *testing.T) {
+	cursorId := uuid.New().String()
+	maliciousNameCursor := pagination.NewCursor(maliciousSqlValue, cursorId).String()
 
 	tests := []struct {
-		testName          string
-		passedDecorator   SqlQueryRetriever
-		filters           *filters.BaseFilters
-		expectedDecorator SqlQueryRetriever
-		expectedError     error
+		testName       string
+		filters        *filters.TodoFilters
+		expectedQuery  string
+		expectedParams []interface{}
+		expectedError  error
 	}{
 		{
-			testName:        "Successfully returning only cursor decorator",
-			passedDecorator: passedBaseDecorator,
-			filters: &filters.BaseFilters{
-				Cursor: cursorValue.String(),
+			testName: "Decorators continue the numbering of the filtering params",
+			filters: &filters.TodoFilters{
+				PaginationFilters: filters.PaginationFilters{First: "2", After: pagination.NewCursor("", cursorId).String()},
+				Status:            statusValue,
 			},
-			expectedDecorator: newCursorDecorator(passedBaseDecorator, cursorValue.String()),
+			expectedQuery:  baseQuery + " WHERE deleted_at IS NULL AND status = $1 AND id > $2 ORDER BY id ASC  LIMIT $3",
+			expectedParams: []interface{}{statusValue, cursorId, 2},
 		},
 		{
-			testName:        "Successfully returning only limit decorator",
-			passedDecorator: passedBaseDecorator,
-			filters: &filters.BaseFilters{
-				Limit: limitValueString,
+			testName: "Malicious sort value inside a valid cursor only ends up in the params",
+			filters: &filters.TodoFilters{
+				PaginationFilters: filters.PaginationFilters{Last: "2", Before: maliciousNameCursor},
+				Status:            statusValue,
+				Sort:              "name:desc",
 			},
-			expectedDecorator: newLimitDecorator(passedBaseDecorator, limitValue),
+			expectedQuery:  baseQuery + " WHERE deleted_at IS NULL AND status = $1 AND (name, id) > ($2, $3) ORDER BY name ASC, id ASC  LIMIT $4",
+			expectedParams: []interface{}{statusValue, maliciousSqlValue, cursorId, 2},
 		},
 		{
-			testName:        "Returning error due to invalid limit param passed",
-			passedDecorator: passedBaseDecorator,
-			filters: &filters.BaseFilters{
-				Limit: invalidLimitValue,
+			testName: "Raw sql passed as a cursor is rejected",
+			filters: &filters.TodoFilters{
+				PaginationFilters: filters.PaginationFilters{After: maliciousSqlValue},
 			},
-			expectedError: fmt.Errorf("invalid limit: %s", invalidLimitValue),
+			expectedError: application_errors.NewInvalidCursorError(maliciousSqlValue),
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			cFactory := NewCommonFactory()
-			gotDecorator, err := cFactory.CreateCommonDecorator(context.TODO(), test.passedDecorator, test.filters)
+			filteringClause, params := test.filters.BuildSQLFiltering()
 
+			retriever, err := sql_query_decorators.GetDecoratorFactoryInstance().CreateSqlDecorator(context.TODO(), test.filters, baseQuery+filteringClause, params)
 			if test.expectedError != nil {
-				require.EqualError(t, test.expectedError, err.Error())
-			} else {
-				require.NoError(t, err)
+				require.EqualError(t, err, test.expectedError.Error())
+				return
 			}
+			require.NoError(t, err)
 
-			require.Equal(t, test.expectedDecorator, gotDecorator)
+			query, queryParams := retriever.DetermineCorrectSqlQuery(context.TODO())
+			require.Equal(t, test.expectedQuery, query)
+			require.Equal(t, test.expectedParams, queryParams)
+			require.NotContains(t, query, maliciousSqlValue)
 		})
 	}
 }
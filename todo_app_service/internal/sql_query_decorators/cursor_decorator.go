@@ -2,32 +2,47 @@ package sql_query_decorators
 
 import (
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
 	"context"
 	"fmt"
 )
 
 type cursorDecorator struct {
-	inner    SqlQueryRetriever
-	cursor   string
-	operator string
+	inner          SqlQueryRetriever
+	sortExpression string
+	cursor         *pagination.Cursor
+	operator       string
 }
 
-func NewCursorDecorator(inner SqlQueryRetriever, cursor string, operator string) *cursorDecorator {
+func NewCursorDecorator(inner SqlQueryRetriever, sortExpression string, cursor *pagination.Cursor, operator string) *cursorDecorator {
 	return &cursorDecorator{
-		inner:    inner,
-		cursor:   cursor,
-		operator: operator,
+		inner:          inner,
+		sortExpression: sortExpression,
+		cursor:         cursor,
+		operator:       operator,
 	}
 }
 
-func (c *cursorDecorator) DetermineCorrectSqlQuery(ctx context.Context) string {
+func (c *cursorDecorator) DetermineCorrectSqlQuery(ctx context.Context) (string, []interface{}) {
 	log.C(ctx).Info("determining correct sql query in cursor retriever")
 
-	currentQuery := c.inner.DetermineCorrectSqlQuery(ctx)
+	currentQuery, params := c.inner.DetermineCorrectSqlQuery(ctx)
 	addition := determineAddition(currentQuery)
 
-	formattedSuffix := fmt.Sprintf(" %s id %s '%s'", addition, c.operator, c.cursor)
+	var formattedSuffix string
+	if c.cursor.HasSortValue() {
+		var sortValuePlaceholder, idPlaceholder string
+		params, sortValuePlaceholder = bindParam(params, c.cursor.SortValue)
+		params, idPlaceholder = bindParam(params, c.cursor.Id)
+
+		formattedSuffix = fmt.Sprintf(" %s (%s, id) %s (%s, %s)", addition, c.sortExpression, c.operator, sortValuePlaceholder, idPlaceholder)
+	} else {
+		var idPlaceholder string
+		params, idPlaceholder = bindParam(params, c.cursor.Id)
+
+		formattedSuffix = fmt.Sprintf(" %s id %s %s", addition, c.operator, idPlaceholder)
+	}
 	currentQuery += formattedSuffix
 
-	return currentQuery
+	return currentQuery, params
 }
@@ -2,6 +2,7 @@ package sql_query_decorators
 
 import (
 	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
 	"context"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -12,27 +13,47 @@ func TestCursorDecorator_DetermineCorrectSqlQuery(t *testing.T) {
 	tests := []struct {
 		testName                string
 		mockInnerDecorator      func() *mocks.SqlQueryRetriever
+		sortExpression          string
+		cursor                  *pagination.Cursor
 		expectedDecoratedString string
+		expectedParams          []interface{}
 	}{
 		{
 			testName: "Returns correctly decorated string where the inner decorator does not contain WHERE",
 			mockInnerDecorator: func() *mocks.SqlQueryRetriever {
 				mock := &mocks.SqlQueryRetriever{}
-				mock.EXPECT().DetermineCorrectSqlQuery(context.TODO()).Return(baseQueryString).Once()
+				mock.EXPECT().DetermineCorrectSqlQuery(context.TODO()).Return(baseQueryString, []interface{}{}).Once()
 
 				return mock
 			},
-			expectedDecoratedString: expectedCursorAdditionWhenInnerNotContainingWhere,
+			cursor:                  idCursor,
+			expectedDecoratedString: baseQueryString + " WHERE id > $1",
+			expectedParams:          []interface{}{cursorId},
 		},
 		{
 			testName: "Returns correctly decorated string where the inner decorator contains WHERE",
 			mockInnerDecorator: func() *mocks.SqlQueryRetriever {
 				mock := &mocks.SqlQueryRetriever{}
-				mock.EXPECT().DetermineCorrectSqlQuery(context.TODO()).Return(baseQueryStringWithWhere).Once()
+				mock.EXPECT().DetermineCorrectSqlQuery(context.TODO()).Return(baseQueryStringWithWhere, []interface{}{filteringParam}).Once()
 
 				return mock
 			},
-			expectedDecoratedString: expectedCursorAdditionWhenInnerContainsWhere,
+			cursor:                  idCursor,
+			expectedDecoratedString: baseQueryStringWithWhere + " AND id > $2",
+			expectedParams:          []interface{}{filteringParam, cursorId},
+		},
+		{
+			testName: "Binds a malicious sort value as a param instead of splicing it into the query",
+			mockInnerDecorator: func() *mocks.SqlQueryRetriever {
+				mock := &mocks.SqlQueryRetriever{}
+				mock.EXPECT().DetermineCorrectSqlQuery(context.TODO()).Return(baseQueryStringWithWhere, []interface{}{filteringParam}).Once()
+
+				return mock
+			},
+			sortExpression:          nameSortExpression,
+			cursor:                  nameCursor,
+			expectedDecoratedString: baseQueryStringWithWhere + " AND (name, id) > ($2, $3)",
+			expectedParams:          []interface{}{filteringParam, maliciousSqlValue, cursorId},
 		},
 	}
 
@@ -43,13 +64,14 @@ func TestCursorDecorator_DetermineCorrectSqlQuery(t *testing.T) {
 				mockInnerDecorator = test.mockInnerDecorator()
 			}
 
-			cDecorator := newCursorDecorator(mockInnerDecorator, cursorValue.String())
+			cDecorator := NewCursorDecorator(mockInnerDecorator, test.sortExpression, test.cursor, ">")
 
-			receivedCursorDecoratedQuery := cDecorator.DetermineCorrectSqlQuery(context.TODO())
+			receivedCursorDecoratedQuery, receivedParams := cDecorator.DetermineCorrectSqlQuery(context.TODO())
 			require.Equal(t, test.expectedDecoratedString, receivedCursorDecoratedQuery)
+			require.Equal(t, test.expectedParams, receivedParams)
+			require.NotContains(t, receivedCursorDecoratedQuery, maliciousSqlValue)
 
 			mock.AssertExpectationsForObjects(t, mockInnerDecorator)
-
 		})
 	}
 }
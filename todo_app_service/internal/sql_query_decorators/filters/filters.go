@@ -24,12 +24,18 @@ func (p *PaginationFilters) GetFilters() map[string]string {
 
 type TodoFilters struct {
 	PaginationFilters
-	Status   string
-	Priority string
-	Name     string
-	Overdue  string
-	ListID   string
-	UserID   string
+	Status          string
+	Priority        string
+	Name            string
+	Overdue         string
+	ListID          string
+	UserID          string
+	ParentID        string
+	ExcludeSubtasks string
+	Label           string
+	BlocksTodoID    string
+	BlockedByTodoID string
+	Sort            string
 }
 
 func (t *TodoFilters) GetFilters() map[string]string {
@@ -38,12 +44,14 @@ func (t *TodoFilters) GetFilters() map[string]string {
 		constants.LAST:   t.Last,
 		constants.AFTER:  t.After,
 		constants.BEFORE: t.Before,
+		constants.SORT:   t.Sort,
 	}
 }
 
-// BuildSQLFiltering returns the where filtering clause plus the needed params so you can inject them
+// BuildSQLFiltering returns the where filtering clause plus the needed params so you can inject them,
+// the todos in the trash are always filtered out
 func (t *TodoFilters) BuildSQLFiltering() (string, []interface{}) {
-	fields := make([]string, 0)
+	fields := []string{`deleted_at IS NULL`}
 	params := make([]interface{}, 0)
 	paramCounter := 0
 
@@ -90,6 +98,121 @@ func (t *TodoFilters) BuildSQLFiltering() (string, []interface{}) {
 
 		params = append(params, t.UserID)
 	}
+	if len(t.ParentID) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`parent_id = $%d`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, t.ParentID)
+	}
+	if t.ExcludeSubtasks == constants.TRUE_VALUE {
+		elem := `parent_id IS NULL`
+		fields = append(fields, elem)
+	}
+	if len(t.Label) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`id IN (SELECT todo_labels.todo_id FROM todo_labels
+JOIN labels ON labels.id = todo_labels.label_id WHERE labels.name = $%d)`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, t.Label)
+	}
+	if len(t.BlocksTodoID) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`id IN (SELECT blocker_id FROM todo_dependencies WHERE todo_id = $%d)`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, t.BlocksTodoID)
+	}
+	if len(t.BlockedByTodoID) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`id IN (SELECT todo_id FROM todo_dependencies WHERE blocker_id = $%d)`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, t.BlockedByTodoID)
+	}
+
+	filteringClause := fmt.Sprintf(` WHERE %s`, strings.Join(fields, " AND "))
+	return filteringClause, params
+}
+
+type ListFilters struct {
+	PaginationFilters
+	Name    string
+	OwnerID string
+	Sort    string
+}
+
+func (l *ListFilters) GetFilters() map[string]string {
+	return map[string]string{
+		constants.FIRST:  l.First,
+		constants.LAST:   l.Last,
+		constants.AFTER:  l.After,
+		constants.BEFORE: l.Before,
+		constants.SORT:   l.Sort,
+	}
+}
+
+func (l *ListFilters) BuildSQLFiltering() (string, []interface{}) {
+	fields := []string{`deleted_at IS NULL`}
+	params := make([]interface{}, 0)
+	paramCounter := 0
+
+	if len(l.Name) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`name = $%d`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, l.Name)
+	}
+
+	if len(l.OwnerID) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`owner = $%d`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, l.OwnerID)
+	}
+
+	filteringClause := fmt.Sprintf(` WHERE %s`, strings.Join(fields, " AND "))
+	return filteringClause, params
+}
+
+type UserFilters struct {
+	PaginationFilters
+	ListID string
+	TodoID string
+}
+
+func (u *UserFilters) GetFilters() map[string]string {
+	return map[string]string{
+		constants.FIRST:  u.First,
+		constants.LAST:   u.Last,
+		constants.AFTER:  u.After,
+		constants.BEFORE: u.Before,
+	}
+}
+
+func (u *UserFilters) BuildSQLFiltering() (string, []interface{}) {
+	fields := make([]string, 0)
+	params := make([]interface{}, 0)
+	paramCounter := 0
+
+	if len(u.ListID) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`list_id = $%d`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, u.ListID)
+	}
+
+	if len(u.TodoID) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`todo_id = $%d`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, u.TodoID)
+	}
 
 	var args string
 	if len(fields) == 0 {
@@ -102,13 +225,14 @@ func (t *TodoFilters) BuildSQLFiltering() (string, []interface{}) {
 	return filteringClause, params
 }
 
-type ListFilters struct {
+type LabelFilters struct {
 	PaginationFilters
 	Name    string
 	OwnerID string
+	TodoID  string
 }
 
-func (l *ListFilters) GetFilters() map[string]string {
+func (l *LabelFilters) GetFilters() map[string]string {
 	return map[string]string{
 		constants.FIRST:  l.First,
 		constants.LAST:   l.Last,
@@ -117,7 +241,7 @@ func (l *ListFilters) GetFilters() map[string]string {
 	}
 }
 
-func (l *ListFilters) BuildSQLFiltering() (string, []interface{}) {
+func (l *LabelFilters) BuildSQLFiltering() (string, []interface{}) {
 	fields := make([]string, 0)
 	params := make([]interface{}, 0)
 	paramCounter := 0
@@ -138,6 +262,14 @@ func (l *ListFilters) BuildSQLFiltering() (string, []interface{}) {
 		params = append(params, l.OwnerID)
 	}
 
+	if len(l.TodoID) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`todo_id = $%d`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, l.TodoID)
+	}
+
 	var args string
 	if len(fields) == 0 {
 		args = `TRUE`
@@ -149,31 +281,89 @@ func (l *ListFilters) BuildSQLFiltering() (string, []interface{}) {
 	return filteringClause, params
 }
 
-type UserFilters struct {
+type CommentFilters struct {
 	PaginationFilters
-	ListID string
+	TodoID   string
+	AuthorID string
 }
 
-func (u *UserFilters) GetFilters() map[string]string {
+func (c *CommentFilters) GetFilters() map[string]string {
 	return map[string]string{
-		constants.FIRST:  u.First,
-		constants.LAST:   u.Last,
-		constants.AFTER:  u.After,
-		constants.BEFORE: u.Before,
+		constants.FIRST:  c.First,
+		constants.LAST:   c.Last,
+		constants.AFTER:  c.After,
+		constants.BEFORE: c.Before,
 	}
 }
 
-func (u *UserFilters) BuildSQLFiltering() (string, []interface{}) {
+func (c *CommentFilters) BuildSQLFiltering() (string, []interface{}) {
 	fields := make([]string, 0)
 	params := make([]interface{}, 0)
 	paramCounter := 0
 
-	if len(u.ListID) != 0 {
+	if len(c.TodoID) != 0 {
 		paramCounter++
-		elem := fmt.Sprintf(`list_id = $%d`, paramCounter)
+		elem := fmt.Sprintf(`todo_id = $%d`, paramCounter)
 		fields = append(fields, elem)
 
-		params = append(params, u.ListID)
+		params = append(params, c.TodoID)
+	}
+
+	if len(c.AuthorID) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`author = $%d`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, c.AuthorID)
+	}
+
+	var args string
+	if len(fields) == 0 {
+		args = `TRUE`
+	} else {
+		args = strings.Join(fields, " AND ")
+	}
+
+	filteringClause := fmt.Sprintf(` WHERE %s`, args)
+	return filteringClause, params
+}
+
+// HistoryFilters always order the history chronologically
+type HistoryFilters struct {
+	PaginationFilters
+	EntityType string
+	EntityID   string
+}
+
+func (h *HistoryFilters) GetFilters() map[string]string {
+	return map[string]string{
+		constants.FIRST:  h.First,
+		constants.LAST:   h.Last,
+		constants.AFTER:  h.After,
+		constants.BEFORE: h.Before,
+		constants.SORT:   constants.CREATED_AT,
+	}
+}
+
+func (h *HistoryFilters) BuildSQLFiltering() (string, []interface{}) {
+	fields := make([]string, 0)
+	params := make([]interface{}, 0)
+	paramCounter := 0
+
+	if len(h.EntityType) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`entity_type = $%d`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, h.EntityType)
+	}
+
+	if len(h.EntityID) != 0 {
+		paramCounter++
+		elem := fmt.Sprintf(`entity_id = $%d`, paramCounter)
+		fields = append(fields, elem)
+
+		params = append(params, h.EntityID)
 	}
 
 	var args string
@@ -0,0 +1,53 @@
+package filters
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTodoFilters_BuildSQLFiltering(t *testing.T) {
+	labelFilter := `id IN (SELECT todo_labels.todo_id FROM todo_labels
+JOIN labels ON labels.id = todo_labels.label_id WHERE labels.name = `
+
+	tests := []struct {
+		testName       string
+		filters        *TodoFilters
+		expectedClause string
+		expectedParams []interface{}
+	}{
+		{
+			testName:       "Without filters only the todos in the trash are filtered out",
+			filters:        &TodoFilters{},
+			expectedClause: ` WHERE deleted_at IS NULL`,
+			expectedParams: []interface{}{},
+		},
+		{
+			testName:       "Filtering the todos by the name of their label",
+			filters:        &TodoFilters{Label: "urgent"},
+			expectedClause: ` WHERE deleted_at IS NULL AND ` + labelFilter + `$1)`,
+			expectedParams: []interface{}{"urgent"},
+		},
+		{
+			testName:       "Label filter takes the next param after the other filters",
+			filters:        &TodoFilters{Status: "open", ListID: "list id", Label: "urgent"},
+			expectedClause: ` WHERE deleted_at IS NULL AND status = $1 AND list_id = $2 AND ` + labelFilter + `$3)`,
+			expectedParams: []interface{}{"open", "list id", "urgent"},
+		},
+		{
+			testName: "Filters after the label filter take the params after it",
+			filters:  &TodoFilters{Label: "urgent", BlockedByTodoID: "blocker id"},
+			expectedClause: ` WHERE deleted_at IS NULL AND ` + labelFilter + `$1) AND ` +
+				`id IN (SELECT todo_id FROM todo_dependencies WHERE blocker_id = $2)`,
+			expectedParams: []interface{}{"urgent", "blocker id"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			clause, params := test.filters.BuildSQLFiltering()
+
+			require.Equal(t, test.expectedClause, clause)
+			require.Equal(t, test.expectedParams, params)
+		})
+	}
+}
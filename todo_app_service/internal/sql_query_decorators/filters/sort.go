@@ -0,0 +1,53 @@
+package filters
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"slices"
+	"strings"
+)
+
+var TodoSortFields = []string{constants.NAME, constants.CREATED_AT, constants.LAST_UPDATED, constants.DUE_DATE, constants.PRIORITY, constants.POSITION}
+var ListSortFields = []string{constants.NAME, constants.CREATED_AT, constants.LAST_UPDATED}
+
+// Sort is the order in which a page of records is returned, records with equal sort values are ordered by id
+type Sort struct {
+	Field     string
+	Direction string
+}
+
+func (s *Sort) IsDefault() bool {
+	return s.Field == constants.ID
+}
+
+func (s *Sort) IsDescending() bool {
+	return s.Direction == constants.DESC_ORDER
+}
+
+// ParseSort parses sort values in the form of field or field:direction, an empty sort value means sorting by id
+func ParseSort(sort string, allowedFields []string) (*Sort, error) {
+	if len(sort) == 0 {
+		return &Sort{Field: constants.ID, Direction: constants.ASC_ORDER}, nil
+	}
+
+	field, direction, _ := strings.Cut(sort, constants.SORT_DIRECTION_SEPARATOR)
+	if len(direction) == 0 {
+		direction = constants.ASC_ORDER
+	}
+	direction = strings.ToUpper(direction)
+
+	if !slices.Contains(allowedFields, field) || (direction != constants.ASC_ORDER && direction != constants.DESC_ORDER) {
+		return nil, application_errors.NewInvalidSortError(sort)
+	}
+
+	return &Sort{Field: field, Direction: direction}, nil
+}
+
+type sortedFilters interface {
+	GetFilters() map[string]string
+}
+
+// ExtractSort returns the sort requested through the filters, the fields are validated per resource by the handlers
+func ExtractSort(f sortedFilters) (*Sort, error) {
+	return ParseSort(f.GetFilters()[constants.SORT], TodoSortFields)
+}
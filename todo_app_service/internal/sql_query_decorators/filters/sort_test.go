@@ -0,0 +1,69 @@
+package filters
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		testName      string
+		sort          string
+		allowedFields []string
+		expectedSort  *Sort
+		expectedError error
+	}{
+		{
+			testName:      "Empty sort value means sorting by id in ascending order",
+			sort:          "",
+			allowedFields: TodoSortFields,
+			expectedSort:  &Sort{Field: constants.ID, Direction: constants.ASC_ORDER},
+		},
+		{
+			testName:      "Sort value without direction is sorted in ascending order",
+			sort:          constants.DUE_DATE,
+			allowedFields: TodoSortFields,
+			expectedSort:  &Sort{Field: constants.DUE_DATE, Direction: constants.ASC_ORDER},
+		},
+		{
+			testName:      "Direction of the sort value is case insensitive",
+			sort:          constants.NAME + constants.SORT_DIRECTION_SEPARATOR + "desc",
+			allowedFields: ListSortFields,
+			expectedSort:  &Sort{Field: constants.NAME, Direction: constants.DESC_ORDER},
+		},
+		{
+			testName:      "Rejecting field which is not allowed for the resource",
+			sort:          constants.PRIORITY,
+			allowedFields: ListSortFields,
+			expectedError: application_errors.NewInvalidSortError(constants.PRIORITY),
+		},
+		{
+			testName:      "Rejecting field which does not exist",
+			sort:          "owner",
+			allowedFields: TodoSortFields,
+			expectedError: application_errors.NewInvalidSortError("owner"),
+		},
+		{
+			testName:      "Rejecting invalid direction",
+			sort:          constants.NAME + constants.SORT_DIRECTION_SEPARATOR + "sideways",
+			allowedFields: TodoSortFields,
+			expectedError: application_errors.NewInvalidSortError(constants.NAME + constants.SORT_DIRECTION_SEPARATOR + "sideways"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			sort, err := ParseSort(test.sort, test.allowedFields)
+
+			if test.expectedError != nil {
+				require.EqualError(t, err, test.expectedError.Error())
+				require.Nil(t, sort)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedSort, sort)
+			}
+		})
+	}
+}
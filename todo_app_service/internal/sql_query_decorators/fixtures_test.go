@@ -1,37 +1,40 @@
 package sql_query_decorators
 
 import (
-	"fmt"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"encoding/base64"
 	"github.com/google/uuid"
 )
 
 var (
-	cursorValue                                       = uuid.New()
-	expectedCursorAdditionWhenInnerNotContainingWhere = fmt.Sprintf("base query WHERE id > '%s'", cursorValue)
-	expectedCursorAdditionWhenInnerContainsWhere      = fmt.Sprintf("base query WHERE AND id > '%s'", cursorValue)
+	cursorId         = uuid.New().String()
+	filteringParam   = "status value"
+	idSort           = &filters.Sort{Field: constants.ID, Direction: constants.ASC_ORDER}
+	nameSort         = &filters.Sort{Field: constants.NAME, Direction: constants.ASC_ORDER}
+	createdAtSort    = &filters.Sort{Field: constants.CREATED_AT, Direction: constants.ASC_ORDER}
+	dueDateSort      = &filters.Sort{Field: constants.DUE_DATE, Direction: constants.ASC_ORDER}
+	positionSort     = &filters.Sort{Field: constants.POSITION, Direction: constants.ASC_ORDER}
+	prioritySort     = &filters.Sort{Field: constants.PRIORITY, Direction: constants.ASC_ORDER}
+	idCursor         = pagination.NewCursor("", cursorId)
+	nameCursor       = pagination.NewCursor(maliciousSqlValue, cursorId)
+	createdAtCursor  = pagination.NewCursor(createdAtValue, cursorId)
+	infinityCursor   = pagination.NewCursor(constants.INFINITY_TIMESTAMP, cursorId)
+	positionCursor   = pagination.NewCursor(positionValue, cursorId)
+	priorityCursor   = pagination.NewCursor(string(constants.High), cursorId)
+	maliciousId      = pagination.NewCursor("", maliciousSqlValue)
+	maliciousTime    = pagination.NewCursor(maliciousSqlValue, cursorId)
+	notBase64Encoded = maliciousSqlValue
+	notJsonEncoded   = base64.RawURLEncoding.EncodeToString([]byte(maliciousSqlValue))
 )
 
 const (
-	invalidLimitValue               = "invalid limit value"
-	limitValueString                = "3"
-	limitValue                      = 3
-	baseQueryString                 = "base query"
-	baseQueryStringWithWhere        = "base query WHERE"
-	limitQueryString                = baseQueryString + " LIMIT 3"
-	priorityCondition               = "priority"
-	statusCondition                 = "status"
-	priorityValue                   = "priority value"
-	statusValue                     = "status value"
-	decoratedPriorityQueryWithWhere = "base query WHERE priority = 'priority value'"
-	decoratedStatusQueryWithWhere   = "base query WHERE status = 'status value'"
-
-	decoratedPriorityQueryWithAnd = "base query WHERE AND priority = 'priority value'"
-	decoratedStatusQueryWithAnd   = "base query WHERE AND status = 'status value'"
+	maliciousSqlValue        = "' OR 1=1; DROP TABLE todos; --"
+	createdAtValue           = "2025-06-01T10:15:30.123456"
+	positionValue            = "2.5"
+	limitValue               = 3
+	baseQueryString          = "base query"
+	baseQueryStringWithWhere = "base query WHERE status = $1"
+	nameSortExpression       = "name"
 )
-
-func initStatusAndLimitDecorators(baseDecorator SqlQueryRetriever) (SqlQueryRetriever, SqlQueryRetriever) {
-	statusDecorator := newCriteriaDecorator(baseDecorator, "status", statusValue)
-	expectedOuter := newLimitDecorator(statusDecorator, limitValue)
-
-	return statusDecorator, expectedOuter
-}
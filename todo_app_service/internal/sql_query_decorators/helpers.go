@@ -1,6 +1,9 @@
 package sql_query_decorators
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 func determineAddition(baseQuery string) string {
 	var addition string
@@ -24,3 +27,10 @@ func determineUserListsAddition(query string) string {
 
 	return addition
 }
+
+// bindParam appends the value to the params and returns the placeholder referring to it
+func bindParam(params []interface{}, value interface{}) ([]interface{}, string) {
+	params = append(params, value)
+
+	return params, fmt.Sprintf("$%d", len(params))
+}
@@ -15,13 +15,14 @@ func NewLimitDecorator(retriever SqlQueryRetriever, limit int) *limitDecorator {
 	return &limitDecorator{retriever: retriever, limit: limit}
 }
 
-func (l *limitDecorator) DetermineCorrectSqlQuery(ctx context.Context) string {
+func (l *limitDecorator) DetermineCorrectSqlQuery(ctx context.Context) (string, []interface{}) {
 	log.C(ctx).Infof("getting todos in limit retriever")
 
-	currentQuery := l.retriever.DetermineCorrectSqlQuery(ctx)
+	currentQuery, params := l.retriever.DetermineCorrectSqlQuery(ctx)
 
-	formattedSuffix := fmt.Sprintf(" LIMIT %d", l.limit)
+	params, limitPlaceholder := bindParam(params, l.limit)
+	formattedSuffix := fmt.Sprintf(" LIMIT %s", limitPlaceholder)
 
 	currentQuery += formattedSuffix
-	return currentQuery
+	return currentQuery, params
 }
@@ -13,16 +13,29 @@ func TestLimitDecorator_DetermineCorrectSqlQuery(t *testing.T) {
 		testName                string
 		mockInnerDecorator      func() *mocks.SqlQueryRetriever
 		expectedDecoratedString string
+		expectedParams          []interface{}
 	}{
 		{
 			testName: "Returns correctly decorated query with limit",
 			mockInnerDecorator: func() *mocks.SqlQueryRetriever {
 				mock := &mocks.SqlQueryRetriever{}
-				mock.EXPECT().DetermineCorrectSqlQuery(context.TODO()).Return(baseQueryString).Once()
+				mock.EXPECT().DetermineCorrectSqlQuery(context.TODO()).Return(baseQueryString, []interface{}{}).Once()
 
 				return mock
 			},
-			expectedDecoratedString: limitQueryString,
+			expectedDecoratedString: baseQueryString + " LIMIT $1",
+			expectedParams:          []interface{}{limitValue},
+		},
+		{
+			testName: "Returns limit placeholder numbered after the inner params",
+			mockInnerDecorator: func() *mocks.SqlQueryRetriever {
+				mock := &mocks.SqlQueryRetriever{}
+				mock.EXPECT().DetermineCorrectSqlQuery(context.TODO()).Return(baseQueryStringWithWhere, []interface{}{filteringParam}).Once()
+
+				return mock
+			},
+			expectedDecoratedString: baseQueryStringWithWhere + " LIMIT $2",
+			expectedParams:          []interface{}{filteringParam, limitValue},
 		},
 	}
 
@@ -33,10 +46,11 @@ func TestLimitDecorator_DetermineCorrectSqlQuery(t *testing.T) {
 				mockDecorator = test.mockInnerDecorator()
 			}
 
-			lDecorator := newLimitDecorator(mockDecorator, limitValue)
-			limitDecoratedQuery := lDecorator.DetermineCorrectSqlQuery(context.TODO())
+			lDecorator := NewLimitDecorator(mockDecorator, limitValue)
+			limitDecoratedQuery, params := lDecorator.DetermineCorrectSqlQuery(context.TODO())
 
 			require.Equal(t, test.expectedDecoratedString, limitDecoratedQuery)
+			require.Equal(t, test.expectedParams, params)
 			mock.AssertExpectationsForObjects(t, mockDecorator)
 		})
 	}
@@ -1,6 +1,7 @@
 package sql_query_decorators
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
 	"context"
 	"fmt"
@@ -8,23 +9,26 @@ import (
 
 type orderByDecorator struct {
 	inner           SqlQueryRetriever
+	sort            *filters.Sort
 	sortingCriteria string
 }
 
-func NewOrderByDecorator(inner SqlQueryRetriever, sortingCriteria string) *orderByDecorator {
+func NewOrderByDecorator(inner SqlQueryRetriever, sort *filters.Sort, sortingCriteria string) *orderByDecorator {
 	return &orderByDecorator{
 		inner:           inner,
+		sort:            sort,
 		sortingCriteria: sortingCriteria,
 	}
 }
 
-func (o *orderByDecorator) DetermineCorrectSqlQuery(ctx context.Context) string {
-	log.C(ctx).Info("determining correct sql query in expired decorator")
+// the order by clause never binds params, both the sort expression and the direction come from a whitelist
+func (o *orderByDecorator) DetermineCorrectSqlQuery(ctx context.Context) (string, []interface{}) {
+	log.C(ctx).Info("determining correct sql query in order by decorator")
 
-	currentQuery := o.inner.DetermineCorrectSqlQuery(ctx)
+	currentQuery, params := o.inner.DetermineCorrectSqlQuery(ctx)
 
-	formattedSuffix := fmt.Sprintf(" ORDER BY id %s ", o.sortingCriteria)
+	formattedSuffix := fmt.Sprintf(" %s ", OrderByClause(o.sort, o.sortingCriteria))
 
 	currentQuery += formattedSuffix
-	return currentQuery
+	return currentQuery, params
 }
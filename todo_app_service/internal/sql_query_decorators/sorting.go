@@ -0,0 +1,91 @@
+package sql_query_decorators
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"fmt"
+	"github.com/google/uuid"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// todos without due date are treated as due in the infinite future so that the keyset comparison never meets a NULL
+var sortExpressions = map[string]string{
+	constants.ID:           "id",
+	constants.NAME:         "name",
+	constants.CREATED_AT:   "created_at",
+	constants.LAST_UPDATED: "last_updated",
+	constants.DUE_DATE:     "COALESCE(due_date, 'infinity'::timestamp)",
+	constants.PRIORITY:     "priority",
+	constants.POSITION:     "position",
+}
+
+var priorities = []constants.Priority{constants.VeryLow, constants.Low, constants.Medium, constants.High, constants.VeryHigh}
+
+func SortExpression(field string) string {
+	return sortExpressions[field]
+}
+
+// OrderByClause orders by the sort expression and uses the id as a tiebreaker so the order is always stable
+func OrderByClause(sort *filters.Sort, direction string) string {
+	if sort.IsDefault() {
+		return fmt.Sprintf("ORDER BY id %s", direction)
+	}
+
+	return fmt.Sprintf("ORDER BY %s %s, id %s", SortExpression(sort.Field), direction, direction)
+}
+
+func ReverseDirection(direction string) string {
+	if direction == constants.DESC_ORDER {
+		return constants.ASC_ORDER
+	}
+
+	return constants.DESC_ORDER
+}
+
+// ParseCursor decodes the opaque cursor and makes sure it carries a valid id and a sort value exactly
+// when the records are not sorted by id
+func ParseCursor(cursor string, sort *filters.Sort) (*pagination.Cursor, error) {
+	parsedCursor, err := pagination.ParseCursor(cursor)
+	if err != nil {
+		return nil, application_errors.NewInvalidCursorError(cursor)
+	}
+
+	if parsedCursor.HasSortValue() == sort.IsDefault() {
+		return nil, application_errors.NewInvalidCursorError(cursor)
+	}
+
+	if _, err = uuid.Parse(parsedCursor.Id); err != nil {
+		return nil, application_errors.NewInvalidCursorError(cursor)
+	}
+
+	if !isValidSortValue(sort.Field, parsedCursor.SortValue) {
+		return nil, application_errors.NewInvalidCursorError(cursor)
+	}
+
+	return parsedCursor, nil
+}
+
+func isValidSortValue(field string, value string) bool {
+	switch field {
+	case constants.CREATED_AT, constants.LAST_UPDATED:
+		_, err := time.Parse(constants.CURSOR_TIME_LAYOUT, value)
+		return err == nil
+	case constants.DUE_DATE:
+		if value == constants.INFINITY_TIMESTAMP {
+			return true
+		}
+		_, err := time.Parse(constants.CURSOR_TIME_LAYOUT, value)
+		return err == nil
+	case constants.POSITION:
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case constants.PRIORITY:
+		return slices.Contains(priorities, constants.Priority(value))
+	default:
+		return true
+	}
+}
@@ -0,0 +1,158 @@
+package sql_query_decorators
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseCursor(t *testing.T) {
+	tests := []struct {
+		testName       string
+		cursor         string
+		sort           *filters.Sort
+		expectedCursor *pagination.Cursor
+		expectedError  error
+	}{
+		{
+			testName:       "Successfully parsing cursor of records sorted by id",
+			cursor:         idCursor.String(),
+			sort:           idSort,
+			expectedCursor: idCursor,
+		},
+		{
+			testName:       "Successfully parsing cursor of records sorted by creation time",
+			cursor:         createdAtCursor.String(),
+			sort:           createdAtSort,
+			expectedCursor: createdAtCursor,
+		},
+		{
+			testName:       "Successfully parsing cursor of todos without due date",
+			cursor:         infinityCursor.String(),
+			sort:           dueDateSort,
+			expectedCursor: infinityCursor,
+		},
+		{
+			testName:       "Successfully parsing cursor of todos sorted by position",
+			cursor:         positionCursor.String(),
+			sort:           positionSort,
+			expectedCursor: positionCursor,
+		},
+		{
+			testName:       "Successfully parsing cursor of todos sorted by priority",
+			cursor:         priorityCursor.String(),
+			sort:           prioritySort,
+			expectedCursor: priorityCursor,
+		},
+		{
+			testName:       "Name cursors may hold any value since it is bound as a param",
+			cursor:         nameCursor.String(),
+			sort:           nameSort,
+			expectedCursor: nameCursor,
+		},
+		{
+			testName:      "Rejecting raw sql passed as a cursor",
+			cursor:        notBase64Encoded,
+			sort:          idSort,
+			expectedError: application_errors.NewInvalidCursorError(notBase64Encoded),
+		},
+		{
+			testName:      "Rejecting base64 encoded raw sql passed as a cursor",
+			cursor:        notJsonEncoded,
+			sort:          idSort,
+			expectedError: application_errors.NewInvalidCursorError(notJsonEncoded),
+		},
+		{
+			testName:      "Rejecting cursor with an id that is not a uuid",
+			cursor:        maliciousId.String(),
+			sort:          idSort,
+			expectedError: application_errors.NewInvalidCursorError(maliciousId.String()),
+		},
+		{
+			testName:      "Rejecting cursor with a sort value that is not a timestamp",
+			cursor:        maliciousTime.String(),
+			sort:          createdAtSort,
+			expectedError: application_errors.NewInvalidCursorError(maliciousTime.String()),
+		},
+		{
+			testName:      "Rejecting cursor with a sort value that is not a position",
+			cursor:        maliciousTime.String(),
+			sort:          positionSort,
+			expectedError: application_errors.NewInvalidCursorError(maliciousTime.String()),
+		},
+		{
+			testName:      "Rejecting cursor with a sort value that is not a priority",
+			cursor:        maliciousTime.String(),
+			sort:          prioritySort,
+			expectedError: application_errors.NewInvalidCursorError(maliciousTime.String()),
+		},
+		{
+			testName:      "Rejecting cursor without sort value when records are sorted by another field",
+			cursor:        idCursor.String(),
+			sort:          nameSort,
+			expectedError: application_errors.NewInvalidCursorError(idCursor.String()),
+		},
+		{
+			testName:      "Rejecting cursor with sort value when records are sorted by id",
+			cursor:        nameCursor.String(),
+			sort:          idSort,
+			expectedError: application_errors.NewInvalidCursorError(nameCursor.String()),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			parsedCursor, err := ParseCursor(test.cursor, test.sort)
+
+			if test.expectedError != nil {
+				require.EqualError(t, err, test.expectedError.Error())
+				require.Nil(t, parsedCursor)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedCursor, parsedCursor)
+			}
+		})
+	}
+}
+
+func TestOrderByClause(t *testing.T) {
+	tests := []struct {
+		testName       string
+		sort           *filters.Sort
+		direction      string
+		expectedClause string
+	}{
+		{
+			testName:       "Records sorted by id are ordered by the id only",
+			sort:           idSort,
+			direction:      constants.ASC_ORDER,
+			expectedClause: "ORDER BY id ASC",
+		},
+		{
+			testName:       "Records sorted by another field use the id as a tiebreaker",
+			sort:           nameSort,
+			direction:      constants.DESC_ORDER,
+			expectedClause: "ORDER BY name DESC, id DESC",
+		},
+		{
+			testName:       "Todos without due date are ordered as due in the infinite future",
+			sort:           dueDateSort,
+			direction:      constants.ASC_ORDER,
+			expectedClause: "ORDER BY COALESCE(due_date, 'infinity'::timestamp) ASC, id ASC",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			require.Equal(t, test.expectedClause, OrderByClause(test.sort, test.direction))
+		})
+	}
+}
+
+func TestReverseDirection(t *testing.T) {
+	require.Equal(t, constants.DESC_ORDER, ReverseDirection(constants.ASC_ORDER))
+	require.Equal(t, constants.ASC_ORDER, ReverseDirection(constants.DESC_ORDER))
+}
@@ -2,6 +2,7 @@ package sql_decorators_creators
 
 import (
 	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
 	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"context"
@@ -13,12 +14,29 @@ func init() {
 
 type beforeCursorDecoratorCreator struct{}
 
-func (*beforeCursorDecoratorCreator) Create(ctx context.Context, inner sql_query_decorators.SqlQueryRetriever, filters sql_query_decorators.Filters) (sql_query_decorators.SqlQueryRetriever, error) {
+func (*beforeCursorDecoratorCreator) Create(ctx context.Context, inner sql_query_decorators.SqlQueryRetriever, f sql_query_decorators.Filters) (sql_query_decorators.SqlQueryRetriever, error) {
 	log.C(ctx).Info("creating before cursor decorator in before cursor decorator creator")
 
-	cursor, contains := filters.GetFilters()[constants.BEFORE]
+	cursor, contains := f.GetFilters()[constants.BEFORE]
 	if contains && len(cursor) != 0 {
-		inner = sql_query_decorators.NewCursorDecorator(inner, cursor, "<")
+		sort, err := filters.ExtractSort(f)
+		if err != nil {
+			log.C(ctx).Errorf("failed to create before cursor decorator, error %s when trying to parse sort", err.Error())
+			return nil, err
+		}
+
+		parsedCursor, err := sql_query_decorators.ParseCursor(cursor, sort)
+		if err != nil {
+			log.C(ctx).Errorf("failed to create before cursor decorator, error %s when trying to parse cursor", err.Error())
+			return nil, err
+		}
+
+		operator := "<"
+		if sort.IsDescending() {
+			operator = ">"
+		}
+
+		inner = sql_query_decorators.NewCursorDecorator(inner, sql_query_decorators.SortExpression(sort.Field), parsedCursor, operator)
 	}
 
 	return inner, nil
@@ -2,6 +2,7 @@ package sql_decorators_creators
 
 import (
 	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators"
+	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
 	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"context"
@@ -14,13 +15,19 @@ func init() {
 type orderByDecoratorCreator struct{}
 
 func (o *orderByDecoratorCreator) Create(ctx context.Context, inner sql_query_decorators.SqlQueryRetriever, f sql_query_decorators.Filters) (sql_query_decorators.SqlQueryRetriever, error) {
-	log.C(ctx).Info("creating overdue decorator in overdue decorator creator")
+	log.C(ctx).Info("creating order by decorator in order by decorator creator")
+
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create order by decorator, error %s when trying to parse sort", err.Error())
+		return nil, err
+	}
 
 	last, containsLast := f.GetFilters()[constants.LAST]
 	if containsLast && len(last) != 0 {
-		inner = sql_query_decorators.NewOrderByDecorator(inner, constants.DESC_ORDER)
+		inner = sql_query_decorators.NewOrderByDecorator(inner, sort, sql_query_decorators.ReverseDirection(sort.Direction))
 	} else {
-		inner = sql_query_decorators.NewOrderByDecorator(inner, constants.ASC_ORDER)
+		inner = sql_query_decorators.NewOrderByDecorator(inner, sort, sort.Direction)
 	}
 
 	return inner, nil
@@ -0,0 +1,288 @@
+package templates
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type templateService interface {
+	CreateTemplateRecord(ctx context.Context, listId string, template *handler_models.CreateTemplate, owner *models.User) (*models.Template, error)
+	GetTemplateRecord(ctx context.Context, templateId string) (*models.Template, error)
+	GetUserTemplatesRecords(ctx context.Context, userId string) ([]*models.Template, error)
+	DeleteTemplateRecord(ctx context.Context, templateId string) error
+	CreateListFromTemplateRecord(ctx context.Context, templateId string, input *handler_models.CreateListFromTemplate, owner *models.User) (*models.List, error)
+}
+
+type fieldValidator interface {
+	Struct(interface{}) error
+}
+
+type Handler struct {
+	serv       templateService
+	fValidator fieldValidator
+	transact   persistence.Transactioner
+}
+
+func NewHandler(service templateService, fValidator fieldValidator, transact persistence.Transactioner) *Handler {
+	return &Handler{
+		serv:       service,
+		fValidator: fValidator,
+		transact:   transact,
+	}
+}
+
+func (h *Handler) HandleCreateTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("creating template in template handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in template handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Error("failed to get list_id from the context in template handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	owner, err := utils.GetValueFromContext[*models.User](r.Context(), middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get template owner due to an error %s when trying to get value from context in template handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	var template handler_models.CreateTemplate
+	if err = json.NewDecoder(r.Body).Decode(&template); err != nil {
+		log.C(ctx).Errorf("failed to decode template handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, template)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create template, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	createdTemplate, err := h.serv.CreateTemplateRecord(ctx, listId, &template, owner)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create template from list with id %s, error %s when calling template service", listId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to create template, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(createdTemplate); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		return
+	}
+}
+
+func (h *Handler) HandleGetTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting template in template handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in template handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	templateId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TemplateId)
+	if err != nil {
+		log.C(ctx).Error("failed to get template_id from the context in template handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TEMPLATE_ID, http.StatusBadRequest)
+		return
+	}
+
+	template, err := h.serv.GetTemplateRecord(ctx, templateId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get template with id %s, error %s when calling template service", templateId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(template); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get template with id %s, error %s", templateId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleGetUserTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting user templates in template handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in template handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	userId, err := utils.GetValueFromContext[string](r.Context(), middlewares.UserId)
+	if err != nil {
+		log.C(ctx).Error("failed to get user_id from the context in template handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER_ID, http.StatusBadRequest)
+		return
+	}
+
+	templates, err := h.serv.GetUserTemplatesRecords(ctx, userId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get templates of user with id %s, error %s when calling template service", userId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(templates); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get templates of user with id %s, error %s", userId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleDeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("deleting template in template handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in template handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	templateId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TemplateId)
+	if err != nil {
+		log.C(ctx).Error("failed to get template_id from the context in template handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TEMPLATE_ID, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.serv.DeleteTemplateRecord(ctx, templateId); err != nil {
+		log.C(ctx).Errorf("failed to delete template with id %s, error %s when calling template service", templateId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to delete template with id %s, error %s", templateId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) HandleCreateListFromTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("creating list from template in template handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in template handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	templateId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TemplateId)
+	if err != nil {
+		log.C(ctx).Error("failed to get template_id from the context in template handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TEMPLATE_ID, http.StatusBadRequest)
+		return
+	}
+
+	owner, err := utils.GetValueFromContext[*models.User](r.Context(), middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get list owner due to an error %s when trying to get value from context in template handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	var input handler_models.CreateListFromTemplate
+	if err = json.NewDecoder(r.Body).Decode(&input); err != nil {
+		log.C(ctx).Errorf("failed to decode create list from template handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, input)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create list from template, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	list, err := h.serv.CreateListFromTemplateRecord(ctx, templateId, &input, owner)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create list from template with id %s, error %s when calling template service", templateId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to create list from template with id %s, error %s", templateId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(list); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		return
+	}
+}
@@ -0,0 +1,141 @@
+package templates
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
+	"database/sql"
+	"errors"
+)
+
+type repository struct{}
+
+func NewRepo() *repository {
+	return &repository{}
+}
+
+func (*repository) GetTemplate(ctx context.Context, templateId string) (*entities.Template, error) {
+	log.C(ctx).Infof("getting template with id %s from template repository", templateId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	entity := &entities.Template{}
+	if err = persist.GetContext(ctx, entity, getTemplateQuery, templateId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("failed to get template with id %s due to sqlErrNoRows", templateId)
+			return nil, application_errors.NewNotFoundError(constants.TEMPLATE_TARGET, templateId)
+		}
+
+		log.C(ctx).Errorf("failed to get template with id %s because of a database error %s", templateId, err.Error())
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (*repository) GetUserTemplates(ctx context.Context, userId string) ([]entities.Template, error) {
+	log.C(ctx).Infof("getting templates of user with id %s from template repository", userId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var templates []entities.Template
+	if err = persist.SelectContext(ctx, &templates, getUserTemplatesQuery, userId); err != nil {
+		log.C(ctx).Errorf("failed to get templates due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// GetTemplateTodos returns the todos of the template, the parents come before their subtasks
+func (*repository) GetTemplateTodos(ctx context.Context, templateId string) ([]entities.TemplateTodo, error) {
+	log.C(ctx).Infof("getting todos of template with id %s from template repository", templateId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var todos []entities.TemplateTodo
+	if err = persist.SelectContext(ctx, &todos, getTemplateTodosQuery, templateId); err != nil {
+		log.C(ctx).Errorf("failed to get template todos due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+func (r *repository) CreateTemplate(ctx context.Context, entity *entities.Template) (*entities.Template, error) {
+	log.C(ctx).Infof("creating template %s in template repository", entity.Name)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	if _, err = persist.NamedExecContext(ctx, createTemplateQuery, entity); err != nil {
+		log.C(ctx).Errorf("failed to create template due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return r.GetTemplate(ctx, entity.Id.String())
+}
+
+func (*repository) CreateTemplateTodo(ctx context.Context, entity *entities.TemplateTodo) error {
+	log.C(ctx).Infof("creating todo %s of template with id %s in template repository", entity.Name, entity.TemplateId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.NamedExecContext(ctx, createTemplateTodoQuery, entity); err != nil {
+		log.C(ctx).Errorf("failed to create template todo due to a failure in the execution of the sql query %s", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (*repository) DeleteTemplate(ctx context.Context, templateId string) error {
+	log.C(ctx).Infof("deleting template with id %s in template repository", templateId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	res, err := persist.ExecContext(ctx, deleteTemplateQuery, templateId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to delete template with id %s due to a database error %s", templateId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to delete template, error when trying to get the number of rows affected")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to delete template, there is no template with id %s", templateId)
+		return application_errors.NewNotFoundError(constants.TEMPLATE_TARGET, templateId)
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package templates
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"database/sql"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type templateRepo interface {
+	GetTemplate(ctx context.Context, templateId string) (*entities.Template, error)
+	GetUserTemplates(ctx context.Context, userId string) ([]entities.Template, error)
+	GetTemplateTodos(ctx context.Context, templateId string) ([]entities.TemplateTodo, error)
+	CreateTemplate(ctx context.Context, entity *entities.Template) (*entities.Template, error)
+	CreateTemplateTodo(ctx context.Context, entity *entities.TemplateTodo) error
+	DeleteTemplate(ctx context.Context, templateId string) error
+}
+
+type listRepo interface {
+	GetList(ctx context.Context, listId string) (*entities.List, error)
+}
+
+type todoRepo interface {
+	GetListTodosParentsFirst(ctx context.Context, listId string) ([]entities.Todo, error)
+	CreateTodo(ctx context.Context, entity *entities.Todo) (*entities.Todo, error)
+}
+
+type listService interface {
+	CreateListRecord(ctx context.Context, list *handler_models.CreateList, ownerId string) (*models.List, error)
+}
+
+type templateConverter interface {
+	ToModel(template *entities.Template, todos []entities.TemplateTodo) *models.Template
+	ManyToModel(templates []entities.Template) []*models.Template
+}
+
+type uuidGenerator interface {
+	Generate() string
+}
+
+type timeGenerator interface {
+	Now() time.Time
+}
+
+type service struct {
+	tmplRepo  templateRepo
+	lRepo     listRepo
+	tRepo     todoRepo
+	lService  listService
+	converter templateConverter
+	uuidGen   uuidGenerator
+	timeGen   timeGenerator
+}
+
+func NewService(tmplRepo templateRepo, lRepo listRepo, tRepo todoRepo, lService listService, converter templateConverter,
+	uuidGen uuidGenerator, timeGen timeGenerator) *service {
+	return &service{
+		tmplRepo:  tmplRepo,
+		lRepo:     lRepo,
+		tRepo:     tRepo,
+		lService:  lService,
+		converter: converter,
+		uuidGen:   uuidGen,
+		timeGen:   timeGen,
+	}
+}
+
+// CreateTemplateRecord saves the list as a template of the caller, the due dates of the todos are kept
+// as their distance from the creation of the list, the assignees are not part of the template
+func (s *service) CreateTemplateRecord(ctx context.Context, listId string, template *handler_models.CreateTemplate, owner *models.User) (*models.Template, error) {
+	log.C(ctx).Infof("saving list with id %s as a template in template service", listId)
+
+	list, err := s.lRepo.GetList(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create template, error %s when getting list with id %s", err.Error(), listId)
+		return nil, err
+	}
+
+	listTodos, err := s.tRepo.GetListTodosParentsFirst(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create template, error %s when getting the todos of list with id %s", err.Error(), listId)
+		return nil, err
+	}
+
+	templateEntity := &entities.Template{
+		Id:          uuid.FromStringOrNil(s.uuidGen.Generate()),
+		Name:        list.Name,
+		Description: list.Description,
+		Owner:       uuid.FromStringOrNil(owner.Id),
+		CreatedAt:   s.timeGen.Now(),
+	}
+
+	if template.Name != nil {
+		templateEntity.Name = *template.Name
+	}
+
+	if template.Description != nil {
+		templateEntity.Description = *template.Description
+	}
+
+	createdTemplate, err := s.tmplRepo.CreateTemplate(ctx, templateEntity)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create template, error %s when calling template repo", err.Error())
+		return nil, err
+	}
+
+	templateTodoIds := make(map[uuid.UUID]uuid.UUID, len(listTodos))
+	templateTodos := make([]entities.TemplateTodo, 0, len(listTodos))
+	for position, todo := range listTodos {
+		templateTodo := entities.TemplateTodo{
+			Id:          uuid.FromStringOrNil(s.uuidGen.Generate()),
+			TemplateId:  createdTemplate.Id,
+			Name:        todo.Name,
+			Description: todo.Description,
+			Priority:    todo.Priority,
+			Position:    position,
+		}
+
+		if parentId, ok := templateTodoIds[todo.ParentId.UUID]; ok && todo.ParentId.Valid {
+			templateTodo.ParentId = uuid.NullUUID{UUID: parentId, Valid: true}
+		}
+
+		if todo.DueDate.Valid {
+			if offset := int64(todo.DueDate.Time.Sub(list.CreatedAt).Seconds()); offset > 0 {
+				templateTodo.DueOffset = sql.NullInt64{Int64: offset, Valid: true}
+				templateTodo.RecurrenceFrequency = todo.RecurrenceFrequency
+				templateTodo.RecurrenceInterval = todo.RecurrenceInterval
+				templateTodo.RecurrenceCount = todo.RecurrenceCount
+			}
+		}
+
+		if err = s.tmplRepo.CreateTemplateTodo(ctx, &templateTodo); err != nil {
+			log.C(ctx).Errorf("failed to create template, error %s when saving todo with id %s", err.Error(), todo.Id)
+			return nil, err
+		}
+
+		templateTodoIds[todo.Id] = templateTodo.Id
+		templateTodos = append(templateTodos, templateTodo)
+	}
+
+	return s.converter.ToModel(createdTemplate, templateTodos), nil
+}
+
+func (s *service) GetTemplateRecord(ctx context.Context, templateId string) (*models.Template, error) {
+	log.C(ctx).Infof("getting template with id %s in template service", templateId)
+
+	template, err := s.tmplRepo.GetTemplate(ctx, templateId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get template with id %s, error %s when calling template repo", templateId, err.Error())
+		return nil, err
+	}
+
+	todos, err := s.tmplRepo.GetTemplateTodos(ctx, templateId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get todos of template with id %s, error %s when calling template repo", templateId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(template, todos), nil
+}
+
+func (s *service) GetUserTemplatesRecords(ctx context.Context, userId string) ([]*models.Template, error) {
+	log.C(ctx).Infof("getting templates of user with id %s in template service", userId)
+
+	templates, err := s.tmplRepo.GetUserTemplates(ctx, userId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get templates of user with id %s, error %s when calling template repo", userId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ManyToModel(templates), nil
+}
+
+func (s *service) DeleteTemplateRecord(ctx context.Context, templateId string) error {
+	log.C(ctx).Infof("deleting template with id %s in template service", templateId)
+
+	if err := s.tmplRepo.DeleteTemplate(ctx, templateId); err != nil {
+		log.C(ctx).Errorf("failed to delete template with id %s, error %s when calling template repo", templateId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// CreateListFromTemplateRecord creates a list owned by the caller with the todos of the template, the due dates are counted
+// from the start date, a due date which would not be after the creation of the list is dropped together with the recurrence
+func (s *service) CreateListFromTemplateRecord(ctx context.Context, templateId string, input *handler_models.CreateListFromTemplate, owner *models.User) (*models.List, error) {
+	log.C(ctx).Infof("creating list from template with id %s in template service", templateId)
+
+	template, err := s.tmplRepo.GetTemplate(ctx, templateId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create list from template, error %s when getting template with id %s", err.Error(), templateId)
+		return nil, err
+	}
+
+	templateTodos, err := s.tmplRepo.GetTemplateTodos(ctx, templateId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create list from template, error %s when getting todos of template with id %s", err.Error(), templateId)
+		return nil, err
+	}
+
+	description := template.Description
+	if input.Description != nil {
+		description = *input.Description
+	}
+
+	list, err := s.lService.CreateListRecord(ctx, &handler_models.CreateList{Name: input.Name, Description: description}, owner.Id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create list from template with id %s, error %s when calling list service", templateId, err.Error())
+		return nil, err
+	}
+
+	startDate := list.CreatedAt
+	if input.StartDate != nil {
+		startDate = *input.StartDate
+	}
+
+	createdTodoIds := make(map[uuid.UUID]uuid.UUID, len(templateTodos))
+	for _, templateTodo := range templateTodos {
+		todo := &entities.Todo{
+			Id:          uuid.FromStringOrNil(s.uuidGen.Generate()),
+			Name:        templateTodo.Name,
+			Description: templateTodo.Description,
+			ListId:      uuid.FromStringOrNil(list.Id),
+			CreatedAt:   list.CreatedAt,
+			LastUpdated: list.CreatedAt,
+			Priority:    templateTodo.Priority,
+		}
+
+		if parentId, ok := createdTodoIds[templateTodo.ParentId.UUID]; ok && templateTodo.ParentId.Valid {
+			todo.ParentId = uuid.NullUUID{UUID: parentId, Valid: true}
+		}
+
+		if templateTodo.DueOffset.Valid {
+			dueDate := startDate.Add(time.Duration(templateTodo.DueOffset.Int64) * time.Second)
+			if dueDate.After(list.CreatedAt) {
+				todo.DueDate = sql.NullTime{Time: dueDate, Valid: true}
+				todo.RecurrenceFrequency = templateTodo.RecurrenceFrequency
+				todo.RecurrenceInterval = templateTodo.RecurrenceInterval
+				todo.RecurrenceCount = templateTodo.RecurrenceCount
+			}
+		}
+
+		if _, err = s.tRepo.CreateTodo(ctx, todo); err != nil {
+			log.C(ctx).Errorf("failed to create list from template with id %s, error %s when calling todo repo", templateId, err.Error())
+			return nil, err
+		}
+
+		createdTodoIds[templateTodo.Id] = todo.Id
+	}
+
+	return list, nil
+}
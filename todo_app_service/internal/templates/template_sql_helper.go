@@ -0,0 +1,21 @@
+package templates
+
+const templateColumns = `id, name, description, owner, created_at`
+
+const templateTodoColumns = `id, template_id, name, description, priority, due_offset, parent_id, position,
+recurrence_frequency, recurrence_interval, recurrence_count`
+
+var getTemplateQuery = `SELECT ` + templateColumns + ` FROM list_templates WHERE id = $1`
+
+var getUserTemplatesQuery = `SELECT ` + templateColumns + ` FROM list_templates WHERE owner = $1 ORDER BY created_at DESC, id`
+
+var getTemplateTodosQuery = `SELECT ` + templateTodoColumns + ` FROM template_todos WHERE template_id = $1 ORDER BY position`
+
+var createTemplateQuery = `INSERT INTO list_templates (` + templateColumns + `)
+VALUES (:id, :name, :description, :owner, :created_at)`
+
+var createTemplateTodoQuery = `INSERT INTO template_todos (` + templateTodoColumns + `)
+VALUES (:id, :template_id, :name, :description, :priority, :due_offset, :parent_id, :position,
+:recurrence_frequency, :recurrence_interval, :recurrence_count)`
+
+var deleteTemplateQuery = `DELETE FROM list_templates WHERE id = $1`
@@ -28,28 +28,49 @@ const (
 	veryHighPriority     = "very high"
 	veryLowPriority      = "very low"
 	mediumPriority       = "medium"
-	sqlQueryGetTodo      = `SELECT id, name, description, list_id, status,
-created_at, last_updated, assigned_to, due_date, priority FROM todos
-WHERE list_id = $1 AND id = $2`
-	sqlQueryGetTodos = `SELECT id, name, description, list_id, status, 
-       					created_at, last_updated, assigned_to, due_date, priority FROM todos
-       					WHERE list_id = $1`
-	sqlQueryGetTodosByStatus = `SELECT id, name, description, list_id, status, created_at, 
-       last_updated, assigned_to, due_date, priority FROM todos WHERE list_id = $1 AND status = $2`
-	sqlQueryGetTodosByPriority = `SELECT id, name, description, list_id, status, created_at, last_updated, 
-       assigned_to, due_date, priority FROM todos WHERE list_id = $1 AND priority = $2`
-	sqlQueryGetTodosByStatusAndPriority = `SELECT id, name, description, list_id, status, created_at, 
-       last_updated, assigned_to, due_date, priority FROM todos WHERE list_id = $1 AND status = $2 
-       AND priority = $3`
+	sqlQueryGetTodo      = `SELECT id, name, description, list_id, status, 
+       					created_at, last_updated, assigned_to, due_date, priority, parent_id,
+       					recurrence_frequency, recurrence_interval, recurrence_until, recurrence_count, recurrence_anchor_day, position, column_id, estimate FROM todos 
+       					WHERE id = $1 AND deleted_at IS NULL`
 	sqlQueryGetTodoAssignee = `SELECT users.id,users.email,users.role FROM users JOIN todos on users.id = todos.assigned_to
-						WHERE todos.id = $1`
-	sqlQueryCreateTodo = `INSERT INTO todos(id, name, description, 
-                  list_id, status, created_at, last_updated, assigned_to, due_date, priority) VALUES(?,?,?,
-                  ?,?,?,?,?,?,?)`
-	sqlQueryDeleteTodo = `DELETE FROM todos WHERE list_id = $1 AND id = $2`
-	sqlQueryUpdateTodo = `UPDATE todos SET (name,description,status,assigned_to,due_date,priority) = (?,?,?,
-                 	 	?,?,?) 
-             			WHERE id = ? AND list_id = ?`
+WHERE todos.id = $1 AND todos.deleted_at IS NULL`
+	sqlQueryDeleteTodo = `UPDATE todos SET deleted_at = NOW(), deleted_by = history_actor()
+WHERE id = $1 AND deleted_at IS NULL`
+	sqlQueryPlaceTodoAfter = `UPDATE todos SET last_updated = $3, position = COALESCE(
+    (anchor.position + (
+        SELECT MIN(next_todos.position) FROM todos AS next_todos
+        WHERE next_todos.list_id = anchor.list_id AND next_todos.position > anchor.position AND next_todos.id <> todos.id
+    )) * 0.5,
+    anchor.position + 1)
+FROM todos AS anchor
+WHERE todos.id = $1 AND anchor.id = $2 AND todos.deleted_at IS NULL`
+	sqlQueryPlaceTodoBefore = `UPDATE todos SET last_updated = $3, position = COALESCE(
+    (anchor.position + (
+        SELECT MAX(previous_todos.position) FROM todos AS previous_todos
+        WHERE previous_todos.list_id = anchor.list_id AND previous_todos.position < anchor.position AND previous_todos.id <> todos.id
+    )) * 0.5,
+    anchor.position - 1)
+FROM todos AS anchor
+WHERE todos.id = $1 AND anchor.id = $2 AND todos.deleted_at IS NULL`
+	sqlQueryIsTodoAssignee       = `SELECT EXISTS(SELECT 1 FROM todo_assignees WHERE todo_id = $1 AND user_id = $2)`
+	sqlQueryGetTodosParticipants = `SELECT assigned_to FROM todos WHERE id = ANY($1) AND assigned_to IS NOT NULL
+UNION
+SELECT user_id FROM todo_assignees WHERE todo_id = ANY($1)
+UNION
+SELECT user_id FROM todo_watchers WHERE todo_id = ANY($1)`
+	sqlQueryCountOpenSubtasks           = `SELECT COUNT(*) FROM todos WHERE parent_id = $1 AND status <> $2 AND deleted_at IS NULL`
+	sqlQueryDetachSubtasks              = `UPDATE todos SET parent_id = NULL WHERE parent_id = $1`
+	sqlQueryIsTodoTransitivelyBlockedBy = `WITH RECURSIVE blockers(id) AS (
+    SELECT blocker_id FROM todo_dependencies WHERE todo_id = $1
+    UNION
+    SELECT todo_dependencies.blocker_id FROM todo_dependencies
+    JOIN blockers ON todo_dependencies.todo_id = blockers.id
+)
+SELECT EXISTS(SELECT 1 FROM blockers WHERE id = $2)`
+	sqlQueryUnassignUserFromTodos = `UPDATE todos SET assigned_to = NULL 
+WHERE assigned_to = $1 AND list_id = $2`
+	sqlQueryRemoveUserFromAssignees = `DELETE FROM todo_assignees WHERE user_id = $1 AND todo_id IN (SELECT id FROM todos WHERE list_id = $2)`
+	sqlQueryRemoveUserFromWatchers  = `DELETE FROM todo_watchers WHERE user_id = $1 AND todo_id IN (SELECT id FROM todos WHERE list_id = $2)`
 )
 
 var (
@@ -104,3 +125,16 @@ func initUserEntity(id uuid.UUID, email string, role string) *entities2.User {
 		Role:  role,
 	}
 }
+
+func recurrenceDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 0, 0, 0, time.UTC)
+}
+
+func recurrenceDatePtr(year int, month time.Month, day int) *time.Time {
+	date := recurrenceDate(year, month, day)
+	return &date
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -1,81 +0,0 @@
-// Code generated by mockery v2.53.3. DO NOT EDIT.
-
-package mocks
-
-import (
-	time "time"
-
-	mock "github.com/stretchr/testify/mock"
-)
-
-// ITimeGenerator is an autogenerated mock type for the ITimeGenerator type
-type ITimeGenerator struct {
-	mock.Mock
-}
-
-type ITimeGenerator_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *ITimeGenerator) EXPECT() *ITimeGenerator_Expecter {
-	return &ITimeGenerator_Expecter{mock: &_m.Mock}
-}
-
-// Now provides a mock function with no fields
-func (_m *ITimeGenerator) Now() time.Time {
-	ret := _m.Called()
-
-	if len(ret) == 0 {
-		panic("no return value specified for Now")
-	}
-
-	var r0 time.Time
-	if rf, ok := ret.Get(0).(func() time.Time); ok {
-		r0 = rf()
-	} else {
-		r0 = ret.Get(0).(time.Time)
-	}
-
-	return r0
-}
-
-// ITimeGenerator_Now_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Now'
-type ITimeGenerator_Now_Call struct {
-	*mock.Call
-}
-
-// Now is a helper method to define mock.On call
-func (_e *ITimeGenerator_Expecter) Now() *ITimeGenerator_Now_Call {
-	return &ITimeGenerator_Now_Call{Call: _e.mock.On("Now")}
-}
-
-func (_c *ITimeGenerator_Now_Call) Run(run func()) *ITimeGenerator_Now_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
-	return _c
-}
-
-func (_c *ITimeGenerator_Now_Call) Return(_a0 time.Time) *ITimeGenerator_Now_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *ITimeGenerator_Now_Call) RunAndReturn(run func() time.Time) *ITimeGenerator_Now_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// NewITimeGenerator creates a new instance of ITimeGenerator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewITimeGenerator(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *ITimeGenerator {
-	mock := &ITimeGenerator{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
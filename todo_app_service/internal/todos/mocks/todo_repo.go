@@ -3,47 +3,915 @@
 package mocks
 
 import (
-	"internProject/todo_app_service/pkg/models"
+	context "context"
+
+	entities "Todo-List/internProject/todo_app_service/internal/entities"
+
+	filters "Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
 
 	mock "github.com/stretchr/testify/mock"
+
+	source "Todo-List/internProject/todo_app_service/internal/source"
+
+	time "time"
 )
 
-// TodoRepo is an autogenerated mock type for the TodoRepo type
-type TodoRepo struct {
-	mock.Mock
+// TodoRepo is an autogenerated mock type for the todoRepo type
+type TodoRepo struct {
+	mock.Mock
+}
+
+type TodoRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TodoRepo) EXPECT() *TodoRepo_Expecter {
+	return &TodoRepo_Expecter{mock: &_m.Mock}
+}
+
+// AddTodoBlocker provides a mock function with given fields: ctx, todoId, blockerId
+func (_m *TodoRepo) AddTodoBlocker(ctx context.Context, todoId string, blockerId string) error {
+	ret := _m.Called(ctx, todoId, blockerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTodoBlocker")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, todoId, blockerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepo_AddTodoBlocker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTodoBlocker'
+type TodoRepo_AddTodoBlocker_Call struct {
+	*mock.Call
+}
+
+// AddTodoBlocker is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId string
+//   - blockerId string
+func (_e *TodoRepo_Expecter) AddTodoBlocker(ctx interface{}, todoId interface{}, blockerId interface{}) *TodoRepo_AddTodoBlocker_Call {
+	return &TodoRepo_AddTodoBlocker_Call{Call: _e.mock.On("AddTodoBlocker", ctx, todoId, blockerId)}
+}
+
+func (_c *TodoRepo_AddTodoBlocker_Call) Run(run func(ctx context.Context, todoId string, blockerId string)) *TodoRepo_AddTodoBlocker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_AddTodoBlocker_Call) Return(_a0 error) *TodoRepo_AddTodoBlocker_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepo_AddTodoBlocker_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepo_AddTodoBlocker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyTodoLabels provides a mock function with given fields: ctx, todoId, copyId
+func (_m *TodoRepo) CopyTodoLabels(ctx context.Context, todoId string, copyId string) error {
+	ret := _m.Called(ctx, todoId, copyId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyTodoLabels")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, todoId, copyId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepo_CopyTodoLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyTodoLabels'
+type TodoRepo_CopyTodoLabels_Call struct {
+	*mock.Call
+}
+
+// CopyTodoLabels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId string
+//   - copyId string
+func (_e *TodoRepo_Expecter) CopyTodoLabels(ctx interface{}, todoId interface{}, copyId interface{}) *TodoRepo_CopyTodoLabels_Call {
+	return &TodoRepo_CopyTodoLabels_Call{Call: _e.mock.On("CopyTodoLabels", ctx, todoId, copyId)}
+}
+
+func (_c *TodoRepo_CopyTodoLabels_Call) Run(run func(ctx context.Context, todoId string, copyId string)) *TodoRepo_CopyTodoLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_CopyTodoLabels_Call) Return(_a0 error) *TodoRepo_CopyTodoLabels_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepo_CopyTodoLabels_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepo_CopyTodoLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountOpenBlockers provides a mock function with given fields: ctx, todoId
+func (_m *TodoRepo) CountOpenBlockers(ctx context.Context, todoId string) (int, error) {
+	ret := _m.Called(ctx, todoId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOpenBlockers")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, todoId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, todoId)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepo_CountOpenBlockers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOpenBlockers'
+type TodoRepo_CountOpenBlockers_Call struct {
+	*mock.Call
+}
+
+// CountOpenBlockers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId string
+func (_e *TodoRepo_Expecter) CountOpenBlockers(ctx interface{}, todoId interface{}) *TodoRepo_CountOpenBlockers_Call {
+	return &TodoRepo_CountOpenBlockers_Call{Call: _e.mock.On("CountOpenBlockers", ctx, todoId)}
+}
+
+func (_c *TodoRepo_CountOpenBlockers_Call) Run(run func(ctx context.Context, todoId string)) *TodoRepo_CountOpenBlockers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_CountOpenBlockers_Call) Return(_a0 int, _a1 error) *TodoRepo_CountOpenBlockers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepo_CountOpenBlockers_Call) RunAndReturn(run func(context.Context, string) (int, error)) *TodoRepo_CountOpenBlockers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountOpenSubtasks provides a mock function with given fields: ctx, parentId
+func (_m *TodoRepo) CountOpenSubtasks(ctx context.Context, parentId string) (int, error) {
+	ret := _m.Called(ctx, parentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOpenSubtasks")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, parentId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, parentId)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, parentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepo_CountOpenSubtasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOpenSubtasks'
+type TodoRepo_CountOpenSubtasks_Call struct {
+	*mock.Call
+}
+
+// CountOpenSubtasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentId string
+func (_e *TodoRepo_Expecter) CountOpenSubtasks(ctx interface{}, parentId interface{}) *TodoRepo_CountOpenSubtasks_Call {
+	return &TodoRepo_CountOpenSubtasks_Call{Call: _e.mock.On("CountOpenSubtasks", ctx, parentId)}
+}
+
+func (_c *TodoRepo_CountOpenSubtasks_Call) Run(run func(ctx context.Context, parentId string)) *TodoRepo_CountOpenSubtasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_CountOpenSubtasks_Call) Return(_a0 int, _a1 error) *TodoRepo_CountOpenSubtasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepo_CountOpenSubtasks_Call) RunAndReturn(run func(context.Context, string) (int, error)) *TodoRepo_CountOpenSubtasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSavepoint provides a mock function with given fields: ctx, name
+func (_m *TodoRepo) CreateSavepoint(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSavepoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepo_CreateSavepoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSavepoint'
+type TodoRepo_CreateSavepoint_Call struct {
+	*mock.Call
+}
+
+// CreateSavepoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *TodoRepo_Expecter) CreateSavepoint(ctx interface{}, name interface{}) *TodoRepo_CreateSavepoint_Call {
+	return &TodoRepo_CreateSavepoint_Call{Call: _e.mock.On("CreateSavepoint", ctx, name)}
+}
+
+func (_c *TodoRepo_CreateSavepoint_Call) Run(run func(ctx context.Context, name string)) *TodoRepo_CreateSavepoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_CreateSavepoint_Call) Return(_a0 error) *TodoRepo_CreateSavepoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepo_CreateSavepoint_Call) RunAndReturn(run func(context.Context, string) error) *TodoRepo_CreateSavepoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTodo provides a mock function with given fields: ctx, entity
+func (_m *TodoRepo) CreateTodo(ctx context.Context, entity *entities.Todo) (*entities.Todo, error) {
+	ret := _m.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTodo")
+	}
+
+	var r0 *entities.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Todo) (*entities.Todo, error)); ok {
+		return rf(ctx, entity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Todo) *entities.Todo); ok {
+		r0 = rf(ctx, entity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.Todo) error); ok {
+		r1 = rf(ctx, entity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepo_CreateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTodo'
+type TodoRepo_CreateTodo_Call struct {
+	*mock.Call
+}
+
+// CreateTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *entities.Todo
+func (_e *TodoRepo_Expecter) CreateTodo(ctx interface{}, entity interface{}) *TodoRepo_CreateTodo_Call {
+	return &TodoRepo_CreateTodo_Call{Call: _e.mock.On("CreateTodo", ctx, entity)}
+}
+
+func (_c *TodoRepo_CreateTodo_Call) Run(run func(ctx context.Context, entity *entities.Todo)) *TodoRepo_CreateTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.Todo))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_CreateTodo_Call) Return(_a0 *entities.Todo, _a1 error) *TodoRepo_CreateTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepo_CreateTodo_Call) RunAndReturn(run func(context.Context, *entities.Todo) (*entities.Todo, error)) *TodoRepo_CreateTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTodo provides a mock function with given fields: ctx, todoId
+func (_m *TodoRepo) DeleteTodo(ctx context.Context, todoId string) error {
+	ret := _m.Called(ctx, todoId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, todoId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepo_DeleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTodo'
+type TodoRepo_DeleteTodo_Call struct {
+	*mock.Call
+}
+
+// DeleteTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId string
+func (_e *TodoRepo_Expecter) DeleteTodo(ctx interface{}, todoId interface{}) *TodoRepo_DeleteTodo_Call {
+	return &TodoRepo_DeleteTodo_Call{Call: _e.mock.On("DeleteTodo", ctx, todoId)}
+}
+
+func (_c *TodoRepo_DeleteTodo_Call) Run(run func(ctx context.Context, todoId string)) *TodoRepo_DeleteTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_DeleteTodo_Call) Return(_a0 error) *TodoRepo_DeleteTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepo_DeleteTodo_Call) RunAndReturn(run func(context.Context, string) error) *TodoRepo_DeleteTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTodos provides a mock function with given fields: ctx
+func (_m *TodoRepo) DeleteTodos(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTodos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepo_DeleteTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTodos'
+type TodoRepo_DeleteTodos_Call struct {
+	*mock.Call
+}
+
+// DeleteTodos is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TodoRepo_Expecter) DeleteTodos(ctx interface{}) *TodoRepo_DeleteTodos_Call {
+	return &TodoRepo_DeleteTodos_Call{Call: _e.mock.On("DeleteTodos", ctx)}
+}
+
+func (_c *TodoRepo_DeleteTodos_Call) Run(run func(ctx context.Context)) *TodoRepo_DeleteTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_DeleteTodos_Call) Return(_a0 error) *TodoRepo_DeleteTodos_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepo_DeleteTodos_Call) RunAndReturn(run func(context.Context) error) *TodoRepo_DeleteTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTodosByListId provides a mock function with given fields: ctx, listId
+func (_m *TodoRepo) DeleteTodosByListId(ctx context.Context, listId string) error {
+	ret := _m.Called(ctx, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTodosByListId")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, listId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepo_DeleteTodosByListId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTodosByListId'
+type TodoRepo_DeleteTodosByListId_Call struct {
+	*mock.Call
+}
+
+// DeleteTodosByListId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId string
+func (_e *TodoRepo_Expecter) DeleteTodosByListId(ctx interface{}, listId interface{}) *TodoRepo_DeleteTodosByListId_Call {
+	return &TodoRepo_DeleteTodosByListId_Call{Call: _e.mock.On("DeleteTodosByListId", ctx, listId)}
+}
+
+func (_c *TodoRepo_DeleteTodosByListId_Call) Run(run func(ctx context.Context, listId string)) *TodoRepo_DeleteTodosByListId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_DeleteTodosByListId_Call) Return(_a0 error) *TodoRepo_DeleteTodosByListId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepo_DeleteTodosByListId_Call) RunAndReturn(run func(context.Context, string) error) *TodoRepo_DeleteTodosByListId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DetachSubtasks provides a mock function with given fields: ctx, parentId
+func (_m *TodoRepo) DetachSubtasks(ctx context.Context, parentId string) error {
+	ret := _m.Called(ctx, parentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachSubtasks")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, parentId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepo_DetachSubtasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachSubtasks'
+type TodoRepo_DetachSubtasks_Call struct {
+	*mock.Call
+}
+
+// DetachSubtasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentId string
+func (_e *TodoRepo_Expecter) DetachSubtasks(ctx interface{}, parentId interface{}) *TodoRepo_DetachSubtasks_Call {
+	return &TodoRepo_DetachSubtasks_Call{Call: _e.mock.On("DetachSubtasks", ctx, parentId)}
+}
+
+func (_c *TodoRepo_DetachSubtasks_Call) Run(run func(ctx context.Context, parentId string)) *TodoRepo_DetachSubtasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_DetachSubtasks_Call) Return(_a0 error) *TodoRepo_DetachSubtasks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepo_DetachSubtasks_Call) RunAndReturn(run func(context.Context, string) error) *TodoRepo_DetachSubtasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaginationInfo provides a mock function with given fields: ctx, f, s
+func (_m *TodoRepo) GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error) {
+	ret := _m.Called(ctx, f, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginationInfo")
+	}
+
+	var r0 *entities.PaginationInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filters.SqlFilters, source.Source) (*entities.PaginationInfo, error)); ok {
+		return rf(ctx, f, s)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filters.SqlFilters, source.Source) *entities.PaginationInfo); ok {
+		r0 = rf(ctx, f, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PaginationInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filters.SqlFilters, source.Source) error); ok {
+		r1 = rf(ctx, f, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepo_GetPaginationInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginationInfo'
+type TodoRepo_GetPaginationInfo_Call struct {
+	*mock.Call
+}
+
+// GetPaginationInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f filters.SqlFilters
+//   - s source.Source
+func (_e *TodoRepo_Expecter) GetPaginationInfo(ctx interface{}, f interface{}, s interface{}) *TodoRepo_GetPaginationInfo_Call {
+	return &TodoRepo_GetPaginationInfo_Call{Call: _e.mock.On("GetPaginationInfo", ctx, f, s)}
+}
+
+func (_c *TodoRepo_GetPaginationInfo_Call) Run(run func(ctx context.Context, f filters.SqlFilters, s source.Source)) *TodoRepo_GetPaginationInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(filters.SqlFilters), args[2].(source.Source))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_GetPaginationInfo_Call) Return(_a0 *entities.PaginationInfo, _a1 error) *TodoRepo_GetPaginationInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepo_GetPaginationInfo_Call) RunAndReturn(run func(context.Context, filters.SqlFilters, source.Source) (*entities.PaginationInfo, error)) *TodoRepo_GetPaginationInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodo provides a mock function with given fields: ctx, todoId
+func (_m *TodoRepo) GetTodo(ctx context.Context, todoId string) (*entities.Todo, error) {
+	ret := _m.Called(ctx, todoId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodo")
+	}
+
+	var r0 *entities.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.Todo, error)); ok {
+		return rf(ctx, todoId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.Todo); ok {
+		r0 = rf(ctx, todoId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepo_GetTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodo'
+type TodoRepo_GetTodo_Call struct {
+	*mock.Call
+}
+
+// GetTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId string
+func (_e *TodoRepo_Expecter) GetTodo(ctx interface{}, todoId interface{}) *TodoRepo_GetTodo_Call {
+	return &TodoRepo_GetTodo_Call{Call: _e.mock.On("GetTodo", ctx, todoId)}
+}
+
+func (_c *TodoRepo_GetTodo_Call) Run(run func(ctx context.Context, todoId string)) *TodoRepo_GetTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_GetTodo_Call) Return(_a0 *entities.Todo, _a1 error) *TodoRepo_GetTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepo_GetTodo_Call) RunAndReturn(run func(context.Context, string) (*entities.Todo, error)) *TodoRepo_GetTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodoAssigneeTo provides a mock function with given fields: ctx, todoId
+func (_m *TodoRepo) GetTodoAssigneeTo(ctx context.Context, todoId string) (*entities.User, error) {
+	ret := _m.Called(ctx, todoId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodoAssigneeTo")
+	}
+
+	var r0 *entities.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.User, error)); ok {
+		return rf(ctx, todoId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.User); ok {
+		r0 = rf(ctx, todoId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepo_GetTodoAssigneeTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoAssigneeTo'
+type TodoRepo_GetTodoAssigneeTo_Call struct {
+	*mock.Call
+}
+
+// GetTodoAssigneeTo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId string
+func (_e *TodoRepo_Expecter) GetTodoAssigneeTo(ctx interface{}, todoId interface{}) *TodoRepo_GetTodoAssigneeTo_Call {
+	return &TodoRepo_GetTodoAssigneeTo_Call{Call: _e.mock.On("GetTodoAssigneeTo", ctx, todoId)}
+}
+
+func (_c *TodoRepo_GetTodoAssigneeTo_Call) Run(run func(ctx context.Context, todoId string)) *TodoRepo_GetTodoAssigneeTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_GetTodoAssigneeTo_Call) Return(_a0 *entities.User, _a1 error) *TodoRepo_GetTodoAssigneeTo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepo_GetTodoAssigneeTo_Call) RunAndReturn(run func(context.Context, string) (*entities.User, error)) *TodoRepo_GetTodoAssigneeTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodoByListId provides a mock function with given fields: ctx, listId, todoId
+func (_m *TodoRepo) GetTodoByListId(ctx context.Context, listId string, todoId string) (*entities.Todo, error) {
+	ret := _m.Called(ctx, listId, todoId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodoByListId")
+	}
+
+	var r0 *entities.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.Todo, error)); ok {
+		return rf(ctx, listId, todoId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.Todo); ok {
+		r0 = rf(ctx, listId, todoId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, listId, todoId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepo_GetTodoByListId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoByListId'
+type TodoRepo_GetTodoByListId_Call struct {
+	*mock.Call
+}
+
+// GetTodoByListId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId string
+//   - todoId string
+func (_e *TodoRepo_Expecter) GetTodoByListId(ctx interface{}, listId interface{}, todoId interface{}) *TodoRepo_GetTodoByListId_Call {
+	return &TodoRepo_GetTodoByListId_Call{Call: _e.mock.On("GetTodoByListId", ctx, listId, todoId)}
+}
+
+func (_c *TodoRepo_GetTodoByListId_Call) Run(run func(ctx context.Context, listId string, todoId string)) *TodoRepo_GetTodoByListId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_GetTodoByListId_Call) Return(_a0 *entities.Todo, _a1 error) *TodoRepo_GetTodoByListId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepo_GetTodoByListId_Call) RunAndReturn(run func(context.Context, string, string) (*entities.Todo, error)) *TodoRepo_GetTodoByListId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodos provides a mock function with given fields: ctx, f
+func (_m *TodoRepo) GetTodos(ctx context.Context, f filters.SqlFilters) ([]entities.Todo, error) {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodos")
+	}
+
+	var r0 []entities.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filters.SqlFilters) ([]entities.Todo, error)); ok {
+		return rf(ctx, f)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filters.SqlFilters) []entities.Todo); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filters.SqlFilters) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepo_GetTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodos'
+type TodoRepo_GetTodos_Call struct {
+	*mock.Call
+}
+
+// GetTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f filters.SqlFilters
+func (_e *TodoRepo_Expecter) GetTodos(ctx interface{}, f interface{}) *TodoRepo_GetTodos_Call {
+	return &TodoRepo_GetTodos_Call{Call: _e.mock.On("GetTodos", ctx, f)}
+}
+
+func (_c *TodoRepo_GetTodos_Call) Run(run func(ctx context.Context, f filters.SqlFilters)) *TodoRepo_GetTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(filters.SqlFilters))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_GetTodos_Call) Return(_a0 []entities.Todo, _a1 error) *TodoRepo_GetTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepo_GetTodos_Call) RunAndReturn(run func(context.Context, filters.SqlFilters) ([]entities.Todo, error)) *TodoRepo_GetTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodosByListId provides a mock function with given fields: ctx, listId, f
+func (_m *TodoRepo) GetTodosByListId(ctx context.Context, listId string, f filters.SqlFilters) ([]entities.Todo, error) {
+	ret := _m.Called(ctx, listId, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodosByListId")
+	}
+
+	var r0 []entities.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, filters.SqlFilters) ([]entities.Todo, error)); ok {
+		return rf(ctx, listId, f)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, filters.SqlFilters) []entities.Todo); ok {
+		r0 = rf(ctx, listId, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, filters.SqlFilters) error); ok {
+		r1 = rf(ctx, listId, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepo_GetTodosByListId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodosByListId'
+type TodoRepo_GetTodosByListId_Call struct {
+	*mock.Call
+}
+
+// GetTodosByListId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId string
+//   - f filters.SqlFilters
+func (_e *TodoRepo_Expecter) GetTodosByListId(ctx interface{}, listId interface{}, f interface{}) *TodoRepo_GetTodosByListId_Call {
+	return &TodoRepo_GetTodosByListId_Call{Call: _e.mock.On("GetTodosByListId", ctx, listId, f)}
 }
 
-type TodoRepo_Expecter struct {
-	mock *mock.Mock
+func (_c *TodoRepo_GetTodosByListId_Call) Run(run func(ctx context.Context, listId string, f filters.SqlFilters)) *TodoRepo_GetTodosByListId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(filters.SqlFilters))
+	})
+	return _c
 }
 
-func (_m *TodoRepo) EXPECT() *TodoRepo_Expecter {
-	return &TodoRepo_Expecter{mock: &_m.Mock}
+func (_c *TodoRepo_GetTodosByListId_Call) Return(_a0 []entities.Todo, _a1 error) *TodoRepo_GetTodosByListId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepo_GetTodosByListId_Call) RunAndReturn(run func(context.Context, string, filters.SqlFilters) ([]entities.Todo, error)) *TodoRepo_GetTodosByListId_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// CreateTodo provides a mock function with given fields: listId, todo
-func (_m *TodoRepo) CreateTodo(listId string, todo *models.Todo) (*models.Todo, error) {
-	ret := _m.Called(listId, todo)
+// GetTodosParticipants provides a mock function with given fields: ctx, todoIds
+func (_m *TodoRepo) GetTodosParticipants(ctx context.Context, todoIds []string) ([]string, error) {
+	ret := _m.Called(ctx, todoIds)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTodo")
+		panic("no return value specified for GetTodosParticipants")
 	}
 
-	var r0 *models.Todo
+	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, *models.Todo) (*models.Todo, error)); ok {
-		return rf(listId, todo)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, todoIds)
 	}
-	if rf, ok := ret.Get(0).(func(string, *models.Todo) *models.Todo); ok {
-		r0 = rf(listId, todo)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, todoIds)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Todo)
+			r0 = ret.Get(0).([]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, *models.Todo) error); ok {
-		r1 = rf(listId, todo)
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, todoIds)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -51,105 +919,174 @@ func (_m *TodoRepo) CreateTodo(listId string, todo *models.Todo) (*models.Todo,
 	return r0, r1
 }
 
-// TodoRepo_CreateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTodo'
-type TodoRepo_CreateTodo_Call struct {
+// TodoRepo_GetTodosParticipants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodosParticipants'
+type TodoRepo_GetTodosParticipants_Call struct {
 	*mock.Call
 }
 
-// CreateTodo is a helper method to define mock.On call
-//   - listId string
-//   - todo *models.Todo
-func (_e *TodoRepo_Expecter) CreateTodo(listId interface{}, todo interface{}) *TodoRepo_CreateTodo_Call {
-	return &TodoRepo_CreateTodo_Call{Call: _e.mock.On("CreateTodo", listId, todo)}
+// GetTodosParticipants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoIds []string
+func (_e *TodoRepo_Expecter) GetTodosParticipants(ctx interface{}, todoIds interface{}) *TodoRepo_GetTodosParticipants_Call {
+	return &TodoRepo_GetTodosParticipants_Call{Call: _e.mock.On("GetTodosParticipants", ctx, todoIds)}
 }
 
-func (_c *TodoRepo_CreateTodo_Call) Run(run func(listId string, todo *models.Todo)) *TodoRepo_CreateTodo_Call {
+func (_c *TodoRepo_GetTodosParticipants_Call) Run(run func(ctx context.Context, todoIds []string)) *TodoRepo_GetTodosParticipants_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(*models.Todo))
+		run(args[0].(context.Context), args[1].([]string))
 	})
 	return _c
 }
 
-func (_c *TodoRepo_CreateTodo_Call) Return(_a0 *models.Todo, _a1 error) *TodoRepo_CreateTodo_Call {
+func (_c *TodoRepo_GetTodosParticipants_Call) Return(_a0 []string, _a1 error) *TodoRepo_GetTodosParticipants_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoRepo_CreateTodo_Call) RunAndReturn(run func(string, *models.Todo) (*models.Todo, error)) *TodoRepo_CreateTodo_Call {
+func (_c *TodoRepo_GetTodosParticipants_Call) RunAndReturn(run func(context.Context, []string) ([]string, error)) *TodoRepo_GetTodosParticipants_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTodo provides a mock function with given fields: listId, todoId
-func (_m *TodoRepo) DeleteTodo(listId string, todoId string) error {
-	ret := _m.Called(listId, todoId)
+// IsTodoAssignee provides a mock function with given fields: ctx, todoId, userId
+func (_m *TodoRepo) IsTodoAssignee(ctx context.Context, todoId string, userId string) (bool, error) {
+	ret := _m.Called(ctx, todoId, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTodo")
+		panic("no return value specified for IsTodoAssignee")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(listId, todoId)
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, todoId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, todoId, userId)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, todoId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// TodoRepo_DeleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTodo'
-type TodoRepo_DeleteTodo_Call struct {
+// TodoRepo_IsTodoAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTodoAssignee'
+type TodoRepo_IsTodoAssignee_Call struct {
 	*mock.Call
 }
 
-// DeleteTodo is a helper method to define mock.On call
-//   - listId string
+// IsTodoAssignee is a helper method to define mock.On call
+//   - ctx context.Context
 //   - todoId string
-func (_e *TodoRepo_Expecter) DeleteTodo(listId interface{}, todoId interface{}) *TodoRepo_DeleteTodo_Call {
-	return &TodoRepo_DeleteTodo_Call{Call: _e.mock.On("DeleteTodo", listId, todoId)}
+//   - userId string
+func (_e *TodoRepo_Expecter) IsTodoAssignee(ctx interface{}, todoId interface{}, userId interface{}) *TodoRepo_IsTodoAssignee_Call {
+	return &TodoRepo_IsTodoAssignee_Call{Call: _e.mock.On("IsTodoAssignee", ctx, todoId, userId)}
 }
 
-func (_c *TodoRepo_DeleteTodo_Call) Run(run func(listId string, todoId string)) *TodoRepo_DeleteTodo_Call {
+func (_c *TodoRepo_IsTodoAssignee_Call) Run(run func(ctx context.Context, todoId string, userId string)) *TodoRepo_IsTodoAssignee_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *TodoRepo_DeleteTodo_Call) Return(_a0 error) *TodoRepo_DeleteTodo_Call {
-	_c.Call.Return(_a0)
+func (_c *TodoRepo_IsTodoAssignee_Call) Return(_a0 bool, _a1 error) *TodoRepo_IsTodoAssignee_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoRepo_DeleteTodo_Call) RunAndReturn(run func(string, string) error) *TodoRepo_DeleteTodo_Call {
+func (_c *TodoRepo_IsTodoAssignee_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *TodoRepo_IsTodoAssignee_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTodo provides a mock function with given fields: listId, todoId
-func (_m *TodoRepo) GetTodo(listId string, todoId string) (*models.Todo, error) {
-	ret := _m.Called(listId, todoId)
+// IsTodoTransitivelyBlockedBy provides a mock function with given fields: ctx, todoId, blockerId
+func (_m *TodoRepo) IsTodoTransitivelyBlockedBy(ctx context.Context, todoId string, blockerId string) (bool, error) {
+	ret := _m.Called(ctx, todoId, blockerId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTodo")
+		panic("no return value specified for IsTodoTransitivelyBlockedBy")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, todoId, blockerId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, todoId, blockerId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, todoId, blockerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepo_IsTodoTransitivelyBlockedBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTodoTransitivelyBlockedBy'
+type TodoRepo_IsTodoTransitivelyBlockedBy_Call struct {
+	*mock.Call
+}
+
+// IsTodoTransitivelyBlockedBy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId string
+//   - blockerId string
+func (_e *TodoRepo_Expecter) IsTodoTransitivelyBlockedBy(ctx interface{}, todoId interface{}, blockerId interface{}) *TodoRepo_IsTodoTransitivelyBlockedBy_Call {
+	return &TodoRepo_IsTodoTransitivelyBlockedBy_Call{Call: _e.mock.On("IsTodoTransitivelyBlockedBy", ctx, todoId, blockerId)}
+}
+
+func (_c *TodoRepo_IsTodoTransitivelyBlockedBy_Call) Run(run func(ctx context.Context, todoId string, blockerId string)) *TodoRepo_IsTodoTransitivelyBlockedBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_IsTodoTransitivelyBlockedBy_Call) Return(_a0 bool, _a1 error) *TodoRepo_IsTodoTransitivelyBlockedBy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepo_IsTodoTransitivelyBlockedBy_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *TodoRepo_IsTodoTransitivelyBlockedBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveTodos provides a mock function with given fields: ctx, todoIds, listId, movedAt
+func (_m *TodoRepo) MoveTodos(ctx context.Context, todoIds []string, listId string, movedAt time.Time) ([]entities.Todo, error) {
+	ret := _m.Called(ctx, todoIds, listId, movedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTodos")
 	}
 
-	var r0 *models.Todo
+	var r0 []entities.Todo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*models.Todo, error)); ok {
-		return rf(listId, todoId)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, time.Time) ([]entities.Todo, error)); ok {
+		return rf(ctx, todoIds, listId, movedAt)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *models.Todo); ok {
-		r0 = rf(listId, todoId)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, time.Time) []entities.Todo); ok {
+		r0 = rf(ctx, todoIds, listId, movedAt)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Todo)
+			r0 = ret.Get(0).([]entities.Todo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(listId, todoId)
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, time.Time) error); ok {
+		r1 = rf(ctx, todoIds, listId, movedAt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -157,58 +1094,121 @@ func (_m *TodoRepo) GetTodo(listId string, todoId string) (*models.Todo, error)
 	return r0, r1
 }
 
-// TodoRepo_GetTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodo'
-type TodoRepo_GetTodo_Call struct {
+// TodoRepo_MoveTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTodos'
+type TodoRepo_MoveTodos_Call struct {
 	*mock.Call
 }
 
-// GetTodo is a helper method to define mock.On call
+// MoveTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoIds []string
 //   - listId string
+//   - movedAt time.Time
+func (_e *TodoRepo_Expecter) MoveTodos(ctx interface{}, todoIds interface{}, listId interface{}, movedAt interface{}) *TodoRepo_MoveTodos_Call {
+	return &TodoRepo_MoveTodos_Call{Call: _e.mock.On("MoveTodos", ctx, todoIds, listId, movedAt)}
+}
+
+func (_c *TodoRepo_MoveTodos_Call) Run(run func(ctx context.Context, todoIds []string, listId string, movedAt time.Time)) *TodoRepo_MoveTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_MoveTodos_Call) Return(_a0 []entities.Todo, _a1 error) *TodoRepo_MoveTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepo_MoveTodos_Call) RunAndReturn(run func(context.Context, []string, string, time.Time) ([]entities.Todo, error)) *TodoRepo_MoveTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceTodoAfter provides a mock function with given fields: ctx, todoId, anchorId, placedAt
+func (_m *TodoRepo) PlaceTodoAfter(ctx context.Context, todoId string, anchorId string, placedAt time.Time) (*entities.Todo, error) {
+	ret := _m.Called(ctx, todoId, anchorId, placedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceTodoAfter")
+	}
+
+	var r0 *entities.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (*entities.Todo, error)); ok {
+		return rf(ctx, todoId, anchorId, placedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) *entities.Todo); ok {
+		r0 = rf(ctx, todoId, anchorId, placedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, todoId, anchorId, placedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepo_PlaceTodoAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceTodoAfter'
+type TodoRepo_PlaceTodoAfter_Call struct {
+	*mock.Call
+}
+
+// PlaceTodoAfter is a helper method to define mock.On call
+//   - ctx context.Context
 //   - todoId string
-func (_e *TodoRepo_Expecter) GetTodo(listId interface{}, todoId interface{}) *TodoRepo_GetTodo_Call {
-	return &TodoRepo_GetTodo_Call{Call: _e.mock.On("GetTodo", listId, todoId)}
+//   - anchorId string
+//   - placedAt time.Time
+func (_e *TodoRepo_Expecter) PlaceTodoAfter(ctx interface{}, todoId interface{}, anchorId interface{}, placedAt interface{}) *TodoRepo_PlaceTodoAfter_Call {
+	return &TodoRepo_PlaceTodoAfter_Call{Call: _e.mock.On("PlaceTodoAfter", ctx, todoId, anchorId, placedAt)}
 }
 
-func (_c *TodoRepo_GetTodo_Call) Run(run func(listId string, todoId string)) *TodoRepo_GetTodo_Call {
+func (_c *TodoRepo_PlaceTodoAfter_Call) Run(run func(ctx context.Context, todoId string, anchorId string, placedAt time.Time)) *TodoRepo_PlaceTodoAfter_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
 	})
 	return _c
 }
 
-func (_c *TodoRepo_GetTodo_Call) Return(_a0 *models.Todo, _a1 error) *TodoRepo_GetTodo_Call {
+func (_c *TodoRepo_PlaceTodoAfter_Call) Return(_a0 *entities.Todo, _a1 error) *TodoRepo_PlaceTodoAfter_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoRepo_GetTodo_Call) RunAndReturn(run func(string, string) (*models.Todo, error)) *TodoRepo_GetTodo_Call {
+func (_c *TodoRepo_PlaceTodoAfter_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (*entities.Todo, error)) *TodoRepo_PlaceTodoAfter_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTodosByListId provides a mock function with given fields: listId
-func (_m *TodoRepo) GetTodosByListId(listId string) ([]*models.Todo, error) {
-	ret := _m.Called(listId)
+// PlaceTodoBefore provides a mock function with given fields: ctx, todoId, anchorId, placedAt
+func (_m *TodoRepo) PlaceTodoBefore(ctx context.Context, todoId string, anchorId string, placedAt time.Time) (*entities.Todo, error) {
+	ret := _m.Called(ctx, todoId, anchorId, placedAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTodosByListId")
+		panic("no return value specified for PlaceTodoBefore")
 	}
 
-	var r0 []*models.Todo
+	var r0 *entities.Todo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*models.Todo, error)); ok {
-		return rf(listId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (*entities.Todo, error)); ok {
+		return rf(ctx, todoId, anchorId, placedAt)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*models.Todo); ok {
-		r0 = rf(listId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) *entities.Todo); ok {
+		r0 = rf(ctx, todoId, anchorId, placedAt)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.Todo)
+			r0 = ret.Get(0).(*entities.Todo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(listId)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, todoId, anchorId, placedAt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,45 +1216,190 @@ func (_m *TodoRepo) GetTodosByListId(listId string) ([]*models.Todo, error) {
 	return r0, r1
 }
 
-// TodoRepo_GetTodosByListId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodosByListId'
-type TodoRepo_GetTodosByListId_Call struct {
+// TodoRepo_PlaceTodoBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceTodoBefore'
+type TodoRepo_PlaceTodoBefore_Call struct {
 	*mock.Call
 }
 
-// GetTodosByListId is a helper method to define mock.On call
-//   - listId string
-func (_e *TodoRepo_Expecter) GetTodosByListId(listId interface{}) *TodoRepo_GetTodosByListId_Call {
-	return &TodoRepo_GetTodosByListId_Call{Call: _e.mock.On("GetTodosByListId", listId)}
+// PlaceTodoBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId string
+//   - anchorId string
+//   - placedAt time.Time
+func (_e *TodoRepo_Expecter) PlaceTodoBefore(ctx interface{}, todoId interface{}, anchorId interface{}, placedAt interface{}) *TodoRepo_PlaceTodoBefore_Call {
+	return &TodoRepo_PlaceTodoBefore_Call{Call: _e.mock.On("PlaceTodoBefore", ctx, todoId, anchorId, placedAt)}
 }
 
-func (_c *TodoRepo_GetTodosByListId_Call) Run(run func(listId string)) *TodoRepo_GetTodosByListId_Call {
+func (_c *TodoRepo_PlaceTodoBefore_Call) Run(run func(ctx context.Context, todoId string, anchorId string, placedAt time.Time)) *TodoRepo_PlaceTodoBefore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
 	})
 	return _c
 }
 
-func (_c *TodoRepo_GetTodosByListId_Call) Return(_a0 []*models.Todo, _a1 error) *TodoRepo_GetTodosByListId_Call {
+func (_c *TodoRepo_PlaceTodoBefore_Call) Return(_a0 *entities.Todo, _a1 error) *TodoRepo_PlaceTodoBefore_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoRepo_GetTodosByListId_Call) RunAndReturn(run func(string) ([]*models.Todo, error)) *TodoRepo_GetTodosByListId_Call {
+func (_c *TodoRepo_PlaceTodoBefore_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (*entities.Todo, error)) *TodoRepo_PlaceTodoBefore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTodo provides a mock function with given fields: listId, todoId, todo
-func (_m *TodoRepo) UpdateTodo(listId string, todoId string, todo *models.Todo) error {
-	ret := _m.Called(listId, todoId, todo)
+// ReleaseSavepoint provides a mock function with given fields: ctx, name
+func (_m *TodoRepo) ReleaseSavepoint(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTodo")
+		panic("no return value specified for ReleaseSavepoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepo_ReleaseSavepoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseSavepoint'
+type TodoRepo_ReleaseSavepoint_Call struct {
+	*mock.Call
+}
+
+// ReleaseSavepoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *TodoRepo_Expecter) ReleaseSavepoint(ctx interface{}, name interface{}) *TodoRepo_ReleaseSavepoint_Call {
+	return &TodoRepo_ReleaseSavepoint_Call{Call: _e.mock.On("ReleaseSavepoint", ctx, name)}
+}
+
+func (_c *TodoRepo_ReleaseSavepoint_Call) Run(run func(ctx context.Context, name string)) *TodoRepo_ReleaseSavepoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_ReleaseSavepoint_Call) Return(_a0 error) *TodoRepo_ReleaseSavepoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepo_ReleaseSavepoint_Call) RunAndReturn(run func(context.Context, string) error) *TodoRepo_ReleaseSavepoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTodoBlocker provides a mock function with given fields: ctx, todoId, blockerId
+func (_m *TodoRepo) RemoveTodoBlocker(ctx context.Context, todoId string, blockerId string) error {
+	ret := _m.Called(ctx, todoId, blockerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTodoBlocker")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, todoId, blockerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepo_RemoveTodoBlocker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTodoBlocker'
+type TodoRepo_RemoveTodoBlocker_Call struct {
+	*mock.Call
+}
+
+// RemoveTodoBlocker is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId string
+//   - blockerId string
+func (_e *TodoRepo_Expecter) RemoveTodoBlocker(ctx interface{}, todoId interface{}, blockerId interface{}) *TodoRepo_RemoveTodoBlocker_Call {
+	return &TodoRepo_RemoveTodoBlocker_Call{Call: _e.mock.On("RemoveTodoBlocker", ctx, todoId, blockerId)}
+}
+
+func (_c *TodoRepo_RemoveTodoBlocker_Call) Run(run func(ctx context.Context, todoId string, blockerId string)) *TodoRepo_RemoveTodoBlocker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_RemoveTodoBlocker_Call) Return(_a0 error) *TodoRepo_RemoveTodoBlocker_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepo_RemoveTodoBlocker_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepo_RemoveTodoBlocker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackToSavepoint provides a mock function with given fields: ctx, name
+func (_m *TodoRepo) RollbackToSavepoint(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackToSavepoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepo_RollbackToSavepoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackToSavepoint'
+type TodoRepo_RollbackToSavepoint_Call struct {
+	*mock.Call
+}
+
+// RollbackToSavepoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *TodoRepo_Expecter) RollbackToSavepoint(ctx interface{}, name interface{}) *TodoRepo_RollbackToSavepoint_Call {
+	return &TodoRepo_RollbackToSavepoint_Call{Call: _e.mock.On("RollbackToSavepoint", ctx, name)}
+}
+
+func (_c *TodoRepo_RollbackToSavepoint_Call) Run(run func(ctx context.Context, name string)) *TodoRepo_RollbackToSavepoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_RollbackToSavepoint_Call) Return(_a0 error) *TodoRepo_RollbackToSavepoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepo_RollbackToSavepoint_Call) RunAndReturn(run func(context.Context, string) error) *TodoRepo_RollbackToSavepoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnassignUserFromTodos provides a mock function with given fields: ctx, userId, listId
+func (_m *TodoRepo) UnassignUserFromTodos(ctx context.Context, userId string, listId string) error {
+	ret := _m.Called(ctx, userId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnassignUserFromTodos")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, *models.Todo) error); ok {
-		r0 = rf(listId, todoId, todo)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userId, listId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -262,32 +1407,92 @@ func (_m *TodoRepo) UpdateTodo(listId string, todoId string, todo *models.Todo)
 	return r0
 }
 
+// TodoRepo_UnassignUserFromTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignUserFromTodos'
+type TodoRepo_UnassignUserFromTodos_Call struct {
+	*mock.Call
+}
+
+// UnassignUserFromTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - listId string
+func (_e *TodoRepo_Expecter) UnassignUserFromTodos(ctx interface{}, userId interface{}, listId interface{}) *TodoRepo_UnassignUserFromTodos_Call {
+	return &TodoRepo_UnassignUserFromTodos_Call{Call: _e.mock.On("UnassignUserFromTodos", ctx, userId, listId)}
+}
+
+func (_c *TodoRepo_UnassignUserFromTodos_Call) Run(run func(ctx context.Context, userId string, listId string)) *TodoRepo_UnassignUserFromTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepo_UnassignUserFromTodos_Call) Return(_a0 error) *TodoRepo_UnassignUserFromTodos_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepo_UnassignUserFromTodos_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepo_UnassignUserFromTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTodo provides a mock function with given fields: ctx, sqlExecParams, sqlFields
+func (_m *TodoRepo) UpdateTodo(ctx context.Context, sqlExecParams map[string]interface{}, sqlFields []string) (*entities.Todo, error) {
+	ret := _m.Called(ctx, sqlExecParams, sqlFields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTodo")
+	}
+
+	var r0 *entities.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]interface{}, []string) (*entities.Todo, error)); ok {
+		return rf(ctx, sqlExecParams, sqlFields)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]interface{}, []string) *entities.Todo); ok {
+		r0 = rf(ctx, sqlExecParams, sqlFields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[string]interface{}, []string) error); ok {
+		r1 = rf(ctx, sqlExecParams, sqlFields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TodoRepo_UpdateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodo'
 type TodoRepo_UpdateTodo_Call struct {
 	*mock.Call
 }
 
 // UpdateTodo is a helper method to define mock.On call
-//   - listId string
-//   - todoId string
-//   - todo *models.Todo
-func (_e *TodoRepo_Expecter) UpdateTodo(listId interface{}, todoId interface{}, todo interface{}) *TodoRepo_UpdateTodo_Call {
-	return &TodoRepo_UpdateTodo_Call{Call: _e.mock.On("UpdateTodo", listId, todoId, todo)}
+//   - ctx context.Context
+//   - sqlExecParams map[string]interface{}
+//   - sqlFields []string
+func (_e *TodoRepo_Expecter) UpdateTodo(ctx interface{}, sqlExecParams interface{}, sqlFields interface{}) *TodoRepo_UpdateTodo_Call {
+	return &TodoRepo_UpdateTodo_Call{Call: _e.mock.On("UpdateTodo", ctx, sqlExecParams, sqlFields)}
 }
 
-func (_c *TodoRepo_UpdateTodo_Call) Run(run func(listId string, todoId string, todo *models.Todo)) *TodoRepo_UpdateTodo_Call {
+func (_c *TodoRepo_UpdateTodo_Call) Run(run func(ctx context.Context, sqlExecParams map[string]interface{}, sqlFields []string)) *TodoRepo_UpdateTodo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(*models.Todo))
+		run(args[0].(context.Context), args[1].(map[string]interface{}), args[2].([]string))
 	})
 	return _c
 }
 
-func (_c *TodoRepo_UpdateTodo_Call) Return(_a0 error) *TodoRepo_UpdateTodo_Call {
-	_c.Call.Return(_a0)
+func (_c *TodoRepo_UpdateTodo_Call) Return(_a0 *entities.Todo, _a1 error) *TodoRepo_UpdateTodo_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoRepo_UpdateTodo_Call) RunAndReturn(run func(string, string, *models.Todo) error) *TodoRepo_UpdateTodo_Call {
+func (_c *TodoRepo_UpdateTodo_Call) RunAndReturn(run func(context.Context, map[string]interface{}, []string) (*entities.Todo, error)) *TodoRepo_UpdateTodo_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,92 @@
+package todos
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+)
+
+// applyTodoColumn keeps the column and the status of the updated todo in sync. A todo in a column takes the status
+// of the column, a todo whose status changes leaves its column. It reports whether the column of the todo has to be cleared.
+func (s *service) applyTodoColumn(ctx context.Context, todoEntity *entities.Todo, modelTodo *models.Todo) (bool, error) {
+	statusChanged := len(modelTodo.Status) != 0 && string(modelTodo.Status) != todoEntity.Status
+	if modelTodo.ColumnId == nil && !statusChanged {
+		return false, nil
+	}
+
+	columns, err := s.cRepo.GetListColumns(ctx, todoEntity.ListId.String())
+	if err != nil {
+		log.C(ctx).Errorf("failed to get columns of list with id %s, error %s", todoEntity.ListId.String(), err.Error())
+		return false, err
+	}
+
+	var targetColumn *entities.Column
+	clearColumn := false
+
+	if modelTodo.ColumnId != nil {
+		if targetColumn = findColumn(columns, *modelTodo.ColumnId); targetColumn == nil {
+			return false, application_errors.ColumnOutOfScopeError
+		}
+
+		if len(modelTodo.Status) != 0 && string(modelTodo.Status) != targetColumn.Status {
+			return false, application_errors.ColumnStatusMismatchError
+		}
+
+		modelTodo.Status = constants.TodoStatus(targetColumn.Status)
+	} else {
+		targetColumn = firstColumnOfStatus(columns, string(modelTodo.Status))
+		clearColumn = todoEntity.ColumnId.Valid
+	}
+
+	currentColumn := effectiveColumn(columns, todoEntity)
+	if targetColumn == nil || !targetColumn.WipLimit.Valid || (currentColumn != nil && currentColumn.Id == targetColumn.Id) {
+		return clearColumn, nil
+	}
+
+	count, err := s.cRepo.CountColumnTodos(ctx, targetColumn)
+	if err != nil {
+		log.C(ctx).Errorf("failed to count the todos in column with id %s, error %s", targetColumn.Id.String(), err.Error())
+		return false, err
+	}
+
+	if count >= int(targetColumn.WipLimit.Int32) {
+		return false, application_errors.NewWipLimitExceededError(targetColumn.Name, int(targetColumn.WipLimit.Int32))
+	}
+
+	return clearColumn, nil
+}
+
+// effectiveColumn returns the column the todo is shown in, a todo without a column is shown in the first column of its status
+func effectiveColumn(columns []entities.Column, todo *entities.Todo) *entities.Column {
+	if todo.ColumnId.Valid {
+		if column := findColumn(columns, todo.ColumnId.UUID.String()); column != nil {
+			return column
+		}
+	}
+
+	return firstColumnOfStatus(columns, todo.Status)
+}
+
+func findColumn(columns []entities.Column, columnId string) *entities.Column {
+	for index := range columns {
+		if columns[index].Id.String() == columnId {
+			return &columns[index]
+		}
+	}
+
+	return nil
+}
+
+// firstColumnOfStatus relies on the columns being ordered by their position
+func firstColumnOfStatus(columns []entities.Column, status string) *entities.Column {
+	for index := range columns {
+		if columns[index].Status == status {
+			return &columns[index]
+		}
+	}
+
+	return nil
+}
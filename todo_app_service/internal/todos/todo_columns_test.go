@@ -0,0 +1,129 @@
+package todos
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/todos/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"database/sql"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestService_ApplyTodoColumn(t *testing.T) {
+	reviewColumn := entities.Column{Id: uuid.Must(uuid.NewV4()), ListId: existingListId, Name: "review",
+		Status: string(constants.InProgress), WipLimit: sql.NullInt32{Int32: 2, Valid: true}}
+	doneColumn := entities.Column{Id: uuid.Must(uuid.NewV4()), ListId: existingListId, Name: "shipped", Status: string(constants.Done)}
+	columns := []entities.Column{reviewColumn, doneColumn}
+	reviewColumnId := reviewColumn.Id.String()
+	unknownColumnId := uuid.Must(uuid.NewV4()).String()
+
+	openTodo := &entities.Todo{Id: existingTodoId, ListId: existingListId, Status: string(constants.Open)}
+	reviewedTodo := &entities.Todo{Id: existingTodoId, ListId: existingListId, Status: string(constants.InProgress),
+		ColumnId: uuid.NullUUID{UUID: reviewColumn.Id, Valid: true}}
+
+	tests := []struct {
+		testName       string
+		todo           *entities.Todo
+		update         *models.Todo
+		mockColumnRepo func() *mocks.ColumnRepo
+		expectedStatus constants.TodoStatus
+		clearColumn    bool
+		err            error
+	}{
+		{
+			testName: "Todo moved to a column takes the status of the column",
+			todo:     openTodo,
+			update:   &models.Todo{ColumnId: &reviewColumnId},
+			mockColumnRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+				mRepo.EXPECT().GetListColumns(context.TODO(), existingListId.String()).Return(columns, nil).Once()
+				mRepo.EXPECT().CountColumnTodos(context.TODO(), &columns[0]).Return(1, nil).Once()
+
+				return mRepo
+			},
+			expectedStatus: constants.InProgress,
+		},
+		{
+			testName: "Failed to move todo to a column which holds its wip limit",
+			todo:     openTodo,
+			update:   &models.Todo{ColumnId: &reviewColumnId},
+			mockColumnRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+				mRepo.EXPECT().GetListColumns(context.TODO(), existingListId.String()).Return(columns, nil).Once()
+				mRepo.EXPECT().CountColumnTodos(context.TODO(), &columns[0]).Return(2, nil).Once()
+
+				return mRepo
+			},
+			err: application_errors.NewWipLimitExceededError(reviewColumn.Name, 2),
+		},
+		{
+			testName: "Failed to move todo to a column with another status than the requested one",
+			todo:     openTodo,
+			update:   &models.Todo{ColumnId: &reviewColumnId, Status: constants.Done},
+			mockColumnRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+				mRepo.EXPECT().GetListColumns(context.TODO(), existingListId.String()).Return(columns, nil).Once()
+
+				return mRepo
+			},
+			err: application_errors.ColumnStatusMismatchError,
+		},
+		{
+			testName: "Failed to move todo to a column of another list",
+			todo:     openTodo,
+			update:   &models.Todo{ColumnId: &unknownColumnId},
+			mockColumnRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+				mRepo.EXPECT().GetListColumns(context.TODO(), existingListId.String()).Return(columns, nil).Once()
+
+				return mRepo
+			},
+			err: application_errors.ColumnOutOfScopeError,
+		},
+		{
+			testName: "Todo whose status changes leaves its column",
+			todo:     reviewedTodo,
+			update:   &models.Todo{Status: constants.Done},
+			mockColumnRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+				mRepo.EXPECT().GetListColumns(context.TODO(), existingListId.String()).Return(columns, nil).Once()
+
+				return mRepo
+			},
+			expectedStatus: constants.Done,
+			clearColumn:    true,
+		},
+		{
+			testName: "Todo which keeps its column and status does not need the columns",
+			todo:     reviewedTodo,
+			update:   &models.Todo{Name: todoName},
+			mockColumnRepo: func() *mocks.ColumnRepo {
+				return &mocks.ColumnRepo{}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mColumnRepo := test.mockColumnRepo()
+
+			tService := NewService(nil, nil, mColumnRepo, nil, nil, nil, nil, nil, nil)
+			clearColumn, err := tService.applyTodoColumn(context.TODO(), test.todo, test.update)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.clearColumn, clearColumn)
+				require.Equal(t, test.expectedStatus, test.update.Status)
+			}
+
+			mock.AssertExpectationsForObjects(t, mColumnRepo)
+		})
+	}
+}
@@ -16,7 +16,7 @@ import (
 	"net/http"
 )
 
-//go:generate mockery --name=IService --output=./mocks --outpkg=mocks --filename=Iservice.go --with-expecter=true
+//go:generate mockery --name=todoService --exported --output=./mocks --outpkg=mocks --filename=todo_service.go --with-expecter=true
 type todoService interface {
 	CreateTodoRecord(ctx context.Context, todo *handler_models.CreateTodo, creator *models.User) (*models.Todo, error)
 	GetTodoRecords(ctx context.Context, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error)
@@ -28,8 +28,20 @@ type todoService interface {
 	DeleteTodosRecords(ctx context.Context) error
 	DeleteTodosRecordsByListId(ctx context.Context, listId string) error
 	UpdateTodoRecord(ctx context.Context, todoId string, todo *handler_models.UpdateTodo) (*models.Todo, error)
+	CreateSubtaskRecord(ctx context.Context, parentId string, subtask *handler_models.CreateSubtask, creator *models.User) (*models.Todo, error)
+	GetSubtasksRecords(ctx context.Context, f filters.SqlFilters, parentId string, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error)
+	DetachSubtasksRecords(ctx context.Context, parentId string) error
+	GetTodoBlockersRecords(ctx context.Context, f filters.SqlFilters, todoId string, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error)
+	GetBlockedTodosRecords(ctx context.Context, f filters.SqlFilters, todoId string, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error)
+	AddTodoBlockerRecord(ctx context.Context, todoId string, blockerId string) (*models.Todo, error)
+	RemoveTodoBlockerRecord(ctx context.Context, todoId string, blockerId string) error
+	MoveTodosRecords(ctx context.Context, todoIds []string, listId string, caller *models.User) ([]*models.Todo, error)
+	CopyTodosRecords(ctx context.Context, todoIds []string, listId string, caller *models.User) ([]*models.Todo, error)
+	ReorderTodoRecord(ctx context.Context, todoId string, reorder *handler_models.ReorderTodo) (*models.Todo, error)
+	BatchTodosRecords(ctx context.Context, batch *handler_models.BatchTodos, caller *models.User) (*models.BatchTodosResult, error)
 }
 
+//go:generate mockery --name=fieldsValidator --exported --output=./mocks --outpkg=mocks --filename=fields_validator.go --with-expecter=true
 type fieldsValidator interface {
 	Struct(st interface{}) error
 }
@@ -167,6 +179,15 @@ func (h *Handler) HandleGetTodos(w http.ResponseWriter, r *http.Request) {
 	}
 
 	overdue := utils.GetContentFromUrl(r, constants.OVERDUE)
+	excludeSubtasks := utils.GetContentFromUrl(r, constants.EXCLUDE_SUBTASKS)
+	label := utils.GetContentFromUrl(r, constants.LABEL)
+
+	sort := utils.GetContentFromUrl(r, constants.SORT)
+	if _, err = filters.ParseSort(sort, filters.TodoSortFields); err != nil {
+		log.C(ctx).Errorf("failed to get todos, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	tFilter := &filters.TodoFilters{
 		PaginationFilters: filters.PaginationFilters{
@@ -175,10 +196,13 @@ func (h *Handler) HandleGetTodos(w http.ResponseWriter, r *http.Request) {
 			After:  after,
 			Before: before,
 		},
-		Status:   status,
-		Priority: priority,
-		Overdue:  overdue,
-		Name:     name,
+		Status:          status,
+		Priority:        priority,
+		Overdue:         overdue,
+		Name:            name,
+		ExcludeSubtasks: excludeSubtasks,
+		Label:           label,
+		Sort:            sort,
 	}
 
 	resourceIdentifier := &resource_identifier.GenericResourceIdentifier{}
@@ -186,7 +210,7 @@ func (h *Handler) HandleGetTodos(w http.ResponseWriter, r *http.Request) {
 
 	todos, err := h.serv.GetTodoRecords(ctx, tFilter, resourceIdentifier)
 	if err != nil {
-		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
 		return
 	}
 
@@ -223,6 +247,14 @@ func (h *Handler) HandleDeleteTodo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if utils.GetContentFromUrl(r, constants.CASCADE) == constants.FALSE_VALUE {
+		if err = h.serv.DetachSubtasksRecords(ctx, todoId); err != nil {
+			log.C(ctx).Errorf("failed to delete todo, error %s when trying to detach its subtasks", err.Error())
+			utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
 	if err = h.serv.DeleteTodoRecord(ctx, todoId); err != nil {
 		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -400,6 +432,15 @@ func (h *Handler) HandleGetTodosByListId(w http.ResponseWriter, r *http.Request)
 	priority := utils.GetContentFromUrl(r, constants.PRIORITY)
 	name := utils.GetContentFromUrl(r, constants.NAME)
 	overdue := utils.GetContentFromUrl(r, constants.OVERDUE)
+	excludeSubtasks := utils.GetContentFromUrl(r, constants.EXCLUDE_SUBTASKS)
+	label := utils.GetContentFromUrl(r, constants.LABEL)
+
+	sort := utils.GetContentFromUrl(r, constants.SORT)
+	if _, err = filters.ParseSort(sort, filters.TodoSortFields); err != nil {
+		log.C(ctx).Errorf("failed to get todos, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	f := &filters.TodoFilters{
 		PaginationFilters: filters.PaginationFilters{
@@ -408,11 +449,14 @@ func (h *Handler) HandleGetTodosByListId(w http.ResponseWriter, r *http.Request)
 			Before: before,
 			Last:   last,
 		},
-		Status:   status,
-		Priority: priority,
-		ListID:   listId,
-		Name:     name,
-		Overdue:  overdue,
+		Status:          status,
+		Priority:        priority,
+		ListID:          listId,
+		Name:            name,
+		Overdue:         overdue,
+		ExcludeSubtasks: excludeSubtasks,
+		Label:           label,
+		Sort:            sort,
 	}
 
 	resourceIdentifier := &resource_identifier.GenericResourceIdentifier{}
@@ -521,3 +565,679 @@ func (h *Handler) HandleGetTodoByListId(w http.ResponseWriter, r *http.Request)
 		return
 	}
 }
+
+func (h *Handler) HandleSubtaskCreation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("creating subtask in todo handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction todo handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	parentId, err := utils.GetValueFromContext[string](ctx, middlewares2.TodoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create subtask, missing todo_id in context")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	var subtask handler_models.CreateSubtask
+	if err = json.NewDecoder(r.Body).Decode(&subtask); err != nil {
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, subtask)
+	if err != nil {
+		log.C(ctx).Error("failed to create subtask, error because one of the required fields is missing")
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	creator, err := utils.GetValueFromContext[*models.User](ctx, middlewares2.UserKey)
+	if err != nil {
+		log.C(ctx).Error("failed to create subtask, missing creator in context...")
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	modelTodo, err := h.serv.CreateSubtaskRecord(ctx, parentId, &subtask, creator)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create subtask of todo with id %s, error %s when calling todo service", parentId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(modelTodo); err != nil {
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to create subtask of todo with id %s, error %s", parentId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handler) HandleGetSubtasks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting subtasks in todo handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction todo handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	parentId, err := utils.GetValueFromContext[string](ctx, middlewares2.TodoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get subtasks, missing todo_id in context")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	first := utils.GetContentFromUrl(r, constants.FIRST)
+	after := utils.GetContentFromUrl(r, constants.AFTER)
+	before := utils.GetContentFromUrl(r, constants.BEFORE)
+	last := utils.GetContentFromUrl(r, constants.LAST)
+
+	if len(first) == 0 && len(last) == 0 {
+		first = constants.DEFAULT_LIMIT_VALUE
+	} else if len(first) != 0 && len(last) != 0 {
+		log.C(ctx).Warn("both first and last passed as query params...")
+		utils.EncodeError(w, "can't pass both first and last values as query params", http.StatusBadRequest)
+		return
+	}
+
+	status := utils.GetContentFromUrl(r, constants.STATUS)
+	priority := utils.GetContentFromUrl(r, constants.PRIORITY)
+	name := utils.GetContentFromUrl(r, constants.NAME)
+	overdue := utils.GetContentFromUrl(r, constants.OVERDUE)
+	label := utils.GetContentFromUrl(r, constants.LABEL)
+
+	sort := utils.GetContentFromUrl(r, constants.SORT)
+	if _, err = filters.ParseSort(sort, filters.TodoSortFields); err != nil {
+		log.C(ctx).Errorf("failed to get todos, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f := &filters.TodoFilters{
+		PaginationFilters: filters.PaginationFilters{
+			First:  first,
+			After:  after,
+			Before: before,
+			Last:   last,
+		},
+		Status:   status,
+		Priority: priority,
+		Name:     name,
+		Overdue:  overdue,
+		ParentID: parentId,
+		Label:    label,
+		Sort:     sort,
+	}
+
+	resourceIdentifier := &resource_identifier.GenericResourceIdentifier{}
+	resourceIdentifier.SetResourceIdentifier(constants.TodosIdentifier)
+
+	subtasks, err := h.serv.GetSubtasksRecords(ctx, f, parentId, resourceIdentifier)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get subtasks of todo with id %s, error in todo service", parentId)
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(subtasks); err != nil {
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get subtasks of todo with id %s, error %s", parentId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleGetTodoBlockers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting blockers of todo in todo handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction todo handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](ctx, middlewares2.TodoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get blockers, missing todo_id in context")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	first := utils.GetContentFromUrl(r, constants.FIRST)
+	after := utils.GetContentFromUrl(r, constants.AFTER)
+	before := utils.GetContentFromUrl(r, constants.BEFORE)
+	last := utils.GetContentFromUrl(r, constants.LAST)
+
+	if len(first) == 0 && len(last) == 0 {
+		first = constants.DEFAULT_LIMIT_VALUE
+	} else if len(first) != 0 && len(last) != 0 {
+		log.C(ctx).Warn("both first and last passed as query params...")
+		utils.EncodeError(w, "can't pass both first and last values as query params", http.StatusBadRequest)
+		return
+	}
+
+	status := utils.GetContentFromUrl(r, constants.STATUS)
+	priority := utils.GetContentFromUrl(r, constants.PRIORITY)
+	name := utils.GetContentFromUrl(r, constants.NAME)
+	overdue := utils.GetContentFromUrl(r, constants.OVERDUE)
+	label := utils.GetContentFromUrl(r, constants.LABEL)
+
+	sort := utils.GetContentFromUrl(r, constants.SORT)
+	if _, err = filters.ParseSort(sort, filters.TodoSortFields); err != nil {
+		log.C(ctx).Errorf("failed to get todos, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f := &filters.TodoFilters{
+		PaginationFilters: filters.PaginationFilters{
+			First:  first,
+			After:  after,
+			Before: before,
+			Last:   last,
+		},
+		Status:       status,
+		Priority:     priority,
+		Name:         name,
+		Overdue:      overdue,
+		Label:        label,
+		BlocksTodoID: todoId,
+		Sort:         sort,
+	}
+
+	resourceIdentifier := &resource_identifier.GenericResourceIdentifier{}
+	resourceIdentifier.SetResourceIdentifier(constants.TodosIdentifier)
+
+	todos, err := h.serv.GetTodoBlockersRecords(ctx, f, todoId, resourceIdentifier)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get blockers of todo with id %s, error in todo service", todoId)
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(todos); err != nil {
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get blockers of todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleGetBlockedTodos(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting todos blocked by todo in todo handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction todo handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](ctx, middlewares2.TodoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get blocked todos, missing todo_id in context")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	first := utils.GetContentFromUrl(r, constants.FIRST)
+	after := utils.GetContentFromUrl(r, constants.AFTER)
+	before := utils.GetContentFromUrl(r, constants.BEFORE)
+	last := utils.GetContentFromUrl(r, constants.LAST)
+
+	if len(first) == 0 && len(last) == 0 {
+		first = constants.DEFAULT_LIMIT_VALUE
+	} else if len(first) != 0 && len(last) != 0 {
+		log.C(ctx).Warn("both first and last passed as query params...")
+		utils.EncodeError(w, "can't pass both first and last values as query params", http.StatusBadRequest)
+		return
+	}
+
+	status := utils.GetContentFromUrl(r, constants.STATUS)
+	priority := utils.GetContentFromUrl(r, constants.PRIORITY)
+	name := utils.GetContentFromUrl(r, constants.NAME)
+	overdue := utils.GetContentFromUrl(r, constants.OVERDUE)
+	label := utils.GetContentFromUrl(r, constants.LABEL)
+
+	sort := utils.GetContentFromUrl(r, constants.SORT)
+	if _, err = filters.ParseSort(sort, filters.TodoSortFields); err != nil {
+		log.C(ctx).Errorf("failed to get todos, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f := &filters.TodoFilters{
+		PaginationFilters: filters.PaginationFilters{
+			First:  first,
+			After:  after,
+			Before: before,
+			Last:   last,
+		},
+		Status:          status,
+		Priority:        priority,
+		Name:            name,
+		Overdue:         overdue,
+		Label:           label,
+		BlockedByTodoID: todoId,
+		Sort:            sort,
+	}
+
+	resourceIdentifier := &resource_identifier.GenericResourceIdentifier{}
+	resourceIdentifier.SetResourceIdentifier(constants.TodosIdentifier)
+
+	todos, err := h.serv.GetBlockedTodosRecords(ctx, f, todoId, resourceIdentifier)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get blocked todos of todo with id %s, error in todo service", todoId)
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(todos); err != nil {
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get blocked todos of todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleAddTodoBlocker(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("adding blocker to todo in todo handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction todo handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](ctx, middlewares2.TodoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add blocker, missing todo_id in context")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	var addBlocker handler_models.AddBlocker
+	if err = json.NewDecoder(r.Body).Decode(&addBlocker); err != nil {
+		log.C(ctx).Errorf("failed to decode add blocker handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, addBlocker)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add blocker, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	blocker, err := h.serv.AddTodoBlockerRecord(ctx, todoId, addBlocker.BlockerId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add blocker to todo with id %s, error %s when calling todo service", todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(blocker); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to add blocker to todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handler) HandleRemoveTodoBlocker(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("removing blocker from todo in todo handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction todo handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](ctx, middlewares2.TodoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to remove blocker, missing todo_id in context")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	blockerId, err := utils.GetValueFromContext[string](ctx, middlewares2.BlockerId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to remove blocker, missing blocker_id in context")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_BLOCKER_ID, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.serv.RemoveTodoBlockerRecord(ctx, todoId, blockerId); err != nil {
+		log.C(ctx).Errorf("failed to remove blocker from todo with id %s, error %s when calling todo service", todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to remove blocker from todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type transferTodosFunc func(ctx context.Context, todoIds []string, listId string, caller *models.User) ([]*models.Todo, error)
+
+func (h *Handler) HandleMoveTodo(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("moving todo in todo handler")
+	h.handleTransferTodo(w, r, h.serv.MoveTodosRecords, http.StatusOK)
+}
+
+func (h *Handler) HandleCopyTodo(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("copying todo in todo handler")
+	h.handleTransferTodo(w, r, h.serv.CopyTodosRecords, http.StatusCreated)
+}
+
+func (h *Handler) HandleMoveTodos(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("moving todos in todo handler")
+	h.handleTransferTodos(w, r, h.serv.MoveTodosRecords, http.StatusOK)
+}
+
+func (h *Handler) HandleCopyTodos(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("copying todos in todo handler")
+	h.handleTransferTodos(w, r, h.serv.CopyTodosRecords, http.StatusCreated)
+}
+
+// handleTransferTodo moves or copies the todo from the request path to the list from the request body
+func (h *Handler) handleTransferTodo(w http.ResponseWriter, r *http.Request, transfer transferTodosFunc, successStatus int) {
+	ctx := r.Context()
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction todo handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](ctx, middlewares2.TodoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to transfer todo, missing todo_id in context")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	caller, err := utils.GetValueFromContext[*models.User](ctx, middlewares2.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get caller due to an error %s when trying to get value from context in todo handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	var transferTodo handler_models.TransferTodo
+	if err = json.NewDecoder(r.Body).Decode(&transferTodo); err != nil {
+		log.C(ctx).Errorf("failed to decode transfer todo handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, transferTodo)
+	if err != nil {
+		log.C(ctx).Errorf("failed to transfer todo, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	todos, err := transfer(ctx, []string{todoId}, transferTodo.ListId, caller)
+	if err != nil {
+		log.C(ctx).Errorf("failed to transfer todo with id %s to list with id %s, error %s when calling todo service", todoId, transferTodo.ListId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	w.WriteHeader(successStatus)
+	if err = json.NewEncoder(w).Encode(todos[0]); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to transfer todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleTransferTodos moves or copies all the todos from the request body to the list from the request body
+func (h *Handler) handleTransferTodos(w http.ResponseWriter, r *http.Request, transfer transferTodosFunc, successStatus int) {
+	ctx := r.Context()
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction todo handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	caller, err := utils.GetValueFromContext[*models.User](ctx, middlewares2.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get caller due to an error %s when trying to get value from context in todo handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	var transferTodos handler_models.TransferTodos
+	if err = json.NewDecoder(r.Body).Decode(&transferTodos); err != nil {
+		log.C(ctx).Errorf("failed to decode transfer todos handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, transferTodos)
+	if err != nil {
+		log.C(ctx).Errorf("failed to transfer todos, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	todos, err := transfer(ctx, transferTodos.TodoIds, transferTodos.ListId, caller)
+	if err != nil {
+		log.C(ctx).Errorf("failed to transfer todos to list with id %s, error %s when calling todo service", transferTodos.ListId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	w.WriteHeader(successStatus)
+	if err = json.NewEncoder(w).Encode(todos); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to transfer todos to list with id %s, error %s", transferTodos.ListId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleReorderTodo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("reordering todo in todo handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction todo handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](ctx, middlewares2.TodoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to reorder todo, missing todo_id in context")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	var reorderTodo handler_models.ReorderTodo
+	if err = json.NewDecoder(r.Body).Decode(&reorderTodo); err != nil {
+		log.C(ctx).Errorf("failed to decode reorder todo handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, reorderTodo)
+	if err != nil {
+		log.C(ctx).Errorf("failed to reorder todo, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	todo, err := h.serv.ReorderTodoRecord(ctx, todoId, &reorderTodo)
+	if err != nil {
+		log.C(ctx).Errorf("failed to reorder todo with id %s, error %s when calling todo service", todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(todo); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to reorder todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// HandleBatchTodos executes all the create, update and delete operations from the request body in a single transaction,
+// the transaction is committed only when the service reports that the batch should be kept
+func (h *Handler) HandleBatchTodos(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("executing batch of todo operations in todo handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction todo handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	caller, err := utils.GetValueFromContext[*models.User](ctx, middlewares2.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get caller due to an error %s when trying to get value from context in todo handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	var batch handler_models.BatchTodos
+	if err = json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		log.C(ctx).Errorf("failed to decode batch todos handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, batch)
+	if err != nil {
+		log.C(ctx).Errorf("failed to execute batch, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.serv.BatchTodosRecords(ctx, &batch, caller)
+	if err != nil {
+		log.C(ctx).Errorf("failed to execute batch of todo operations, error %s when calling todo service", err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if result.Committed {
+		if err = tx.Commit(); err != nil {
+			log.C(ctx).Errorf("failed to commit transaction when trying to execute batch of todo operations, error %s", err.Error())
+			utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if err = json.NewEncoder(w).Encode(result); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
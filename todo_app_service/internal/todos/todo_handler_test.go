@@ -1,5 +1,24 @@
 package todos
 
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/todos/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
 /*
 import (
 	"bytes"
@@ -388,3 +407,345 @@ func TestHandler_HandleUpdateTodoRecord(t *testing.T) {
 	}
 }
 */
+
+func TestHandler_HandleAddTodoBlocker(t *testing.T) {
+	blocker := &models.Todo{Id: nonExistingTodoId.String(), Name: todoName, ListId: existingListId.String()}
+	cycleError := application_errors.NewDependencyCycleError(existingTodoId.String(), nonExistingTodoId.String())
+
+	tests := []struct {
+		testName        string
+		body            string
+		mockTodoService func() *mocks.TodoService
+		dbMock          func(mck sqlmock.Sqlmock)
+		expectedStatus  int
+		expectedBlocker *models.Todo
+		err             error
+	}{
+		{
+			testName: "Successfully adding blocker",
+			body:     `{"blocker_id":"` + nonExistingTodoId.String() + `"}`,
+			mockTodoService: func() *mocks.TodoService {
+				mService := &mocks.TodoService{}
+
+				mService.EXPECT().
+					AddTodoBlockerRecord(mock.Anything, existingTodoId.String(), nonExistingTodoId.String()).
+					Return(blocker, nil).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			expectedStatus:  http.StatusOK,
+			expectedBlocker: blocker,
+		},
+		{
+			testName: "Failed to add blocker which would create a dependency cycle",
+			body:     `{"blocker_id":"` + nonExistingTodoId.String() + `"}`,
+			mockTodoService: func() *mocks.TodoService {
+				mService := &mocks.TodoService{}
+
+				mService.EXPECT().
+					AddTodoBlockerRecord(mock.Anything, existingTodoId.String(), nonExistingTodoId.String()).
+					Return(nil, cycleError).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusConflict,
+			err:            cycleError,
+		},
+		{
+			testName: "Failed to add blocker from another list",
+			body:     `{"blocker_id":"` + nonExistingTodoId.String() + `"}`,
+			mockTodoService: func() *mocks.TodoService {
+				mService := &mocks.TodoService{}
+
+				mService.EXPECT().
+					AddTodoBlockerRecord(mock.Anything, existingTodoId.String(), nonExistingTodoId.String()).
+					Return(nil, application_errors.BlockerOutOfScopeError).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusBadRequest,
+			err:            application_errors.BlockerOutOfScopeError,
+		},
+		{
+			testName: "Failed to add blocker with invalid id",
+			body:     `{"blocker_id":"invalid"}`,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusBadRequest,
+			err:            application_errors.NewEmptyFieldError("BlockerId"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			mService := &mocks.TodoService{}
+			if test.mockTodoService != nil {
+				mService = test.mockTodoService()
+			}
+
+			handler := NewHandler(mService, validator.New(), persistence.NewSqlDb(db))
+
+			req := httptest.NewRequest(http.MethodPost, "/todos/"+existingTodoId.String()+"/blockers", bytes.NewBufferString(test.body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.TodoId, existingTodoId.String()))
+			rr := httptest.NewRecorder()
+
+			handler.HandleAddTodoBlocker(rr, req)
+
+			require.Equal(t, test.expectedStatus, rr.Code)
+			if test.err != nil {
+				errorMatchHelper(t, rr, test.err)
+			} else {
+				var received models.Todo
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &received))
+				require.Equal(t, test.expectedBlocker, &received)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, mService)
+		})
+	}
+}
+
+func TestHandler_HandleBatchTodos(t *testing.T) {
+	caller := &models.User{Id: assigneeId.String(), Role: writerRole}
+	body := `{"mode":"per_item","operations":[{"op":"delete","todo_id":"` + existingTodoId.String() + `"}]}`
+
+	tests := []struct {
+		testName       string
+		body           string
+		result         *models.BatchTodosResult
+		dbMock         func(mck sqlmock.Sqlmock)
+		expectedStatus int
+		err            error
+	}{
+		{
+			testName: "Successfully committing batch which the service kept",
+			body:     body,
+			result: &models.BatchTodosResult{Committed: true, Results: []*models.BatchTodoResult{
+				{Index: 0, Op: "delete", Status: http.StatusNoContent},
+			}},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			testName: "Rolling back batch which the service did not keep",
+			body:     body,
+			result: &models.BatchTodosResult{Committed: false, Results: []*models.BatchTodoResult{
+				{Index: 0, Op: "delete", Status: http.StatusNotFound, Error: "todo not found"},
+			}},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			testName: "Failed to execute batch without operations",
+			body:     `{"operations":[]}`,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusBadRequest,
+			err:            application_errors.NewEmptyFieldError("Operations"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			mService := &mocks.TodoService{}
+			if test.result != nil {
+				mService.EXPECT().
+					BatchTodosRecords(mock.Anything, mock.Anything, caller).
+					Return(test.result, nil).Once()
+			}
+
+			handler := NewHandler(mService, validator.New(), persistence.NewSqlDb(db))
+
+			req := httptest.NewRequest(http.MethodPost, "/todos/batch", bytes.NewBufferString(test.body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserKey, caller))
+			rr := httptest.NewRecorder()
+
+			handler.HandleBatchTodos(rr, req)
+
+			require.Equal(t, test.expectedStatus, rr.Code)
+			if test.err != nil {
+				errorMatchHelper(t, rr, test.err)
+			} else {
+				var received models.BatchTodosResult
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &received))
+				require.Equal(t, test.result, &received)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, mService)
+		})
+	}
+}
+
+func TestHandler_HandleReorderTodo(t *testing.T) {
+	anchorId := nonExistingTodoId.String()
+	reordered := &models.Todo{Id: existingTodoId.String(), Name: todoName, ListId: existingListId.String(), Position: "1.5"}
+
+	tests := []struct {
+		testName        string
+		body            string
+		mockTodoService func() *mocks.TodoService
+		dbMock          func(mck sqlmock.Sqlmock)
+		expectedStatus  int
+		expectedTodo    *models.Todo
+		err             error
+	}{
+		{
+			testName: "Successfully placing todo after anchor",
+			body:     `{"after":"` + anchorId + `"}`,
+			mockTodoService: func() *mocks.TodoService {
+				mService := &mocks.TodoService{}
+
+				mService.EXPECT().
+					ReorderTodoRecord(mock.Anything, existingTodoId.String(), &handler_models.ReorderTodo{After: &anchorId}).
+					Return(reordered, nil).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			expectedStatus: http.StatusOK,
+			expectedTodo:   reordered,
+		},
+		{
+			testName: "Successfully placing todo before anchor",
+			body:     `{"before":"` + anchorId + `"}`,
+			mockTodoService: func() *mocks.TodoService {
+				mService := &mocks.TodoService{}
+
+				mService.EXPECT().
+					ReorderTodoRecord(mock.Anything, existingTodoId.String(), &handler_models.ReorderTodo{Before: &anchorId}).
+					Return(reordered, nil).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			expectedStatus: http.StatusOK,
+			expectedTodo:   reordered,
+		},
+		{
+			testName: "Failed to place todo next to anchor from another list",
+			body:     `{"after":"` + anchorId + `"}`,
+			mockTodoService: func() *mocks.TodoService {
+				mService := &mocks.TodoService{}
+
+				mService.EXPECT().
+					ReorderTodoRecord(mock.Anything, existingTodoId.String(), &handler_models.ReorderTodo{After: &anchorId}).
+					Return(nil, application_errors.ReorderOutOfScopeError).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusBadRequest,
+			err:            application_errors.ReorderOutOfScopeError,
+		},
+		{
+			testName: "Failed to reorder todo with both before and after",
+			body:     `{"before":"` + anchorId + `","after":"` + anchorId + `"}`,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusBadRequest,
+			err:            application_errors.NewEmptyFieldError("Before"),
+		},
+		{
+			testName: "Failed to reorder todo without anchor",
+			body:     `{}`,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusBadRequest,
+			err:            application_errors.NewEmptyFieldError("Before"),
+		},
+		{
+			testName: "Failed to reorder todo with invalid anchor id",
+			body:     `{"after":"invalid"}`,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus: http.StatusBadRequest,
+			err:            application_errors.NewEmptyFieldError("After"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			mService := &mocks.TodoService{}
+			if test.mockTodoService != nil {
+				mService = test.mockTodoService()
+			}
+
+			handler := NewHandler(mService, validator.New(), persistence.NewSqlDb(db))
+
+			req := httptest.NewRequest(http.MethodPost, "/todos/"+existingTodoId.String()+"/reorder", bytes.NewBufferString(test.body))
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.TodoId, existingTodoId.String()))
+			rr := httptest.NewRecorder()
+
+			handler.HandleReorderTodo(rr, req)
+
+			require.Equal(t, test.expectedStatus, rr.Code)
+			if test.err != nil {
+				errorMatchHelper(t, rr, test.err)
+			} else {
+				var received models.Todo
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &received))
+				require.Equal(t, test.expectedTodo, &received)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, mService)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package todos
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"time"
+)
+
+// nextOccurrence returns the todo that follows a completed recurring todo, or nil when the series has ended.
+// Periods that already passed while the todo was open are skipped, so the next due date always lies after now.
+// Monthly series keep the day of month they were started on, so a series due on the 31st falls on the last day
+// of shorter months and returns to the 31st afterwards.
+func nextOccurrence(todo *models.Todo, recurrence *models.Recurrence, dueDate time.Time, now time.Time) *models.Todo {
+	if recurrence.Count != nil && *recurrence.Count <= 1 {
+		return nil
+	}
+
+	anchorDay := recurrence.AnchorDay
+	if anchorDay == 0 {
+		anchorDay = dueDate.Day()
+	}
+
+	nextDueDate := rollForward(dueDate, recurrence.Frequency, recurrence.Interval, anchorDay)
+	for !nextDueDate.After(now) {
+		nextDueDate = rollForward(nextDueDate, recurrence.Frequency, recurrence.Interval, anchorDay)
+	}
+	if recurrence.Until != nil && nextDueDate.After(*recurrence.Until) {
+		return nil
+	}
+
+	nextRecurrence := &models.Recurrence{
+		Frequency: recurrence.Frequency,
+		Interval:  recurrence.Interval,
+		Until:     recurrence.Until,
+	}
+	if recurrence.Count != nil {
+		remaining := *recurrence.Count - 1
+		nextRecurrence.Count = &remaining
+	}
+	if recurrence.Frequency == constants.Monthly {
+		nextRecurrence.AnchorDay = anchorDay
+	}
+
+	return &models.Todo{
+		Name:        todo.Name,
+		Description: todo.Description,
+		ListId:      todo.ListId,
+		Priority:    todo.Priority,
+		AssignedTo:  todo.AssignedTo,
+		DueDate:     &nextDueDate,
+		ParentId:    todo.ParentId,
+		Recurrence:  nextRecurrence,
+		Estimate:    todo.Estimate,
+	}
+}
+
+func rollForward(dueDate time.Time, frequency constants.RecurrenceFrequency, interval int, anchorDay int) time.Time {
+	if interval < 1 {
+		interval = constants.DEFAULT_RECURRENCE_INTERVAL
+	}
+
+	switch frequency {
+	case constants.Weekly:
+		return dueDate.AddDate(0, 0, 7*interval)
+	case constants.Monthly:
+		return addMonthsClamped(dueDate, interval, anchorDay)
+	default:
+		return dueDate.AddDate(0, 0, interval)
+	}
+}
+
+// addMonthsClamped moves the date to the anchor day of the target month, the anchor day is clamped to the end
+// of the target month instead of overflowing into the next one
+func addMonthsClamped(t time.Time, months int, anchorDay int) time.Time {
+	firstOfTargetMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfTargetMonth.AddDate(0, 1, -1).Day()
+
+	day := anchorDay
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return firstOfTargetMonth.AddDate(0, 0, day-1)
+}
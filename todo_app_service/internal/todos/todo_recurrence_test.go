@@ -0,0 +1,202 @@
+package todos
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRollForward(t *testing.T) {
+	tests := []struct {
+		testName        string
+		dueDate         time.Time
+		frequency       constants.RecurrenceFrequency
+		interval        int
+		anchorDay       int
+		expectedDueDate time.Time
+	}{
+		{
+			testName:        "Rolling daily series forward by the interval",
+			dueDate:         recurrenceDate(2025, time.January, 30),
+			frequency:       constants.Daily,
+			interval:        3,
+			anchorDay:       30,
+			expectedDueDate: recurrenceDate(2025, time.February, 2),
+		},
+		{
+			testName:        "Rolling weekly series forward by whole weeks",
+			dueDate:         recurrenceDate(2025, time.January, 30),
+			frequency:       constants.Weekly,
+			interval:        2,
+			anchorDay:       30,
+			expectedDueDate: recurrenceDate(2025, time.February, 13),
+		},
+		{
+			testName:        "Rolling series forward by the default interval when interval is not positive",
+			dueDate:         recurrenceDate(2025, time.January, 30),
+			frequency:       constants.Daily,
+			interval:        0,
+			anchorDay:       30,
+			expectedDueDate: recurrenceDate(2025, time.January, 31),
+		},
+		{
+			testName:        "Clamping monthly series to the end of a shorter month",
+			dueDate:         recurrenceDate(2025, time.January, 31),
+			frequency:       constants.Monthly,
+			interval:        1,
+			anchorDay:       31,
+			expectedDueDate: recurrenceDate(2025, time.February, 28),
+		},
+		{
+			testName:        "Clamping monthly series to the end of february in a leap year",
+			dueDate:         recurrenceDate(2024, time.January, 31),
+			frequency:       constants.Monthly,
+			interval:        1,
+			anchorDay:       31,
+			expectedDueDate: recurrenceDate(2024, time.February, 29),
+		},
+		{
+			testName:        "Returning monthly series to the anchor day after a shorter month",
+			dueDate:         recurrenceDate(2025, time.February, 28),
+			frequency:       constants.Monthly,
+			interval:        1,
+			anchorDay:       31,
+			expectedDueDate: recurrenceDate(2025, time.March, 31),
+		},
+		{
+			testName:        "Rolling monthly series over the end of the year",
+			dueDate:         recurrenceDate(2025, time.November, 30),
+			frequency:       constants.Monthly,
+			interval:        3,
+			anchorDay:       31,
+			expectedDueDate: recurrenceDate(2026, time.February, 28),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			nextDueDate := rollForward(test.dueDate, test.frequency, test.interval, test.anchorDay)
+			require.Equal(t, test.expectedDueDate, nextDueDate)
+		})
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	tests := []struct {
+		testName           string
+		recurrence         *models.Recurrence
+		dueDate            time.Time
+		now                time.Time
+		expectedDueDate    *time.Time
+		expectedRecurrence *models.Recurrence
+	}{
+		{
+			testName:           "Creating next occurrence of monthly series anchored to the due date",
+			recurrence:         &models.Recurrence{Frequency: constants.Monthly, Interval: 1},
+			dueDate:            recurrenceDate(2025, time.January, 31),
+			now:                recurrenceDate(2025, time.January, 20),
+			expectedDueDate:    recurrenceDatePtr(2025, time.February, 28),
+			expectedRecurrence: &models.Recurrence{Frequency: constants.Monthly, Interval: 1, AnchorDay: 31},
+		},
+		{
+			testName:           "Creating next occurrence of monthly series from its stored anchor day",
+			recurrence:         &models.Recurrence{Frequency: constants.Monthly, Interval: 1, AnchorDay: 31},
+			dueDate:            recurrenceDate(2025, time.February, 28),
+			now:                recurrenceDate(2025, time.February, 20),
+			expectedDueDate:    recurrenceDatePtr(2025, time.March, 31),
+			expectedRecurrence: &models.Recurrence{Frequency: constants.Monthly, Interval: 1, AnchorDay: 31},
+		},
+		{
+			testName:           "Skipping periods which passed while the todo was open",
+			recurrence:         &models.Recurrence{Frequency: constants.Monthly, Interval: 1},
+			dueDate:            recurrenceDate(2025, time.January, 31),
+			now:                recurrenceDate(2025, time.April, 5),
+			expectedDueDate:    recurrenceDatePtr(2025, time.April, 30),
+			expectedRecurrence: &models.Recurrence{Frequency: constants.Monthly, Interval: 1, AnchorDay: 31},
+		},
+		{
+			testName:           "Creating next occurrence of weekly series without an anchor day",
+			recurrence:         &models.Recurrence{Frequency: constants.Weekly, Interval: 1},
+			dueDate:            recurrenceDate(2025, time.January, 31),
+			now:                recurrenceDate(2025, time.January, 31),
+			expectedDueDate:    recurrenceDatePtr(2025, time.February, 7),
+			expectedRecurrence: &models.Recurrence{Frequency: constants.Weekly, Interval: 1},
+		},
+		{
+			testName:           "Decrementing the remaining count of the series",
+			recurrence:         &models.Recurrence{Frequency: constants.Daily, Interval: 1, Count: intPtr(3)},
+			dueDate:            recurrenceDate(2025, time.January, 31),
+			now:                recurrenceDate(2025, time.January, 31),
+			expectedDueDate:    recurrenceDatePtr(2025, time.February, 1),
+			expectedRecurrence: &models.Recurrence{Frequency: constants.Daily, Interval: 1, Count: intPtr(2)},
+		},
+		{
+			testName:   "Ending series after its last counted occurrence",
+			recurrence: &models.Recurrence{Frequency: constants.Daily, Interval: 1, Count: intPtr(1)},
+			dueDate:    recurrenceDate(2025, time.January, 31),
+			now:        recurrenceDate(2025, time.January, 31),
+		},
+		{
+			testName: "Keeping the end of the series when next occurrence is due before it",
+			recurrence: &models.Recurrence{Frequency: constants.Weekly, Interval: 1,
+				Until: recurrenceDatePtr(2025, time.February, 7)},
+			dueDate:         recurrenceDate(2025, time.January, 31),
+			now:             recurrenceDate(2025, time.January, 31),
+			expectedDueDate: recurrenceDatePtr(2025, time.February, 7),
+			expectedRecurrence: &models.Recurrence{Frequency: constants.Weekly, Interval: 1,
+				Until: recurrenceDatePtr(2025, time.February, 7)},
+		},
+		{
+			testName: "Ending series when next occurrence would be due after its end",
+			recurrence: &models.Recurrence{Frequency: constants.Weekly, Interval: 1,
+				Until: recurrenceDatePtr(2025, time.February, 6)},
+			dueDate: recurrenceDate(2025, time.January, 31),
+			now:     recurrenceDate(2025, time.January, 31),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			todo := &models.Todo{
+				Name:     todoName,
+				ListId:   existingListId.String(),
+				Priority: constants.Priority(priority),
+			}
+
+			next := nextOccurrence(todo, test.recurrence, test.dueDate, test.now)
+			if test.expectedDueDate == nil {
+				require.Nil(t, next)
+				return
+			}
+
+			require.NotNil(t, next)
+			require.Equal(t, test.expectedDueDate, next.DueDate)
+			require.Equal(t, test.expectedRecurrence, next.Recurrence)
+			require.Equal(t, todo.Name, next.Name)
+			require.Equal(t, todo.ListId, next.ListId)
+			require.Equal(t, todo.Priority, next.Priority)
+		})
+	}
+}
+
+func TestNextOccurrence_MonthlySeriesDoesNotDrift(t *testing.T) {
+	recurrence := &models.Recurrence{Frequency: constants.Monthly, Interval: 1}
+	dueDate := recurrenceDate(2025, time.January, 31)
+
+	expectedDueDates := []time.Time{
+		recurrenceDate(2025, time.February, 28),
+		recurrenceDate(2025, time.March, 31),
+		recurrenceDate(2025, time.April, 30),
+		recurrenceDate(2025, time.May, 31),
+	}
+
+	for _, expectedDueDate := range expectedDueDates {
+		next := nextOccurrence(&models.Todo{}, recurrence, dueDate, dueDate)
+		require.NotNil(t, next)
+		require.Equal(t, expectedDueDate, *next.DueDate)
+
+		recurrence, dueDate = next.Recurrence, *next.DueDate
+	}
+}
@@ -13,14 +13,16 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/lib/pq"
+	"time"
 )
 
 type genericRepository interface {
-	GetPaginationInfo(ctx context.Context, sourceName string, filter string, params []interface{}) (*entities.PaginationInfo, error)
+	GetSortedPaginationInfo(ctx context.Context, sourceName string, filter string, sortExpression string, direction string, params []interface{}) (*entities.PaginationInfo, error)
 }
 
 type sqlDecoratorFactory interface {
-	CreateSqlDecorator(ctx context.Context, f sql_query_decorators.Filters, initialQuery string) (sql_query_decorators.SqlQueryRetriever, error)
+	CreateSqlDecorator(ctx context.Context, f sql_query_decorators.Filters, initialQuery string, initialParams []interface{}) (sql_query_decorators.SqlQueryRetriever, error)
 }
 
 type repository struct {
@@ -44,20 +46,26 @@ func (r *repository) GetTodos(ctx context.Context, f filters.SqlFilters) ([]enti
 		return nil, err
 	}
 
-	baseQuery := `SELECT id,name,description,list_id,status,created_at,last_updated,assigned_to,due_date,priority, COUNT(*) OVER() AS total_count
+	baseQuery := `SELECT id,name,description,list_id,status,created_at,last_updated,assigned_to,due_date,priority,parent_id,recurrence_frequency,recurrence_interval,recurrence_until,recurrence_count,position,column_id,estimate, COUNT(*) OVER() AS total_count
 FROM todos`
 	filteringClause, params := f.BuildSQLFiltering()
 	baseQuery += filteringClause
 
-	decorator, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery)
+	decorator, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery, params)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get todos, error when calling factory function")
 		return nil, err
 	}
 
-	sqlQuery := decorator.DetermineCorrectSqlQuery(ctx)
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to parse sort, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQuery, params := decorator.DetermineCorrectSqlQuery(ctx)
 	completeSqlQuery := fmt.Sprintf(`SELECT id,name,description,list_id,status,created_at,
-       last_updated,assigned_to,due_date,priority FROM (%s) ORDER BY id`, sqlQuery)
+       last_updated,assigned_to,due_date,priority,parent_id,recurrence_frequency,recurrence_interval,recurrence_until,recurrence_count,position,column_id,estimate FROM (%s) %s`, sqlQuery, sql_query_decorators.OrderByClause(sort, sort.Direction))
 
 	var todos []entities.Todo
 	if err = persist.SelectContext(ctx, &todos, completeSqlQuery, params...); err != nil {
@@ -77,7 +85,8 @@ func (*repository) DeleteTodosByListId(ctx context.Context, listId string) error
 		return err
 	}
 
-	sqlQueryString := `DELETE FROM todos WHERE list_id = $1`
+	sqlQueryString := `UPDATE todos SET deleted_at = NOW(), deleted_by = history_actor()
+WHERE list_id = $1 AND deleted_at IS NULL`
 
 	_, err = persist.ExecContext(ctx, sqlQueryString, listId)
 	if err != nil {
@@ -98,8 +107,9 @@ func (*repository) GetTodo(ctx context.Context, todoId string) (*entities.Todo,
 	}
 
 	sqlQueryString := `SELECT id, name, description, list_id, status, 
-       					created_at, last_updated, assigned_to, due_date, priority FROM todos 
-       					WHERE id = $1`
+       					created_at, last_updated, assigned_to, due_date, priority, parent_id,
+       					recurrence_frequency, recurrence_interval, recurrence_until, recurrence_count, recurrence_anchor_day, position, column_id, estimate FROM todos 
+       					WHERE id = $1 AND deleted_at IS NULL`
 
 	entity := &entities.Todo{}
 	if err = persist.GetContext(ctx, entity, sqlQueryString, todoId); err != nil {
@@ -123,7 +133,8 @@ func (*repository) DeleteTodo(ctx context.Context, todoId string) error {
 		return err
 	}
 
-	sqlQueryString := `DELETE FROM todos WHERE id = $1`
+	sqlQueryString := `UPDATE todos SET deleted_at = NOW(), deleted_by = history_actor()
+WHERE id = $1 AND deleted_at IS NULL`
 
 	if _, err = persist.ExecContext(ctx, sqlQueryString, todoId); err != nil {
 		log.C(ctx).Errorf("failed to delete todo due to a database error %s", err.Error())
@@ -142,7 +153,7 @@ func (*repository) DeleteTodos(ctx context.Context) error {
 		return err
 	}
 
-	sqlQueryString := `DELETE FROM todos`
+	sqlQueryString := `UPDATE todos SET deleted_at = NOW(), deleted_by = history_actor() WHERE deleted_at IS NULL`
 
 	if _, err = persist.ExecContext(ctx, sqlQueryString); err != nil {
 		log.C(ctx).Errorf("failed to delete todos due to a database errror %s", err.Error())
@@ -162,8 +173,11 @@ func (r *repository) CreateTodo(ctx context.Context, entity *entities.Todo) (*en
 	}
 
 	sqlQueryString := `INSERT INTO todos(id, name, description, 
-                  list_id, created_at, last_updated, assigned_to, due_date, priority) VALUES(:id,:name,:description,
-                  :list_id,:created_at,:last_updated,:assigned_to,:due_date,:priority)`
+                  list_id, created_at, last_updated, assigned_to, due_date, priority, parent_id,
+                  recurrence_frequency, recurrence_interval, recurrence_until, recurrence_count, recurrence_anchor_day, estimate, position) VALUES(:id,:name,:description,
+                  :list_id,:created_at,:last_updated,:assigned_to,:due_date,:priority,:parent_id,
+                  :recurrence_frequency,:recurrence_interval,:recurrence_until,:recurrence_count,:recurrence_anchor_day,:estimate,
+                  COALESCE(CAST(NULLIF(:position, '') AS NUMERIC), (SELECT COALESCE(MAX(position), 0) + 1 FROM todos WHERE list_id = :list_id)))`
 
 	_, err = persist.NamedExecContext(ctx, sqlQueryString, entity)
 	if err != nil {
@@ -216,7 +230,7 @@ func (*repository) GetTodoAssigneeTo(ctx context.Context, todoId string) (*entit
 
 	user := &entities.User{}
 	sqlQueryString := `SELECT users.id,users.email,users.role FROM users JOIN todos on users.id = todos.assigned_to
-WHERE todos.id = $1`
+WHERE todos.id = $1 AND todos.deleted_at IS NULL`
 
 	if err = persist.GetContext(ctx, user, sqlQueryString, todoId); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -230,6 +244,28 @@ WHERE todos.id = $1`
 	return user, nil
 }
 
+// IsTodoAssignee checks whether the user is one of the extra assignees of the todo, the user the todo is assigned to
+// is not part of them
+func (*repository) IsTodoAssignee(ctx context.Context, todoId string, userId string) (bool, error) {
+	log.C(ctx).Infof("checking whether user with id %s is assignee of todo with id %s in todo repository", userId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return false, err
+	}
+
+	sqlQueryString := `SELECT EXISTS(SELECT 1 FROM todo_assignees WHERE todo_id = $1 AND user_id = $2)`
+
+	var isAssignee bool
+	if err = persist.GetContext(ctx, &isAssignee, sqlQueryString, todoId, userId); err != nil {
+		log.C(ctx).Errorf("failed to check whether user is assignee of todo, error %s", err.Error())
+		return false, err
+	}
+
+	return isAssignee, nil
+}
+
 func (r *repository) GetTodosByListId(ctx context.Context, listId string, f filters.SqlFilters) ([]entities.Todo, error) {
 	log.C(ctx).Infof("getting todos of list with id %s", listId)
 
@@ -239,18 +275,24 @@ func (r *repository) GetTodosByListId(ctx context.Context, listId string, f filt
 		return nil, err
 	}
 
-	baseQuery := `SELECT id,name,description,list_id,status,created_at,last_updated,assigned_to,due_date,priority FROM todos`
+	baseQuery := `SELECT id,name,description,list_id,status,created_at,last_updated,assigned_to,due_date,priority,parent_id,recurrence_frequency,recurrence_interval,recurrence_until,recurrence_count,position,column_id,estimate FROM todos`
 	filteringClause, params := f.BuildSQLFiltering()
 	baseQuery += filteringClause
 
-	decorator, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery)
+	decorator, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery, params)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get todos of list with id %s, error when creating query decorator", listId)
 		return nil, err
 	}
 
-	sqlQueryString := decorator.DetermineCorrectSqlQuery(ctx)
-	completeSqlQuery := fmt.Sprintf(`SELECT id,name,description,list_id,status,created_at,last_updated,assigned_to,due_date,priority FROM (%s) ORDER BY id`, sqlQueryString)
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to parse sort, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQueryString, params := decorator.DetermineCorrectSqlQuery(ctx)
+	completeSqlQuery := fmt.Sprintf(`SELECT id,name,description,list_id,status,created_at,last_updated,assigned_to,due_date,priority,parent_id,recurrence_frequency,recurrence_interval,recurrence_until,recurrence_count,position,column_id,estimate FROM (%s) %s`, sqlQueryString, sql_query_decorators.OrderByClause(sort, sort.Direction))
 
 	var todos []entities.Todo
 	if err = persist.SelectContext(ctx, &todos, completeSqlQuery, params...); err != nil {
@@ -270,7 +312,8 @@ func (*repository) GetTodoByListId(ctx context.Context, listId string, todoId st
 	}
 
 	sqlQueryString := `SELECT id, name, description, list_id, status, created_at, last_updated, 
-assigned_to, due_date, priority FROM todos WHERE list_id = $1 AND id = $2`
+assigned_to, due_date, priority, parent_id,
+recurrence_frequency, recurrence_interval, recurrence_until, recurrence_count, recurrence_anchor_day, position, column_id, estimate FROM todos WHERE list_id = $1 AND id = $2 AND deleted_at IS NULL`
 
 	todo := &entities.Todo{}
 	if err = persist.GetContext(ctx, todo, sqlQueryString, listId, todoId); err != nil {
@@ -302,6 +345,324 @@ WHERE assigned_to = $1 AND list_id = $2`
 		return err
 	}
 
+	participantsQueries := []string{
+		`DELETE FROM todo_assignees WHERE user_id = $1 AND todo_id IN (SELECT id FROM todos WHERE list_id = $2)`,
+		`DELETE FROM todo_watchers WHERE user_id = $1 AND todo_id IN (SELECT id FROM todos WHERE list_id = $2)`,
+	}
+
+	for _, query := range participantsQueries {
+		if _, err = persist.ExecContext(ctx, query, userId, listId); err != nil {
+			log.C(ctx).Errorf("failed to remove user from todo participants, error %s", err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (*repository) CountOpenSubtasks(ctx context.Context, parentId string) (int, error) {
+	log.C(ctx).Infof("counting open subtasks of todo with id %s in todo repository", parentId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return 0, err
+	}
+
+	sqlQueryString := `SELECT COUNT(*) FROM todos WHERE parent_id = $1 AND status <> $2 AND deleted_at IS NULL`
+
+	var count int
+	if err = persist.GetContext(ctx, &count, sqlQueryString, parentId, constants.Done); err != nil {
+		log.C(ctx).Errorf("failed to count open subtasks of todo with id %s, error %s", parentId, err.Error())
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (*repository) DetachSubtasks(ctx context.Context, parentId string) error {
+	log.C(ctx).Infof("detaching subtasks from todo with id %s in todo repository", parentId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return err
+	}
+
+	sqlQueryString := `UPDATE todos SET parent_id = NULL WHERE parent_id = $1`
+
+	if _, err = persist.ExecContext(ctx, sqlQueryString, parentId); err != nil {
+		log.C(ctx).Errorf("failed to detach subtasks from todo with id %s, error %s", parentId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (*repository) AddTodoBlocker(ctx context.Context, todoId string, blockerId string) error {
+	log.C(ctx).Infof("adding todo with id %s as a blocker of todo with id %s in todo repository", blockerId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return err
+	}
+
+	sqlQueryString := `INSERT INTO todo_dependencies (todo_id, blocker_id) VALUES ($1, $2)
+ON CONFLICT (todo_id, blocker_id) DO NOTHING`
+
+	if _, err = persist.ExecContext(ctx, sqlQueryString, todoId, blockerId); err != nil {
+		log.C(ctx).Errorf("failed to add blocker to todo with id %s, error %s", todoId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (*repository) RemoveTodoBlocker(ctx context.Context, todoId string, blockerId string) error {
+	log.C(ctx).Infof("removing blocker with id %s from todo with id %s in todo repository", blockerId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return err
+	}
+
+	sqlQueryString := `DELETE FROM todo_dependencies WHERE todo_id = $1 AND blocker_id = $2`
+
+	res, err := persist.ExecContext(ctx, sqlQueryString, todoId, blockerId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to remove blocker from todo with id %s, error %s", todoId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to remove blocker, error when trying to get the number of rows affected")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to remove blocker, todo with id %s is not blocked by todo with id %s", todoId, blockerId)
+		return application_errors.NewNotFoundError(constants.DEPENDENCY_TARGET, blockerId)
+	}
+
+	return nil
+}
+
+func (*repository) CountOpenBlockers(ctx context.Context, todoId string) (int, error) {
+	log.C(ctx).Infof("counting open blockers of todo with id %s in todo repository", todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return 0, err
+	}
+
+	sqlQueryString := `SELECT COUNT(*) FROM todo_dependencies
+JOIN todos ON todos.id = todo_dependencies.blocker_id
+WHERE todo_dependencies.todo_id = $1 AND todos.status <> $2 AND todos.deleted_at IS NULL`
+
+	var count int
+	if err = persist.GetContext(ctx, &count, sqlQueryString, todoId, constants.Done); err != nil {
+		log.C(ctx).Errorf("failed to count open blockers of todo with id %s, error %s", todoId, err.Error())
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// IsTodoTransitivelyBlockedBy reports whether blockerId can be reached from todoId by following blocker edges
+func (*repository) IsTodoTransitivelyBlockedBy(ctx context.Context, todoId string, blockerId string) (bool, error) {
+	log.C(ctx).Infof("checking whether todo with id %s is transitively blocked by todo with id %s", todoId, blockerId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return false, err
+	}
+
+	sqlQueryString := `WITH RECURSIVE blockers(id) AS (
+    SELECT blocker_id FROM todo_dependencies WHERE todo_id = $1
+    UNION
+    SELECT todo_dependencies.blocker_id FROM todo_dependencies
+    JOIN blockers ON todo_dependencies.todo_id = blockers.id
+)
+SELECT EXISTS(SELECT 1 FROM blockers WHERE id = $2)`
+
+	var isBlocked bool
+	if err = persist.GetContext(ctx, &isBlocked, sqlQueryString, todoId, blockerId); err != nil {
+		log.C(ctx).Errorf("failed to check dependency path between todos, error %s", err.Error())
+		return false, err
+	}
+
+	return isBlocked, nil
+}
+
+// MoveTodos moves the todos with their subtasks to the list and removes the blockers and labels which are out of scope
+// in the new list, it returns all the moved todos
+func (*repository) MoveTodos(ctx context.Context, todoIds []string, listId string, movedAt time.Time) ([]entities.Todo, error) {
+	log.C(ctx).Infof("moving todos to list with id %s in todo repository", listId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return nil, err
+	}
+
+	var movedTodos []entities.Todo
+	if err = persist.SelectContext(ctx, &movedTodos, moveTodosQuery, pq.Array(todoIds), listId, movedAt); err != nil {
+		log.C(ctx).Errorf("failed to move todos to list with id %s, error %s", listId, err.Error())
+		return nil, err
+	}
+
+	if _, err = persist.ExecContext(ctx, detachMovedTodosQuery, pq.Array(todoIds)); err != nil {
+		log.C(ctx).Errorf("failed to detach moved todos from their parents, error %s", err.Error())
+		return nil, err
+	}
+
+	if _, err = persist.ExecContext(ctx, removeCrossListDependenciesQuery, listId); err != nil {
+		log.C(ctx).Errorf("failed to remove dependencies between lists of moved todos, error %s", err.Error())
+		return nil, err
+	}
+
+	if _, err = persist.ExecContext(ctx, removeOutOfScopeLabelsQuery, listId); err != nil {
+		log.C(ctx).Errorf("failed to remove out of scope labels of moved todos, error %s", err.Error())
+		return nil, err
+	}
+
+	return movedTodos, nil
+}
+
+// GetTodosParticipants returns the ids of the assignees, the extra assignees and the watchers of the todos
+func (*repository) GetTodosParticipants(ctx context.Context, todoIds []string) ([]string, error) {
+	log.C(ctx).Info("getting participants of todos in todo repository")
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return nil, err
+	}
+
+	var participants []string
+	if err = persist.SelectContext(ctx, &participants, todosParticipantsQuery, pq.Array(todoIds)); err != nil {
+		log.C(ctx).Errorf("failed to get participants of todos, error %s", err.Error())
+		return nil, err
+	}
+
+	return participants, nil
+}
+
+func (*repository) CopyTodoLabels(ctx context.Context, todoId string, copyId string) error {
+	log.C(ctx).Infof("copying labels of todo with id %s to todo with id %s in todo repository", todoId, copyId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, copyTodoLabelsQuery, todoId, copyId); err != nil {
+		log.C(ctx).Errorf("failed to copy labels of todo with id %s, error %s", todoId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetListTodosParentsFirst returns all the todos of the list which are not in the trash, the parents come before their subtasks,
+// so the todos can be recreated one by one in the returned order
+func (*repository) GetListTodosParentsFirst(ctx context.Context, listId string) ([]entities.Todo, error) {
+	log.C(ctx).Infof("getting all todos of list with id %s in todo repository", listId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return nil, err
+	}
+
+	var listTodos []entities.Todo
+	if err = persist.SelectContext(ctx, &listTodos, listTodosParentsFirstQuery, listId); err != nil {
+		log.C(ctx).Errorf("failed to get todos of list with id %s, error %s", listId, err.Error())
+		return nil, err
+	}
+
+	return listTodos, nil
+}
+
+// PlaceTodoAfter changes only the position of the todo, so that it comes right after the anchor todo
+func (r *repository) PlaceTodoAfter(ctx context.Context, todoId string, anchorId string, placedAt time.Time) (*entities.Todo, error) {
+	log.C(ctx).Infof("placing todo with id %s after todo with id %s in todo repository", todoId, anchorId)
+
+	return r.placeTodo(ctx, placeTodoAfterQuery, todoId, anchorId, placedAt)
+}
+
+// PlaceTodoBefore changes only the position of the todo, so that it comes right before the anchor todo
+func (r *repository) PlaceTodoBefore(ctx context.Context, todoId string, anchorId string, placedAt time.Time) (*entities.Todo, error) {
+	log.C(ctx).Infof("placing todo with id %s before todo with id %s in todo repository", todoId, anchorId)
+
+	return r.placeTodo(ctx, placeTodoBeforeQuery, todoId, anchorId, placedAt)
+}
+
+func (r *repository) placeTodo(ctx context.Context, sqlQueryString string, todoId string, anchorId string, placedAt time.Time) (*entities.Todo, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return nil, err
+	}
+
+	if _, err = persist.ExecContext(ctx, sqlQueryString, todoId, anchorId, placedAt); err != nil {
+		log.C(ctx).Errorf("failed to place todo with id %s next to todo with id %s, error %s", todoId, anchorId, err.Error())
+		return nil, err
+	}
+
+	return r.GetTodo(ctx, todoId)
+}
+
+// CreateSavepoint marks the current state of the transaction, rolling back to the savepoint undoes only the statements
+// executed after it and keeps the transaction usable after a failed statement
+func (*repository) CreateSavepoint(ctx context.Context, name string) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		log.C(ctx).Errorf("failed to create savepoint %s, error %s", name, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (*repository) RollbackToSavepoint(ctx context.Context, name string) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); err != nil {
+		log.C(ctx).Errorf("failed to roll back to savepoint %s, error %s", name, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (*repository) ReleaseSavepoint(ctx context.Context, name string) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in todo repo, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		log.C(ctx).Errorf("failed to release savepoint %s, error %s", name, err.Error())
+		return err
+	}
+
 	return nil
 }
 
@@ -309,5 +670,12 @@ func (r *repository) GetPaginationInfo(ctx context.Context, f filters.SqlFilters
 	log.C(ctx).Info("getting todos pagination info in todo repository")
 
 	filter, params := f.BuildSQLFiltering()
-	return r.genericRepo.GetPaginationInfo(ctx, s.GetSource(), filter, params)
+
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to parse sort, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.genericRepo.GetSortedPaginationInfo(ctx, s.GetSource(), filter, sql_query_decorators.SortExpression(sort.Field), sort.Direction, params)
 }
@@ -1,10 +1,13 @@
 package todos
 
 import (
-	application_errors2 "Todo-List/internProject/todo_app_service/internal/application_errors"
-	entities2 "Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
 	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
 	"database/sql"
+	"github.com/gofrs/uuid"
 	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 	sqlmock "github.com/zhashkevych/go-sqlxmock"
@@ -12,636 +15,574 @@ import (
 	"testing"
 )
 
-func TestSqlTodoDB_GetTodo(t *testing.T) {
+func TestRepository_GetTodo(t *testing.T) {
 	tests := []struct {
 		testName       string
-		listId         string
 		todoId         string
-		mockSetup      func(mck sqlmock.Sqlmock)
-		expectError    bool
+		dbMock         func(mck sqlmock.Sqlmock)
 		err            error
-		expectedEntity *entities2.Todo
+		expectedEntity *entities.Todo
 	}{
 		{
 			testName: "Successfully returns expected todo entity",
-			listId:   nonExistingListId.String(),
-			todoId:   nonExistingTodoId.String(),
-			mockSetup: func(mck sqlmock.Sqlmock) {
+			todoId:   existingTodoId.String(),
+			dbMock: func(mck sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"id", "name", "description", "list_id",
 					"status", "created_at", "last_updated", "assigned_to", "due_date", "priority"}).
-					AddRow(nonExistingTodoId, todoName, todoDescription, nonExistingListId, todoStatus, testDate, testDate,
+					AddRow(existingTodoId, todoName, todoDescription, existingListId, todoStatus, testDate, testDate,
 						assigneeNullId, testNullDate, priority)
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodo)).WithArgs(nonExistingListId, nonExistingTodoId).
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodo)).WithArgs(existingTodoId.String()).
 					WillReturnRows(rows)
 			},
-			expectError: false,
-			err:         nil,
-			expectedEntity: initTodoEntity(nonExistingTodoId, todoName, todoDescription, nonExistingListId, todoStatus, testDate,
+			expectedEntity: initTodoEntity(existingTodoId, todoName, todoDescription, existingListId, todoStatus, testDate,
 				testDate, assigneeNullId, testNullDate, priority),
 		},
 		{
 			testName: "Unable to get todo because of invalid todo_id",
-			listId:   nonExistingListId.String(),
-			todoId:   existingTodoId.String(),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodo)).WithArgs(nonExistingListId, existingTodoId).
-					WillReturnError(sql.ErrNoRows)
-			},
-			expectError:    true,
-			err:            application_errors2.InvalidListOrTodoIdError,
-			expectedEntity: nil,
-		},
-		{
-			testName: "Unable to get todo because of invalid list_id",
-			listId:   existingListId.String(),
 			todoId:   nonExistingTodoId.String(),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodo)).WithArgs(existingListId, nonExistingTodoId).
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodo)).WithArgs(nonExistingTodoId.String()).
 					WillReturnError(sql.ErrNoRows)
 			},
-			expectError:    true,
-			err:            application_errors2.InvalidListOrTodoIdError,
-			expectedEntity: nil,
+			err: application_errors.NewNotFoundError(constants.TODO_TARGET, nonExistingTodoId.String()),
 		},
 		{
 			testName: "Unable to get todo because of a database error",
-			listId:   existingListId.String(),
 			todoId:   existingTodoId.String(),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodo)).WithArgs(existingListId, existingTodoId).
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodo)).WithArgs(existingTodoId.String()).
 					WillReturnError(databaseError)
 			},
-			expectError:    true,
-			err:            databaseError,
-			expectedEntity: nil,
+			err: databaseError,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			db, mock, err := sqlmock.Newx()
+			db, mck, err := sqlmock.Newx()
 			require.NoError(t, err)
 			defer db.Close()
 
-			test.mockSetup(mock)
+			test.dbMock(mck)
 
-			todoRepo := NewSQLTodoDB(db)
-			gotTodo, err := todoRepo.GetTodo(test.listId, test.todoId)
-			if test.expectError {
-				require.ErrorIs(t, err, test.err)
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			gotTodo, err := NewRepo(nil, nil).GetTodo(ctx, test.todoId)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
+
 			require.Equal(t, test.expectedEntity, gotTodo)
-			require.NoError(t, mock.ExpectationsWereMet())
+			require.NoError(t, mck.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestSqlTodoDB_GetTodosByListId(t *testing.T) {
+func TestRepository_GetTodoAssigneeTo(t *testing.T) {
 	tests := []struct {
 		testName         string
-		listId           string
-		mockSetup        func(mck sqlmock.Sqlmock)
-		expectError      bool
+		todoId           string
+		dbMock           func(mck sqlmock.Sqlmock)
 		err              error
-		expectedEntities []entities2.Todo
+		expectedAssignee *entities.User
 	}{
 		{
-			testName: "Successfully returns expected entities",
-			listId:   nonExistingListId.String(),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "description", "list_id",
-					"status", "created_at", "last_updated", "assigned_to", "due_date", "priority"}).
-					AddRow(nonExistingTodoId, todoName, todoDescription, nonExistingListId, todoDoneStatus, testDate, testDate,
-						assigneeNullId, testNullDate, veryLowPriority).
-					AddRow(nonExistingTodoId2, todoName, todoDescription, nonExistingListId, todoInProgressStatus, testDate, testDate,
-						assigneeNullId, testNullDate, veryHighPriority)
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodos)).WithArgs(nonExistingListId).WillReturnRows(rows)
+			testName: "Successfully returning todo assignee",
+			todoId:   existingTodoId.String(),
+			dbMock: func(mck sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "email", "role"}).
+					AddRow(assigneeId, email, writerRole)
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodoAssignee)).WithArgs(existingTodoId.String()).
+					WillReturnRows(rows)
 			},
-			expectError: false,
-			err:         nil,
-			expectedEntities: []entities2.Todo{
-				*initTodoEntity(nonExistingTodoId, todoName, todoDescription, nonExistingListId,
-					todoDoneStatus, testDate, testDate, assigneeNullId, testNullDate, veryLowPriority),
-				*initTodoEntity(nonExistingTodoId2, todoName, todoDescription, nonExistingListId,
-					todoInProgressStatus, testDate, testDate, assigneeNullId, testNullDate, veryHighPriority),
+			expectedAssignee: initUserEntity(assigneeId, email, writerRole),
+		},
+		{
+			testName: "Successfully returning no assignee for todo which is not assigned",
+			todoId:   nonExistingTodoId.String(),
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodoAssignee)).WithArgs(nonExistingTodoId.String()).
+					WillReturnError(sql.ErrNoRows)
 			},
 		},
 		{
-			testName: "Unable to get entities because of database error",
-			listId:   existingListId.String(),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodos)).WithArgs(existingListId).
+			testName: "Unable to get todo assignee because of a database error",
+			todoId:   existingTodoId.String(),
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodoAssignee)).WithArgs(existingTodoId.String()).
 					WillReturnError(databaseError)
 			},
-			expectError: true,
-			err:         databaseError,
+			err: databaseError,
 		},
 	}
+
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			db, mock, err := sqlmock.Newx()
+			db, mck, err := sqlmock.Newx()
 			require.NoError(t, err)
 			defer db.Close()
 
-			test.mockSetup(mock)
-			todoRepo := NewSQLTodoDB(db)
+			test.dbMock(mck)
 
-			gotEntities, err := todoRepo.GetTodosByListId(test.listId)
-			if test.expectError {
-				require.ErrorIs(t, err, test.err)
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			assignee, err := NewRepo(nil, nil).GetTodoAssigneeTo(ctx, test.todoId)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
-			require.Equal(t, test.expectedEntities, gotEntities)
-			require.NoError(t, mock.ExpectationsWereMet())
+
+			require.Equal(t, test.expectedAssignee, assignee)
+			require.NoError(t, mck.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestSqlTodoDB_GetTodosByStatus(t *testing.T) {
+func TestRepository_DeleteTodo(t *testing.T) {
 	tests := []struct {
-		testName         string
-		listId           string
-		status           string
-		mockSetup        func(mck sqlmock.Sqlmock)
-		expectError      bool
-		err              error
-		expectedEntities []entities2.Todo
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
 	}{
 		{
-			testName: "Successfully returns expected entities",
-			listId:   nonExistingListId.String(),
-			status:   todoDoneStatus,
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "description", "list_id",
-					"status", "created_at", "last_updated", "assigned_to", "due_date", "priority"}).
-					AddRow(nonExistingTodoId, todoName, todoDescription, nonExistingListId, todoDoneStatus, testDate, testDate,
-						assigneeNullId, testNullDate, veryHighPriority).
-					AddRow(nonExistingTodoId2, todoName, todoDescription, nonExistingListId, todoDoneStatus, testDate, testDate,
-						assigneeNullId, testNullDate, veryLowPriority)
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodosByStatus)).WithArgs(nonExistingListId, todoDoneStatus).WillReturnRows(rows)
-			},
-			expectError: false,
-			err:         nil,
-			expectedEntities: []entities2.Todo{
-				*initTodoEntity(nonExistingTodoId, todoName, todoDescription, nonExistingListId,
-					todoDoneStatus, testDate, testDate, assigneeNullId, testNullDate, veryHighPriority),
-				*initTodoEntity(nonExistingTodoId2, todoName, todoDescription, nonExistingListId,
-					todoDoneStatus, testDate, testDate, assigneeNullId, testNullDate, veryLowPriority),
+			testName: "Successfully deletes todo",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteTodo)).WithArgs(existingTodoId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 		},
 		{
-			testName: "Unable to get entities because of database error",
-			listId:   existingListId.String(),
-			status:   todoStatus,
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodosByStatus)).WithArgs(existingListId, todoStatus).
+			testName: "Unable to delete todo because of a database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteTodo)).WithArgs(existingTodoId.String()).
 					WillReturnError(databaseError)
 			},
-			expectError:      true,
-			err:              databaseError,
-			expectedEntities: nil,
+			err: databaseError,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			db, mock, err := sqlmock.Newx()
+			db, mck, err := sqlmock.Newx()
 			require.NoError(t, err)
 			defer db.Close()
 
-			test.mockSetup(mock)
-			todoRepo := NewSQLTodoDB(db)
+			test.dbMock(mck)
 
-			gotEntities, err := todoRepo.GetTodosByStatus(test.listId, test.status)
-			if test.expectError {
-				require.ErrorIs(t, err, test.err)
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo(nil, nil).DeleteTodo(ctx, existingTodoId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
-			require.Equal(t, test.expectedEntities, gotEntities)
-			require.NoError(t, mock.ExpectationsWereMet())
+
+			require.NoError(t, mck.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestSqlTodoDB_GetTodosByPriority(t *testing.T) {
+func TestRepository_CountOpenSubtasks(t *testing.T) {
 	tests := []struct {
-		testName         string
-		listId           string
-		priority         string
-		mockSetup        func(mck sqlmock.Sqlmock)
-		expectError      bool
-		err              error
-		expectedEntities []entities2.Todo
+		testName      string
+		parentId      string
+		dbMock        func(mck sqlmock.Sqlmock)
+		err           error
+		expectedCount int
 	}{
 		{
-			testName: "Successfully returns expected entities",
-			listId:   nonExistingListId.String(),
-			priority: veryLowPriority,
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "description", "list_id",
-					"status", "created_at", "last_updated", "assigned_to", "due_date", "priority"}).
-					AddRow(nonExistingTodoId, todoName, todoDescription, nonExistingListId, todoInProgressStatus, testDate, testDate,
-						assigneeNullId, testNullDate, veryLowPriority).
-					AddRow(nonExistingTodoId2, todoName, todoDescription, nonExistingListId, todoStatus, testDate, testDate,
-						assigneeNullId, testNullDate, veryLowPriority)
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodosByPriority)).WithArgs(nonExistingListId, veryLowPriority).WillReturnRows(rows)
-			},
-			expectError: false,
-			err:         nil,
-			expectedEntities: []entities2.Todo{
-				*initTodoEntity(nonExistingTodoId, todoName, todoDescription, nonExistingListId,
-					todoInProgressStatus, testDate, testDate, assigneeNullId, testNullDate, veryLowPriority),
-				*initTodoEntity(nonExistingTodoId2, todoName, todoDescription, nonExistingListId,
-					todoStatus, testDate, testDate, assigneeNullId, testNullDate, veryLowPriority),
+			testName: "Successfully counting open subtasks",
+			parentId: existingTodoId.String(),
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryCountOpenSubtasks)).
+					WithArgs(existingTodoId.String(), constants.Done).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
 			},
+			expectedCount: 2,
 		},
 		{
-			testName: "Unable to get entities because of database error",
-			listId:   existingListId.String(),
-			priority: priority,
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodosByPriority)).WithArgs(existingListId, priority).
+			testName: "Failed to count open subtasks due to database error",
+			parentId: existingTodoId.String(),
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryCountOpenSubtasks)).
+					WithArgs(existingTodoId.String(), constants.Done).
 					WillReturnError(databaseError)
 			},
-			expectError: true,
-			err:         databaseError,
+			err: databaseError,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			db, mock, err := sqlmock.Newx()
+			db, mck, err := sqlmock.Newx()
 			require.NoError(t, err)
 			defer db.Close()
 
-			test.mockSetup(mock)
-			todoRepo := NewSQLTodoDB(db)
+			test.dbMock(mck)
 
-			gotEntities, err := todoRepo.GetTodosByPriority(test.listId, test.priority)
-			if test.expectError {
-				require.ErrorIs(t, err, test.err)
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			count, err := NewRepo(nil, nil).CountOpenSubtasks(ctx, test.parentId)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
-			require.Equal(t, test.expectedEntities, gotEntities)
-			require.NoError(t, mock.ExpectationsWereMet())
+
+			require.Equal(t, test.expectedCount, count)
+			require.NoError(t, mck.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestSqlTodoDB_GetTodosByStatusAndPriority(t *testing.T) {
+func TestRepository_DetachSubtasks(t *testing.T) {
 	tests := []struct {
-		testName         string
-		listId           string
-		status           string
-		priority         string
-		mockSetup        func(mck sqlmock.Sqlmock)
-		expectError      bool
-		err              error
-		expectedEntities []entities2.Todo
+		testName string
+		parentId string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
 	}{
 		{
-			testName: "Successfully returns expected entities",
-			listId:   nonExistingListId.String(),
-			status:   todoInProgressStatus,
-			priority: mediumPriority,
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "description", "list_id",
-					"status", "created_at", "last_updated", "assigned_to", "due_date", "priority"}).
-					AddRow(nonExistingTodoId, todoName, todoDescription, nonExistingListId, todoInProgressStatus, testDate, testDate,
-						assigneeNullId, testNullDate, mediumPriority).
-					AddRow(nonExistingTodoId2, todoName, todoDescription, nonExistingListId, todoInProgressStatus, testDate, testDate,
-						assigneeNullId, testNullDate, mediumPriority)
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodosByStatusAndPriority)).WithArgs(nonExistingListId, todoInProgressStatus, mediumPriority).
-					WillReturnRows(rows)
-			},
-			expectError: false,
-			err:         nil,
-			expectedEntities: []entities2.Todo{
-				*initTodoEntity(nonExistingTodoId, todoName, todoDescription, nonExistingListId,
-					todoInProgressStatus, testDate, testDate, assigneeNullId, testNullDate, mediumPriority),
-				*initTodoEntity(nonExistingTodoId2, todoName, todoDescription, nonExistingListId,
-					todoInProgressStatus, testDate, testDate, assigneeNullId, testNullDate, mediumPriority),
+			testName: "Successfully detaching subtasks",
+			parentId: existingTodoId.String(),
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDetachSubtasks)).
+					WithArgs(existingTodoId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 3))
 			},
 		},
 		{
-			testName: "Unable to get entities because of database error",
-			listId:   existingListId.String(),
-			status:   todoStatus,
-			priority: priority,
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodosByStatusAndPriority)).WithArgs(existingListId, todoStatus, priority).
+			testName: "Failed to detach subtasks due to database error",
+			parentId: existingTodoId.String(),
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDetachSubtasks)).
+					WithArgs(existingTodoId.String()).
 					WillReturnError(databaseError)
 			},
-			expectError: true,
-			err:         databaseError,
+			err: databaseError,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			db, mock, err := sqlmock.Newx()
+			db, mck, err := sqlmock.Newx()
 			require.NoError(t, err)
 			defer db.Close()
 
-			test.mockSetup(mock)
-			todoRepo := NewSQLTodoDB(db)
+			test.dbMock(mck)
 
-			gotEntities, err := todoRepo.GetTodosByStatusAndPriority(test.listId, test.status, test.priority)
-			if test.expectError {
-				require.ErrorIs(t, err, test.err)
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo(nil, nil).DetachSubtasks(ctx, test.parentId)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
-			require.Equal(t, test.expectedEntities, gotEntities)
-			require.NoError(t, mock.ExpectationsWereMet())
+
+			require.NoError(t, mck.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestSqlTodoDB_GetTodoAssigneeTo(t *testing.T) {
+func TestRepository_IsTodoTransitivelyBlockedBy(t *testing.T) {
 	tests := []struct {
-		testName         string
-		todoId           string
-		mockSetup        func(mck sqlmock.Sqlmock)
-		expectError      bool
-		err              error
-		expectedAssignee *entities2.User
+		testName          string
+		dbMock            func(mck sqlmock.Sqlmock)
+		err               error
+		expectedIsBlocked bool
 	}{
 		{
-			testName: "Successfully returning todo assignee",
-			todoId:   existingTodoId.String(),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "email", "role"}).
-					AddRow(assigneeId, email, writerRole)
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodoAssignee)).WithArgs(existingTodoId).
-					WillReturnRows(rows)
+			testName: "Successfully finding a dependency path to the blocker",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryIsTodoTransitivelyBlockedBy)).
+					WithArgs(existingTodoId.String(), nonExistingTodoId.String()).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
 			},
-			expectError:      false,
-			err:              nil,
-			expectedAssignee: initUserEntity(assigneeId, email, writerRole),
+			expectedIsBlocked: true,
 		},
 		{
-			testName: "Unable to get todo assignee because of invalid todo_id",
-			todoId:   nonExistingTodoId.String(),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodoAssignee)).WithArgs(nonExistingTodoId).
-					WillReturnError(sql.ErrNoRows)
+			testName: "Successfully finding no dependency path to the blocker",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryIsTodoTransitivelyBlockedBy)).
+					WithArgs(existingTodoId.String(), nonExistingTodoId.String()).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 			},
-			expectError:      true,
-			err:              application_errors2.NewNotFoundError(constants.TODO_TARGET, nonExistingTodoId.String()),
-			expectedAssignee: nil,
 		},
 		{
-			testName: "Unable to get todo assignee because of invalid todo_id",
-			todoId:   nonExistingTodoId.String(),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodoAssignee)).WithArgs(nonExistingTodoId).
+			testName: "Failed to look for dependency path due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryIsTodoTransitivelyBlockedBy)).
+					WithArgs(existingTodoId.String(), nonExistingTodoId.String()).
 					WillReturnError(databaseError)
 			},
-			expectError:      true,
-			err:              databaseError,
-			expectedAssignee: nil,
+			err: databaseError,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			db, mock, err := sqlmock.Newx()
+			db, mck, err := sqlmock.Newx()
 			require.NoError(t, err)
 			defer db.Close()
 
-			test.mockSetup(mock)
-			todoRepo := NewSQLTodoDB(db)
-			assignee, err := todoRepo.GetTodoAssigneeTo(test.todoId)
-			if test.expectError {
-				require.ErrorIs(t, err, test.err)
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			isBlocked, err := NewRepo(nil, nil).IsTodoTransitivelyBlockedBy(ctx, existingTodoId.String(), nonExistingTodoId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
-			require.Equal(t, test.expectedAssignee, assignee)
-			require.NoError(t, mock.ExpectationsWereMet())
+
+			require.Equal(t, test.expectedIsBlocked, isBlocked)
+			require.NoError(t, mck.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestSqlTodoDB_CreateTodo(t *testing.T) {
+func TestRepository_UnassignUserFromTodos(t *testing.T) {
 	tests := []struct {
-		testName       string
-		passedEntity   *entities2.Todo
-		mockSetup      func(mck sqlmock.Sqlmock)
-		expectError    bool
-		err            error
-		expectedEntity *entities2.Todo
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
 	}{
 		{
-			testName: "Successfully creates todo",
-			passedEntity: initTodoEntity(nonExistingTodoId, todoName, todoDescription,
-				nonExistingListId, todoStatus, testDate, testDate, assigneeNullId, testNullDate, priority),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectExec(regexp.QuoteMeta(sqlQueryCreateTodo)).WithArgs(nonExistingTodoId, todoName, todoDescription,
-					nonExistingListId, todoStatus, testDate, testDate, assigneeNullId, testNullDate, priority).
+			testName: "Successfully unassigning user and removing the user from the assignees and the watchers of the list todos",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryUnassignUserFromTodos)).
+					WithArgs(assigneeId.String(), existingListId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryRemoveUserFromAssignees)).
+					WithArgs(assigneeId.String(), existingListId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryRemoveUserFromWatchers)).
+					WithArgs(assigneeId.String(), existingListId.String()).
 					WillReturnResult(sqlmock.NewResult(0, 1))
-
-				rows := sqlmock.NewRows([]string{"id", "name", "description", "list_id", "status",
-					"created_at", "last_updated", "assigned_to", "due_date", "priority"}).
-					AddRow(nonExistingTodoId, todoName, todoDescription,
-						nonExistingListId, todoStatus, testDate, testDate, assigneeNullId, testNullDate, priority)
-				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodo)).WithArgs(nonExistingListId, nonExistingTodoId).
-					WillReturnRows(rows)
 			},
-			expectError: false,
-			err:         nil,
-			expectedEntity: initTodoEntity(nonExistingTodoId, todoName, todoDescription,
-				nonExistingListId, todoStatus, testDate, testDate, assigneeNullId, testNullDate, priority),
 		},
 		{
-			testName: "Unable to create todo because of invalid todo_id",
-			passedEntity: initTodoEntity(existingTodoId, todoName, todoDescription,
-				nonExistingListId, todoStatus, testDate, testDate, assigneeNullId, testNullDate, priority),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectExec(regexp.QuoteMeta(sqlQueryCreateTodo)).WithArgs(existingTodoId, todoName, todoDescription,
-					nonExistingListId, todoStatus, testDate, testDate, assigneeNullId, testNullDate, priority).
-					WillReturnError(&pq.Error{
-						Code: "23505",
-					})
+			testName: "Failed to remove user from the watchers due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryUnassignUserFromTodos)).
+					WithArgs(assigneeId.String(), existingListId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryRemoveUserFromAssignees)).
+					WithArgs(assigneeId.String(), existingListId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryRemoveUserFromWatchers)).
+					WithArgs(assigneeId.String(), existingListId.String()).
+					WillReturnError(databaseError)
 			},
-			expectError:    true,
-			err:            application_errors2.NewNotFoundError(constants.TODO_TARGET, existingTodoId.String()),
-			expectedEntity: nil,
+			err: databaseError,
 		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo(nil, nil).UnassignUserFromTodos(ctx, assigneeId.String(), existingListId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_PlaceTodo(t *testing.T) {
+	todoColumns := []string{"id", "name", "list_id", "position"}
+	placedTodo := &entities.Todo{Id: existingTodoId, Name: todoName, ListId: existingListId, Position: "1.5"}
+
+	tests := []struct {
+		testName       string
+		before         bool
+		dbMock         func(mck sqlmock.Sqlmock)
+		err            error
+		expectedEntity *entities.Todo
+	}{
 		{
-			testName: "Unable to create todo because of invalid list_id",
-			passedEntity: initTodoEntity(nonExistingTodoId, todoName, todoDescription,
-				existingListId, todoStatus, testDate, testDate, assigneeNullId, testNullDate, priority),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectExec(regexp.QuoteMeta(sqlQueryCreateTodo)).WithArgs(nonExistingTodoId, todoName, todoDescription,
-					existingListId, todoStatus, testDate, testDate, assigneeNullId, testNullDate, priority).
-					WillReturnError(&pq.Error{
-						Code:       "23503",
-						Constraint: "todos_list_id_fkey",
-					})
+			testName: "Successfully placing todo halfway between the anchor and the todo after it",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryPlaceTodoAfter)).
+					WithArgs(existingTodoId.String(), nonExistingTodoId.String(), testDate).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodo)).
+					WithArgs(existingTodoId.String()).
+					WillReturnRows(sqlmock.NewRows(todoColumns).AddRow(existingTodoId, todoName, existingListId, "1.5"))
 			},
-			expectError:    true,
-			err:            application_errors2.NewNotFoundError(constants.LIST_TARGET, existingListId.String()),
-			expectedEntity: nil,
+			expectedEntity: placedTodo,
 		},
 		{
-			testName: "Unable to create todo because of invalid assignee_id",
-			passedEntity: initTodoEntity(nonExistingTodoId, todoName, todoDescription, nonExistingListId,
-				todoStatus, testDate, testDate, nonExistingAssigneeNullId, testNullDate, priority),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectExec(regexp.QuoteMeta(sqlQueryCreateTodo)).WithArgs(nonExistingTodoId, todoName, todoDescription,
-					nonExistingListId, todoStatus, testDate, testDate, nonExistingAssigneeNullId, testNullDate, priority).
-					WillReturnError(&pq.Error{
-						Code:       "23503",
-						Constraint: "todos_assigned_to_fkey",
-					})
+			testName: "Successfully placing todo halfway between the todo before the anchor and the anchor",
+			before:   true,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryPlaceTodoBefore)).
+					WithArgs(existingTodoId.String(), nonExistingTodoId.String(), testDate).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodo)).
+					WithArgs(existingTodoId.String()).
+					WillReturnRows(sqlmock.NewRows(todoColumns).AddRow(existingTodoId, todoName, existingListId, "1.5"))
 			},
-			expectError:    true,
-			err:            application_errors2.NewNotFoundError(constants.USER_TARGET, nonExistingAssigneeNullId.UUID.String()),
-			expectedEntity: nil,
+			expectedEntity: placedTodo,
 		},
 		{
-			testName: "Unable to create todo because of database error",
-			passedEntity: initTodoEntity(nonExistingTodoId, todoName, todoDescription, nonExistingListId,
-				todoStatus, testDate, testDate, nonExistingAssigneeNullId, testNullDate, priority),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectExec(regexp.QuoteMeta(sqlQueryCreateTodo)).WithArgs(nonExistingTodoId, todoName, todoDescription,
-					nonExistingListId, todoStatus, testDate, testDate, nonExistingAssigneeNullId, testNullDate, priority).
+			testName: "Failed to place todo due to database error",
+			before:   true,
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryPlaceTodoBefore)).
+					WithArgs(existingTodoId.String(), nonExistingTodoId.String(), testDate).
 					WillReturnError(databaseError)
 			},
-			expectError:    true,
-			err:            databaseError,
-			expectedEntity: nil,
+			err: databaseError,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			db, mock, err := sqlmock.Newx()
+			db, mck, err := sqlmock.Newx()
 			require.NoError(t, err)
 			defer db.Close()
 
-			test.mockSetup(mock)
-			todoRepo := NewSQLTodoDB(db)
-			gotEntity, err := todoRepo.CreateTodo(test.passedEntity)
-			if test.expectError {
-				require.ErrorIs(t, err, test.err)
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			repo := NewRepo(nil, nil)
+
+			var todo *entities.Todo
+			if test.before {
+				todo, err = repo.PlaceTodoBefore(ctx, existingTodoId.String(), nonExistingTodoId.String(), testDate)
+			} else {
+				todo, err = repo.PlaceTodoAfter(ctx, existingTodoId.String(), nonExistingTodoId.String(), testDate)
+			}
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
-			require.Equal(t, test.expectedEntity, gotEntity)
-			require.NoError(t, mock.ExpectationsWereMet())
+
+			require.Equal(t, test.expectedEntity, todo)
+			require.NoError(t, mck.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestSqlTodoDB_DeleteTodo(t *testing.T) {
+func TestRepository_GetTodosParticipants(t *testing.T) {
+	todoIds := []string{existingTodoId.String(), nonExistingTodoId.String()}
+	watcherId := uuid.Must(uuid.NewV4())
+
 	tests := []struct {
-		testName    string
-		listId      string
-		todoId      string
-		mockSetup   func(mck sqlmock.Sqlmock)
-		expectError bool
-		err         error
+		testName             string
+		dbMock               func(mck sqlmock.Sqlmock)
+		err                  error
+		expectedParticipants []string
 	}{
 		{
-			testName: "Successfully deletes todo",
-			listId:   existingListId.String(),
-			todoId:   existingTodoId.String(),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteTodo)).WithArgs(existingListId, existingTodoId).
-					WillReturnResult(sqlmock.NewResult(0, 1))
+			testName: "Successfully getting assignees, extra assignees and watchers of todos",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodosParticipants)).
+					WithArgs(pq.Array(todoIds)).
+					WillReturnRows(sqlmock.NewRows([]string{"assigned_to"}).AddRow(assigneeId.String()).AddRow(watcherId.String()))
 			},
-			expectError: false,
-			err:         nil,
+			expectedParticipants: []string{assigneeId.String(), watcherId.String()},
 		},
 		{
-			testName: "Unable to delete todo because of a database error",
-			listId:   existingListId.String(),
-			todoId:   existingTodoId.String(),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteTodo)).WithArgs(existingListId, existingTodoId).
+			testName: "Failed to get participants of todos due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetTodosParticipants)).
+					WithArgs(pq.Array(todoIds)).
 					WillReturnError(databaseError)
 			},
-			expectError: true,
-			err:         databaseError,
+			err: databaseError,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			db, mock, err := sqlmock.Newx()
+			db, mck, err := sqlmock.Newx()
 			require.NoError(t, err)
 			defer db.Close()
 
-			test.mockSetup(mock)
-			todoRepo := NewSQLTodoDB(db)
-			err = todoRepo.DeleteTodo(test.listId, test.todoId)
-			if test.expectError {
-				require.ErrorIs(t, err, test.err)
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			participants, err := NewRepo(nil, nil).GetTodosParticipants(ctx, todoIds)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, participants)
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, test.expectedParticipants, participants)
 			}
-			require.NoError(t, mock.ExpectationsWereMet())
+
+			require.NoError(t, mck.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestSqlTodoDB_UpdateTodo(t *testing.T) {
+func TestRepository_IsTodoAssignee(t *testing.T) {
 	tests := []struct {
-		testName     string
-		passedEntity *entities2.Todo
-		mockSetup    func(mck sqlmock.Sqlmock)
-		expectError  bool
-		err          error
+		testName           string
+		dbMock             func(mck sqlmock.Sqlmock)
+		err                error
+		expectedIsAssignee bool
 	}{
 		{
-			testName: "Successfully updating todo",
-			passedEntity: initTodoEntity(existingTodoId, todoName, todoDescription,
-				existingListId, todoStatus, testDate, testDate, assigneeNullId, testNullDate, priority),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectExec(regexp.QuoteMeta(sqlQueryUpdateTodo)).WithArgs(todoName, todoDescription, todoStatus,
-					testDate, assigneeNullId, testNullDate, priority, existingTodoId, existingListId).
-					WillReturnResult(sqlmock.NewResult(0, 1))
+			testName: "Successfully finding the user among the assignees of the todo",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryIsTodoAssignee)).
+					WithArgs(existingTodoId.String(), assigneeId.String()).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
 			},
-			expectError: false,
-			err:         nil,
+			expectedIsAssignee: true,
 		},
 		{
-			testName: "Unable to update todo because of invalid todo_id or list_id",
-			passedEntity: initTodoEntity(nonExistingTodoId, todoName, todoDescription,
-				nonExistingListId, todoStatus, testDate, testDate, assigneeNullId, testNullDate, priority),
-			mockSetup: func(mck sqlmock.Sqlmock) {
-				mck.ExpectExec(regexp.QuoteMeta(sqlQueryUpdateTodo)).WithArgs(todoName, todoDescription, todoStatus,
-					testDate, assigneeNullId, testNullDate, priority, nonExistingTodoId, nonExistingListId).
-					WillReturnResult(sqlmock.NewResult(0, 0))
+			testName: "Successfully finding that the user is not among the assignees of the todo",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryIsTodoAssignee)).
+					WithArgs(existingTodoId.String(), assigneeId.String()).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 			},
-			expectError: true,
-			err:         application_errors2.InvalidListOrTodoIdError,
+		},
+		{
+			testName: "Failed to check the assignees of the todo due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryIsTodoAssignee)).
+					WithArgs(existingTodoId.String(), assigneeId.String()).
+					WillReturnError(databaseError)
+			},
+			err: databaseError,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			db, mock, err := sqlmock.Newx()
+			db, mck, err := sqlmock.Newx()
 			require.NoError(t, err)
 			defer db.Close()
 
-			test.mockSetup(mock)
-			todoRepo := NewSQLTodoDB(db)
-			err = todoRepo.UpdateTodo(test.passedEntity)
-			if test.expectError {
-				require.ErrorIs(t, err, test.err)
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			isAssignee, err := NewRepo(nil, nil).IsTodoAssignee(ctx, existingTodoId.String(), assigneeId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
 			} else {
 				require.NoError(t, err)
 			}
-			require.NoError(t, mock.ExpectationsWereMet())
+
+			require.Equal(t, test.expectedIsAssignee, isAssignee)
+			require.NoError(t, mck.ExpectationsWereMet())
 		})
 	}
 }
@@ -1,10 +1,12 @@
 package todos
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
 	"Todo-List/internProject/todo_app_service/internal/entities"
 	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
 	"Todo-List/internProject/todo_app_service/internal/source"
 	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/internal/utils"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
 	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/handler_models"
@@ -12,10 +14,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 )
 
-//go:generate mockery --name=TodoRepo --output=./mocks --outpkg=mocks --filename=todo_repo.go --with-expecter=true
+var errUserWithoutAccess = errors.New("user has no access to the list")
+
+const batchOperationSavepoint = "batch_operation"
+
+//go:generate mockery --name=todoRepo --exported --output=./mocks --outpkg=mocks --filename=todo_repo.go --with-expecter=true
 type todoRepo interface {
 	CreateTodo(ctx context.Context, entity *entities.Todo) (*entities.Todo, error)
 	UpdateTodo(ctx context.Context, sqlExecParams map[string]interface{}, sqlFields []string) (*entities.Todo, error)
@@ -26,63 +33,99 @@ type todoRepo interface {
 	GetTodos(ctx context.Context, f filters.SqlFilters) ([]entities.Todo, error)
 	GetTodosByListId(ctx context.Context, listId string, f filters.SqlFilters) ([]entities.Todo, error)
 	GetTodoAssigneeTo(ctx context.Context, todoId string) (*entities.User, error)
+	IsTodoAssignee(ctx context.Context, todoId string, userId string) (bool, error)
 	GetTodoByListId(ctx context.Context, listId string, todoId string) (*entities.Todo, error)
 	UnassignUserFromTodos(ctx context.Context, userId string, listId string) error
 	GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error)
+	CountOpenSubtasks(ctx context.Context, parentId string) (int, error)
+	DetachSubtasks(ctx context.Context, parentId string) error
+	AddTodoBlocker(ctx context.Context, todoId string, blockerId string) error
+	RemoveTodoBlocker(ctx context.Context, todoId string, blockerId string) error
+	CountOpenBlockers(ctx context.Context, todoId string) (int, error)
+	IsTodoTransitivelyBlockedBy(ctx context.Context, todoId string, blockerId string) (bool, error)
+	MoveTodos(ctx context.Context, todoIds []string, listId string, movedAt time.Time) ([]entities.Todo, error)
+	GetTodosParticipants(ctx context.Context, todoIds []string) ([]string, error)
+	CopyTodoLabels(ctx context.Context, todoId string, copyId string) error
+	PlaceTodoAfter(ctx context.Context, todoId string, anchorId string, placedAt time.Time) (*entities.Todo, error)
+	PlaceTodoBefore(ctx context.Context, todoId string, anchorId string, placedAt time.Time) (*entities.Todo, error)
+	CreateSavepoint(ctx context.Context, name string) error
+	RollbackToSavepoint(ctx context.Context, name string) error
+	ReleaseSavepoint(ctx context.Context, name string) error
+}
+
+//go:generate mockery --name=columnRepo --exported --output=./mocks --outpkg=mocks --filename=column_repo.go --with-expecter=true
+type columnRepo interface {
+	GetListColumns(ctx context.Context, listId string) ([]entities.Column, error)
+	CountColumnTodos(ctx context.Context, column *entities.Column) (int, error)
 }
 
+//go:generate mockery --name=listRepo --exported --output=./mocks --outpkg=mocks --filename=list_repo.go --with-expecter=true
 type listRepo interface {
 	GetList(ctx context.Context, listId string) (*entities.List, error)
 	GetListOwner(ctx context.Context, listId string) (*entities.User, error)
 	CheckWhetherUserIsCollaborator(ctx context.Context, listId string, userId string) (bool, error)
+	CheckWhetherUserIsEditor(ctx context.Context, listId string, userId string) (bool, error)
 }
 
-//go:generate mockery --name=IUuidGenerator --output=./mocks --outpkg=mocks --filename=IUuidGenerator.go --with-expecter=true
+//go:generate mockery --name=uuidGenerator --exported --output=./mocks --outpkg=mocks --filename=uuid_generator.go --with-expecter=true
 type uuidGenerator interface {
 	Generate() string
 }
 
-//go:generate mockery --name=ITimeGenerator --output=./mocks --outpkg=mocks --filename=ITimeGenerator.go --with-expecter=true
+//go:generate mockery --name=timeGenerator --exported --output=./mocks --outpkg=mocks --filename=time_generator.go --with-expecter=true
 type timeGenerator interface {
 	Now() time.Time
 }
 
+//go:generate mockery --name=todoConverter --exported --output=./mocks --outpkg=mocks --filename=todo_converter.go --with-expecter=true
 type todoConverter interface {
 	ToModel(todo *entities.Todo) *models.Todo
 	ToEntity(todo *models.Todo) *entities.Todo
-	ManyToPage(todos []entities.Todo, pageInfo *entities.PaginationInfo) *models.TodoPage
+	ManyToPage(todos []entities.Todo, pageInfo *entities.PaginationInfo, sortField string) *models.TodoPage
 	ConvertFromCreateHandlerModelToModel(todo *handler_models.CreateTodo) *models.Todo
 	ConvertFromUpdateHandlerModelToModel(todo *handler_models.UpdateTodo) *models.Todo
+	ConvertFromCreateSubtaskHandlerModelToModel(subtask *handler_models.CreateSubtask, parent *models.Todo) *models.Todo
 }
 
+//go:generate mockery --name=userConverter --exported --output=./mocks --outpkg=mocks --filename=user_converter.go --with-expecter=true
 type userConverter interface {
 	ToModel(user *entities.User) *models.User
 }
 
+//go:generate mockery --name=resourceIdentifierAdapter --exported --output=./mocks --outpkg=mocks --filename=resource_identifier_adapter.go --with-expecter=true
 type resourceIdentifierAdapter interface {
 	AdaptResourceIdentifier(rf resource_identifier.ResourceIdentifier) string
 }
 
+//go:generate mockery --name=historyRecorder --exported --output=./mocks --outpkg=mocks --filename=history_recorder.go --with-expecter=true
+type historyRecorder interface {
+	RecordActor(ctx context.Context) error
+}
+
 type service struct {
 	tRepo      todoRepo
 	lRepo      listRepo
+	cRepo      columnRepo
 	uuidGen    uuidGenerator
 	timeGen    timeGenerator
 	tConverter todoConverter
 	uConverter userConverter
 	rfAdapter  resourceIdentifierAdapter
+	hRecorder  historyRecorder
 }
 
-func NewService(tRepo todoRepo, lRepo listRepo, uuidGen uuidGenerator, timeGen timeGenerator,
-	todoConverter todoConverter, userConverter userConverter, rfAdapter resourceIdentifierAdapter) *service {
+func NewService(tRepo todoRepo, lRepo listRepo, cRepo columnRepo, uuidGen uuidGenerator, timeGen timeGenerator,
+	todoConverter todoConverter, userConverter userConverter, rfAdapter resourceIdentifierAdapter, hRecorder historyRecorder) *service {
 	return &service{
 		tRepo:      tRepo,
 		lRepo:      lRepo,
+		cRepo:      cRepo,
 		uuidGen:    uuidGen,
 		timeGen:    timeGen,
 		tConverter: todoConverter,
 		uConverter: userConverter,
 		rfAdapter:  rfAdapter,
+		hRecorder:  hRecorder,
 	}
 }
 
@@ -90,12 +133,17 @@ func (s *service) CreateTodoRecord(ctx context.Context, todo *handler_models.Cre
 	log.C(ctx).Info("creating todo in todo service")
 
 	if creator.Role != constants.Admin {
-		if err := s.checkWhetherUserHasAccessToTodo(ctx, creator.Id, todo.ListId, errors.New("only users that are part of the list can create todo")); err != nil {
+		if err := s.checkWhetherUserCanEditTodos(ctx, creator.Id, todo.ListId, errors.New("only the list owner and the list editors can create todo")); err != nil {
 			log.C(ctx).Errorf("failed to create todo, error %s user trying to create todo does not have access to it", err.Error())
 			return nil, err
 		}
 	}
 
+	return s.createListTodo(ctx, todo)
+}
+
+// createListTodo creates the todo once the caller is known to be allowed to create todos in its list
+func (s *service) createListTodo(ctx context.Context, todo *handler_models.CreateTodo) (*models.Todo, error) {
 	if todo.AssignedTo != nil {
 		if err := s.checkWhetherUserHasAccessToTodo(ctx, *todo.AssignedTo, todo.ListId, errors.New("only the list owner and the list collaborators can be assigned to todo")); err != nil {
 			log.C(ctx).Errorf("failed to create todo, error %s", err.Error())
@@ -105,10 +153,53 @@ func (s *service) CreateTodoRecord(ctx context.Context, todo *handler_models.Cre
 
 	modelTodo := s.tConverter.ConvertFromCreateHandlerModelToModel(todo)
 
+	return s.createTodo(ctx, modelTodo)
+}
+
+func (s *service) CreateSubtaskRecord(ctx context.Context, parentId string, subtask *handler_models.CreateSubtask, creator *models.User) (*models.Todo, error) {
+	log.C(ctx).Infof("creating subtask of todo with id %s in todo service", parentId)
+
+	parentEntity, err := s.tRepo.GetTodo(ctx, parentId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create subtask, error %s when trying to get parent todo with id %s", err.Error(), parentId)
+		return nil, err
+	}
+
+	parent := s.tConverter.ToModel(parentEntity)
+	if parent.Status == constants.Done {
+		log.C(ctx).Errorf("failed to create subtask, parent todo with id %s is already done", parentId)
+		return nil, application_errors.DoneParentTodoError
+	}
+
+	if creator.Role != constants.Admin {
+		if err = s.checkWhetherUserCanEditTodos(ctx, creator.Id, parent.ListId, errors.New("only the list owner and the list editors can create subtask")); err != nil {
+			log.C(ctx).Errorf("failed to create subtask, error %s user trying to create subtask does not have access to it", err.Error())
+			return nil, err
+		}
+	}
+
+	if subtask.AssignedTo != nil {
+		if err = s.checkWhetherUserHasAccessToTodo(ctx, *subtask.AssignedTo, parent.ListId, errors.New("only the list owner and the list collaborators can be assigned to todo")); err != nil {
+			log.C(ctx).Errorf("failed to create subtask, error %s", err.Error())
+			return nil, err
+		}
+	}
+
+	modelTodo := s.tConverter.ConvertFromCreateSubtaskHandlerModelToModel(subtask, parent)
+
+	return s.createTodo(ctx, modelTodo)
+}
+
+func (s *service) createTodo(ctx context.Context, modelTodo *models.Todo) (*models.Todo, error) {
 	modelTodo.Id = s.uuidGen.Generate()
 	modelTodo.LastUpdated = s.timeGen.Now()
 	modelTodo.CreatedAt = s.timeGen.Now()
 
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return nil, err
+	}
+
 	entityTodo := s.tConverter.ToEntity(modelTodo)
 
 	returnedEntity, err := s.tRepo.CreateTodo(ctx, entityTodo)
@@ -123,6 +214,11 @@ func (s *service) CreateTodoRecord(ctx context.Context, todo *handler_models.Cre
 func (s *service) DeleteTodoRecord(ctx context.Context, todoId string) error {
 	log.C(ctx).Infof("deleting todo with id %s in todo service", todoId)
 
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
 	if err := s.tRepo.DeleteTodo(ctx, todoId); err != nil {
 		log.C(ctx).Errorf("failed to delete todo with id %s", err.Error())
 		return err
@@ -139,6 +235,11 @@ func (s *service) DeleteTodosRecordsByListId(ctx context.Context, listId string)
 		return err
 	}
 
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
 	if err := s.tRepo.DeleteTodosByListId(ctx, listId); err != nil {
 		log.C(ctx).Errorf("failed to delete todos by list id %s, error %s", listId, err.Error())
 		return err
@@ -150,6 +251,11 @@ func (s *service) DeleteTodosRecordsByListId(ctx context.Context, listId string)
 func (s *service) DeleteTodosRecords(ctx context.Context) error {
 	log.C(ctx).Info("deleting all todos")
 
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
 	if err := s.tRepo.DeleteTodos(ctx); err != nil {
 		log.C(ctx).Errorf("failed to delete todos in todo sercice, error %s", err.Error())
 		return err
@@ -165,11 +271,17 @@ func (s *service) UpdateTodoRecord(ctx context.Context, todoId string, todo *han
 
 	todoEntity, err := s.tRepo.GetTodo(ctx, todoId)
 	if err != nil {
-		log.C(ctx).Errorf("failed to get todo with id %s when trying to update todo, error %s", err.Error())
+		log.C(ctx).Errorf("failed to get todo with id %s when trying to update todo, error %s", todoId, err.Error())
 		return nil, err
 	}
 
 	modelTodo := s.tConverter.ConvertFromUpdateHandlerModelToModel(todo)
+	clearColumn, err := s.applyTodoColumn(ctx, todoEntity, modelTodo)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update todo with id %s, error %s", todoId, err.Error())
+		return nil, err
+	}
+
 	if todo.AssignedTo != nil {
 		assignError := fmt.Errorf("only the list owner and the list collaborators can be assigned to todo")
 
@@ -179,7 +291,55 @@ func (s *service) UpdateTodoRecord(ctx context.Context, todoId string, todo *han
 		}
 	}
 
+	if modelTodo.Status == constants.Done {
+		if err = s.checkWhetherSubtasksAreDone(ctx, todoId); err != nil {
+			log.C(ctx).Errorf("failed to update todo with id %s, error %s", todoId, err.Error())
+			return nil, err
+		}
+	}
+
+	if (modelTodo.Status == constants.InProgress || modelTodo.Status == constants.Done) && string(modelTodo.Status) != todoEntity.Status {
+		if err = s.checkWhetherBlockersAreDone(ctx, todoId); err != nil {
+			log.C(ctx).Errorf("failed to update todo with id %s, error %s", todoId, err.Error())
+			return nil, err
+		}
+	}
+
+	if modelTodo.Recurrence != nil && modelTodo.DueDate == nil && !todoEntity.DueDate.Valid {
+		log.C(ctx).Errorf("failed to update todo with id %s, recurrence rule provided for todo without due date", todoId)
+		return nil, application_errors.RecurrenceWithoutDueDateError
+	}
+
+	currentTodo := s.tConverter.ToModel(todoEntity)
+
+	recurrence := currentTodo.Recurrence
+	if modelTodo.Recurrence != nil {
+		recurrence = modelTodo.Recurrence
+	} else if recurrence != nil && modelTodo.DueDate != nil {
+		rescheduled := *recurrence
+		rescheduled.AnchorDay = 0
+		recurrence = &rescheduled
+	}
+
+	shouldRecur := recurrence != nil && modelTodo.Status == constants.Done && currentTodo.Status != constants.Done
+	if shouldRecur {
+		modelTodo.Recurrence = nil
+	}
+
 	determineSqlFieldsAndParamsTodo(modelTodo, sqlExecParams, &sqlFields)
+	if shouldRecur {
+		clearRecurrenceSqlFields(&sqlFields)
+	} else if modelTodo.DueDate != nil || modelTodo.Recurrence != nil {
+		sqlFields = append(sqlFields, "recurrence_anchor_day = NULL")
+	}
+	if clearColumn {
+		sqlFields = append(sqlFields, "column_id = NULL")
+	}
+
+	if err = s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return nil, err
+	}
 
 	entity, err := s.tRepo.UpdateTodo(ctx, sqlExecParams, sqlFields)
 	if err != nil {
@@ -187,7 +347,33 @@ func (s *service) UpdateTodoRecord(ctx context.Context, todoId string, todo *han
 		return nil, err
 	}
 
-	return s.tConverter.ToModel(entity), nil
+	updatedTodo := s.tConverter.ToModel(entity)
+	if shouldRecur {
+		if err = s.createNextOccurrence(ctx, updatedTodo, recurrence); err != nil {
+			log.C(ctx).Errorf("failed to create next occurrence of todo with id %s, error %s", todoId, err.Error())
+			return nil, err
+		}
+	}
+
+	return updatedTodo, nil
+}
+
+func (s *service) createNextOccurrence(ctx context.Context, todo *models.Todo, recurrence *models.Recurrence) error {
+	log.C(ctx).Infof("creating next occurrence of recurring todo with id %s", todo.Id)
+
+	if todo.DueDate == nil {
+		log.C(ctx).Errorf("recurring todo with id %s has no due date", todo.Id)
+		return application_errors.RecurrenceWithoutDueDateError
+	}
+
+	next := nextOccurrence(todo, recurrence, *todo.DueDate, s.timeGen.Now())
+	if next == nil {
+		log.C(ctx).Debugf("recurring todo with id %s was the last occurrence of its series", todo.Id)
+		return nil
+	}
+
+	_, err := s.createTodo(ctx, next)
+	return err
 }
 
 func (s *service) GetTodoRecord(ctx context.Context, todoId string) (*models.Todo, error) {
@@ -214,6 +400,19 @@ func (s *service) GetTodoAssigneeToRecord(ctx context.Context, todoId string) (*
 	return s.uConverter.ToModel(assignee), nil
 }
 
+// IsTodoAssigneeRecord checks whether the user is one of the extra assignees of the todo
+func (s *service) IsTodoAssigneeRecord(ctx context.Context, todoId string, userId string) (bool, error) {
+	log.C(ctx).Infof("checking whether user with id %s is assignee of todo with id %s", userId, todoId)
+
+	isAssignee, err := s.tRepo.IsTodoAssignee(ctx, todoId, userId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check whether user is assignee of todo with id %s, error %s when calling todo repo", todoId, err.Error())
+		return false, err
+	}
+
+	return isAssignee, nil
+}
+
 func (s *service) GetTodoRecords(ctx context.Context, f filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error) {
 	log.C(ctx).Info("getting todos in todo service")
 
@@ -230,7 +429,13 @@ func (s *service) GetTodoRecords(ctx context.Context, f filters.SqlFilters, rf r
 		return nil, err
 	}
 
-	return s.tConverter.ManyToPage(eTodos, paginationInfo), nil
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to extract sort from filters, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.tConverter.ManyToPage(eTodos, paginationInfo, sort.Field), nil
 }
 
 func (s *service) GetTodosByListId(ctx context.Context, f filters.SqlFilters, listId string, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error) {
@@ -254,7 +459,154 @@ func (s *service) GetTodosByListId(ctx context.Context, f filters.SqlFilters, li
 		return nil, err
 	}
 
-	return s.tConverter.ManyToPage(eTodos, paginationInfo), nil
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to extract sort from filters, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.tConverter.ManyToPage(eTodos, paginationInfo, sort.Field), nil
+}
+
+func (s *service) GetSubtasksRecords(ctx context.Context, f filters.SqlFilters, parentId string, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error) {
+	log.C(ctx).Infof("getting subtasks of todo with id %s in todo service", parentId)
+
+	if _, err := s.tRepo.GetTodo(ctx, parentId); err != nil {
+		log.C(ctx).Errorf("failed to get subtasks of todo with id %s, error when calling todo repo", parentId)
+		return nil, err
+	}
+
+	eTodos, err := s.tRepo.GetTodos(ctx, f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get subtasks of todo with id %s, error %s when calling todo repo", parentId, err.Error())
+		return nil, err
+	}
+
+	sqlSource := prepareSqlSource(s.rfAdapter, rf)
+	paginationInfo, err := s.tRepo.GetPaginationInfo(ctx, f, sqlSource)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get first and last ids of subtasks, error %s", err.Error())
+		return nil, err
+	}
+
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to extract sort from filters, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.tConverter.ManyToPage(eTodos, paginationInfo, sort.Field), nil
+}
+
+func (s *service) DetachSubtasksRecords(ctx context.Context, parentId string) error {
+	log.C(ctx).Infof("detaching subtasks of todo with id %s in todo service", parentId)
+
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
+	if err := s.tRepo.DetachSubtasks(ctx, parentId); err != nil {
+		log.C(ctx).Errorf("failed to detach subtasks of todo with id %s, error %s", parentId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetTodoBlockersRecords(ctx context.Context, f filters.SqlFilters, todoId string, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error) {
+	log.C(ctx).Infof("getting blockers of todo with id %s in todo service", todoId)
+
+	return s.getTodoDependenciesRecords(ctx, f, todoId, rf)
+}
+
+func (s *service) GetBlockedTodosRecords(ctx context.Context, f filters.SqlFilters, todoId string, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error) {
+	log.C(ctx).Infof("getting todos blocked by todo with id %s in todo service", todoId)
+
+	return s.getTodoDependenciesRecords(ctx, f, todoId, rf)
+}
+
+func (s *service) getTodoDependenciesRecords(ctx context.Context, f filters.SqlFilters, todoId string, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error) {
+	if _, err := s.tRepo.GetTodo(ctx, todoId); err != nil {
+		log.C(ctx).Errorf("failed to get dependencies of todo with id %s, error when calling todo repo", todoId)
+		return nil, err
+	}
+
+	eTodos, err := s.tRepo.GetTodos(ctx, f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get dependencies of todo with id %s, error %s when calling todo repo", todoId, err.Error())
+		return nil, err
+	}
+
+	sqlSource := prepareSqlSource(s.rfAdapter, rf)
+	paginationInfo, err := s.tRepo.GetPaginationInfo(ctx, f, sqlSource)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get first and last ids of todo dependencies, error %s", err.Error())
+		return nil, err
+	}
+
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to extract sort from filters, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.tConverter.ManyToPage(eTodos, paginationInfo, sort.Field), nil
+}
+
+func (s *service) AddTodoBlockerRecord(ctx context.Context, todoId string, blockerId string) (*models.Todo, error) {
+	log.C(ctx).Infof("adding todo with id %s as a blocker of todo with id %s in todo service", blockerId, todoId)
+
+	todoEntity, err := s.tRepo.GetTodo(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add blocker, error %s when trying to get todo with id %s", err.Error(), todoId)
+		return nil, err
+	}
+
+	blockerEntity, err := s.tRepo.GetTodo(ctx, blockerId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add blocker, error %s when trying to get blocker with id %s", err.Error(), blockerId)
+		return nil, err
+	}
+
+	if todoEntity.ListId != blockerEntity.ListId {
+		log.C(ctx).Errorf("failed to add blocker, todo with id %s and blocker with id %s are in different lists", todoId, blockerId)
+		return nil, application_errors.BlockerOutOfScopeError
+	}
+
+	if todoId == blockerId {
+		log.C(ctx).Errorf("failed to add blocker, todo with id %s can't block itself", todoId)
+		return nil, application_errors.NewDependencyCycleError(todoId, blockerId)
+	}
+
+	createsCycle, err := s.tRepo.IsTodoTransitivelyBlockedBy(ctx, blockerId, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add blocker to todo with id %s, error %s when checking for cycles", todoId, err.Error())
+		return nil, err
+	}
+
+	if createsCycle {
+		log.C(ctx).Errorf("failed to add blocker, todo with id %s is already blocked by todo with id %s", blockerId, todoId)
+		return nil, application_errors.NewDependencyCycleError(todoId, blockerId)
+	}
+
+	if err = s.tRepo.AddTodoBlocker(ctx, todoId, blockerId); err != nil {
+		log.C(ctx).Errorf("failed to add blocker to todo with id %s, error %s when calling todo repo", todoId, err.Error())
+		return nil, err
+	}
+
+	return s.tConverter.ToModel(blockerEntity), nil
+}
+
+func (s *service) RemoveTodoBlockerRecord(ctx context.Context, todoId string, blockerId string) error {
+	log.C(ctx).Infof("removing blocker with id %s from todo with id %s in todo service", blockerId, todoId)
+
+	if err := s.tRepo.RemoveTodoBlocker(ctx, todoId, blockerId); err != nil {
+		log.C(ctx).Errorf("failed to remove blocker from todo with id %s, error %s", todoId, err.Error())
+		return err
+	}
+
+	return nil
 }
 
 func (s *service) GetTodoByListId(ctx context.Context, listId string, todoId string) (*models.Todo, error) {
@@ -274,8 +626,367 @@ func (s *service) GetTodoByListId(ctx context.Context, listId string, todoId str
 	return s.tConverter.ToModel(entityTodo), nil
 }
 
+// MoveTodosRecords moves the todos with their subtasks to another list, the todos keep their ids and therefore their history,
+// the assignees, extra assignees and watchers of the moved todos and their subtasks who can not access the target list
+// are removed from the todos of the target list
+func (s *service) MoveTodosRecords(ctx context.Context, todoIds []string, listId string, caller *models.User) ([]*models.Todo, error) {
+	log.C(ctx).Infof("moving todos to list with id %s in todo service", listId)
+
+	todos, err := s.getTodosToTransfer(ctx, todoIds, listId, caller)
+	if err != nil {
+		log.C(ctx).Errorf("failed to move todos to list with id %s, error %s", listId, err.Error())
+		return nil, err
+	}
+
+	if err = s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(todos))
+	for _, todo := range todos {
+		ids = append(ids, todo.Id.String())
+	}
+
+	movedTodos, err := s.tRepo.MoveTodos(ctx, ids, listId, s.timeGen.Now())
+	if err != nil {
+		log.C(ctx).Errorf("failed to move todos to list with id %s, error %s when calling todo repo", listId, err.Error())
+		return nil, err
+	}
+
+	movedIds := make([]string, 0, len(movedTodos))
+	for _, movedTodo := range movedTodos {
+		movedIds = append(movedIds, movedTodo.Id.String())
+	}
+
+	participants, err := s.tRepo.GetTodosParticipants(ctx, movedIds)
+	if err != nil {
+		log.C(ctx).Errorf("failed to move todos to list with id %s, error %s when getting the todos participants", listId, err.Error())
+		return nil, err
+	}
+
+	for _, participant := range participants {
+		if err = s.unassignUserWithoutAccess(ctx, participant, listId); err != nil {
+			log.C(ctx).Errorf("failed to move todos to list with id %s, error %s", listId, err.Error())
+			return nil, err
+		}
+	}
+
+	result := make([]*models.Todo, 0, len(ids))
+	for _, id := range ids {
+		movedTodo, err := s.tRepo.GetTodo(ctx, id)
+		if err != nil {
+			log.C(ctx).Errorf("failed to get moved todo with id %s, error %s", id, err.Error())
+			return nil, err
+		}
+
+		result = append(result, s.tConverter.ToModel(movedTodo))
+	}
+
+	return result, nil
+}
+
+// CopyTodosRecords creates open copies of the todos in another list, the copies take over the labels which are in scope
+// of the target list and the assignee if the assignee can access the target list, but not the subtasks, blockers and comments
+func (s *service) CopyTodosRecords(ctx context.Context, todoIds []string, listId string, caller *models.User) ([]*models.Todo, error) {
+	log.C(ctx).Infof("copying todos to list with id %s in todo service", listId)
+
+	todos, err := s.getTodosToTransfer(ctx, todoIds, listId, caller)
+	if err != nil {
+		log.C(ctx).Errorf("failed to copy todos to list with id %s, error %s", listId, err.Error())
+		return nil, err
+	}
+
+	copies := make([]*models.Todo, 0, len(todos))
+	for _, todo := range todos {
+		todoCopy := s.tConverter.ToModel(todo)
+		todoCopy.ListId = listId
+		todoCopy.Position = ""
+		todoCopy.Status = constants.Open
+		todoCopy.ParentId = nil
+
+		if todoCopy.AssignedTo != nil {
+			if err = s.checkWhetherUserHasAccessToTodo(ctx, *todoCopy.AssignedTo, listId, errUserWithoutAccess); err != nil {
+				if !errors.Is(err, errUserWithoutAccess) {
+					log.C(ctx).Errorf("failed to copy todo with id %s, error %s", todo.Id, err.Error())
+					return nil, err
+				}
+				todoCopy.AssignedTo = nil
+			}
+		}
+
+		createdCopy, err := s.createTodo(ctx, todoCopy)
+		if err != nil {
+			log.C(ctx).Errorf("failed to copy todo with id %s, error %s", todo.Id, err.Error())
+			return nil, err
+		}
+
+		if err = s.tRepo.CopyTodoLabels(ctx, todo.Id.String(), createdCopy.Id); err != nil {
+			log.C(ctx).Errorf("failed to copy labels of todo with id %s, error %s when calling todo repo", todo.Id, err.Error())
+			return nil, err
+		}
+
+		copies = append(copies, createdCopy)
+	}
+
+	return copies, nil
+}
+
+// ReorderTodoRecord places the todo right before or right after another todo of the same list,
+// the positions of the other todos stay untouched
+func (s *service) ReorderTodoRecord(ctx context.Context, todoId string, reorder *handler_models.ReorderTodo) (*models.Todo, error) {
+	anchorId := reorder.After
+	if reorder.Before != nil {
+		anchorId = reorder.Before
+	}
+	log.C(ctx).Infof("placing todo with id %s next to todo with id %s in todo service", todoId, *anchorId)
+
+	todoEntity, err := s.tRepo.GetTodo(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to reorder todo, error %s when trying to get todo with id %s", err.Error(), todoId)
+		return nil, err
+	}
+
+	anchorEntity, err := s.tRepo.GetTodo(ctx, *anchorId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to reorder todo, error %s when trying to get todo with id %s", err.Error(), *anchorId)
+		return nil, err
+	}
+
+	if todoEntity.ListId != anchorEntity.ListId || todoId == *anchorId {
+		log.C(ctx).Errorf("failed to reorder todo, todo with id %s can't be placed next to todo with id %s", todoId, *anchorId)
+		return nil, application_errors.ReorderOutOfScopeError
+	}
+
+	if err = s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return nil, err
+	}
+
+	var placedTodo *entities.Todo
+	if reorder.Before != nil {
+		placedTodo, err = s.tRepo.PlaceTodoBefore(ctx, todoId, *anchorId, s.timeGen.Now())
+	} else {
+		placedTodo, err = s.tRepo.PlaceTodoAfter(ctx, todoId, *anchorId, s.timeGen.Now())
+	}
+	if err != nil {
+		log.C(ctx).Errorf("failed to reorder todo with id %s, error %s when calling todo repo", todoId, err.Error())
+		return nil, err
+	}
+
+	return s.tConverter.ToModel(placedTodo), nil
+}
+
+// BatchTodosRecords executes the operations one after another in the transaction from the context, in the all or nothing mode
+// the first failing operation stops the batch and nothing has to be committed, in the per item mode every failing operation
+// is undone on its own and the other operations are kept
+func (s *service) BatchTodosRecords(ctx context.Context, batch *handler_models.BatchTodos, caller *models.User) (*models.BatchTodosResult, error) {
+	log.C(ctx).Infof("executing batch of %d todo operations in todo service", len(batch.Operations))
+
+	perItem := batch.Mode == constants.PER_ITEM_BATCH_MODE
+	result := &models.BatchTodosResult{
+		Committed: true,
+		Results:   make([]*models.BatchTodoResult, 0, len(batch.Operations)),
+	}
+
+	for index := range batch.Operations {
+		operation := &batch.Operations[index]
+
+		if perItem {
+			if err := s.tRepo.CreateSavepoint(ctx, batchOperationSavepoint); err != nil {
+				log.C(ctx).Errorf("failed to execute batch operation %d, error %s when creating savepoint", index, err.Error())
+				return nil, err
+			}
+		}
+
+		todo, err := s.executeBatchOperation(ctx, operation, caller)
+		if err != nil {
+			log.C(ctx).Errorf("failed to execute batch operation %d, error %s", index, err.Error())
+
+			result.Results = append(result.Results, &models.BatchTodoResult{
+				Index:  index,
+				Op:     operation.Op,
+				Status: utils.DetermineStatusCode(err),
+				Error:  err.Error(),
+			})
+
+			if !perItem {
+				result.Committed = false
+				markBatchAsRolledBack(result, batch, index)
+				return result, nil
+			}
+
+			if err = s.tRepo.RollbackToSavepoint(ctx, batchOperationSavepoint); err != nil {
+				log.C(ctx).Errorf("failed to undo batch operation %d, error %s", index, err.Error())
+				return nil, err
+			}
+			continue
+		}
+
+		if perItem {
+			if err = s.tRepo.ReleaseSavepoint(ctx, batchOperationSavepoint); err != nil {
+				log.C(ctx).Errorf("failed to execute batch operation %d, error %s when releasing savepoint", index, err.Error())
+				return nil, err
+			}
+		}
+
+		result.Results = append(result.Results, &models.BatchTodoResult{
+			Index:  index,
+			Op:     operation.Op,
+			Status: batchOperationSuccessStatus(operation.Op),
+			Todo:   todo,
+		})
+	}
+
+	return result, nil
+}
+
+// executeBatchOperation checks whether the caller may execute the operation and executes it the same way
+// as the standalone endpoint of the operation would
+func (s *service) executeBatchOperation(ctx context.Context, operation *handler_models.BatchTodoOperation, caller *models.User) (*models.Todo, error) {
+	if operation.Op == constants.BATCH_CREATE_OPERATION {
+		if err := s.checkWhetherUserCanCreateTodo(ctx, operation.Create.ListId, caller); err != nil {
+			return nil, err
+		}
+
+		return s.createListTodo(ctx, operation.Create)
+	}
+
+	todo, err := s.tRepo.GetTodo(ctx, operation.TodoId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkWhetherUserCanModifyTodo(ctx, todo, caller); err != nil {
+		return nil, err
+	}
+
+	if operation.Op == constants.BATCH_UPDATE_OPERATION {
+		return s.UpdateTodoRecord(ctx, operation.TodoId, operation.Update)
+	}
+
+	return nil, s.DeleteTodoRecord(ctx, operation.TodoId)
+}
+
+// checkWhetherUserCanModifyTodo lets through the same users as the todo modify middleware, the admins, the assignees,
+// the list owner and the list editors
+func (s *service) checkWhetherUserCanModifyTodo(ctx context.Context, todo *entities.Todo, caller *models.User) error {
+	if caller.Role == constants.Admin || (todo.AssignedTo.Valid && todo.AssignedTo.UUID.String() == caller.Id) {
+		return nil
+	}
+
+	isAssignee, err := s.tRepo.IsTodoAssignee(ctx, todo.Id.String(), caller.Id)
+	if err != nil {
+		return err
+	}
+
+	if isAssignee {
+		return nil
+	}
+
+	return s.checkWhetherUserCanEditTodos(ctx, caller.Id, todo.ListId.String(), application_errors.TodoAccessForbiddenError)
+}
+
+// checkWhetherUserCanCreateTodo lets through the same users as POST /lists/{list_id}/todos, the admins and the writers
+// who own the list or are at least editors in it
+func (s *service) checkWhetherUserCanCreateTodo(ctx context.Context, listId string, caller *models.User) error {
+	if caller.Role == constants.Admin {
+		return nil
+	}
+
+	if caller.Role != constants.Writer {
+		return application_errors.TodoCreationForbiddenError
+	}
+
+	return s.checkWhetherUserCanEditTodos(ctx, caller.Id, listId, application_errors.TodoCreationForbiddenError)
+}
+
+// markBatchAsRolledBack reports the operations other than the failed one as not applied, because the batch was rolled back
+func markBatchAsRolledBack(result *models.BatchTodosResult, batch *handler_models.BatchTodos, failedIndex int) {
+	for _, executed := range result.Results[:failedIndex] {
+		executed.Status = http.StatusFailedDependency
+		executed.Todo = nil
+		executed.Error = fmt.Sprintf("rolled back because operation %d failed", failedIndex)
+	}
+
+	for index := failedIndex + 1; index < len(batch.Operations); index++ {
+		result.Results = append(result.Results, &models.BatchTodoResult{
+			Index:  index,
+			Op:     batch.Operations[index].Op,
+			Status: http.StatusFailedDependency,
+			Error:  fmt.Sprintf("not executed because operation %d failed", failedIndex),
+		})
+	}
+}
+
+func batchOperationSuccessStatus(op string) int {
+	switch op {
+	case constants.BATCH_CREATE_OPERATION:
+		return http.StatusCreated
+	case constants.BATCH_DELETE_OPERATION:
+		return http.StatusNoContent
+	default:
+		return http.StatusOK
+	}
+}
+
+// getTodosToTransfer returns the todos which are going to be moved or copied to the list, the caller has to be able to
+// modify the todos in their current lists and in the target list
+func (s *service) getTodosToTransfer(ctx context.Context, todoIds []string, listId string, caller *models.User) ([]*entities.Todo, error) {
+	if _, err := s.lRepo.GetList(ctx, listId); err != nil {
+		log.C(ctx).Errorf("failed to get target list with id %s, error %s when calling list repo", listId, err.Error())
+		return nil, err
+	}
+
+	listIds := map[string]bool{listId: true}
+	seenTodos := make(map[string]bool, len(todoIds))
+	todos := make([]*entities.Todo, 0, len(todoIds))
+	for _, todoId := range todoIds {
+		if seenTodos[todoId] {
+			continue
+		}
+		seenTodos[todoId] = true
+
+		todo, err := s.tRepo.GetTodo(ctx, todoId)
+		if err != nil {
+			log.C(ctx).Errorf("failed to get todo with id %s, error %s when calling todo repo", todoId, err.Error())
+			return nil, err
+		}
+
+		listIds[todo.ListId.String()] = true
+		todos = append(todos, todo)
+	}
+
+	if caller.Role == constants.Admin {
+		return todos, nil
+	}
+
+	for id := range listIds {
+		if err := s.checkWhetherUserCanEditTodos(ctx, caller.Id, id, errors.New("only the list owner and the list editors can move and copy todos")); err != nil {
+			log.C(ctx).Errorf("failed to transfer todos, error %s user can not modify todos of list with id %s", err.Error(), id)
+			return nil, err
+		}
+	}
+
+	return todos, nil
+}
+
+// unassignUserWithoutAccess unassigns the user from the todos of the list if the user is neither the list owner nor its collaborator
+func (s *service) unassignUserWithoutAccess(ctx context.Context, userId string, listId string) error {
+	err := s.checkWhetherUserHasAccessToTodo(ctx, userId, listId, errUserWithoutAccess)
+	if err == nil {
+		return nil
+	}
+
+	if !errors.Is(err, errUserWithoutAccess) {
+		return err
+	}
+
+	return s.tRepo.UnassignUserFromTodos(ctx, userId, listId)
+}
+
 func (s *service) checkWhetherUserHasAccessToTodo(ctx context.Context, userId string, listId string, desiredErr error) error {
-	log.C(ctx).Info("checking whether user with id %s has access to todos from list with id %s", userId, listId)
+	log.C(ctx).Infof("checking whether user with id %s has access to todos from list with id %s", userId, listId)
 
 	todoListOwner, err := s.lRepo.GetListOwner(ctx, listId)
 	if err != nil {
@@ -297,9 +1008,72 @@ func (s *service) checkWhetherUserHasAccessToTodo(ctx context.Context, userId st
 	return nil
 }
 
+// checkWhetherUserCanEditTodos is like checkWhetherUserHasAccessToTodo, but the viewers of the list are rejected as well
+func (s *service) checkWhetherUserCanEditTodos(ctx context.Context, userId string, listId string, desiredErr error) error {
+	log.C(ctx).Infof("checking whether user with id %s can edit todos from list with id %s", userId, listId)
+
+	todoListOwner, err := s.lRepo.GetListOwner(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check whether user can edit todos, error %s when trying to get list owner", err.Error())
+		return err
+	}
+
+	isEditor, err := s.lRepo.CheckWhetherUserIsEditor(ctx, listId, userId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check whether user can edit todos, error %s when trying to check whether user with id %s is editor", err.Error(), userId)
+		return err
+	}
+
+	if userId != todoListOwner.Id.String() && !isEditor {
+		log.C(ctx).Debug("user is not owner and is not an editor of the list...")
+		return desiredErr
+	}
+
+	return nil
+}
+
+func (s *service) checkWhetherSubtasksAreDone(ctx context.Context, todoId string) error {
+	log.C(ctx).Infof("checking whether todo with id %s has open subtasks", todoId)
+
+	openSubtasks, err := s.tRepo.CountOpenSubtasks(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to count open subtasks of todo with id %s, error %s", todoId, err.Error())
+		return err
+	}
+
+	if openSubtasks != 0 {
+		log.C(ctx).Debugf("todo with id %s has %d open subtasks...", todoId, openSubtasks)
+		return application_errors.OpenSubtasksError
+	}
+
+	return nil
+}
+
+func (s *service) checkWhetherBlockersAreDone(ctx context.Context, todoId string) error {
+	log.C(ctx).Infof("checking whether todo with id %s has open blockers", todoId)
+
+	openBlockers, err := s.tRepo.CountOpenBlockers(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to count open blockers of todo with id %s, error %s", todoId, err.Error())
+		return err
+	}
+
+	if openBlockers != 0 {
+		log.C(ctx).Debugf("todo with id %s has %d open blockers...", todoId, openBlockers)
+		return application_errors.OpenBlockersError
+	}
+
+	return nil
+}
+
 func (s *service) UnassignUserFromTodos(ctx context.Context, userId string, listId string) error {
 	log.C(ctx).Infof("unassigning user with id %s from todo from id %s", userId, listId)
 
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
 	if err := s.tRepo.UnassignUserFromTodos(ctx, userId, listId); err != nil {
 		log.C(ctx).Errorf("failed to unassign user with id %s from todos of list with id %s, error %s", userId, listId, err.Error())
 		return err
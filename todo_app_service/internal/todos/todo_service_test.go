@@ -1,5 +1,23 @@
 package todos
 
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/todos/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+	"time"
+)
+
 /*
 import (
 	"fmt"
@@ -317,3 +335,1276 @@ func TestService_UpdateTodoRecord(t *testing.T) {
 	}
 }
 */
+
+func TestService_CheckWhetherSubtasksAreDone(t *testing.T) {
+	tests := []struct {
+		testName     string
+		mockTodoRepo func() *mocks.TodoRepo
+		err          error
+	}{
+		{
+			testName: "Successfully checking todo without open subtasks",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					CountOpenSubtasks(context.TODO(), existingTodoId.String()).
+					Return(0, nil).Once()
+
+				return mRepo
+			},
+		},
+		{
+			testName: "Failed check because todo has open subtasks",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					CountOpenSubtasks(context.TODO(), existingTodoId.String()).
+					Return(2, nil).Once()
+
+				return mRepo
+			},
+			err: application_errors.OpenSubtasksError,
+		},
+		{
+			testName: "Failed check due to error when counting open subtasks",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					CountOpenSubtasks(context.TODO(), existingTodoId.String()).
+					Return(0, databaseError).Once()
+
+				return mRepo
+			},
+			err: databaseError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockTodoRepo()
+
+			tService := NewService(mRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			err := tService.checkWhetherSubtasksAreDone(context.TODO(), existingTodoId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, mRepo)
+		})
+	}
+}
+
+func TestService_DetachSubtasksRecords(t *testing.T) {
+	tests := []struct {
+		testName      string
+		mockTodoRepo  func() *mocks.TodoRepo
+		mockHRecorder func() *mocks.HistoryRecorder
+		err           error
+	}{
+		{
+			testName: "Successfully detaching subtasks",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					DetachSubtasks(context.TODO(), existingTodoId.String()).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockHRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(nil).Once()
+
+				return mRecorder
+			},
+		},
+		{
+			testName: "Failed to detach subtasks due to error when recording history actor",
+			mockHRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(databaseError).Once()
+
+				return mRecorder
+			},
+			err: databaseError,
+		},
+		{
+			testName: "Failed to detach subtasks due to error in todo repository",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					DetachSubtasks(context.TODO(), existingTodoId.String()).
+					Return(databaseError).Once()
+
+				return mRepo
+			},
+			mockHRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(nil).Once()
+
+				return mRecorder
+			},
+			err: databaseError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := &mocks.TodoRepo{}
+			if test.mockTodoRepo != nil {
+				mRepo = test.mockTodoRepo()
+			}
+
+			mRecorder := test.mockHRecorder()
+
+			tService := NewService(mRepo, nil, nil, nil, nil, nil, nil, nil, mRecorder)
+
+			err := tService.DetachSubtasksRecords(context.TODO(), existingTodoId.String())
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, mRepo, mRecorder)
+		})
+	}
+}
+
+func TestService_UpdateTodoRecord_Recurrence(t *testing.T) {
+	now := recurrenceDate(2025, time.January, 20)
+	doneStatus := constants.Done
+	newDueDate := recurrenceDatePtr(2025, time.March, 15)
+	nextTodoId := nonExistingTodoId.String()
+
+	todoEntity := &entities.Todo{
+		Id:       existingTodoId,
+		ListId:   existingListId,
+		Status:   string(constants.Open),
+		DueDate:  sql.NullTime{Time: recurrenceDate(2025, time.January, 31), Valid: true},
+		Priority: priority,
+	}
+	updatedEntity := &entities.Todo{Id: existingTodoId, ListId: existingListId, Status: string(constants.Done)}
+	nextEntity := &entities.Todo{Id: nonExistingTodoId, ListId: existingListId}
+
+	currentModel := func(recurrence *models.Recurrence) *models.Todo {
+		return &models.Todo{
+			Id:         existingTodoId.String(),
+			Name:       todoName,
+			ListId:     existingListId.String(),
+			Status:     constants.Open,
+			Priority:   priority,
+			DueDate:    recurrenceDatePtr(2025, time.January, 31),
+			Recurrence: recurrence,
+		}
+	}
+	updatedModel := &models.Todo{
+		Id:       existingTodoId.String(),
+		Name:     todoName,
+		ListId:   existingListId.String(),
+		Status:   constants.Done,
+		Priority: priority,
+		DueDate:  recurrenceDatePtr(2025, time.January, 31),
+	}
+	nextModel := &models.Todo{
+		Id:          nextTodoId,
+		Name:        todoName,
+		ListId:      existingListId.String(),
+		Priority:    priority,
+		DueDate:     recurrenceDatePtr(2025, time.February, 28),
+		Recurrence:  &models.Recurrence{Frequency: constants.Monthly, Interval: 1, AnchorDay: 31},
+		CreatedAt:   now,
+		LastUpdated: now,
+	}
+	clearedRecurrenceFields := []string{"status = :status", "recurrence_frequency = NULL", "recurrence_interval = NULL",
+		"recurrence_until = NULL", "recurrence_count = NULL", "recurrence_anchor_day = NULL"}
+
+	tests := []struct {
+		testName       string
+		update         *handler_models.UpdateTodo
+		mockTodoRepo   func() *mocks.TodoRepo
+		mockColumnRepo func() *mocks.ColumnRepo
+		mockConverter  func() *mocks.TodoConverter
+		mockUuidGen    func() *mocks.UuidGenerator
+		mockTimeGen    func() *mocks.TimeGenerator
+		mockHRecorder  func() *mocks.HistoryRecorder
+		expectedTodo   *models.Todo
+		err            error
+	}{
+		{
+			testName: "Successfully completing monthly recurring todo and creating next occurrence on the clamped anchor day",
+			update:   &handler_models.UpdateTodo{Status: &doneStatus},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().CountOpenSubtasks(context.TODO(), existingTodoId.String()).Return(0, nil).Once()
+				mRepo.EXPECT().CountOpenBlockers(context.TODO(), existingTodoId.String()).Return(0, nil).Once()
+				mRepo.EXPECT().
+					UpdateTodo(context.TODO(), map[string]interface{}{"id": existingTodoId.String(), "status": constants.Done}, clearedRecurrenceFields).
+					Return(updatedEntity, nil).Once()
+				mRepo.EXPECT().CreateTodo(context.TODO(), nextEntity).Return(nextEntity, nil).Once()
+
+				return mRepo
+			},
+			mockColumnRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+
+				mRepo.EXPECT().GetListColumns(context.TODO(), existingListId.String()).Return(nil, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+
+				mConverter.EXPECT().
+					ConvertFromUpdateHandlerModelToModel(&handler_models.UpdateTodo{Status: &doneStatus}).
+					Return(&models.Todo{Status: constants.Done}).Once()
+				mConverter.EXPECT().
+					ToModel(todoEntity).
+					Return(currentModel(&models.Recurrence{Frequency: constants.Monthly, Interval: 1})).Once()
+				mConverter.EXPECT().ToModel(updatedEntity).Return(updatedModel).Once()
+				mConverter.EXPECT().ToEntity(nextModel).Return(nextEntity).Once()
+				mConverter.EXPECT().ToModel(nextEntity).Return(nextModel).Once()
+
+				return mConverter
+			},
+			mockUuidGen: func() *mocks.UuidGenerator {
+				mUuidGen := &mocks.UuidGenerator{}
+
+				mUuidGen.EXPECT().Generate().Return(nextTodoId).Once()
+
+				return mUuidGen
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				mTimeGen := &mocks.TimeGenerator{}
+
+				mTimeGen.EXPECT().Now().Return(now).Times(3)
+
+				return mTimeGen
+			},
+			mockHRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Twice()
+
+				return mRecorder
+			},
+			expectedTodo: updatedModel,
+		},
+		{
+			testName: "Successfully completing the last occurrence of a counted series without creating next occurrence",
+			update:   &handler_models.UpdateTodo{Status: &doneStatus},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().CountOpenSubtasks(context.TODO(), existingTodoId.String()).Return(0, nil).Once()
+				mRepo.EXPECT().CountOpenBlockers(context.TODO(), existingTodoId.String()).Return(0, nil).Once()
+				mRepo.EXPECT().
+					UpdateTodo(context.TODO(), map[string]interface{}{"id": existingTodoId.String(), "status": constants.Done}, clearedRecurrenceFields).
+					Return(updatedEntity, nil).Once()
+
+				return mRepo
+			},
+			mockColumnRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+
+				mRepo.EXPECT().GetListColumns(context.TODO(), existingListId.String()).Return(nil, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+
+				mConverter.EXPECT().
+					ConvertFromUpdateHandlerModelToModel(&handler_models.UpdateTodo{Status: &doneStatus}).
+					Return(&models.Todo{Status: constants.Done}).Once()
+				mConverter.EXPECT().
+					ToModel(todoEntity).
+					Return(currentModel(&models.Recurrence{Frequency: constants.Monthly, Interval: 1, Count: intPtr(1)})).Once()
+				mConverter.EXPECT().ToModel(updatedEntity).Return(updatedModel).Once()
+
+				return mConverter
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				mTimeGen := &mocks.TimeGenerator{}
+
+				mTimeGen.EXPECT().Now().Return(now).Once()
+
+				return mTimeGen
+			},
+			mockHRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Once()
+
+				return mRecorder
+			},
+			expectedTodo: updatedModel,
+		},
+		{
+			testName: "Failed to complete recurring todo with open subtasks",
+			update:   &handler_models.UpdateTodo{Status: &doneStatus},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().CountOpenSubtasks(context.TODO(), existingTodoId.String()).Return(1, nil).Once()
+
+				return mRepo
+			},
+			mockColumnRepo: func() *mocks.ColumnRepo {
+				mRepo := &mocks.ColumnRepo{}
+
+				mRepo.EXPECT().GetListColumns(context.TODO(), existingListId.String()).Return(nil, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+
+				mConverter.EXPECT().
+					ConvertFromUpdateHandlerModelToModel(&handler_models.UpdateTodo{Status: &doneStatus}).
+					Return(&models.Todo{Status: constants.Done}).Once()
+
+				return mConverter
+			},
+			err: application_errors.OpenSubtasksError,
+		},
+		{
+			testName: "Successfully rescheduling recurring todo and resetting the anchor day of its series",
+			update:   &handler_models.UpdateTodo{DueDate: newDueDate},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().
+					UpdateTodo(context.TODO(), map[string]interface{}{"id": existingTodoId.String(), "due_date": *newDueDate},
+						[]string{"due_date = :due_date", "recurrence_anchor_day = NULL"}).
+					Return(todoEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+
+				mConverter.EXPECT().
+					ConvertFromUpdateHandlerModelToModel(&handler_models.UpdateTodo{DueDate: newDueDate}).
+					Return(&models.Todo{DueDate: newDueDate}).Once()
+				mConverter.EXPECT().
+					ToModel(todoEntity).
+					Return(currentModel(&models.Recurrence{Frequency: constants.Monthly, Interval: 1, AnchorDay: 31})).Twice()
+
+				return mConverter
+			},
+			mockHRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Once()
+
+				return mRecorder
+			},
+			expectedTodo: currentModel(&models.Recurrence{Frequency: constants.Monthly, Interval: 1, AnchorDay: 31}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mTodoRepo := test.mockTodoRepo()
+			mConverter := test.mockConverter()
+
+			mColumnRepo := &mocks.ColumnRepo{}
+			if test.mockColumnRepo != nil {
+				mColumnRepo = test.mockColumnRepo()
+			}
+
+			mUuidGen := &mocks.UuidGenerator{}
+			if test.mockUuidGen != nil {
+				mUuidGen = test.mockUuidGen()
+			}
+
+			mTimeGen := &mocks.TimeGenerator{}
+			if test.mockTimeGen != nil {
+				mTimeGen = test.mockTimeGen()
+			}
+
+			mRecorder := &mocks.HistoryRecorder{}
+			if test.mockHRecorder != nil {
+				mRecorder = test.mockHRecorder()
+			}
+
+			tService := NewService(mTodoRepo, nil, mColumnRepo, mUuidGen, mTimeGen, mConverter, nil, nil, mRecorder)
+
+			todo, err := tService.UpdateTodoRecord(context.TODO(), existingTodoId.String(), test.update)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedTodo, todo)
+			mock.AssertExpectationsForObjects(t, mTodoRepo, mColumnRepo, mConverter, mUuidGen, mTimeGen, mRecorder)
+		})
+	}
+}
+
+func TestService_AddTodoBlockerRecord(t *testing.T) {
+	todoEntity := &entities.Todo{Id: existingTodoId, ListId: existingListId}
+	blockerEntity := &entities.Todo{Id: nonExistingTodoId, ListId: existingListId}
+	blockerFromAnotherList := &entities.Todo{Id: nonExistingTodoId, ListId: nonExistingListId}
+	blockerModel := &models.Todo{Id: nonExistingTodoId.String(), ListId: existingListId.String()}
+
+	tests := []struct {
+		testName        string
+		todoId          string
+		blockerId       string
+		mockTodoRepo    func() *mocks.TodoRepo
+		mockConverter   func() *mocks.TodoConverter
+		expectedBlocker *models.Todo
+		err             error
+	}{
+		{
+			testName:  "Successfully adding blocker",
+			todoId:    existingTodoId.String(),
+			blockerId: nonExistingTodoId.String(),
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().GetTodo(context.TODO(), nonExistingTodoId.String()).Return(blockerEntity, nil).Once()
+				mRepo.EXPECT().
+					IsTodoTransitivelyBlockedBy(context.TODO(), nonExistingTodoId.String(), existingTodoId.String()).
+					Return(false, nil).Once()
+				mRepo.EXPECT().
+					AddTodoBlocker(context.TODO(), existingTodoId.String(), nonExistingTodoId.String()).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+
+				mConverter.EXPECT().ToModel(blockerEntity).Return(blockerModel).Once()
+
+				return mConverter
+			},
+			expectedBlocker: blockerModel,
+		},
+		{
+			testName:  "Failed to add todo as its own blocker",
+			todoId:    existingTodoId.String(),
+			blockerId: existingTodoId.String(),
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Twice()
+
+				return mRepo
+			},
+			err: application_errors.NewDependencyCycleError(existingTodoId.String(), existingTodoId.String()),
+		},
+		{
+			testName:  "Failed to add blocker which is already transitively blocked by the todo",
+			todoId:    existingTodoId.String(),
+			blockerId: nonExistingTodoId.String(),
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().GetTodo(context.TODO(), nonExistingTodoId.String()).Return(blockerEntity, nil).Once()
+				mRepo.EXPECT().
+					IsTodoTransitivelyBlockedBy(context.TODO(), nonExistingTodoId.String(), existingTodoId.String()).
+					Return(true, nil).Once()
+
+				return mRepo
+			},
+			err: application_errors.NewDependencyCycleError(existingTodoId.String(), nonExistingTodoId.String()),
+		},
+		{
+			testName:  "Failed to add blocker from another list",
+			todoId:    existingTodoId.String(),
+			blockerId: nonExistingTodoId.String(),
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().GetTodo(context.TODO(), nonExistingTodoId.String()).Return(blockerFromAnotherList, nil).Once()
+
+				return mRepo
+			},
+			err: application_errors.BlockerOutOfScopeError,
+		},
+		{
+			testName:  "Failed to add blocker due to error when checking for cycles",
+			todoId:    existingTodoId.String(),
+			blockerId: nonExistingTodoId.String(),
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().GetTodo(context.TODO(), nonExistingTodoId.String()).Return(blockerEntity, nil).Once()
+				mRepo.EXPECT().
+					IsTodoTransitivelyBlockedBy(context.TODO(), nonExistingTodoId.String(), existingTodoId.String()).
+					Return(false, databaseError).Once()
+
+				return mRepo
+			},
+			err: databaseError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockTodoRepo()
+
+			mConverter := &mocks.TodoConverter{}
+			if test.mockConverter != nil {
+				mConverter = test.mockConverter()
+			}
+
+			tService := NewService(mRepo, nil, nil, nil, nil, mConverter, nil, nil, nil)
+
+			blocker, err := tService.AddTodoBlockerRecord(context.TODO(), test.todoId, test.blockerId)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedBlocker, blocker)
+			mock.AssertExpectationsForObjects(t, mRepo, mConverter)
+		})
+	}
+}
+
+func TestService_MoveTodosRecords(t *testing.T) {
+	callerId := uuid.Must(uuid.NewV4())
+	caller := &models.User{Id: callerId.String(), Role: constants.Writer}
+	targetListId := nonExistingListId.String()
+	todoEntity := &entities.Todo{Id: existingTodoId, ListId: existingListId, AssignedTo: assigneeNullId}
+	movedEntity := entities.Todo{Id: existingTodoId, ListId: nonExistingListId, AssignedTo: assigneeNullId}
+	movedModel := &models.Todo{Id: existingTodoId.String(), ListId: targetListId}
+	extraAssigneeId := uuid.Must(uuid.NewV4())
+	unassignedTodo := &entities.Todo{Id: existingTodoId, ListId: existingListId}
+	unassignedMoved := entities.Todo{Id: existingTodoId, ListId: nonExistingListId}
+	movedSubtask := entities.Todo{Id: nonExistingTodoId, ListId: nonExistingListId, ParentId: uuid.NullUUID{UUID: existingTodoId, Valid: true}}
+	notAnEditor := errors.New("only the list owner and the list editors can move and copy todos")
+
+	tests := []struct {
+		testName      string
+		mockTodoRepo  func() *mocks.TodoRepo
+		mockListRepo  func() *mocks.ListRepo
+		mockRecorder  func() *mocks.HistoryRecorder
+		mockTimeGen   func() *mocks.TimeGenerator
+		mockConverter func() *mocks.TodoConverter
+		expectedTodos []*models.Todo
+		err           error
+	}{
+		{
+			testName: "Successfully moving todo and unassigning the assignee who can't access the target list",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Twice()
+				mRepo.EXPECT().
+					MoveTodos(context.TODO(), []string{existingTodoId.String()}, targetListId, testDate).
+					Return([]entities.Todo{movedEntity}, nil).Once()
+				mRepo.EXPECT().
+					GetTodosParticipants(context.TODO(), []string{existingTodoId.String()}).
+					Return([]string{assigneeId.String()}, nil).Once()
+				mRepo.EXPECT().
+					UnassignUserFromTodos(context.TODO(), assigneeId.String(), targetListId).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().GetList(context.TODO(), targetListId).Return(&entities.List{Id: nonExistingListId}, nil).Once()
+				mRepo.EXPECT().GetListOwner(context.TODO(), existingListId.String()).Return(&entities.User{Id: callerId}, nil).Once()
+				mRepo.EXPECT().GetListOwner(context.TODO(), targetListId).Return(&entities.User{Id: callerId}, nil).Twice()
+				mRepo.EXPECT().CheckWhetherUserIsEditor(context.TODO(), existingListId.String(), callerId.String()).Return(false, nil).Once()
+				mRepo.EXPECT().CheckWhetherUserIsEditor(context.TODO(), targetListId, callerId.String()).Return(false, nil).Once()
+				mRepo.EXPECT().
+					CheckWhetherUserIsCollaborator(context.TODO(), targetListId, assigneeId.String()).
+					Return(false, nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Once()
+
+				return mRecorder
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				mTimeGen := &mocks.TimeGenerator{}
+				mTimeGen.EXPECT().Now().Return(testDate).Once()
+
+				return mTimeGen
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+				mConverter.EXPECT().ToModel(todoEntity).Return(movedModel).Once()
+
+				return mConverter
+			},
+			expectedTodos: []*models.Todo{movedModel},
+		},
+		{
+			testName: "Successfully moving todo with subtask and removing the extra assignee who can't access the target list",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(unassignedTodo, nil).Twice()
+				mRepo.EXPECT().
+					MoveTodos(context.TODO(), []string{existingTodoId.String()}, targetListId, testDate).
+					Return([]entities.Todo{unassignedMoved, movedSubtask}, nil).Once()
+				mRepo.EXPECT().
+					GetTodosParticipants(context.TODO(), []string{existingTodoId.String(), nonExistingTodoId.String()}).
+					Return([]string{extraAssigneeId.String(), callerId.String()}, nil).Once()
+				mRepo.EXPECT().
+					UnassignUserFromTodos(context.TODO(), extraAssigneeId.String(), targetListId).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().GetList(context.TODO(), targetListId).Return(&entities.List{Id: nonExistingListId}, nil).Once()
+				mRepo.EXPECT().GetListOwner(context.TODO(), existingListId.String()).Return(&entities.User{Id: callerId}, nil).Once()
+				mRepo.EXPECT().GetListOwner(context.TODO(), targetListId).Return(&entities.User{Id: callerId}, nil).Times(3)
+				mRepo.EXPECT().CheckWhetherUserIsEditor(context.TODO(), existingListId.String(), callerId.String()).Return(false, nil).Once()
+				mRepo.EXPECT().CheckWhetherUserIsEditor(context.TODO(), targetListId, callerId.String()).Return(false, nil).Once()
+				mRepo.EXPECT().
+					CheckWhetherUserIsCollaborator(context.TODO(), targetListId, extraAssigneeId.String()).
+					Return(false, nil).Once()
+				mRepo.EXPECT().
+					CheckWhetherUserIsCollaborator(context.TODO(), targetListId, callerId.String()).
+					Return(false, nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Once()
+
+				return mRecorder
+			},
+			mockTimeGen: func() *mocks.TimeGenerator {
+				mTimeGen := &mocks.TimeGenerator{}
+				mTimeGen.EXPECT().Now().Return(testDate).Once()
+
+				return mTimeGen
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+				mConverter.EXPECT().ToModel(unassignedTodo).Return(movedModel).Once()
+
+				return mConverter
+			},
+			expectedTodos: []*models.Todo{movedModel},
+		},
+		{
+			testName: "Failed to move todo to list the caller can't edit",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().GetList(context.TODO(), targetListId).Return(&entities.List{Id: nonExistingListId}, nil).Once()
+				mRepo.EXPECT().GetListOwner(context.TODO(), existingListId.String()).Return(&entities.User{Id: callerId}, nil).Maybe()
+				mRepo.EXPECT().CheckWhetherUserIsEditor(context.TODO(), existingListId.String(), callerId.String()).Return(false, nil).Maybe()
+				mRepo.EXPECT().GetListOwner(context.TODO(), targetListId).Return(&entities.User{Id: assigneeId}, nil).Once()
+				mRepo.EXPECT().CheckWhetherUserIsEditor(context.TODO(), targetListId, callerId.String()).Return(false, nil).Once()
+
+				return mRepo
+			},
+			err: notAnEditor,
+		},
+		{
+			testName: "Failed to move todo to list which does not exist",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				return &mocks.TodoRepo{}
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), targetListId).
+					Return(nil, application_errors.NewNotFoundError(constants.LIST_TARGET, targetListId)).Once()
+
+				return mRepo
+			},
+			err: application_errors.NewNotFoundError(constants.LIST_TARGET, targetListId),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mTodoRepo := test.mockTodoRepo()
+			mListRepo := test.mockListRepo()
+
+			mRecorder := &mocks.HistoryRecorder{}
+			if test.mockRecorder != nil {
+				mRecorder = test.mockRecorder()
+			}
+
+			mTimeGen := &mocks.TimeGenerator{}
+			if test.mockTimeGen != nil {
+				mTimeGen = test.mockTimeGen()
+			}
+
+			mConverter := &mocks.TodoConverter{}
+			if test.mockConverter != nil {
+				mConverter = test.mockConverter()
+			}
+
+			tService := NewService(mTodoRepo, mListRepo, nil, nil, mTimeGen, mConverter, nil, nil, mRecorder)
+			todos, err := tService.MoveTodosRecords(context.TODO(), []string{existingTodoId.String(), existingTodoId.String()}, targetListId, caller)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, todos)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedTodos, todos)
+			}
+
+			mock.AssertExpectationsForObjects(t, mTodoRepo, mListRepo, mRecorder, mTimeGen, mConverter)
+		})
+	}
+}
+
+func TestService_CopyTodosRecords(t *testing.T) {
+	admin := &models.User{Id: uuid.Must(uuid.NewV4()).String(), Role: constants.Admin}
+	targetListId := nonExistingListId.String()
+	copyId := nonExistingTodoId.String()
+	todoEntity := &entities.Todo{Id: existingTodoId, Name: todoName, ListId: existingListId, Status: todoDoneStatus, AssignedTo: assigneeNullId}
+	copyEntity := &entities.Todo{Id: nonExistingTodoId, Name: todoName, ListId: nonExistingListId, Status: todoStatus}
+
+	tests := []struct {
+		testName       string
+		hasAccess      bool
+		expectedCopy   *models.Todo
+		expectedCopies []*models.Todo
+	}{
+		{
+			testName:  "Successfully copying todo as an open todo without the assignee who can't access the target list",
+			hasAccess: false,
+			expectedCopy: &models.Todo{Id: copyId, Name: todoName, ListId: targetListId, Status: constants.Open,
+				CreatedAt: testDate, LastUpdated: testDate},
+		},
+		{
+			testName:  "Successfully copying todo together with the assignee who can access the target list",
+			hasAccess: true,
+			expectedCopy: &models.Todo{Id: copyId, Name: todoName, ListId: targetListId, Status: constants.Open,
+				CreatedAt: testDate, LastUpdated: testDate, AssignedTo: convertToStringPointer(assigneeId.String())},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			copyModel := &models.Todo{Id: copyId, Name: todoName, ListId: targetListId, Status: constants.Open}
+
+			mTodoRepo := &mocks.TodoRepo{}
+			mTodoRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+			mTodoRepo.EXPECT().CreateTodo(context.TODO(), copyEntity).Return(copyEntity, nil).Once()
+			mTodoRepo.EXPECT().CopyTodoLabels(context.TODO(), existingTodoId.String(), copyId).Return(nil).Once()
+
+			mListRepo := &mocks.ListRepo{}
+			mListRepo.EXPECT().GetList(context.TODO(), targetListId).Return(&entities.List{Id: nonExistingListId}, nil).Once()
+			mListRepo.EXPECT().GetListOwner(context.TODO(), targetListId).Return(&entities.User{Id: existingListId}, nil).Once()
+			mListRepo.EXPECT().
+				CheckWhetherUserIsCollaborator(context.TODO(), targetListId, assigneeId.String()).
+				Return(test.hasAccess, nil).Once()
+
+			mConverter := &mocks.TodoConverter{}
+			mConverter.EXPECT().ToModel(todoEntity).Return(&models.Todo{
+				Id: existingTodoId.String(), Name: todoName, ListId: existingListId.String(), Status: constants.Done,
+				Position: "1", ParentId: convertToStringPointer(existingTodoId.String()), AssignedTo: convertToStringPointer(assigneeId.String()),
+			}).Once()
+			mConverter.EXPECT().ToEntity(test.expectedCopy).Return(copyEntity).Once()
+			mConverter.EXPECT().ToModel(copyEntity).Return(copyModel).Once()
+
+			mUuidGen := &mocks.UuidGenerator{}
+			mUuidGen.EXPECT().Generate().Return(copyId).Once()
+
+			mTimeGen := &mocks.TimeGenerator{}
+			mTimeGen.EXPECT().Now().Return(testDate).Twice()
+
+			mRecorder := &mocks.HistoryRecorder{}
+			mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Once()
+
+			tService := NewService(mTodoRepo, mListRepo, nil, mUuidGen, mTimeGen, mConverter, nil, nil, mRecorder)
+			copies, err := tService.CopyTodosRecords(context.TODO(), []string{existingTodoId.String()}, targetListId, admin)
+
+			require.NoError(t, err)
+			require.Equal(t, []*models.Todo{copyModel}, copies)
+			mock.AssertExpectationsForObjects(t, mTodoRepo, mListRepo, mConverter, mUuidGen, mTimeGen, mRecorder)
+		})
+	}
+}
+
+func TestService_BatchTodosRecords(t *testing.T) {
+	admin := &models.User{Id: uuid.Must(uuid.NewV4()).String(), Role: constants.Admin}
+	todoNotFound := application_errors.NewNotFoundError(constants.TODO_TARGET, nonExistingTodoId.String())
+	operations := []handler_models.BatchTodoOperation{
+		{Op: constants.BATCH_DELETE_OPERATION, TodoId: existingTodoId.String()},
+		{Op: constants.BATCH_DELETE_OPERATION, TodoId: nonExistingTodoId.String()},
+		{Op: constants.BATCH_DELETE_OPERATION, TodoId: nonExistingTodoId2.String()},
+	}
+
+	tests := []struct {
+		testName       string
+		mode           string
+		mockTodoRepo   func() *mocks.TodoRepo
+		mockRecorder   func() *mocks.HistoryRecorder
+		expectedResult *models.BatchTodosResult
+	}{
+		{
+			testName: "Failed operation rolls back the whole batch in the all or nothing mode",
+			mode:     constants.ALL_OR_NOTHING_BATCH_MODE,
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(&entities.Todo{Id: existingTodoId}, nil).Once()
+				mRepo.EXPECT().DeleteTodo(context.TODO(), existingTodoId.String()).Return(nil).Once()
+				mRepo.EXPECT().GetTodo(context.TODO(), nonExistingTodoId.String()).Return(nil, todoNotFound).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Once()
+
+				return mRecorder
+			},
+			expectedResult: &models.BatchTodosResult{
+				Committed: false,
+				Results: []*models.BatchTodoResult{
+					{Index: 0, Op: constants.BATCH_DELETE_OPERATION, Status: http.StatusFailedDependency, Error: "rolled back because operation 1 failed"},
+					{Index: 1, Op: constants.BATCH_DELETE_OPERATION, Status: http.StatusNotFound, Error: todoNotFound.Error()},
+					{Index: 2, Op: constants.BATCH_DELETE_OPERATION, Status: http.StatusFailedDependency, Error: "not executed because operation 1 failed"},
+				},
+			},
+		},
+		{
+			testName: "Failed operation is rolled back to its savepoint and the other operations are kept in the per item mode",
+			mode:     constants.PER_ITEM_BATCH_MODE,
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().CreateSavepoint(context.TODO(), batchOperationSavepoint).Return(nil).Times(3)
+				mRepo.EXPECT().ReleaseSavepoint(context.TODO(), batchOperationSavepoint).Return(nil).Twice()
+				mRepo.EXPECT().RollbackToSavepoint(context.TODO(), batchOperationSavepoint).Return(nil).Once()
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(&entities.Todo{Id: existingTodoId}, nil).Once()
+				mRepo.EXPECT().DeleteTodo(context.TODO(), existingTodoId.String()).Return(nil).Once()
+				mRepo.EXPECT().GetTodo(context.TODO(), nonExistingTodoId.String()).Return(nil, todoNotFound).Once()
+				mRepo.EXPECT().GetTodo(context.TODO(), nonExistingTodoId2.String()).Return(&entities.Todo{Id: nonExistingTodoId2}, nil).Once()
+				mRepo.EXPECT().DeleteTodo(context.TODO(), nonExistingTodoId2.String()).Return(nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Twice()
+
+				return mRecorder
+			},
+			expectedResult: &models.BatchTodosResult{
+				Committed: true,
+				Results: []*models.BatchTodoResult{
+					{Index: 0, Op: constants.BATCH_DELETE_OPERATION, Status: http.StatusNoContent},
+					{Index: 1, Op: constants.BATCH_DELETE_OPERATION, Status: http.StatusNotFound, Error: todoNotFound.Error()},
+					{Index: 2, Op: constants.BATCH_DELETE_OPERATION, Status: http.StatusNoContent},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mTodoRepo := test.mockTodoRepo()
+			mRecorder := test.mockRecorder()
+
+			tService := NewService(mTodoRepo, nil, nil, nil, nil, nil, nil, nil, mRecorder)
+			result, err := tService.BatchTodosRecords(context.TODO(), &handler_models.BatchTodos{Mode: test.mode, Operations: operations}, admin)
+
+			require.NoError(t, err)
+			require.Equal(t, test.expectedResult, result)
+			mock.AssertExpectationsForObjects(t, mTodoRepo, mRecorder)
+		})
+	}
+}
+
+func TestService_BatchTodosRecords_Create(t *testing.T) {
+	listOwner := &entities.User{Id: uuid.Must(uuid.NewV4())}
+	writer := &models.User{Id: uuid.Must(uuid.NewV4()).String(), Role: constants.Writer}
+	reader := &models.User{Id: uuid.Must(uuid.NewV4()).String(), Role: constants.Reader}
+	createTodo := &handler_models.CreateTodo{Name: todoName, ListId: existingListId.String()}
+	todoModel := &models.Todo{Id: existingTodoId.String(), Name: todoName, ListId: existingListId.String()}
+	todoEntity := &entities.Todo{Id: existingTodoId, Name: todoName, ListId: existingListId}
+	forbidden := []*models.BatchTodoResult{{
+		Index:  0,
+		Op:     constants.BATCH_CREATE_OPERATION,
+		Status: http.StatusForbidden,
+		Error:  application_errors.TodoCreationForbiddenError.Error(),
+	}}
+
+	tests := []struct {
+		testName        string
+		caller          *models.User
+		mockListRepo    func() *mocks.ListRepo
+		mockTodoRepo    func() *mocks.TodoRepo
+		mockConverter   func() *mocks.TodoConverter
+		mockRecorder    func() *mocks.HistoryRecorder
+		expectedResults []*models.BatchTodoResult
+	}{
+		{
+			testName: "Successfully creating todo in list where the writer is an editor",
+			caller:   writer,
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().GetListOwner(context.TODO(), existingListId.String()).Return(listOwner, nil).Once()
+				mRepo.EXPECT().CheckWhetherUserIsEditor(context.TODO(), existingListId.String(), writer.Id).Return(true, nil).Once()
+
+				return mRepo
+			},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().CreateTodo(context.TODO(), todoEntity).Return(todoEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+
+				mConverter.EXPECT().ConvertFromCreateHandlerModelToModel(createTodo).Return(todoModel).Once()
+				mConverter.EXPECT().ToEntity(todoModel).Return(todoEntity).Once()
+				mConverter.EXPECT().ToModel(todoEntity).Return(todoModel).Once()
+
+				return mConverter
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Once()
+
+				return mRecorder
+			},
+			expectedResults: []*models.BatchTodoResult{{
+				Index:  0,
+				Op:     constants.BATCH_CREATE_OPERATION,
+				Status: http.StatusCreated,
+				Todo:   todoModel,
+			}},
+		},
+		{
+			testName: "Failed to create todo in list where the writer is only a viewer",
+			caller:   writer,
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().GetListOwner(context.TODO(), existingListId.String()).Return(listOwner, nil).Once()
+				mRepo.EXPECT().CheckWhetherUserIsEditor(context.TODO(), existingListId.String(), writer.Id).Return(false, nil).Once()
+
+				return mRepo
+			},
+			expectedResults: forbidden,
+		},
+		{
+			testName:        "Failed to create todo as a reader who can not create entities",
+			caller:          reader,
+			expectedResults: forbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mListRepo := &mocks.ListRepo{}
+			if test.mockListRepo != nil {
+				mListRepo = test.mockListRepo()
+			}
+
+			mTodoRepo := &mocks.TodoRepo{}
+			if test.mockTodoRepo != nil {
+				mTodoRepo = test.mockTodoRepo()
+			}
+
+			mConverter := &mocks.TodoConverter{}
+			if test.mockConverter != nil {
+				mConverter = test.mockConverter()
+			}
+
+			mRecorder := &mocks.HistoryRecorder{}
+			if test.mockRecorder != nil {
+				mRecorder = test.mockRecorder()
+			}
+
+			mUuidGen := &mocks.UuidGenerator{}
+			mUuidGen.EXPECT().Generate().Return(existingTodoId.String()).Maybe()
+
+			mTimeGen := &mocks.TimeGenerator{}
+			mTimeGen.EXPECT().Now().Return(testDate).Maybe()
+
+			tService := NewService(mTodoRepo, mListRepo, nil, mUuidGen, mTimeGen, mConverter, nil, nil, mRecorder)
+			batch := &handler_models.BatchTodos{
+				Mode:       constants.ALL_OR_NOTHING_BATCH_MODE,
+				Operations: []handler_models.BatchTodoOperation{{Op: constants.BATCH_CREATE_OPERATION, Create: createTodo}},
+			}
+
+			result, err := tService.BatchTodosRecords(context.TODO(), batch, test.caller)
+
+			require.NoError(t, err)
+			require.Equal(t, test.expectedResults, result.Results)
+			mock.AssertExpectationsForObjects(t, mListRepo, mTodoRepo, mConverter, mRecorder)
+		})
+	}
+}
+
+func TestService_ReorderTodoRecord(t *testing.T) {
+	anchorId := nonExistingTodoId.String()
+	todoEntity := &entities.Todo{Id: existingTodoId, ListId: existingListId}
+	anchorEntity := &entities.Todo{Id: nonExistingTodoId, ListId: existingListId}
+	otherListAnchor := &entities.Todo{Id: nonExistingTodoId, ListId: nonExistingListId}
+	placedEntity := &entities.Todo{Id: existingTodoId, ListId: existingListId, Position: "1.5"}
+	placedModel := &models.Todo{Id: existingTodoId.String(), ListId: existingListId.String(), Position: "1.5"}
+	anchorNotFound := application_errors.NewNotFoundError(constants.TODO_TARGET, anchorId)
+
+	tests := []struct {
+		testName      string
+		reorder       *handler_models.ReorderTodo
+		mockTodoRepo  func() *mocks.TodoRepo
+		mockConverter func() *mocks.TodoConverter
+		expectedTodo  *models.Todo
+		err           error
+	}{
+		{
+			testName: "Successfully placing todo after the anchor",
+			reorder:  &handler_models.ReorderTodo{After: &anchorId},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().GetTodo(context.TODO(), anchorId).Return(anchorEntity, nil).Once()
+				mRepo.EXPECT().PlaceTodoAfter(context.TODO(), existingTodoId.String(), anchorId, testDate).Return(placedEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+				mConverter.EXPECT().ToModel(placedEntity).Return(placedModel).Once()
+
+				return mConverter
+			},
+			expectedTodo: placedModel,
+		},
+		{
+			testName: "Successfully placing todo before the anchor",
+			reorder:  &handler_models.ReorderTodo{Before: &anchorId},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().GetTodo(context.TODO(), anchorId).Return(anchorEntity, nil).Once()
+				mRepo.EXPECT().PlaceTodoBefore(context.TODO(), existingTodoId.String(), anchorId, testDate).Return(placedEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+				mConverter.EXPECT().ToModel(placedEntity).Return(placedModel).Once()
+
+				return mConverter
+			},
+			expectedTodo: placedModel,
+		},
+		{
+			testName: "Failed to place todo next to anchor from another list",
+			reorder:  &handler_models.ReorderTodo{After: &anchorId},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().GetTodo(context.TODO(), anchorId).Return(otherListAnchor, nil).Once()
+
+				return mRepo
+			},
+			err: application_errors.ReorderOutOfScopeError,
+		},
+		{
+			testName: "Failed to place todo next to itself",
+			reorder:  &handler_models.ReorderTodo{Before: convertToStringPointer(existingTodoId.String())},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Twice()
+
+				return mRepo
+			},
+			err: application_errors.ReorderOutOfScopeError,
+		},
+		{
+			testName: "Failed to place todo next to anchor which does not exist",
+			reorder:  &handler_models.ReorderTodo{After: &anchorId},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().GetTodo(context.TODO(), anchorId).Return(nil, anchorNotFound).Once()
+
+				return mRepo
+			},
+			err: anchorNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mTodoRepo := test.mockTodoRepo()
+
+			mConverter := &mocks.TodoConverter{}
+			if test.mockConverter != nil {
+				mConverter = test.mockConverter()
+			}
+
+			mRecorder := &mocks.HistoryRecorder{}
+			mTimeGen := &mocks.TimeGenerator{}
+			if test.err == nil {
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Once()
+				mTimeGen.EXPECT().Now().Return(testDate).Once()
+			}
+
+			tService := NewService(mTodoRepo, nil, nil, nil, mTimeGen, mConverter, nil, nil, mRecorder)
+
+			todo, err := tService.ReorderTodoRecord(context.TODO(), existingTodoId.String(), test.reorder)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedTodo, todo)
+			mock.AssertExpectationsForObjects(t, mTodoRepo, mConverter, mRecorder, mTimeGen)
+		})
+	}
+}
+
+func TestService_BatchTodosRecords_Modify(t *testing.T) {
+	listOwner := &entities.User{Id: uuid.Must(uuid.NewV4())}
+	writer := &models.User{Id: uuid.Must(uuid.NewV4()).String(), Role: constants.Writer}
+	todoEntity := &entities.Todo{Id: existingTodoId, ListId: existingListId}
+
+	tests := []struct {
+		testName        string
+		mockListRepo    func() *mocks.ListRepo
+		mockTodoRepo    func() *mocks.TodoRepo
+		mockRecorder    func() *mocks.HistoryRecorder
+		expectedResults []*models.BatchTodoResult
+	}{
+		{
+			testName: "Successfully deleting todo as one of its extra assignees",
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().IsTodoAssignee(context.TODO(), existingTodoId.String(), writer.Id).Return(true, nil).Once()
+				mRepo.EXPECT().DeleteTodo(context.TODO(), existingTodoId.String()).Return(nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+				mRecorder.EXPECT().RecordActor(context.TODO()).Return(nil).Once()
+
+				return mRecorder
+			},
+			expectedResults: []*models.BatchTodoResult{{
+				Index:  0,
+				Op:     constants.BATCH_DELETE_OPERATION,
+				Status: http.StatusNoContent,
+			}},
+		},
+		{
+			testName: "Failed to delete todo as a viewer who is not one of its assignees",
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().GetListOwner(context.TODO(), existingListId.String()).Return(listOwner, nil).Once()
+				mRepo.EXPECT().CheckWhetherUserIsEditor(context.TODO(), existingListId.String(), writer.Id).Return(false, nil).Once()
+
+				return mRepo
+			},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().GetTodo(context.TODO(), existingTodoId.String()).Return(todoEntity, nil).Once()
+				mRepo.EXPECT().IsTodoAssignee(context.TODO(), existingTodoId.String(), writer.Id).Return(false, nil).Once()
+
+				return mRepo
+			},
+			expectedResults: []*models.BatchTodoResult{{
+				Index:  0,
+				Op:     constants.BATCH_DELETE_OPERATION,
+				Status: http.StatusForbidden,
+				Error:  application_errors.TodoAccessForbiddenError.Error(),
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mListRepo := &mocks.ListRepo{}
+			if test.mockListRepo != nil {
+				mListRepo = test.mockListRepo()
+			}
+
+			mTodoRepo := test.mockTodoRepo()
+
+			mRecorder := &mocks.HistoryRecorder{}
+			if test.mockRecorder != nil {
+				mRecorder = test.mockRecorder()
+			}
+
+			tService := NewService(mTodoRepo, mListRepo, nil, nil, nil, nil, nil, nil, mRecorder)
+			batch := &handler_models.BatchTodos{
+				Mode:       constants.ALL_OR_NOTHING_BATCH_MODE,
+				Operations: []handler_models.BatchTodoOperation{{Op: constants.BATCH_DELETE_OPERATION, TodoId: existingTodoId.String()}},
+			}
+
+			result, err := tService.BatchTodosRecords(context.TODO(), batch, writer)
+
+			require.NoError(t, err)
+			require.Equal(t, test.expectedResults, result.Results)
+			mock.AssertExpectationsForObjects(t, mListRepo, mTodoRepo, mRecorder)
+		})
+	}
+}
@@ -12,7 +12,7 @@ var (
 	todoColumns = []string{
 		"id", "name", "description", "list_id",
 		"status", "created_at", "last_updated",
-		"assigned_to", "due_date", "priority",
+		"assigned_to", "due_date", "priority", "parent_id",
 	}
 	baseSelectTodos = fmt.Sprintf(
 		"SELECT %s FROM todos ",
@@ -21,7 +21,7 @@ var (
 )
 
 func parseTodoQuery(sqlFields []string) string {
-	return fmt.Sprintf("UPDATE todos SET %s WHERE id = :id", strings.Join(sqlFields, ", "))
+	return fmt.Sprintf("UPDATE todos SET %s WHERE id = :id AND deleted_at IS NULL", strings.Join(sqlFields, ", "))
 }
 
 func determineSqlFieldsAndParamsTodo(todo *models.Todo, sqlExecParams map[string]interface{}, sqlFields *[]string) {
@@ -54,6 +54,34 @@ func determineSqlFieldsAndParamsTodo(todo *models.Todo, sqlExecParams map[string
 		sqlExecParams["due_date"] = *todo.DueDate
 		*sqlFields = append(*sqlFields, "due_date = :due_date")
 	}
+
+	if todo.Recurrence != nil {
+		sqlExecParams["recurrence_frequency"] = todo.Recurrence.Frequency
+		sqlExecParams["recurrence_interval"] = todo.Recurrence.Interval
+		sqlExecParams["recurrence_until"] = todo.Recurrence.Until
+		sqlExecParams["recurrence_count"] = todo.Recurrence.Count
+		*sqlFields = append(*sqlFields, "recurrence_frequency = :recurrence_frequency", "recurrence_interval = :recurrence_interval",
+			"recurrence_until = :recurrence_until", "recurrence_count = :recurrence_count")
+	}
+	if todo.ColumnId != nil {
+		sqlExecParams["column_id"] = *todo.ColumnId
+		*sqlFields = append(*sqlFields, "column_id = :column_id")
+	}
+
+	if todo.Estimate != nil {
+		if *todo.Estimate == 0 {
+			*sqlFields = append(*sqlFields, "estimate = NULL")
+		} else {
+			sqlExecParams["estimate"] = *todo.Estimate
+			*sqlFields = append(*sqlFields, "estimate = :estimate")
+		}
+	}
+}
+
+// clearRecurrenceSqlFields removes the recurrence rule from a todo once it has been handed over to the next occurrence
+func clearRecurrenceSqlFields(sqlFields *[]string) {
+	*sqlFields = append(*sqlFields, "recurrence_frequency = NULL", "recurrence_interval = NULL",
+		"recurrence_until = NULL", "recurrence_count = NULL", "recurrence_anchor_day = NULL")
 }
 
 func determineAddition(baseQuery string) string {
@@ -71,3 +99,103 @@ func getCorrectTodosByListIdAdditionToQuery(baseQuery string) string {
 	addition := determineAddition(baseQuery)
 	return fmt.Sprintf("%s list_id = $1", addition)
 }
+
+// moveTodosQuery moves the todos together with all of their subtasks, the subtasks in the trash included,
+// so that a subtask never ends up in a different list than its parent, the moved todos are placed at the end
+// of the list in the order they had before
+const moveTodosQuery = `WITH RECURSIVE moved_todos(id) AS (
+    SELECT id FROM todos WHERE id = ANY($1)
+    UNION
+    SELECT todos.id FROM todos JOIN moved_todos ON todos.parent_id = moved_todos.id
+), last_position AS (
+    SELECT COALESCE(MAX(position), 0) AS position FROM todos WHERE list_id = $2
+), ranked_todos AS (
+    SELECT todos.id, ROW_NUMBER() OVER (ORDER BY todos.position, todos.id) AS rank
+    FROM todos JOIN moved_todos ON todos.id = moved_todos.id
+)
+UPDATE todos SET list_id = $2, last_updated = $3, column_id = NULL, position = last_position.position + ranked_todos.rank
+FROM ranked_todos, last_position WHERE todos.id = ranked_todos.id
+RETURNING todos.id, todos.name, todos.description, todos.list_id, todos.status, todos.created_at, todos.last_updated,
+todos.assigned_to, todos.due_date, todos.priority, todos.parent_id,
+todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until, todos.recurrence_count, todos.position, todos.column_id, todos.estimate`
+
+// todosParticipantsQuery selects the distinct ids of the users who are assigned to, additionally assigned to or
+// watching any of the todos
+const todosParticipantsQuery = `SELECT assigned_to FROM todos WHERE id = ANY($1) AND assigned_to IS NOT NULL
+UNION
+SELECT user_id FROM todo_assignees WHERE todo_id = ANY($1)
+UNION
+SELECT user_id FROM todo_watchers WHERE todo_id = ANY($1)`
+
+// detachMovedTodosQuery turns the moved todos whose parent stayed in another list into top level todos
+const detachMovedTodosQuery = `UPDATE todos SET parent_id = NULL
+FROM todos AS parents
+WHERE todos.parent_id = parents.id AND todos.id = ANY($1) AND todos.list_id <> parents.list_id`
+
+// removeCrossListDependenciesQuery removes the dependencies between the todos of the list and the todos of other lists,
+// blockers are scoped per list
+const removeCrossListDependenciesQuery = `DELETE FROM todo_dependencies
+USING todos AS blocked, todos AS blockers
+WHERE todo_dependencies.todo_id = blocked.id AND todo_dependencies.blocker_id = blockers.id
+AND blocked.list_id <> blockers.list_id AND $1 IN (blocked.list_id, blockers.list_id)`
+
+// removeOutOfScopeLabelsQuery detaches from the todos of the list the labels that are not owned by the list owner,
+// labels are scoped per list owner
+const removeOutOfScopeLabelsQuery = `DELETE FROM todo_labels
+USING todos, labels, lists
+WHERE todo_labels.todo_id = todos.id AND todo_labels.label_id = labels.id AND todos.list_id = lists.id
+AND lists.id = $1 AND labels.owner <> lists.owner`
+
+// copyTodoLabelsQuery attaches to the copy the labels of the original todo which are owned by the owner of the copy's list
+const copyTodoLabelsQuery = `INSERT INTO todo_labels (todo_id, label_id)
+SELECT copies.id, todo_labels.label_id FROM todo_labels
+JOIN labels ON labels.id = todo_labels.label_id
+JOIN todos AS copies ON copies.id = $2
+JOIN lists ON lists.id = copies.list_id
+WHERE todo_labels.todo_id = $1 AND labels.owner = lists.owner
+ON CONFLICT (todo_id, label_id) DO NOTHING`
+
+// listTodosParentsFirstQuery returns the todos of the list which are not in the trash, every parent comes before its subtasks,
+// a subtask whose parent is in the trash is returned as a top level todo
+const listTodosParentsFirstQuery = `WITH RECURSIVE list_todos AS (
+    SELECT todos.id, todos.name, todos.description, todos.list_id, todos.status, todos.created_at, todos.last_updated,
+    todos.assigned_to, todos.due_date, todos.priority, todos.parent_id,
+    todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until, todos.recurrence_count, todos.position, todos.column_id, todos.estimate, 0 AS depth
+    FROM todos
+    WHERE todos.list_id = $1 AND todos.deleted_at IS NULL AND NOT EXISTS (
+        SELECT 1 FROM todos AS parents
+        WHERE parents.id = todos.parent_id AND parents.list_id = $1 AND parents.deleted_at IS NULL
+    )
+    UNION ALL
+    SELECT todos.id, todos.name, todos.description, todos.list_id, todos.status, todos.created_at, todos.last_updated,
+    todos.assigned_to, todos.due_date, todos.priority, todos.parent_id,
+    todos.recurrence_frequency, todos.recurrence_interval, todos.recurrence_until, todos.recurrence_count, todos.position, todos.column_id, todos.estimate, list_todos.depth + 1
+    FROM todos JOIN list_todos ON todos.parent_id = list_todos.id
+    WHERE todos.list_id = $1 AND todos.deleted_at IS NULL
+)
+SELECT id, name, description, list_id, status, created_at, last_updated, assigned_to, due_date, priority, parent_id,
+recurrence_frequency, recurrence_interval, recurrence_until, recurrence_count, position, column_id, estimate
+FROM list_todos ORDER BY depth, position, id`
+
+// placeTodoAfterQuery puts the todo halfway between the anchor and the todo that follows the anchor, or one step after
+// the anchor when the anchor is the last todo of the list, numeric multiplication is exact so the halfway position never
+// collapses onto one of its neighbours
+const placeTodoAfterQuery = `UPDATE todos SET last_updated = $3, position = COALESCE(
+    (anchor.position + (
+        SELECT MIN(next_todos.position) FROM todos AS next_todos
+        WHERE next_todos.list_id = anchor.list_id AND next_todos.position > anchor.position AND next_todos.id <> todos.id
+    )) * 0.5,
+    anchor.position + 1)
+FROM todos AS anchor
+WHERE todos.id = $1 AND anchor.id = $2 AND todos.deleted_at IS NULL`
+
+// placeTodoBeforeQuery mirrors placeTodoAfterQuery, the todo is put halfway between the todo that precedes the anchor
+// and the anchor, or one step before the anchor when the anchor is the first todo of the list
+const placeTodoBeforeQuery = `UPDATE todos SET last_updated = $3, position = COALESCE(
+    (anchor.position + (
+        SELECT MAX(previous_todos.position) FROM todos AS previous_todos
+        WHERE previous_todos.list_id = anchor.list_id AND previous_todos.position < anchor.position AND previous_todos.id <> todos.id
+    )) * 0.5,
+    anchor.position - 1)
+FROM todos AS anchor
+WHERE todos.id = $1 AND anchor.id = $2 AND todos.deleted_at IS NULL`
@@ -0,0 +1,52 @@
+package trash
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"database/sql"
+	"errors"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+const (
+	retention          = 30 * 24 * time.Hour
+	sqlQueryRestore    = `UPDATE todos SET deleted_at = NULL, deleted_by = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+	sqlQueryPurgeLists = `DELETE FROM lists WHERE deleted_at < $1`
+	sqlQueryPurgeTodos = `DELETE FROM todos WHERE deleted_at < $1`
+)
+
+var (
+	callerId  = uuid.Must(uuid.NewV4())
+	otherId   = uuid.Must(uuid.NewV4())
+	todoId    = uuid.Must(uuid.NewV4())
+	listId    = uuid.Must(uuid.NewV4())
+	now       = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	deletedAt = now.Add(-time.Hour)
+	dbError   = errors.New("database error")
+
+	caller = &models.User{Id: callerId.String(), Role: constants.Writer}
+	admin  = &models.User{Id: otherId.String(), Role: constants.Admin}
+	other  = &models.User{Id: otherId.String(), Role: constants.Writer}
+
+	todoNotFound = application_errors.NewNotFoundError(constants.TODO_TARGET, todoId.String())
+	listNotFound = application_errors.NewNotFoundError(constants.LIST_TARGET, listId.String())
+
+	deletedTodoEntity = entities.Todo{
+		Id:        todoId,
+		ListId:    listId,
+		DeletedAt: sql.NullTime{Time: deletedAt.Add(-time.Hour), Valid: true},
+		DeletedBy: uuid.NullUUID{UUID: callerId, Valid: true},
+	}
+	deletedListEntity = entities.List{
+		Id:        listId,
+		DeletedAt: sql.NullTime{Time: deletedAt, Valid: true},
+		DeletedBy: uuid.NullUUID{UUID: callerId, Valid: true},
+	}
+	todoEntity = &entities.Todo{Id: todoId, ListId: listId}
+	listEntity = &entities.List{Id: listId}
+	todoModel  = &models.Todo{Id: todoId.String(), ListId: listId.String()}
+	listModel  = &models.List{Id: listId.String()}
+)
@@ -0,0 +1,61 @@
+package trash
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"context"
+	"time"
+)
+
+//go:generate mockery --name=purgeService --exported --output=./mocks --outpkg=mocks --filename=purge_service.go --with-expecter=true
+type purgeService interface {
+	PurgeExpiredRecords(ctx context.Context) error
+}
+
+// Purger periodically removes the records whose retention period in the trash has expired
+type Purger struct {
+	serv     purgeService
+	transact persistence.Transactioner
+	interval time.Duration
+}
+
+func NewPurger(service purgeService, transact persistence.Transactioner, interval time.Duration) *Purger {
+	return &Purger{
+		serv:     service,
+		transact: transact,
+		interval: interval,
+	}
+}
+
+// Run purges the trash right away and then once every interval until the context is done
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.purge(ctx); err != nil {
+			log.C(ctx).Errorf("failed to purge trash, error %s", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Purger) purge(ctx context.Context) error {
+	tx, err := p.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in trash purger, error %s", err.Error())
+		return err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	if err = p.serv.PurgeExpiredRecords(persistence.SaveToContext(ctx, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,77 @@
+package trash
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/trash/mocks"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestPurger_Purge(t *testing.T) {
+	tests := []struct {
+		testName         string
+		mockPurgeService func() *mocks.PurgeService
+		dbMock           func(mck sqlmock.Sqlmock)
+		err              error
+	}{
+		{
+			testName: "Successfully purging the trash in a committed transaction",
+			mockPurgeService: func() *mocks.PurgeService {
+				mService := &mocks.PurgeService{}
+
+				mService.EXPECT().
+					PurgeExpiredRecords(mock.Anything).
+					Return(nil).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+		},
+		{
+			testName: "Failed to purge the trash rolls back the transaction",
+			mockPurgeService: func() *mocks.PurgeService {
+				mService := &mocks.PurgeService{}
+
+				mService.EXPECT().
+					PurgeExpiredRecords(mock.Anything).
+					Return(dbError).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+			mService := test.mockPurgeService()
+
+			err = NewPurger(mService, persistence.NewSqlDb(db), time.Hour).purge(context.TODO())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, mService)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package trash
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+type repository struct{}
+
+func NewRepo() *repository {
+	return &repository{}
+}
+
+func (*repository) GetDeletedTodos(ctx context.Context, userId string) ([]entities.Todo, error) {
+	log.C(ctx).Infof("getting todos deleted by user with id %s in trash repository", userId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var todos []entities.Todo
+	if err = persist.SelectContext(ctx, &todos, deletedTodosByUserQuery, userId); err != nil {
+		log.C(ctx).Errorf("failed to get deleted todos due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+func (*repository) GetDeletedLists(ctx context.Context, userId string) ([]entities.List, error) {
+	log.C(ctx).Infof("getting lists deleted by user with id %s in trash repository", userId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var lists []entities.List
+	if err = persist.SelectContext(ctx, &lists, deletedListsByUserQuery, userId); err != nil {
+		log.C(ctx).Errorf("failed to get deleted lists due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+func (*repository) GetDeletedTodo(ctx context.Context, todoId string) (*entities.Todo, error) {
+	log.C(ctx).Infof("getting deleted todo with id %s in trash repository", todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	entity := &entities.Todo{}
+	if err = persist.GetContext(ctx, entity, deletedTodoQuery, todoId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("failed to get deleted todo with id %s due to sqlErrNoRows", todoId)
+			return nil, application_errors.NewNotFoundError(constants.TODO_TARGET, todoId)
+		}
+		log.C(ctx).Errorf("failed to get deleted todo with id %s because of a database error %s", todoId, err.Error())
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (*repository) GetDeletedList(ctx context.Context, listId string) (*entities.List, error) {
+	log.C(ctx).Infof("getting deleted list with id %s in trash repository", listId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	entity := &entities.List{}
+	if err = persist.GetContext(ctx, entity, deletedListQuery, listId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("failed to get deleted list with id %s due to sqlErrNoRows", listId)
+			return nil, application_errors.NewNotFoundError(constants.LIST_TARGET, listId)
+		}
+		log.C(ctx).Errorf("failed to get deleted list with id %s because of a database error %s", listId, err.Error())
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (*repository) RestoreTodo(ctx context.Context, todoId string) error {
+	log.C(ctx).Infof("restoring todo with id %s in trash repository", todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	res, err := persist.ExecContext(ctx, restoreTodoQuery, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to restore todo with id %s due to a database error %s", todoId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to restore todo, error when trying to get the number of rows affected")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to restore todo, todo with id %s is not in the trash", todoId)
+		return application_errors.NewNotFoundError(constants.TODO_TARGET, todoId)
+	}
+
+	return nil
+}
+
+func (*repository) RestoreList(ctx context.Context, entity *entities.List) error {
+	listId := entity.Id.String()
+	log.C(ctx).Infof("restoring list with id %s in trash repository", listId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	res, err := persist.ExecContext(ctx, restoreListQuery, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to restore list with id %s due to a database error %s", listId, err.Error())
+		return persistence.MapPostgresListErrorToError(err, entity)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to restore list, error when trying to get the number of rows affected")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to restore list, list with id %s is not in the trash", listId)
+		return application_errors.NewNotFoundError(constants.LIST_TARGET, listId)
+	}
+
+	return nil
+}
+
+// PurgeDeletedBefore permanently removes the lists and todos that were moved to the trash before the given time
+func (*repository) PurgeDeletedBefore(ctx context.Context, deletedBefore time.Time) error {
+	log.C(ctx).Infof("purging records deleted before %s in trash repository", deletedBefore.Format(time.RFC3339))
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, purgeListsQuery, deletedBefore); err != nil {
+		log.C(ctx).Errorf("failed to purge deleted lists due to a database error %s", err.Error())
+		return err
+	}
+
+	if _, err = persist.ExecContext(ctx, purgeTodosQuery, deletedBefore); err != nil {
+		log.C(ctx).Errorf("failed to purge deleted todos due to a database error %s", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package trash
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"context"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"regexp"
+	"testing"
+)
+
+func TestRepository_RestoreTodo(t *testing.T) {
+	tests := []struct {
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
+	}{
+		{
+			testName: "Successfully restoring todo",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryRestore)).
+					WithArgs(todoId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			testName: "Failed to restore todo which is not in the trash",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryRestore)).
+					WithArgs(todoId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			err: todoNotFound,
+		},
+		{
+			testName: "Failed to restore todo due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryRestore)).
+					WithArgs(todoId.String()).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo().RestoreTodo(ctx, todoId.String())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_PurgeDeletedBefore(t *testing.T) {
+	tests := []struct {
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
+	}{
+		{
+			testName: "Successfully purging lists and todos deleted before the given time",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryPurgeLists)).
+					WithArgs(deletedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryPurgeTodos)).
+					WithArgs(deletedAt).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+		},
+		{
+			testName: "Failed to purge todos after the lists due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryPurgeLists)).
+					WithArgs(deletedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryPurgeTodos)).
+					WithArgs(deletedAt).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo().PurgeDeletedBefore(ctx, deletedAt)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
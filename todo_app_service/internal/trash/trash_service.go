@@ -0,0 +1,213 @@
+package trash
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/gofrs/uuid"
+	"sort"
+	"time"
+)
+
+//go:generate mockery --name=trashRepo --exported --output=./mocks --outpkg=mocks --filename=trash_repo.go --with-expecter=true
+type trashRepo interface {
+	GetDeletedTodos(ctx context.Context, userId string) ([]entities.Todo, error)
+	GetDeletedLists(ctx context.Context, userId string) ([]entities.List, error)
+	GetDeletedTodo(ctx context.Context, todoId string) (*entities.Todo, error)
+	GetDeletedList(ctx context.Context, listId string) (*entities.List, error)
+	RestoreTodo(ctx context.Context, todoId string) error
+	RestoreList(ctx context.Context, entity *entities.List) error
+	PurgeDeletedBefore(ctx context.Context, deletedBefore time.Time) error
+}
+
+//go:generate mockery --name=todoRepo --exported --output=./mocks --outpkg=mocks --filename=todo_repo.go --with-expecter=true
+type todoRepo interface {
+	GetTodo(ctx context.Context, todoId string) (*entities.Todo, error)
+}
+
+//go:generate mockery --name=listRepo --exported --output=./mocks --outpkg=mocks --filename=list_repo.go --with-expecter=true
+type listRepo interface {
+	GetList(ctx context.Context, listId string) (*entities.List, error)
+}
+
+//go:generate mockery --name=todoConverter --exported --output=./mocks --outpkg=mocks --filename=todo_converter.go --with-expecter=true
+type todoConverter interface {
+	ToModel(todo *entities.Todo) *models.Todo
+}
+
+//go:generate mockery --name=listConverter --exported --output=./mocks --outpkg=mocks --filename=list_converter.go --with-expecter=true
+type listConverter interface {
+	ToModel(list *entities.List) *models.List
+}
+
+//go:generate mockery --name=historyRecorder --exported --output=./mocks --outpkg=mocks --filename=history_recorder.go --with-expecter=true
+type historyRecorder interface {
+	RecordActor(ctx context.Context) error
+}
+
+//go:generate mockery --name=timeGenerator --exported --output=./mocks --outpkg=mocks --filename=time_generator.go --with-expecter=true
+type timeGenerator interface {
+	Now() time.Time
+}
+
+type service struct {
+	trRepo     trashRepo
+	tRepo      todoRepo
+	lRepo      listRepo
+	tConverter todoConverter
+	lConverter listConverter
+	hRecorder  historyRecorder
+	timeGen    timeGenerator
+	retention  time.Duration
+}
+
+func NewService(trRepo trashRepo, tRepo todoRepo, lRepo listRepo, tConverter todoConverter, lConverter listConverter,
+	hRecorder historyRecorder, timeGen timeGenerator, retention time.Duration) *service {
+	return &service{
+		trRepo:     trRepo,
+		tRepo:      tRepo,
+		lRepo:      lRepo,
+		tConverter: tConverter,
+		lConverter: lConverter,
+		hRecorder:  hRecorder,
+		timeGen:    timeGen,
+		retention:  retention,
+	}
+}
+
+// GetTrash returns the todos and lists deleted by the caller, the most recently deleted first
+func (s *service) GetTrash(ctx context.Context, caller *models.User) (*models.Trash, error) {
+	log.C(ctx).Infof("getting trash of user with id %s in trash service", caller.Id)
+
+	deletedTodos, err := s.trRepo.GetDeletedTodos(ctx, caller.Id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get trash, error %s when getting deleted todos", err.Error())
+		return nil, err
+	}
+
+	deletedLists, err := s.trRepo.GetDeletedLists(ctx, caller.Id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get trash, error %s when getting deleted lists", err.Error())
+		return nil, err
+	}
+
+	items := make([]*models.TrashItem, 0, len(deletedTodos)+len(deletedLists))
+	for index := range deletedTodos {
+		items = append(items, &models.TrashItem{
+			Type:      constants.TODO_TRASH_ITEM,
+			DeletedAt: deletedTodos[index].DeletedAt.Time,
+			Todo:      s.tConverter.ToModel(&deletedTodos[index]),
+		})
+	}
+
+	for index := range deletedLists {
+		items = append(items, &models.TrashItem{
+			Type:      constants.LIST_TRASH_ITEM,
+			DeletedAt: deletedLists[index].DeletedAt.Time,
+			List:      s.lConverter.ToModel(&deletedLists[index]),
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+
+	return &models.Trash{
+		Data:       items,
+		TotalCount: len(items),
+	}, nil
+}
+
+func (s *service) RestoreTodoRecord(ctx context.Context, todoId string, caller *models.User) (*models.Todo, error) {
+	log.C(ctx).Infof("restoring todo with id %s in trash service", todoId)
+
+	deletedTodo, err := s.trRepo.GetDeletedTodo(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to restore todo, error %s when getting deleted todo", err.Error())
+		return nil, err
+	}
+
+	if !canRestore(caller, deletedTodo.DeletedBy) {
+		log.C(ctx).Errorf("failed to restore todo, todo with id %s is not in the trash of user with id %s", todoId, caller.Id)
+		return nil, application_errors.NewNotFoundError(constants.TODO_TARGET, todoId)
+	}
+
+	listId := deletedTodo.ListId.String()
+	if _, err = s.lRepo.GetList(ctx, listId); err != nil {
+		log.C(ctx).Errorf("failed to restore todo, error %s when getting list with id %s", err.Error(), listId)
+		return nil, err
+	}
+
+	if err = s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return nil, err
+	}
+
+	if err = s.trRepo.RestoreTodo(ctx, todoId); err != nil {
+		log.C(ctx).Errorf("failed to restore todo with id %s, error %s when calling trash repo", todoId, err.Error())
+		return nil, err
+	}
+
+	restoredTodo, err := s.tRepo.GetTodo(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get restored todo with id %s, error %s", todoId, err.Error())
+		return nil, err
+	}
+
+	return s.tConverter.ToModel(restoredTodo), nil
+}
+
+func (s *service) RestoreListRecord(ctx context.Context, listId string, caller *models.User) (*models.List, error) {
+	log.C(ctx).Infof("restoring list with id %s in trash service", listId)
+
+	deletedList, err := s.trRepo.GetDeletedList(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to restore list, error %s when getting deleted list", err.Error())
+		return nil, err
+	}
+
+	if !canRestore(caller, deletedList.DeletedBy) {
+		log.C(ctx).Errorf("failed to restore list, list with id %s is not in the trash of user with id %s", listId, caller.Id)
+		return nil, application_errors.NewNotFoundError(constants.LIST_TARGET, listId)
+	}
+
+	if err = s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return nil, err
+	}
+
+	if err = s.trRepo.RestoreList(ctx, deletedList); err != nil {
+		log.C(ctx).Errorf("failed to restore list with id %s, error %s when calling trash repo", listId, err.Error())
+		return nil, err
+	}
+
+	restoredList, err := s.lRepo.GetList(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get restored list with id %s, error %s", listId, err.Error())
+		return nil, err
+	}
+
+	return s.lConverter.ToModel(restoredList), nil
+}
+
+// PurgeExpiredRecords permanently removes the records that have been in the trash for longer than the retention period
+func (s *service) PurgeExpiredRecords(ctx context.Context) error {
+	deletedBefore := s.timeGen.Now().Add(-s.retention)
+	log.C(ctx).Infof("purging records deleted before %s in trash service", deletedBefore.Format(time.RFC3339))
+
+	if err := s.trRepo.PurgeDeletedBefore(ctx, deletedBefore); err != nil {
+		log.C(ctx).Errorf("failed to purge expired records, error %s when calling trash repo", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// canRestore reports whether the caller may restore a deleted record, only the user who deleted it and the
+// administrators can, for everyone else the record does not exist
+func canRestore(caller *models.User, deletedBy uuid.NullUUID) bool {
+	return caller.Role == constants.Admin || (deletedBy.Valid && deletedBy.UUID.String() == caller.Id)
+}
@@ -0,0 +1,503 @@
+package trash
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/trash/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestService_GetTrash(t *testing.T) {
+	tests := []struct {
+		testName      string
+		mockTrashRepo func() *mocks.TrashRepo
+		mockTConv     func() *mocks.TodoConverter
+		mockLConv     func() *mocks.ListConverter
+		expectedTrash *models.Trash
+		err           error
+	}{
+		{
+			testName: "Successfully getting the trash with the most recently deleted items first",
+			mockTrashRepo: func() *mocks.TrashRepo {
+				mRepo := &mocks.TrashRepo{}
+
+				mRepo.EXPECT().
+					GetDeletedTodos(context.TODO(), caller.Id).
+					Return([]entities.Todo{deletedTodoEntity}, nil).Once()
+
+				mRepo.EXPECT().
+					GetDeletedLists(context.TODO(), caller.Id).
+					Return([]entities.List{deletedListEntity}, nil).Once()
+
+				return mRepo
+			},
+			mockTConv: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+
+				mConverter.EXPECT().
+					ToModel(&deletedTodoEntity).
+					Return(todoModel).Once()
+
+				return mConverter
+			},
+			mockLConv: func() *mocks.ListConverter {
+				mConverter := &mocks.ListConverter{}
+
+				mConverter.EXPECT().
+					ToModel(&deletedListEntity).
+					Return(listModel).Once()
+
+				return mConverter
+			},
+			expectedTrash: &models.Trash{
+				Data: []*models.TrashItem{
+					{Type: constants.LIST_TRASH_ITEM, DeletedAt: deletedListEntity.DeletedAt.Time, List: listModel},
+					{Type: constants.TODO_TRASH_ITEM, DeletedAt: deletedTodoEntity.DeletedAt.Time, Todo: todoModel},
+				},
+				TotalCount: 2,
+			},
+		},
+		{
+			testName: "Failed to get the trash due to error when getting deleted lists",
+			mockTrashRepo: func() *mocks.TrashRepo {
+				mRepo := &mocks.TrashRepo{}
+
+				mRepo.EXPECT().
+					GetDeletedTodos(context.TODO(), caller.Id).
+					Return([]entities.Todo{deletedTodoEntity}, nil).Once()
+
+				mRepo.EXPECT().
+					GetDeletedLists(context.TODO(), caller.Id).
+					Return(nil, dbError).Once()
+
+				return mRepo
+			},
+			mockTConv: func() *mocks.TodoConverter {
+				return &mocks.TodoConverter{}
+			},
+			mockLConv: func() *mocks.ListConverter {
+				return &mocks.ListConverter{}
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockTrashRepo()
+			mTConv := test.mockTConv()
+			mLConv := test.mockLConv()
+
+			tService := NewService(mRepo, nil, nil, mTConv, mLConv, nil, nil, retention)
+			trash, err := tService.GetTrash(context.TODO(), caller)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, trash)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedTrash, trash)
+			}
+
+			mock.AssertExpectationsForObjects(t, mRepo, mTConv, mLConv)
+		})
+	}
+}
+
+func TestService_RestoreTodoRecord(t *testing.T) {
+	tests := []struct {
+		testName      string
+		caller        *models.User
+		mockTrashRepo func() *mocks.TrashRepo
+		mockTodoRepo  func() *mocks.TodoRepo
+		mockListRepo  func() *mocks.ListRepo
+		mockRecorder  func() *mocks.HistoryRecorder
+		mockConverter func() *mocks.TodoConverter
+		expectedTodo  *models.Todo
+		err           error
+	}{
+		{
+			testName: "Successfully restoring todo deleted by the caller",
+			caller:   caller,
+			mockTrashRepo: func() *mocks.TrashRepo {
+				mRepo := &mocks.TrashRepo{}
+
+				mRepo.EXPECT().
+					GetDeletedTodo(context.TODO(), todoId.String()).
+					Return(&deletedTodoEntity, nil).Once()
+
+				mRepo.EXPECT().
+					RestoreTodo(context.TODO(), todoId.String()).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					GetTodo(context.TODO(), todoId.String()).
+					Return(todoEntity, nil).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(listEntity, nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(nil).Once()
+
+				return mRecorder
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+
+				mConverter.EXPECT().
+					ToModel(todoEntity).
+					Return(todoModel).Once()
+
+				return mConverter
+			},
+			expectedTodo: todoModel,
+		},
+		{
+			testName: "Successfully restoring todo deleted by another user as an admin",
+			caller:   admin,
+			mockTrashRepo: func() *mocks.TrashRepo {
+				mRepo := &mocks.TrashRepo{}
+
+				mRepo.EXPECT().
+					GetDeletedTodo(context.TODO(), todoId.String()).
+					Return(&deletedTodoEntity, nil).Once()
+
+				mRepo.EXPECT().
+					RestoreTodo(context.TODO(), todoId.String()).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				mRepo := &mocks.TodoRepo{}
+
+				mRepo.EXPECT().
+					GetTodo(context.TODO(), todoId.String()).
+					Return(todoEntity, nil).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(listEntity, nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(nil).Once()
+
+				return mRecorder
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				mConverter := &mocks.TodoConverter{}
+
+				mConverter.EXPECT().
+					ToModel(todoEntity).
+					Return(todoModel).Once()
+
+				return mConverter
+			},
+			expectedTodo: todoModel,
+		},
+		{
+			testName: "Failed to restore todo which is not in the trash of the caller",
+			caller:   other,
+			mockTrashRepo: func() *mocks.TrashRepo {
+				mRepo := &mocks.TrashRepo{}
+
+				mRepo.EXPECT().
+					GetDeletedTodo(context.TODO(), todoId.String()).
+					Return(&deletedTodoEntity, nil).Once()
+
+				return mRepo
+			},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				return &mocks.TodoRepo{}
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				return &mocks.ListRepo{}
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				return &mocks.HistoryRecorder{}
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				return &mocks.TodoConverter{}
+			},
+			err: todoNotFound,
+		},
+		{
+			testName: "Failed to restore todo whose list is in the trash",
+			caller:   caller,
+			mockTrashRepo: func() *mocks.TrashRepo {
+				mRepo := &mocks.TrashRepo{}
+
+				mRepo.EXPECT().
+					GetDeletedTodo(context.TODO(), todoId.String()).
+					Return(&deletedTodoEntity, nil).Once()
+
+				return mRepo
+			},
+			mockTodoRepo: func() *mocks.TodoRepo {
+				return &mocks.TodoRepo{}
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(nil, listNotFound).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				return &mocks.HistoryRecorder{}
+			},
+			mockConverter: func() *mocks.TodoConverter {
+				return &mocks.TodoConverter{}
+			},
+			err: listNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mTrashRepo := test.mockTrashRepo()
+			mTodoRepo := test.mockTodoRepo()
+			mListRepo := test.mockListRepo()
+			mRecorder := test.mockRecorder()
+			mConverter := test.mockConverter()
+
+			tService := NewService(mTrashRepo, mTodoRepo, mListRepo, mConverter, nil, mRecorder, nil, retention)
+			todo, err := tService.RestoreTodoRecord(context.TODO(), todoId.String(), test.caller)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, todo)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedTodo, todo)
+			}
+
+			mock.AssertExpectationsForObjects(t, mTrashRepo, mTodoRepo, mListRepo, mRecorder, mConverter)
+		})
+	}
+}
+
+func TestService_RestoreListRecord(t *testing.T) {
+	tests := []struct {
+		testName      string
+		caller        *models.User
+		mockTrashRepo func() *mocks.TrashRepo
+		mockListRepo  func() *mocks.ListRepo
+		mockRecorder  func() *mocks.HistoryRecorder
+		mockConverter func() *mocks.ListConverter
+		expectedList  *models.List
+		err           error
+	}{
+		{
+			testName: "Successfully restoring list deleted by the caller",
+			caller:   caller,
+			mockTrashRepo: func() *mocks.TrashRepo {
+				mRepo := &mocks.TrashRepo{}
+
+				mRepo.EXPECT().
+					GetDeletedList(context.TODO(), listId.String()).
+					Return(&deletedListEntity, nil).Once()
+
+				mRepo.EXPECT().
+					RestoreList(context.TODO(), &deletedListEntity).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				mRepo := &mocks.ListRepo{}
+
+				mRepo.EXPECT().
+					GetList(context.TODO(), listId.String()).
+					Return(listEntity, nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(nil).Once()
+
+				return mRecorder
+			},
+			mockConverter: func() *mocks.ListConverter {
+				mConverter := &mocks.ListConverter{}
+
+				mConverter.EXPECT().
+					ToModel(listEntity).
+					Return(listModel).Once()
+
+				return mConverter
+			},
+			expectedList: listModel,
+		},
+		{
+			testName: "Failed to restore list which is not in the trash of the caller",
+			caller:   other,
+			mockTrashRepo: func() *mocks.TrashRepo {
+				mRepo := &mocks.TrashRepo{}
+
+				mRepo.EXPECT().
+					GetDeletedList(context.TODO(), listId.String()).
+					Return(&deletedListEntity, nil).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				return &mocks.ListRepo{}
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				return &mocks.HistoryRecorder{}
+			},
+			mockConverter: func() *mocks.ListConverter {
+				return &mocks.ListConverter{}
+			},
+			err: listNotFound,
+		},
+		{
+			testName: "Failed to restore list due to error in trash repository",
+			caller:   caller,
+			mockTrashRepo: func() *mocks.TrashRepo {
+				mRepo := &mocks.TrashRepo{}
+
+				mRepo.EXPECT().
+					GetDeletedList(context.TODO(), listId.String()).
+					Return(&deletedListEntity, nil).Once()
+
+				mRepo.EXPECT().
+					RestoreList(context.TODO(), &deletedListEntity).
+					Return(dbError).Once()
+
+				return mRepo
+			},
+			mockListRepo: func() *mocks.ListRepo {
+				return &mocks.ListRepo{}
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(nil).Once()
+
+				return mRecorder
+			},
+			mockConverter: func() *mocks.ListConverter {
+				return &mocks.ListConverter{}
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mTrashRepo := test.mockTrashRepo()
+			mListRepo := test.mockListRepo()
+			mRecorder := test.mockRecorder()
+			mConverter := test.mockConverter()
+
+			tService := NewService(mTrashRepo, nil, mListRepo, nil, mConverter, mRecorder, nil, retention)
+			list, err := tService.RestoreListRecord(context.TODO(), listId.String(), test.caller)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, list)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedList, list)
+			}
+
+			mock.AssertExpectationsForObjects(t, mTrashRepo, mListRepo, mRecorder, mConverter)
+		})
+	}
+}
+
+func TestService_PurgeExpiredRecords(t *testing.T) {
+	tests := []struct {
+		testName      string
+		mockTrashRepo func() *mocks.TrashRepo
+		err           error
+	}{
+		{
+			testName: "Successfully purging the records deleted before the retention period",
+			mockTrashRepo: func() *mocks.TrashRepo {
+				mRepo := &mocks.TrashRepo{}
+
+				mRepo.EXPECT().
+					PurgeDeletedBefore(context.TODO(), now.Add(-retention)).
+					Return(nil).Once()
+
+				return mRepo
+			},
+		},
+		{
+			testName: "Failed to purge the records due to error in trash repository",
+			mockTrashRepo: func() *mocks.TrashRepo {
+				mRepo := &mocks.TrashRepo{}
+
+				mRepo.EXPECT().
+					PurgeDeletedBefore(context.TODO(), now.Add(-retention)).
+					Return(dbError).Once()
+
+				return mRepo
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockTrashRepo()
+
+			mTimeGen := &mocks.TimeGenerator{}
+			mTimeGen.EXPECT().Now().Return(now).Once()
+
+			err := NewService(mRepo, nil, nil, nil, nil, nil, mTimeGen, retention).PurgeExpiredRecords(context.TODO())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, mRepo, mTimeGen)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package trash
+
+// deletedTodosByUserQuery returns the todos the user moved to the trash, the todos that were deleted together
+// with their list are left out because they can only be restored with it
+const deletedTodosByUserQuery = `SELECT todos.id, todos.name, todos.description, todos.list_id, todos.status, todos.created_at,
+todos.last_updated, todos.assigned_to, todos.due_date, todos.priority, todos.parent_id, todos.recurrence_frequency,
+todos.recurrence_interval, todos.recurrence_until, todos.recurrence_count, todos.deleted_at, todos.deleted_by, todos.position, todos.column_id, todos.estimate FROM todos
+JOIN lists ON lists.id = todos.list_id
+WHERE todos.deleted_by = $1 AND todos.deleted_at IS NOT NULL AND lists.deleted_at IS NULL
+ORDER BY todos.deleted_at DESC, todos.id`
+
+const deletedListsByUserQuery = `SELECT id, name, created_at, last_updated, owner, description, deleted_at, deleted_by FROM lists
+WHERE deleted_by = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id`
+
+const deletedTodoQuery = `SELECT id, name, description, list_id, status, created_at, last_updated, assigned_to, due_date, priority,
+parent_id, recurrence_frequency, recurrence_interval, recurrence_until, recurrence_count, deleted_at, deleted_by, position, column_id, estimate FROM todos
+WHERE id = $1 AND deleted_at IS NOT NULL`
+
+const deletedListQuery = `SELECT id, name, created_at, last_updated, owner, description, deleted_at, deleted_by FROM lists
+WHERE id = $1 AND deleted_at IS NOT NULL`
+
+const restoreTodoQuery = `UPDATE todos SET deleted_at = NULL, deleted_by = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+
+// restoreListQuery restores the list together with the todos that were deleted with it, both statements see the
+// list as it was before the restore so the todos are matched by the deletion time of the list
+const restoreListQuery = `WITH restored_todos AS (
+    UPDATE todos SET deleted_at = NULL, deleted_by = NULL
+    FROM lists WHERE lists.id = $1 AND todos.list_id = lists.id AND todos.deleted_at = lists.deleted_at
+)
+UPDATE lists SET deleted_at = NULL, deleted_by = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+
+const purgeListsQuery = `DELETE FROM lists WHERE deleted_at < $1`
+
+const purgeTodosQuery = `DELETE FROM todos WHERE deleted_at < $1`
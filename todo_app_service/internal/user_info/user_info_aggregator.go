@@ -3,12 +3,13 @@ package user_info
 import (
 	"Todo-List/internProject/todo_app_service/internal/utils"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
 )
 
 type userInfoService interface {
 	DetermineUserGitHubEmail(ctx context.Context, accessToken string) (string, error)
-	GetUserAppRole(ctx context.Context, accessToken string) (string, error)
+	GetUserMemberships(ctx context.Context, accessToken string) (*models.Memberships, error)
 }
 type aggregator struct {
 	service userInfoService
@@ -18,11 +19,11 @@ func NewAggregator(service userInfoService) *aggregator {
 	return &aggregator{service: service}
 }
 
-func (a *aggregator) AggregateUserInfo(ctx context.Context, accessToken string) (string, string, error) {
+func (a *aggregator) AggregateUserInfo(ctx context.Context, accessToken string) (*models.UserIdentity, error) {
 	log.C(ctx).Info("aggregating user info in user_info_aggregator")
 
 	emailChan := make(chan utils.ChannelResult[string], 1)
-	roleChan := make(chan utils.ChannelResult[string], 1)
+	membershipsChan := make(chan utils.ChannelResult[*models.Memberships], 1)
 
 	go func() {
 		userEmail, err := a.service.DetermineUserGitHubEmail(ctx, accessToken)
@@ -33,18 +34,21 @@ func (a *aggregator) AggregateUserInfo(ctx context.Context, accessToken string)
 	}()
 
 	go func() {
-		userRole, err := a.service.GetUserAppRole(ctx, accessToken)
-		roleChan <- utils.ChannelResult[string]{
-			Result: userRole,
+		userMemberships, err := a.service.GetUserMemberships(ctx, accessToken)
+		membershipsChan <- utils.ChannelResult[*models.Memberships]{
+			Result: userMemberships,
 			Err:    err,
 		}
 	}()
 
-	email, role, err := utils.OrchestrateGoRoutines(ctx, emailChan, roleChan)
+	email, memberships, err := utils.OrchestrateGoRoutines(ctx, emailChan, membershipsChan)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get tokens, error %s when trying to orchestrate goroutines", err.Error())
-		return "", "", err
+		return nil, err
 	}
 
-	return email, role, nil
+	return &models.UserIdentity{
+		Email:       email,
+		Memberships: *memberships,
+	}, nil
 }
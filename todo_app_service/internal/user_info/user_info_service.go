@@ -2,14 +2,15 @@ package user_info
 
 import (
 	"Todo-List/internProject/todo_app_service/internal/gitHub"
-	"Todo-List/internProject/todo_app_service/internal/utils"
 	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
 	"errors"
 )
 
 type githubService interface {
 	GetUserOrganizations(ctx context.Context, accessToken string) ([]*gitHub.Organization, error)
+	GetUserTeams(ctx context.Context, accessToken string) ([]*gitHub.Team, error)
 	GetUserInfo(ctx context.Context, accessToken string) (*gitHub.UserInfo, error)
 	GetUserInfoPrivate(ctx context.Context, accessToken string) (*gitHub.UserInfo, error)
 }
@@ -45,18 +46,31 @@ func (u *service) DetermineUserGitHubEmail(ctx context.Context, accessToken stri
 	return *userInfo.Email, nil
 }
 
-func (u *service) GetUserAppRole(ctx context.Context, accessToken string) (string, error) {
+func (u *service) GetUserMemberships(ctx context.Context, accessToken string) (*models.Memberships, error) {
+	log.C(ctx).Info("getting user organizations and teams in user info service")
+
 	userOrganizations, err := u.gService.GetUserOrganizations(ctx, accessToken)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get user organizations, error %s when calling github service", err.Error())
-		return "", nil
+		return nil, err
 	}
 
-	role, err := utils.DetermineRole(userOrganizations)
+	userTeams, err := u.gService.GetUserTeams(ctx, accessToken)
 	if err != nil {
-		log.C(ctx).Errorf("failed to determine user role, error %s", err.Error())
-		return "", nil
+		log.C(ctx).Errorf("failed to get user teams, error %s when calling github service", err.Error())
+		return nil, err
+	}
+
+	memberships := &models.Memberships{
+		Organizations: make([]string, 0, len(userOrganizations)),
+		Teams:         make([]string, 0, len(userTeams)),
+	}
+	for _, org := range userOrganizations {
+		memberships.Organizations = append(memberships.Organizations, org.Login)
+	}
+	for _, team := range userTeams {
+		memberships.Teams = append(memberships.Teams, team.Organization.Login+"/"+team.Slug)
 	}
 
-	return role, nil
+	return memberships, nil
 }
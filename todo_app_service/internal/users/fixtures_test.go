@@ -56,6 +56,11 @@ var (
 	errAlreadyExistingEmail    = application_errors.NewAlreadyExistError(constants.USER_TARGET, existingUserEmail)
 	errAlreadyExistingUserId   = application_errors.NewAlreadyExistError(constants.USER_TARGET, existingUserId)
 	testDate                   = time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+	sqlQueryReassignUserLists  = `WITH reassigned_lists AS (
+    UPDATE lists SET owner = $2, last_updated = NOW() WHERE owner = $1 RETURNING id
+)
+DELETE FROM user_lists USING reassigned_lists
+WHERE user_lists.list_id = reassigned_lists.id AND user_lists.user_id = $2`
 )
 
 func initEntityUser(id string, email string, role string) *entities.User {
@@ -22,7 +22,7 @@ func (_m *SqlQueryRetriever) EXPECT() *SqlQueryRetriever_Expecter {
 }
 
 // DetermineCorrectSqlQuery provides a mock function with given fields: ctx
-func (_m *SqlQueryRetriever) DetermineCorrectSqlQuery(ctx context.Context) string {
+func (_m *SqlQueryRetriever) DetermineCorrectSqlQuery(ctx context.Context) (string, []interface{}) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
@@ -30,13 +30,25 @@ func (_m *SqlQueryRetriever) DetermineCorrectSqlQuery(ctx context.Context) strin
 	}
 
 	var r0 string
+	var r1 []interface{}
+	if rf, ok := ret.Get(0).(func(context.Context) (string, []interface{})); ok {
+		return rf(ctx)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
 		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) []interface{}); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]interface{})
+		}
+	}
+
+	return r0, r1
 }
 
 // SqlQueryRetriever_DetermineCorrectSqlQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetermineCorrectSqlQuery'
@@ -57,12 +69,12 @@ func (_c *SqlQueryRetriever_DetermineCorrectSqlQuery_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *SqlQueryRetriever_DetermineCorrectSqlQuery_Call) Return(_a0 string) *SqlQueryRetriever_DetermineCorrectSqlQuery_Call {
-	_c.Call.Return(_a0)
+func (_c *SqlQueryRetriever_DetermineCorrectSqlQuery_Call) Return(_a0 string, _a1 []interface{}) *SqlQueryRetriever_DetermineCorrectSqlQuery_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SqlQueryRetriever_DetermineCorrectSqlQuery_Call) RunAndReturn(run func(context.Context) string) *SqlQueryRetriever_DetermineCorrectSqlQuery_Call {
+func (_c *SqlQueryRetriever_DetermineCorrectSqlQuery_Call) RunAndReturn(run func(context.Context) (string, []interface{})) *SqlQueryRetriever_DetermineCorrectSqlQuery_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -11,13 +11,16 @@ import (
 	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
 	"encoding/json"
+	"github.com/gofrs/uuid"
 	"net/http"
 )
 
+//go:generate mockery --name=userService --exported --output=./mocks --outpkg=mocks --filename=user_service.go --with-expecter=true
 type userService interface {
 	GetUsersRecords(ctx context.Context, uFilters filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.UserPage, error)
 	GetUserRecord(ctx context.Context, userId string) (*models.User, error)
 	DeleteUserRecord(ctx context.Context, id string) error
+	DeleteUserRecordAndReassignLists(ctx context.Context, id string, newOwnerId string) error
 	DeleteUsers(ctx context.Context) error
 	GetUserListsRecords(ctx context.Context, userId string, uFilter filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.ListPage, error)
 	GetTodosAssignedToUser(ctx context.Context, userId string, userFilters filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error)
@@ -151,9 +154,35 @@ func (h *Handler) HandleDeleteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err = h.service.DeleteUserRecord(ctx, userId); err != nil {
+	reassignListsTo := utils.GetContentFromUrl(r, constants.REASSIGN_LISTS_TO)
+	if len(reassignListsTo) == 0 {
+		err = h.service.DeleteUserRecord(ctx, userId)
+	} else {
+		var caller *models.User
+		if caller, err = utils.GetValueFromContext[*models.User](ctx, middlewares.UserKey); err != nil {
+			log.C(ctx).Errorf("failed to get caller due to an error %s when trying to get value from context in user handler", err.Error())
+			utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+			return
+		}
+
+		if caller.Role != constants.Admin {
+			log.C(ctx).Errorf("failed to delete user, user with id %s is not allowed to reassign lists", caller.Id)
+			utils.EncodeError(w, constants.ONLY_ADMINS_CAN_REASSIGN_LISTS, http.StatusForbidden)
+			return
+		}
+
+		if _, err = uuid.FromString(reassignListsTo); err != nil {
+			log.C(ctx).Errorf("failed to delete user, invalid %s value %s", constants.REASSIGN_LISTS_TO, reassignListsTo)
+			utils.EncodeError(w, constants.INVALID_REASSIGN_LISTS_TO, http.StatusBadRequest)
+			return
+		}
+
+		err = h.service.DeleteUserRecordAndReassignLists(ctx, userId, reassignListsTo)
+	}
+
+	if err != nil {
 		log.C(ctx).Errorf("failed to delete user in user handler, error %s when calling user service", err.Error())
-		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
 		return
 	}
 
@@ -227,6 +256,13 @@ func (h *Handler) HandleGetUserLists(w http.ResponseWriter, r *http.Request) {
 		first = constants.DEFAULT_LIMIT_VALUE
 	}
 
+	sort := utils.GetContentFromUrl(r, constants.SORT)
+	if _, err = filters.ParseSort(sort, filters.ListSortFields); err != nil {
+		log.C(ctx).Errorf("failed to get lists, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	lFilters := &filters.ListFilters{
 		PaginationFilters: filters.PaginationFilters{
 			First:  first,
@@ -236,6 +272,7 @@ func (h *Handler) HandleGetUserLists(w http.ResponseWriter, r *http.Request) {
 		},
 		OwnerID: userId,
 		Name:    name,
+		Sort:    sort,
 	}
 
 	resourceIdentifier := &resource_identifier.GenericResourceIdentifier{}
@@ -291,6 +328,15 @@ func (h *Handler) HandleGetTodosAssignedToUser(w http.ResponseWriter, r *http.Re
 	priority := utils.GetContentFromUrl(r, constants.PRIORITY)
 	overdue := utils.GetContentFromUrl(r, constants.OVERDUE)
 	name := utils.GetContentFromUrl(r, constants.NAME)
+	excludeSubtasks := utils.GetContentFromUrl(r, constants.EXCLUDE_SUBTASKS)
+	label := utils.GetContentFromUrl(r, constants.LABEL)
+
+	sort := utils.GetContentFromUrl(r, constants.SORT)
+	if _, err = filters.ParseSort(sort, filters.TodoSortFields); err != nil {
+		log.C(ctx).Errorf("failed to get todos, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	todoFilters := &filters.TodoFilters{
 		PaginationFilters: filters.PaginationFilters{
@@ -299,11 +345,14 @@ func (h *Handler) HandleGetTodosAssignedToUser(w http.ResponseWriter, r *http.Re
 			Before: before,
 			Last:   last,
 		},
-		Status:   status,
-		Priority: priority,
-		Overdue:  overdue,
-		Name:     name,
-		UserID:   userId,
+		Status:          status,
+		Priority:        priority,
+		Overdue:         overdue,
+		Name:            name,
+		UserID:          userId,
+		ExcludeSubtasks: excludeSubtasks,
+		Label:           label,
+		Sort:            sort,
 	}
 
 	resourceIdentifier := &resource_identifier.GenericResourceIdentifier{}
@@ -16,11 +16,11 @@ import (
 )
 
 type genericRepository interface {
-	GetPaginationInfo(ctx context.Context, sourceName string, filter string, params []interface{}) (*entities.PaginationInfo, error)
+	GetSortedPaginationInfo(ctx context.Context, sourceName string, filter string, sortExpression string, direction string, params []interface{}) (*entities.PaginationInfo, error)
 }
 
 type sqlDecoratorFactory interface {
-	CreateSqlDecorator(ctx context.Context, filters sql_query_decorators.Filters, initialQuery string) (sql_query_decorators.SqlQueryRetriever, error)
+	CreateSqlDecorator(ctx context.Context, filters sql_query_decorators.Filters, initialQuery string, initialParams []interface{}) (sql_query_decorators.SqlQueryRetriever, error)
 }
 
 type repository struct {
@@ -75,6 +75,31 @@ func (*repository) DeleteUser(ctx context.Context, id string) error {
 	return nil
 }
 
+// ReassignUserLists makes the given user the owner of every list of the other user, including the lists in the trash,
+// the new owner stops being a collaborator of these lists
+func (*repository) ReassignUserLists(ctx context.Context, userId string, newOwnerId string) error {
+	log.C(ctx).Infof("reassigning lists of user with id %s to user with id %s in user repository", userId, newOwnerId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from context in user repo, error %s", err.Error())
+		return err
+	}
+
+	sqlQueryString := `WITH reassigned_lists AS (
+    UPDATE lists SET owner = $2, last_updated = NOW() WHERE owner = $1 RETURNING id
+)
+DELETE FROM user_lists USING reassigned_lists
+WHERE user_lists.list_id = reassigned_lists.id AND user_lists.user_id = $2`
+
+	if _, err = persist.ExecContext(ctx, sqlQueryString, userId, newOwnerId); err != nil {
+		log.C(ctx).Errorf("failed to reassign lists of user with id %s, error %s when executing sql query", userId, err.Error())
+		return errors.New("unexpected database error")
+	}
+
+	return nil
+}
+
 func (*repository) DeleteUsers(ctx context.Context) error {
 	log.C(ctx).Info("failed to delete users in user repository")
 
@@ -168,13 +193,13 @@ func (r *repository) GetUsers(ctx context.Context, f filters.SqlFilters) ([]enti
 	filteringClause, params := f.BuildSQLFiltering()
 	baseQuery += filteringClause
 
-	decorator, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery)
+	decorator, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery, params)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get users, error when calling factory function")
 		return nil, err
 	}
 
-	sqlQueryString := decorator.DetermineCorrectSqlQuery(ctx)
+	sqlQueryString, params := decorator.DetermineCorrectSqlQuery(ctx)
 	completeQuery := fmt.Sprintf(`SELECT id, email, role FROM (%s) ORDER BY id`, sqlQueryString)
 
 	var userEntities []entities.User
@@ -244,20 +269,28 @@ func (r *repository) GetTodosAssignedToUser(ctx context.Context, userID string,
 	}
 
 	baseQuery := `SELECT id, name, description, list_id, status, created_at,
-				last_updated, assigned_to, due_date, priority FROM user_todos`
+				last_updated, assigned_to, due_date, priority, parent_id,
+				recurrence_frequency, recurrence_interval, recurrence_until, recurrence_count, position, column_id, estimate FROM user_todos`
 
 	filteringClause, params := f.BuildSQLFiltering()
 	baseQuery += filteringClause
 
-	decorator, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery)
+	decorator, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery, params)
 	if err != nil {
 		log.C(ctx).Error("failed to get todos assigned to user in user service, error when calling factory function")
 		return nil, err
 	}
 
-	sqlQuery := decorator.DetermineCorrectSqlQuery(ctx)
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to parse sort, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQuery, params := decorator.DetermineCorrectSqlQuery(ctx)
 	completeQuery := fmt.Sprintf(`SELECT id, name, description, list_id, status, created_at,
-				last_updated, assigned_to, due_date, priority FROM (%s) ORDER BY id`, sqlQuery)
+				last_updated, assigned_to, due_date, priority, parent_id,
+				recurrence_frequency, recurrence_interval, recurrence_until, recurrence_count, position, column_id, estimate FROM (%s) %s`, sqlQuery, sql_query_decorators.OrderByClause(sort, sort.Direction))
 
 	var todos []entities.Todo
 	if err = persist.SelectContext(ctx, &todos, completeQuery, params...); err != nil {
@@ -281,14 +314,20 @@ func (r *repository) GetUserLists(ctx context.Context, userID string, f filters.
 	filteringClause, params := f.BuildSQLFiltering()
 	baseQuery += filteringClause
 
-	decorator, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery)
+	decorator, err := r.factory.CreateSqlDecorator(ctx, f, baseQuery, params)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get lists where user participates in, error when calling factory function")
 		return nil, err
 	}
 
-	sqlQueryString := decorator.DetermineCorrectSqlQuery(ctx)
-	completeQuery := fmt.Sprintf(`SELECT id, name, created_at, last_updated, owner, description FROM (%s) ORDER BY id`, sqlQueryString)
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to parse sort, error %s", err.Error())
+		return nil, err
+	}
+
+	sqlQueryString, params := decorator.DetermineCorrectSqlQuery(ctx)
+	completeQuery := fmt.Sprintf(`SELECT id, name, created_at, last_updated, owner, description FROM (%s) %s`, sqlQueryString, sql_query_decorators.OrderByClause(sort, sort.Direction))
 
 	var listEntities []entities.List
 	if err = persist.SelectContext(ctx, &listEntities, completeQuery, params...); err != nil {
@@ -307,5 +346,12 @@ func (r *repository) GetPaginationInfo(ctx context.Context, f filters.SqlFilters
 	log.C(ctx).Info("getting users pagination info in user repository")
 
 	filteringClause, params := f.BuildSQLFiltering()
-	return r.genericRepo.GetPaginationInfo(ctx, s.GetSource(), filteringClause, params)
+
+	sort, err := filters.ExtractSort(f)
+	if err != nil {
+		log.C(ctx).Errorf("failed to parse sort, error %s", err.Error())
+		return nil, err
+	}
+
+	return r.genericRepo.GetSortedPaginationInfo(ctx, s.GetSource(), filteringClause, sql_query_decorators.SortExpression(sort.Field), sort.Direction, params)
 }
@@ -1,5 +1,15 @@
 package users
 
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"context"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"regexp"
+	"testing"
+)
+
+/*
 import (
 	"Todo-List/internProject/todo_app_service/internal/entities"
 	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/mocks"
@@ -802,3 +812,51 @@ func TestSqlUserDB_UpdateUserPartially(t *testing.T) {
 	}
 
 }
+*/
+
+func TestRepository_ReassignUserLists(t *testing.T) {
+	tests := []struct {
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
+	}{
+		{
+			testName: "Successfully reassigning the lists of the user",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryReassignUserLists)).
+					WithArgs(existingUserId, existingUserId2).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+		},
+		{
+			testName: "Failed to reassign the lists of the user due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryReassignUserLists)).
+					WithArgs(existingUserId, existingUserId2).
+					WillReturnError(errUnexpectedDatabaseError)
+			},
+			err: errUnexpectedDatabaseError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo(nil, nil).ReassignUserLists(ctx, existingUserId, existingUserId2)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
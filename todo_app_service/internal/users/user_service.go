@@ -1,6 +1,7 @@
 package users
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
 	"Todo-List/internProject/todo_app_service/internal/entities"
 	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
 	"Todo-List/internProject/todo_app_service/internal/source"
@@ -11,6 +12,7 @@ import (
 	"context"
 )
 
+//go:generate mockery --name=userRepo --exported --output=./mocks --outpkg=mocks --filename=user_repo.go --with-expecter=true
 type userRepo interface {
 	CreateUser(ctx context.Context, user *entities.User) (*entities.User, error)
 	GetUsers(ctx context.Context, f filters.SqlFilters) ([]entities.User, error)
@@ -19,12 +21,14 @@ type userRepo interface {
 	UpdateUserPartially(ctx context.Context, params map[string]interface{}, fields []string) (*entities.User, error)
 	UpdateUser(ctx context.Context, userId string, user *entities.User) (*entities.User, error)
 	DeleteUser(ctx context.Context, userId string) error
+	ReassignUserLists(ctx context.Context, userId string, newOwnerId string) error
 	DeleteUsers(ctx context.Context) error
 	GetTodosAssignedToUser(ctx context.Context, userID string, f filters.SqlFilters) ([]entities.Todo, error)
 	GetUserLists(ctx context.Context, userID string, f filters.SqlFilters) ([]entities.List, error)
 	GetPaginationInfo(ctx context.Context, f filters.SqlFilters, s source.Source) (*entities.PaginationInfo, error)
 }
 
+//go:generate mockery --name=userConverter --exported --output=./mocks --outpkg=mocks --filename=user_converter.go --with-expecter=true
 type userConverter interface {
 	ToModel(user *entities.User) *models.User
 	ToEntity(user *models.User) *entities.User
@@ -33,21 +37,31 @@ type userConverter interface {
 	ManyToPage(users []entities.User, paginationInfo *entities.PaginationInfo) *models.UserPage
 }
 
+//go:generate mockery --name=uuidGenerator --exported --output=./mocks --outpkg=mocks --filename=uuid_generator.go --with-expecter=true
 type uuidGenerator interface {
 	Generate() string
 }
 
+//go:generate mockery --name=listConverter --exported --output=./mocks --outpkg=mocks --filename=list_converter.go --with-expecter=true
 type listConverter interface {
-	ManyToPage(lists []entities.List, paginationInfo *entities.PaginationInfo) *models.ListPage
+	ManyToPage(lists []entities.List, paginationInfo *entities.PaginationInfo, sortField string) *models.ListPage
 }
 
+//go:generate mockery --name=todoConverter --exported --output=./mocks --outpkg=mocks --filename=todo_converter.go --with-expecter=true
 type todoConverter interface {
-	ManyToPage(todos []entities.Todo, paginationInfo *entities.PaginationInfo) *models.TodoPage
+	ManyToPage(todos []entities.Todo, paginationInfo *entities.PaginationInfo, sortField string) *models.TodoPage
 }
 
+//go:generate mockery --name=resourceIdentifierAdapter --exported --output=./mocks --outpkg=mocks --filename=resource_identifier_adapter.go --with-expecter=true
 type resourceIdentifierAdapter interface {
 	AdaptResourceIdentifier(rf resource_identifier.ResourceIdentifier) string
 }
+
+//go:generate mockery --name=historyRecorder --exported --output=./mocks --outpkg=mocks --filename=history_recorder.go --with-expecter=true
+type historyRecorder interface {
+	RecordActor(ctx context.Context) error
+}
+
 type service struct {
 	repo       userRepo
 	converter  userConverter
@@ -55,10 +69,11 @@ type service struct {
 	tConverter todoConverter
 	uuidGen    uuidGenerator
 	rfAdapter  resourceIdentifierAdapter
+	hRecorder  historyRecorder
 }
 
 func NewService(repo userRepo, converter userConverter, lConverter listConverter,
-	tConverter todoConverter, uuidGen uuidGenerator, rfAdapter resourceIdentifierAdapter) *service {
+	tConverter todoConverter, uuidGen uuidGenerator, rfAdapter resourceIdentifierAdapter, hRecorder historyRecorder) *service {
 	return &service{
 		repo:       repo,
 		converter:  converter,
@@ -66,6 +81,7 @@ func NewService(repo userRepo, converter userConverter, lConverter listConverter
 		tConverter: tConverter,
 		uuidGen:    uuidGen,
 		rfAdapter:  rfAdapter,
+		hRecorder:  hRecorder,
 	}
 }
 
@@ -141,6 +157,34 @@ func (s *service) DeleteUserRecord(ctx context.Context, id string) error {
 	return nil
 }
 
+// DeleteUserRecordAndReassignLists hands the lists of the user over to another user before deleting the user,
+// so that the lists and their todos are kept instead of being deleted together with their owner
+func (s *service) DeleteUserRecordAndReassignLists(ctx context.Context, id string, newOwnerId string) error {
+	log.C(ctx).Infof("deleting user with id %s and reassigning his lists to user with id %s in user service", id, newOwnerId)
+
+	if id == newOwnerId {
+		log.C(ctx).Errorf("failed to delete user with id %s, the lists can not be reassigned to the deleted user", id)
+		return application_errors.InvalidNewOwnerError
+	}
+
+	if _, err := s.repo.GetUser(ctx, newOwnerId); err != nil {
+		log.C(ctx).Errorf("failed to reassign lists, error %s when getting user with id %s", err.Error(), newOwnerId)
+		return err
+	}
+
+	if err := s.hRecorder.RecordActor(ctx); err != nil {
+		log.C(ctx).Errorf("failed to record history actor, error %s", err.Error())
+		return err
+	}
+
+	if err := s.repo.ReassignUserLists(ctx, id, newOwnerId); err != nil {
+		log.C(ctx).Errorf("failed to reassign lists of user with id %s, error %s when calling user repo", id, err.Error())
+		return err
+	}
+
+	return s.DeleteUserRecord(ctx, id)
+}
+
 func (s *service) DeleteUsers(ctx context.Context) error {
 	log.C(ctx).Info("deleting users in user service")
 
@@ -201,7 +245,13 @@ func (s *service) GetUserListsRecords(ctx context.Context, userId string, lFilte
 		return nil, err
 	}
 
-	return s.lConverter.ManyToPage(listEntities, paginationInfo), nil
+	sort, err := filters.ExtractSort(lFilter)
+	if err != nil {
+		log.C(ctx).Errorf("failed to extract sort from filters, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.lConverter.ManyToPage(listEntities, paginationInfo, sort.Field), nil
 }
 
 func (s *service) GetTodosAssignedToUser(ctx context.Context, userId string, tFilters filters.SqlFilters, rf resource_identifier.ResourceIdentifier) (*models.TodoPage, error) {
@@ -225,7 +275,13 @@ func (s *service) GetTodosAssignedToUser(ctx context.Context, userId string, tFi
 		return nil, err
 	}
 
-	return s.tConverter.ManyToPage(todoEntities, paginationInfo), nil
+	sort, err := filters.ExtractSort(tFilters)
+	if err != nil {
+		log.C(ctx).Errorf("failed to extract sort from filters, error %s", err.Error())
+		return nil, err
+	}
+
+	return s.tConverter.ManyToPage(todoEntities, paginationInfo, sort.Field), nil
 }
 
 func prepareSqlSource(adapter resourceIdentifierAdapter, rf resource_identifier.ResourceIdentifier) source.Source {
@@ -0,0 +1,124 @@
+package users
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/users/mocks"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestService_DeleteUserRecordAndReassignLists(t *testing.T) {
+	tests := []struct {
+		testName     string
+		newOwnerId   string
+		mockUserRepo func() *mocks.UserRepo
+		mockRecorder func() *mocks.HistoryRecorder
+		err          error
+	}{
+		{
+			testName:   "Successfully reassigning the lists of the user before deleting the user",
+			newOwnerId: existingUserId2,
+			mockUserRepo: func() *mocks.UserRepo {
+				mRepo := &mocks.UserRepo{}
+
+				mRepo.EXPECT().
+					GetUser(context.TODO(), existingUserId2).
+					Return(initEntityUser(existingUserId2, existingUserEmail2, existingUserRole), nil).Once()
+
+				mRepo.EXPECT().
+					ReassignUserLists(context.TODO(), existingUserId, existingUserId2).
+					Return(nil).Once()
+
+				mRepo.EXPECT().
+					DeleteUser(context.TODO(), existingUserId).
+					Return(nil).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(nil).Once()
+
+				return mRecorder
+			},
+		},
+		{
+			testName:   "Failed to reassign the lists of the user to the same user",
+			newOwnerId: existingUserId,
+			mockUserRepo: func() *mocks.UserRepo {
+				return &mocks.UserRepo{}
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				return &mocks.HistoryRecorder{}
+			},
+			err: application_errors.InvalidNewOwnerError,
+		},
+		{
+			testName:   "Failed to reassign the lists of the user to user who does not exist",
+			newOwnerId: nonExistingUserId,
+			mockUserRepo: func() *mocks.UserRepo {
+				mRepo := &mocks.UserRepo{}
+
+				mRepo.EXPECT().
+					GetUser(context.TODO(), nonExistingUserId).
+					Return(nil, errInvalidUserId).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				return &mocks.HistoryRecorder{}
+			},
+			err: errInvalidUserId,
+		},
+		{
+			testName:   "Failed to delete the user when the lists can't be reassigned",
+			newOwnerId: existingUserId2,
+			mockUserRepo: func() *mocks.UserRepo {
+				mRepo := &mocks.UserRepo{}
+
+				mRepo.EXPECT().
+					GetUser(context.TODO(), existingUserId2).
+					Return(initEntityUser(existingUserId2, existingUserEmail2, existingUserRole), nil).Once()
+
+				mRepo.EXPECT().
+					ReassignUserLists(context.TODO(), existingUserId, existingUserId2).
+					Return(errUnexpectedDatabaseError).Once()
+
+				return mRepo
+			},
+			mockRecorder: func() *mocks.HistoryRecorder {
+				mRecorder := &mocks.HistoryRecorder{}
+
+				mRecorder.EXPECT().
+					RecordActor(context.TODO()).
+					Return(nil).Once()
+
+				return mRecorder
+			},
+			err: errUnexpectedDatabaseError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockUserRepo()
+			mRecorder := test.mockRecorder()
+
+			uService := NewService(mRepo, nil, nil, nil, nil, nil, mRecorder)
+			err := uService.DeleteUserRecordAndReassignLists(context.TODO(), existingUserId, test.newOwnerId)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, mRepo, mRecorder)
+		})
+	}
+}
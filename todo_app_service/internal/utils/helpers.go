@@ -3,9 +3,9 @@ package utils
 import (
 	"Todo-List/internProject/todo_app_service/internal/application_errors"
 	"Todo-List/internProject/todo_app_service/internal/entities"
-	"Todo-List/internProject/todo_app_service/internal/gitHub"
 	config "Todo-List/internProject/todo_app_service/pkg/configuration"
 	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -14,9 +14,8 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/gofrs/uuid"
 	"github.com/golang-jwt/jwt/v5"
+	"net"
 	"net/http"
-	"slices"
-	"strings"
 	"time"
 )
 
@@ -115,38 +114,6 @@ func CheckForValidationError(val fieldValidator, s interface{}) (string, error)
 	return "", nil
 }
 
-func getOrganizationNames(organizations []*gitHub.Organization) []string {
-	orgNames := make([]string, 0, len(organizations))
-	for _, org := range organizations {
-		orgNames = append(orgNames, org.Login)
-	}
-	return orgNames
-}
-
-func containsSubstring(slice []string, substr string) bool {
-	return slices.ContainsFunc(slice, func(s string) bool {
-		return strings.Contains(s, substr)
-	})
-}
-
-func DetermineRole(organizations []*gitHub.Organization) (string, error) {
-	orgNames := getOrganizationNames(organizations)
-
-	if containsSubstring(orgNames, constants.ADMIN_ORG) {
-		return string(constants.Admin), nil
-	}
-
-	if containsSubstring(orgNames, constants.WRITER_ORG) {
-		return string(constants.Writer), nil
-	}
-
-	if containsSubstring(orgNames, constants.READER_ORG) {
-		return string(constants.Reader), nil
-	}
-
-	return "", fmt.Errorf("invalid role")
-}
-
 func DetermineCorrectJwtErrorMessage(err error) string {
 	if errors.Is(err, jwt.ErrSignatureInvalid) {
 		return "invalid token signature"
@@ -188,12 +155,36 @@ func DetermineJWTErrorWhenParsingWithClaims(ctx context.Context, err error) erro
 }
 
 func EncodeErrorWithCorrectStatusCode(w http.ResponseWriter, err error) {
+	EncodeError(w, err.Error(), DetermineStatusCode(err))
+}
+
+// DetermineStatusCode returns the http status code which describes the error
+func DetermineStatusCode(err error) int {
 	var nff *application_errors.NotFoundError
+	var aee *application_errors.AlreadyExistError
+	var dce *application_errors.DependencyCycleError
+	var ise *application_errors.InvalidSortError
+	var ice *application_errors.InvalidCursorError
+	var wle *application_errors.WipLimitExceededError
 	if errors.Is(err, sql.ErrNoRows) || errors.As(err, &nff) {
-		EncodeError(w, err.Error(), http.StatusNotFound)
-	} else {
-		EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return http.StatusNotFound
+	} else if errors.Is(err, application_errors.OpenSubtasksError) || errors.Is(err, application_errors.DoneParentTodoError) ||
+		errors.Is(err, application_errors.OpenBlockersError) || errors.As(err, &aee) || errors.As(err, &dce) || errors.As(err, &wle) {
+		return http.StatusConflict
+	} else if errors.Is(err, application_errors.LabelOutOfScopeError) || errors.Is(err, application_errors.RecurrenceWithoutDueDateError) ||
+		errors.Is(err, application_errors.BlockerOutOfScopeError) || errors.Is(err, application_errors.InvalidInvitationError) ||
+		errors.Is(err, application_errors.InvalidNewOwnerError) || errors.Is(err, application_errors.ReorderOutOfScopeError) ||
+		errors.Is(err, application_errors.ColumnOutOfScopeError) || errors.Is(err, application_errors.ColumnStatusMismatchError) ||
+		errors.Is(err, application_errors.ParticipantOutOfScopeError) || errors.As(err, &ise) || errors.As(err, &ice) {
+		return http.StatusBadRequest
+	} else if errors.Is(err, application_errors.TodoAccessForbiddenError) || errors.Is(err, application_errors.TodoCreationForbiddenError) ||
+		errors.Is(err, application_errors.CollaboratorsCopyForbiddenError) {
+		return http.StatusForbidden
+	} else if errors.Is(err, application_errors.InvalidRefreshTokenError) || errors.Is(err, application_errors.RefreshTokenReuseError) {
+		return http.StatusUnauthorized
 	}
+
+	return http.StatusInternalServerError
 }
 
 func GetServerStatus(statusValue string) map[string]string {
@@ -202,39 +193,39 @@ func GetServerStatus(statusValue string) map[string]string {
 	}
 }
 
-func OrchestrateGoRoutines(ctx context.Context, chan1 chan ChannelResult[string], chan2 chan ChannelResult[string]) (string, string, error) {
+func OrchestrateGoRoutines(ctx context.Context, chan1 chan ChannelResult[string], chan2 chan ChannelResult[*models.Memberships]) (string, *models.Memberships, error) {
 	var result1 string
-	var result2 string
+	var result2 *models.Memberships
 
 	for i := 0; i < constants.GOROUTINES_COUNT; i++ {
 		select {
 		case chRes := <-chan1:
 			if chRes.Err != nil {
 				config.C(ctx).Errorf("failed to get jwt, error %s when trying to determine user's github email", chRes.Err.Error())
-				return "", "", chRes.Err
+				return "", nil, chRes.Err
 			}
 
 			if len(chRes.Result) == 0 {
 				config.C(ctx).Errorf("failed to get jwt, empty email...")
-				return "", "", errors.New("email can't be empty")
+				return "", nil, errors.New("email can't be empty")
 			}
 
 			result1 = chRes.Result
 
 		case chRes := <-chan2:
 			if chRes.Err != nil {
-				config.C(ctx).Errorf("failed to get jwt, error %s when trying to get user's app role", chRes.Err.Error())
-				return "", "", chRes.Err
+				config.C(ctx).Errorf("failed to get jwt, error %s when trying to get user's organizations and teams", chRes.Err.Error())
+				return "", nil, chRes.Err
 			}
-			if len(chRes.Result) == 0 {
-				config.C(ctx).Errorf("failed to get jwt, empty role received...")
-				return "", "", errors.New("role can't be determined")
+			if chRes.Result == nil {
+				config.C(ctx).Errorf("failed to get jwt, no memberships received...")
+				return "", nil, errors.New("memberships can't be determined")
 			}
 
 			result2 = chRes.Result
 
 		case <-ctx.Done():
-			return "", "", ctx.Err()
+			return "", nil, ctx.Err()
 		}
 	}
 
@@ -249,3 +240,22 @@ func CheckForNotFoundError(err error) bool {
 
 	return false
 }
+
+// ExtractSessionDevice describes the client of the request, the address of the peer is used since
+// forwarding headers can be set by the client itself
+func ExtractSessionDevice(r *http.Request, label string) *models.SessionDevice {
+	ipAddress, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ipAddress = r.RemoteAddr
+	}
+
+	if runes := []rune(label); len(runes) > constants.MAX_DEVICE_LABEL_LENGTH {
+		label = string(runes[:constants.MAX_DEVICE_LABEL_LENGTH])
+	}
+
+	return &models.SessionDevice{
+		Label:     label,
+		UserAgent: r.UserAgent(),
+		IpAddress: ipAddress,
+	}
+}
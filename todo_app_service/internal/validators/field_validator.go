@@ -1,10 +1,14 @@
 package validators
 
 import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"github.com/go-playground/validator/v10"
 	"sync"
 )
 
+const recurrenceFrequencyTag = "recurrence_frequency"
+const collaboratorRoleTag = "collaborator_role"
+
 type fieldValidator struct {
 	wrappedValidator *validator.Validate
 }
@@ -16,7 +20,16 @@ var (
 
 func GetInstance() *fieldValidator {
 	once.Do(func() {
-		instance = &fieldValidator{wrappedValidator: validator.New()}
+		wrappedValidator := validator.New()
+		if err := wrappedValidator.RegisterValidation(recurrenceFrequencyTag, validateRecurrenceFrequency); err != nil {
+			panic(err)
+		}
+
+		if err := wrappedValidator.RegisterValidation(collaboratorRoleTag, validateCollaboratorRole); err != nil {
+			panic(err)
+		}
+
+		instance = &fieldValidator{wrappedValidator: wrappedValidator}
 	})
 	return instance
 }
@@ -24,3 +37,21 @@ func GetInstance() *fieldValidator {
 func (f *fieldValidator) Struct(s interface{}) error {
 	return f.wrappedValidator.Struct(s)
 }
+
+func validateRecurrenceFrequency(fl validator.FieldLevel) bool {
+	switch constants.RecurrenceFrequency(fl.Field().String()) {
+	case constants.Daily, constants.Weekly, constants.Monthly:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateCollaboratorRole(fl validator.FieldLevel) bool {
+	switch constants.CollaboratorRole(fl.Field().String()) {
+	case constants.Viewer, constants.Editor, constants.Manager:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,49 @@
+package worklogs
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"encoding/json"
+	"errors"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	note                    = "reviewed the pull request"
+	sqlQueryDeleteWorkLog   = `DELETE FROM work_logs WHERE id = $1 AND todo_id = $2`
+	sqlQueryTodoTimeTotals  = `SELECT COALESCE(todos.estimate, 0) AS estimate`
+	sqlQueryGetUserWorkLogs = `SELECT id, todo_id, user_id, duration, note, logged_on, created_at, last_updated FROM work_logs
+WHERE user_id = $1`
+)
+
+var (
+	workLogId = uuid.Must(uuid.NewV4())
+	todoId    = uuid.Must(uuid.NewV4())
+	userId    = uuid.Must(uuid.NewV4())
+	now       = time.Date(2025, time.March, 3, 15, 45, 0, 0, time.UTC)
+	today     = time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC)
+	yesterday = time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC)
+	dbError   = errors.New("database error")
+
+	workLogNotFound = application_errors.NewNotFoundError(constants.WORK_LOG_TARGET, workLogId.String())
+	todoNotFound    = application_errors.NewNotFoundError(constants.TODO_TARGET, todoId.String())
+	userNotFound    = application_errors.NewNotFoundError(constants.USER_TARGET, userId.String())
+
+	workLogEntity = &entities.WorkLog{Id: workLogId, TodoId: todoId, UserId: userId, Duration: 30, Note: note,
+		LoggedOn: today, CreatedAt: now, LastUpdated: now}
+	workLogModel = &models.WorkLog{Id: workLogId.String(), TodoId: todoId.String(), UserId: userId.String(), Duration: 30, Note: note,
+		LoggedOn: today, CreatedAt: now, LastUpdated: now}
+)
+
+func extractErrorFromResponseRecorder(tb testing.TB, rr *httptest.ResponseRecorder, errMessage string) {
+	tb.Helper()
+	var got map[string]string
+	require.NoError(tb, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Equal(tb, map[string]string{"error": errMessage}, got)
+}
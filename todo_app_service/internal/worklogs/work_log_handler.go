@@ -0,0 +1,424 @@
+package worklogs
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+//go:generate mockery --name=workLogService --exported --output=./mocks --outpkg=mocks --filename=work_log_service.go --with-expecter=true
+type workLogService interface {
+	LogWorkRecord(ctx context.Context, todoId string, workLog *handler_models.CreateWorkLog, userId string) (*models.WorkLog, error)
+	GetTodoWorkLogsRecords(ctx context.Context, todoId string) ([]*models.WorkLog, error)
+	UpdateWorkLogRecord(ctx context.Context, todoId string, workLogId string, workLog *handler_models.UpdateWorkLog) (*models.WorkLog, error)
+	DeleteWorkLogRecord(ctx context.Context, todoId string, workLogId string) error
+	GetTodoTimeTotalsRecord(ctx context.Context, todoId string) (*models.TimeTotals, error)
+	GetListTimeTotalsRecord(ctx context.Context, listId string) (*models.TimeTotals, error)
+	GetUserWorkLogReport(ctx context.Context, userId string, from *time.Time, to *time.Time) (*models.WorkLogReport, error)
+}
+
+//go:generate mockery --name=fieldValidator --exported --output=./mocks --outpkg=mocks --filename=field_validator.go --with-expecter=true
+type fieldValidator interface {
+	Struct(interface{}) error
+}
+
+type Handler struct {
+	serv       workLogService
+	fValidator fieldValidator
+	transact   persistence.Transactioner
+}
+
+func NewHandler(service workLogService, fValidator fieldValidator, transact persistence.Transactioner) *Handler {
+	return &Handler{
+		serv:       service,
+		fValidator: fValidator,
+		transact:   transact,
+	}
+}
+
+func (h *Handler) HandleLogWork(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("logging work in work log handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in work log handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in work log handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	user, err := utils.GetValueFromContext[*models.User](r.Context(), middlewares.UserKey)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get user due to an error %s when trying to get value from context in work log handler", err.Error())
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER, http.StatusInternalServerError)
+		return
+	}
+
+	var workLog handler_models.CreateWorkLog
+	if err = json.NewDecoder(r.Body).Decode(&workLog); err != nil {
+		log.C(ctx).Errorf("failed to decode work log handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, workLog)
+	if err != nil {
+		log.C(ctx).Errorf("failed to log work, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	createdWorkLog, err := h.serv.LogWorkRecord(ctx, todoId, &workLog, user.Id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to log work on todo with id %s, error %s when calling work log service", todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to log work, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(createdWorkLog); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		return
+	}
+}
+
+func (h *Handler) HandleGetTodoWorkLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting todo work logs in work log handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in work log handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in work log handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	workLogs, err := h.serv.GetTodoWorkLogsRecords(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get work logs of todo with id %s, error %s when calling work log service", todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(workLogs); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get work logs of todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleUpdateWorkLog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("updating work log in work log handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in work log handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in work log handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	workLogId, err := utils.GetValueFromContext[string](r.Context(), middlewares.WorkLogId)
+	if err != nil {
+		log.C(ctx).Error("failed to get work_log_id from the context in work log handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_WORK_LOG_ID, http.StatusBadRequest)
+		return
+	}
+
+	var workLog handler_models.UpdateWorkLog
+	if err = json.NewDecoder(r.Body).Decode(&workLog); err != nil {
+		log.C(ctx).Errorf("failed to decode work log handler model %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_REQUEST_BODY, http.StatusBadRequest)
+		return
+	}
+
+	field, err := utils.CheckForValidationError(h.fValidator, workLog)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update work log, error because one of the fields is invalid %s", field)
+		utils.EncodeError(w, application_errors.NewEmptyFieldError(field).Error(), http.StatusBadRequest)
+		return
+	}
+
+	updatedWorkLog, err := h.serv.UpdateWorkLogRecord(ctx, todoId, workLogId, &workLog)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update work log with id %s, error %s when calling work log service", workLogId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(updatedWorkLog); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to update work log with id %s, error %s", workLogId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleDeleteWorkLog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("deleting work log in work log handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in work log handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in work log handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	workLogId, err := utils.GetValueFromContext[string](r.Context(), middlewares.WorkLogId)
+	if err != nil {
+		log.C(ctx).Error("failed to get work_log_id from the context in work log handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_WORK_LOG_ID, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.serv.DeleteWorkLogRecord(ctx, todoId, workLogId); err != nil {
+		log.C(ctx).Errorf("failed to delete work log with id %s, error %s when calling work log service", workLogId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to delete work log with id %s, error %s", workLogId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) HandleGetTodoTimeTotals(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting todo time totals in work log handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in work log handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	todoId, err := utils.GetValueFromContext[string](r.Context(), middlewares.TodoId)
+	if err != nil {
+		log.C(ctx).Error("failed to get todo_id from the context in work log handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_TODO_ID, http.StatusBadRequest)
+		return
+	}
+
+	totals, err := h.serv.GetTodoTimeTotalsRecord(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get time totals of todo with id %s, error %s when calling work log service", todoId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(totals); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get time totals of todo with id %s, error %s", todoId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleGetListTimeTotals(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting list time totals in work log handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in work log handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	listId, err := utils.GetValueFromContext[string](r.Context(), middlewares.ListId)
+	if err != nil {
+		log.C(ctx).Error("failed to get list_id from the context in work log handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_LIST_ID, http.StatusBadRequest)
+		return
+	}
+
+	totals, err := h.serv.GetListTimeTotalsRecord(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get time totals of list with id %s, error %s when calling work log service", listId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(totals); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get time totals of list with id %s, error %s", listId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) HandleGetUserWorkLogReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.C(ctx).Info("getting user work log report in work log handler")
+
+	tx, err := h.transact.BeginContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to begin transaction in work log handler, error %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	userId, err := utils.GetValueFromContext[string](r.Context(), middlewares.UserId)
+	if err != nil {
+		log.C(ctx).Error("failed to get user_id from the context in work log handler")
+		utils.EncodeError(w, constants.CONTEXT_NOT_CONTAINING_VALID_USER_ID, http.StatusBadRequest)
+		return
+	}
+
+	from, to, err := parseReportPeriod(utils.GetContentFromUrl(r, constants.FROM), utils.GetContentFromUrl(r, constants.TO))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get work log report, invalid period %s", err.Error())
+		utils.EncodeError(w, constants.INVALID_WORK_LOG_PERIOD, http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.serv.GetUserWorkLogReport(ctx, userId, from, to)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get work log report of user with id %s, error %s when calling work log service", userId, err.Error())
+
+		utils.EncodeErrorWithCorrectStatusCode(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(report); err != nil {
+		log.C(ctx).Errorf("failed to encode JSON %s", err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).Errorf("failed to commit transaction when trying to get work log report of user with id %s, error %s", userId, err.Error())
+		utils.EncodeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// parseReportPeriod parses the optional ends of the period of a work log report
+func parseReportPeriod(rawFrom string, rawTo string) (*time.Time, *time.Time, error) {
+	from, err := parseReportDate(rawFrom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	to, err := parseReportDate(rawTo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if from != nil && to != nil && from.After(*to) {
+		return nil, nil, errors.New("from is after to")
+	}
+
+	return from, to, nil
+}
+
+func parseReportDate(rawDate string) (*time.Time, error) {
+	if len(rawDate) == 0 {
+		return nil, nil
+	}
+
+	date, err := time.Parse(constants.DATE_LAYOUT, rawDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
@@ -0,0 +1,126 @@
+package worklogs
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/middlewares"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"Todo-List/internProject/todo_app_service/internal/worklogs/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"encoding/json"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandler_HandleGetUserWorkLogReport(t *testing.T) {
+	report := &models.WorkLogReport{UserId: userId.String(), From: &yesterday, To: &today, TimeSpent: 30, WorkLogs: []*models.WorkLog{workLogModel}}
+
+	tests := []struct {
+		testName        string
+		query           string
+		mockService     func() *mocks.WorkLogService
+		dbMock          func(mck sqlmock.Sqlmock)
+		expectedStatus  int
+		expectedReport  *models.WorkLogReport
+		expectedMessage string
+	}{
+		{
+			testName: "Successfully getting the report of the period",
+			query:    "?from=2025-03-02&to=2025-03-03",
+			mockService: func() *mocks.WorkLogService {
+				mService := &mocks.WorkLogService{}
+
+				mService.EXPECT().
+					GetUserWorkLogReport(mock.Anything, userId.String(), &yesterday, &today).
+					Return(report, nil).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectCommit()
+			},
+			expectedStatus: http.StatusOK,
+			expectedReport: report,
+		},
+		{
+			testName: "Failed to get the report of the period which ends before it starts",
+			query:    "?from=2025-03-03&to=2025-03-02",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: constants.INVALID_WORK_LOG_PERIOD,
+		},
+		{
+			testName: "Failed to get the report of the period with invalid date",
+			query:    "?from=03.02.2025",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: constants.INVALID_WORK_LOG_PERIOD,
+		},
+		{
+			testName: "Failed to get the report of user who does not exist",
+			mockService: func() *mocks.WorkLogService {
+				mService := &mocks.WorkLogService{}
+
+				mService.EXPECT().
+					GetUserWorkLogReport(mock.Anything, userId.String(), (*time.Time)(nil), (*time.Time)(nil)).
+					Return(nil, userNotFound).Once()
+
+				return mService
+			},
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectBegin()
+				mck.ExpectRollback()
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectedMessage: userNotFound.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			mService := &mocks.WorkLogService{}
+			if test.mockService != nil {
+				mService = test.mockService()
+			}
+
+			handler := NewHandler(mService, validator.New(), persistence.NewSqlDb(db))
+
+			req := httptest.NewRequest(http.MethodGet, "/users/"+userId.String()+"/work-logs"+test.query, nil)
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.UserId, userId.String()))
+			rr := httptest.NewRecorder()
+
+			handler.HandleGetUserWorkLogReport(rr, req)
+
+			require.Equal(t, test.expectedStatus, rr.Code)
+			if len(test.expectedMessage) != 0 {
+				extractErrorFromResponseRecorder(t, rr, test.expectedMessage)
+			} else {
+				var received models.WorkLogReport
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &received))
+				require.Equal(t, test.expectedReport, &received)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, mService)
+		})
+	}
+}
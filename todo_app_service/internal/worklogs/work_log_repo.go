@@ -0,0 +1,196 @@
+package worklogs
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+type repository struct{}
+
+func NewRepo() *repository {
+	return &repository{}
+}
+
+func (*repository) GetWorkLog(ctx context.Context, todoId string, workLogId string) (*entities.WorkLog, error) {
+	log.C(ctx).Infof("getting work log with id %s of todo with id %s from work log repository", workLogId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	entity := &entities.WorkLog{}
+	if err = persist.GetContext(ctx, entity, getWorkLogQuery, workLogId, todoId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("failed to get work log with id %s due to sqlErrNoRows", workLogId)
+			return nil, application_errors.NewNotFoundError(constants.WORK_LOG_TARGET, workLogId)
+		}
+
+		log.C(ctx).Errorf("failed to get work log with id %s because of a database error %s", workLogId, err.Error())
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (*repository) GetTodoWorkLogs(ctx context.Context, todoId string) ([]entities.WorkLog, error) {
+	log.C(ctx).Infof("getting work logs of todo with id %s from work log repository", todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var workLogs []entities.WorkLog
+	if err = persist.SelectContext(ctx, &workLogs, getTodoWorkLogsQuery, todoId); err != nil {
+		log.C(ctx).Errorf("failed to get work logs due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return workLogs, nil
+}
+
+func (*repository) GetUserWorkLogs(ctx context.Context, userId string, from *time.Time, to *time.Time) ([]entities.WorkLog, error) {
+	log.C(ctx).Infof("getting work logs of user with id %s from work log repository", userId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	var workLogs []entities.WorkLog
+	if err = persist.SelectContext(ctx, &workLogs, getUserWorkLogsQuery, userId, from, to); err != nil {
+		log.C(ctx).Errorf("failed to get work logs of user due to a failure in the execution of the sql query %s", err.Error())
+		return nil, err
+	}
+
+	return workLogs, nil
+}
+
+func (r *repository) CreateWorkLog(ctx context.Context, entity *entities.WorkLog) (*entities.WorkLog, error) {
+	log.C(ctx).Infof("logging work on todo with id %s in work log repository", entity.TodoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	if _, err = persist.NamedExecContext(ctx, createWorkLogQuery, entity); err != nil {
+		log.C(ctx).Errorf("failed to create work log, error %s when executing sql query", err.Error())
+		return nil, err
+	}
+
+	return r.GetWorkLog(ctx, entity.TodoId.String(), entity.Id.String())
+}
+
+func (r *repository) UpdateWorkLog(ctx context.Context, sqlExecParams map[string]interface{}, sqlFields []string) (*entities.WorkLog, error) {
+	workLogId := sqlExecParams["id"].(string)
+	todoId := sqlExecParams["todo_id"].(string)
+	log.C(ctx).Infof("updating work log with id %s in work log repository", workLogId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	res, err := persist.NamedExecContext(ctx, parseSqlUpdateWorkLogQuery(sqlFields), sqlExecParams)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update work log, error when executing sql query %s", err.Error())
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to update work log, error when trying to get the number of rows affected")
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to update work log, invalid work_log_id provided %s", workLogId)
+		return nil, application_errors.NewNotFoundError(constants.WORK_LOG_TARGET, workLogId)
+	}
+
+	return r.GetWorkLog(ctx, todoId, workLogId)
+}
+
+func (*repository) DeleteWorkLog(ctx context.Context, todoId string, workLogId string) error {
+	log.C(ctx).Infof("deleting work log with id %s of todo with id %s in work log repository", workLogId, todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return err
+	}
+
+	res, err := persist.ExecContext(ctx, deleteWorkLogQuery, workLogId, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to delete work log with id %s, error %s", workLogId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.C(ctx).Error("failed to delete work log, error when trying to get the number of rows affected")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		log.C(ctx).Errorf("failed to delete work log, invalid work_log_id provided %s", workLogId)
+		return application_errors.NewNotFoundError(constants.WORK_LOG_TARGET, workLogId)
+	}
+
+	return nil
+}
+
+func (*repository) GetTodoTimeTotals(ctx context.Context, todoId string) (*entities.TimeTotals, error) {
+	log.C(ctx).Infof("getting time totals of todo with id %s from work log repository", todoId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	totals := &entities.TimeTotals{}
+	if err = persist.GetContext(ctx, totals, todoTimeTotalsQuery, todoId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("failed to get time totals of todo with id %s due to sqlErrNoRows", todoId)
+			return nil, application_errors.NewNotFoundError(constants.TODO_TARGET, todoId)
+		}
+
+		log.C(ctx).Errorf("failed to get time totals of todo with id %s because of a database error %s", todoId, err.Error())
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+func (*repository) GetListTimeTotals(ctx context.Context, listId string) (*entities.TimeTotals, error) {
+	log.C(ctx).Infof("getting time totals of list with id %s from work log repository", listId)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get persistence from ctx, error %s", err.Error())
+		return nil, err
+	}
+
+	totals := &entities.TimeTotals{}
+	if err = persist.GetContext(ctx, totals, listTimeTotalsQuery, listId); err != nil {
+		log.C(ctx).Errorf("failed to get time totals of list with id %s because of a database error %s", listId, err.Error())
+		return nil, err
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,140 @@
+package worklogs
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/persistence"
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/require"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+	"regexp"
+	"testing"
+)
+
+func TestRepository_DeleteWorkLog(t *testing.T) {
+	tests := []struct {
+		testName string
+		dbMock   func(mck sqlmock.Sqlmock)
+		err      error
+	}{
+		{
+			testName: "Successfully deleting work log",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteWorkLog)).
+					WithArgs(workLogId.String(), todoId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			testName: "Failed to delete work log which is not logged on the todo",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectExec(regexp.QuoteMeta(sqlQueryDeleteWorkLog)).
+					WithArgs(workLogId.String(), todoId.String()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			err: workLogNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			err = NewRepo().DeleteWorkLog(ctx, todoId.String(), workLogId.String())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetTodoTimeTotals(t *testing.T) {
+	tests := []struct {
+		testName  string
+		dbMock    func(mck sqlmock.Sqlmock)
+		estimate  int
+		timeSpent int
+		err       error
+	}{
+		{
+			testName: "Successfully getting the estimate and the logged work of the todo",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryTodoTimeTotals)).
+					WithArgs(todoId.String()).
+					WillReturnRows(sqlmock.NewRows([]string{"estimate", "time_spent"}).AddRow(120, 45))
+			},
+			estimate:  120,
+			timeSpent: 45,
+		},
+		{
+			testName: "Failed to get the time totals of todo which does not exist",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryTodoTimeTotals)).
+					WithArgs(todoId.String()).
+					WillReturnError(sql.ErrNoRows)
+			},
+			err: todoNotFound,
+		},
+		{
+			testName: "Failed to get the time totals of the todo due to database error",
+			dbMock: func(mck sqlmock.Sqlmock) {
+				mck.ExpectQuery(regexp.QuoteMeta(sqlQueryTodoTimeTotals)).
+					WithArgs(todoId.String()).
+					WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			db, mck, err := sqlmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+
+			test.dbMock(mck)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			totals, err := NewRepo().GetTodoTimeTotals(ctx, todoId.String())
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, totals)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.estimate, totals.Estimate)
+				require.Equal(t, test.timeSpent, totals.TimeSpent)
+			}
+
+			require.NoError(t, mck.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetUserWorkLogs(t *testing.T) {
+	db, mck, err := sqlmock.Newx()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mck.ExpectQuery(regexp.QuoteMeta(sqlQueryGetUserWorkLogs)).
+		WithArgs(userId.String(), &yesterday, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "todo_id", "user_id", "duration", "note", "logged_on", "created_at", "last_updated"}).
+			AddRow(workLogId, todoId, userId, 30, note, today, now, now))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	workLogs, err := NewRepo().GetUserWorkLogs(ctx, userId.String(), &yesterday, nil)
+
+	require.NoError(t, err)
+	require.Len(t, workLogs, 1)
+	require.Equal(t, *workLogEntity, workLogs[0])
+	require.NoError(t, mck.ExpectationsWereMet())
+}
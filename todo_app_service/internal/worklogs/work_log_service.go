@@ -0,0 +1,226 @@
+package worklogs
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"time"
+)
+
+//go:generate mockery --name=workLogRepo --exported --output=./mocks --outpkg=mocks --filename=work_log_repo.go --with-expecter=true
+type workLogRepo interface {
+	GetWorkLog(ctx context.Context, todoId string, workLogId string) (*entities.WorkLog, error)
+	GetTodoWorkLogs(ctx context.Context, todoId string) ([]entities.WorkLog, error)
+	GetUserWorkLogs(ctx context.Context, userId string, from *time.Time, to *time.Time) ([]entities.WorkLog, error)
+	CreateWorkLog(ctx context.Context, entity *entities.WorkLog) (*entities.WorkLog, error)
+	UpdateWorkLog(ctx context.Context, sqlExecParams map[string]interface{}, sqlFields []string) (*entities.WorkLog, error)
+	DeleteWorkLog(ctx context.Context, todoId string, workLogId string) error
+	GetTodoTimeTotals(ctx context.Context, todoId string) (*entities.TimeTotals, error)
+	GetListTimeTotals(ctx context.Context, listId string) (*entities.TimeTotals, error)
+}
+
+//go:generate mockery --name=todoRepo --exported --output=./mocks --outpkg=mocks --filename=todo_repo.go --with-expecter=true
+type todoRepo interface {
+	GetTodo(ctx context.Context, todoId string) (*entities.Todo, error)
+}
+
+//go:generate mockery --name=listRepo --exported --output=./mocks --outpkg=mocks --filename=list_repo.go --with-expecter=true
+type listRepo interface {
+	GetList(ctx context.Context, listId string) (*entities.List, error)
+}
+
+//go:generate mockery --name=userRepo --exported --output=./mocks --outpkg=mocks --filename=user_repo.go --with-expecter=true
+type userRepo interface {
+	GetUser(ctx context.Context, userId string) (*entities.User, error)
+}
+
+//go:generate mockery --name=uuidGenerator --exported --output=./mocks --outpkg=mocks --filename=uuid_generator.go --with-expecter=true
+type uuidGenerator interface {
+	Generate() string
+}
+
+//go:generate mockery --name=timeGenerator --exported --output=./mocks --outpkg=mocks --filename=time_generator.go --with-expecter=true
+type timeGenerator interface {
+	Now() time.Time
+}
+
+//go:generate mockery --name=workLogConverter --exported --output=./mocks --outpkg=mocks --filename=work_log_converter.go --with-expecter=true
+type workLogConverter interface {
+	ToModel(workLog *entities.WorkLog) *models.WorkLog
+	ToEntity(workLog *models.WorkLog) *entities.WorkLog
+	ManyToModel(workLogs []entities.WorkLog) []*models.WorkLog
+	TotalsToModel(totals *entities.TimeTotals) *models.TimeTotals
+}
+
+type service struct {
+	wRepo     workLogRepo
+	tRepo     todoRepo
+	lRepo     listRepo
+	uRepo     userRepo
+	uuidGen   uuidGenerator
+	timeGen   timeGenerator
+	converter workLogConverter
+}
+
+func NewService(wRepo workLogRepo, tRepo todoRepo, lRepo listRepo, uRepo userRepo, uuidGen uuidGenerator,
+	timeGen timeGenerator, converter workLogConverter) *service {
+	return &service{
+		wRepo:     wRepo,
+		tRepo:     tRepo,
+		lRepo:     lRepo,
+		uRepo:     uRepo,
+		uuidGen:   uuidGen,
+		timeGen:   timeGen,
+		converter: converter,
+	}
+}
+
+func (s *service) LogWorkRecord(ctx context.Context, todoId string, workLog *handler_models.CreateWorkLog, userId string) (*models.WorkLog, error) {
+	log.C(ctx).Infof("logging work on todo with id %s in work log service", todoId)
+
+	now := s.timeGen.Now()
+
+	loggedOn := now
+	if workLog.LoggedOn != nil {
+		loggedOn = *workLog.LoggedOn
+	}
+
+	modelWorkLog := &models.WorkLog{
+		Id:          s.uuidGen.Generate(),
+		TodoId:      todoId,
+		UserId:      userId,
+		Duration:    workLog.Duration,
+		Note:        workLog.Note,
+		LoggedOn:    truncateToDay(loggedOn),
+		CreatedAt:   now,
+		LastUpdated: now,
+	}
+
+	entity, err := s.wRepo.CreateWorkLog(ctx, s.converter.ToEntity(modelWorkLog))
+	if err != nil {
+		log.C(ctx).Errorf("failed to log work on todo with id %s, error %s when calling work log repo", todoId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(entity), nil
+}
+
+func (s *service) GetWorkLogRecord(ctx context.Context, todoId string, workLogId string) (*models.WorkLog, error) {
+	log.C(ctx).Infof("getting work log with id %s in work log service", workLogId)
+
+	entity, err := s.wRepo.GetWorkLog(ctx, todoId, workLogId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get work log with id %s, error %s when calling work log repo", workLogId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(entity), nil
+}
+
+func (s *service) GetTodoWorkLogsRecords(ctx context.Context, todoId string) ([]*models.WorkLog, error) {
+	log.C(ctx).Infof("getting work logs of todo with id %s in work log service", todoId)
+
+	if _, err := s.tRepo.GetTodo(ctx, todoId); err != nil {
+		log.C(ctx).Errorf("failed to get work logs of todo with id %s, error when calling todo repo", todoId)
+		return nil, err
+	}
+
+	eWorkLogs, err := s.wRepo.GetTodoWorkLogs(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get work logs of todo with id %s, error %s when calling work log repo", todoId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ManyToModel(eWorkLogs), nil
+}
+
+func (s *service) UpdateWorkLogRecord(ctx context.Context, todoId string, workLogId string, workLog *handler_models.UpdateWorkLog) (*models.WorkLog, error) {
+	log.C(ctx).Infof("updating work log with id %s in work log service", workLogId)
+
+	sqlExecParams := map[string]interface{}{"id": workLogId, "todo_id": todoId}
+	sqlFields := make([]string, 0, 4)
+
+	determineSqlFieldsAndParamsWorkLog(workLog, s.timeGen.Now(), sqlExecParams, &sqlFields)
+	if len(sqlFields) == 0 {
+		return s.GetWorkLogRecord(ctx, todoId, workLogId)
+	}
+
+	entity, err := s.wRepo.UpdateWorkLog(ctx, sqlExecParams, sqlFields)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update work log with id %s, error %s when calling work log repo", workLogId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.ToModel(entity), nil
+}
+
+func (s *service) DeleteWorkLogRecord(ctx context.Context, todoId string, workLogId string) error {
+	log.C(ctx).Infof("deleting work log with id %s in work log service", workLogId)
+
+	if err := s.wRepo.DeleteWorkLog(ctx, todoId, workLogId); err != nil {
+		log.C(ctx).Errorf("failed to delete work log with id %s, error %s when calling work log repo", workLogId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetTodoTimeTotalsRecord(ctx context.Context, todoId string) (*models.TimeTotals, error) {
+	log.C(ctx).Infof("getting time totals of todo with id %s in work log service", todoId)
+
+	totals, err := s.wRepo.GetTodoTimeTotals(ctx, todoId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get time totals of todo with id %s, error %s when calling work log repo", todoId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.TotalsToModel(totals), nil
+}
+
+func (s *service) GetListTimeTotalsRecord(ctx context.Context, listId string) (*models.TimeTotals, error) {
+	log.C(ctx).Infof("getting time totals of list with id %s in work log service", listId)
+
+	if _, err := s.lRepo.GetList(ctx, listId); err != nil {
+		log.C(ctx).Errorf("failed to get time totals of list with id %s, error when calling list repo", listId)
+		return nil, err
+	}
+
+	totals, err := s.wRepo.GetListTimeTotals(ctx, listId)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get time totals of list with id %s, error %s when calling work log repo", listId, err.Error())
+		return nil, err
+	}
+
+	return s.converter.TotalsToModel(totals), nil
+}
+
+// GetUserWorkLogReport returns the work the user logged in the period together with its total, both ends of the period are optional
+func (s *service) GetUserWorkLogReport(ctx context.Context, userId string, from *time.Time, to *time.Time) (*models.WorkLogReport, error) {
+	log.C(ctx).Infof("getting work log report of user with id %s in work log service", userId)
+
+	if _, err := s.uRepo.GetUser(ctx, userId); err != nil {
+		log.C(ctx).Errorf("failed to get work log report of user with id %s, error when calling user repo", userId)
+		return nil, err
+	}
+
+	eWorkLogs, err := s.wRepo.GetUserWorkLogs(ctx, userId, from, to)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get work logs of user with id %s, error %s when calling work log repo", userId, err.Error())
+		return nil, err
+	}
+
+	report := &models.WorkLogReport{
+		UserId:   userId,
+		From:     from,
+		To:       to,
+		WorkLogs: s.converter.ManyToModel(eWorkLogs),
+	}
+
+	for _, workLog := range report.WorkLogs {
+		report.TimeSpent += workLog.Duration
+	}
+
+	return report, nil
+}
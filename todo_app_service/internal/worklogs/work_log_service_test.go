@@ -0,0 +1,238 @@
+package worklogs
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/entities"
+	"Todo-List/internProject/todo_app_service/internal/worklogs/mocks"
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"Todo-List/internProject/todo_app_service/pkg/models"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestService_LogWorkRecord(t *testing.T) {
+	loggedYesterday := yesterday.Add(20 * time.Hour)
+
+	tests := []struct {
+		testName         string
+		workLog          *handler_models.CreateWorkLog
+		expectedLoggedOn time.Time
+	}{
+		{
+			testName:         "Successfully logging work without a date on the current day",
+			workLog:          &handler_models.CreateWorkLog{Duration: 30, Note: note},
+			expectedLoggedOn: today,
+		},
+		{
+			testName:         "Successfully logging work on the day of the given date",
+			workLog:          &handler_models.CreateWorkLog{Duration: 30, Note: note, LoggedOn: &loggedYesterday},
+			expectedLoggedOn: yesterday,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			expectedModel := &models.WorkLog{Id: workLogId.String(), TodoId: todoId.String(), UserId: userId.String(), Duration: 30,
+				Note: note, LoggedOn: test.expectedLoggedOn, CreatedAt: now, LastUpdated: now}
+
+			mUuidGen := &mocks.UuidGenerator{}
+			mUuidGen.EXPECT().Generate().Return(workLogId.String()).Once()
+
+			mTimeGen := &mocks.TimeGenerator{}
+			mTimeGen.EXPECT().Now().Return(now).Once()
+
+			mConverter := &mocks.WorkLogConverter{}
+			mConverter.EXPECT().ToEntity(expectedModel).Return(workLogEntity).Once()
+			mConverter.EXPECT().ToModel(workLogEntity).Return(workLogModel).Once()
+
+			mRepo := &mocks.WorkLogRepo{}
+			mRepo.EXPECT().CreateWorkLog(context.TODO(), workLogEntity).Return(workLogEntity, nil).Once()
+
+			wService := NewService(mRepo, nil, nil, nil, mUuidGen, mTimeGen, mConverter)
+			workLog, err := wService.LogWorkRecord(context.TODO(), todoId.String(), test.workLog, userId.String())
+
+			require.NoError(t, err)
+			require.Equal(t, workLogModel, workLog)
+			mock.AssertExpectationsForObjects(t, mUuidGen, mTimeGen, mConverter, mRepo)
+		})
+	}
+}
+
+func TestService_UpdateWorkLogRecord(t *testing.T) {
+	duration := 45
+	updatedAt := now.Add(time.Hour)
+
+	tests := []struct {
+		testName        string
+		workLog         *handler_models.UpdateWorkLog
+		mockRepo        func() *mocks.WorkLogRepo
+		mockConverter   func() *mocks.WorkLogConverter
+		expectedWorkLog *models.WorkLog
+		err             error
+	}{
+		{
+			testName: "Successfully updating the duration of the work log",
+			workLog:  &handler_models.UpdateWorkLog{Duration: &duration},
+			mockRepo: func() *mocks.WorkLogRepo {
+				mRepo := &mocks.WorkLogRepo{}
+
+				mRepo.EXPECT().
+					UpdateWorkLog(context.TODO(),
+						map[string]interface{}{"id": workLogId.String(), "todo_id": todoId.String(), "duration": duration, "last_updated": updatedAt},
+						[]string{"duration = :duration", "last_updated = :last_updated"}).
+					Return(workLogEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.WorkLogConverter {
+				mConverter := &mocks.WorkLogConverter{}
+				mConverter.EXPECT().ToModel(workLogEntity).Return(workLogModel).Once()
+
+				return mConverter
+			},
+			expectedWorkLog: workLogModel,
+		},
+		{
+			testName: "Returning the work log unchanged when there is nothing to update",
+			workLog:  &handler_models.UpdateWorkLog{},
+			mockRepo: func() *mocks.WorkLogRepo {
+				mRepo := &mocks.WorkLogRepo{}
+				mRepo.EXPECT().GetWorkLog(context.TODO(), todoId.String(), workLogId.String()).Return(workLogEntity, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.WorkLogConverter {
+				mConverter := &mocks.WorkLogConverter{}
+				mConverter.EXPECT().ToModel(workLogEntity).Return(workLogModel).Once()
+
+				return mConverter
+			},
+			expectedWorkLog: workLogModel,
+		},
+		{
+			testName: "Failed to update work log which does not exist",
+			workLog:  &handler_models.UpdateWorkLog{Duration: &duration},
+			mockRepo: func() *mocks.WorkLogRepo {
+				mRepo := &mocks.WorkLogRepo{}
+
+				mRepo.EXPECT().
+					UpdateWorkLog(context.TODO(), mock.Anything, mock.Anything).
+					Return(nil, workLogNotFound).Once()
+
+				return mRepo
+			},
+			err: workLogNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mRepo := test.mockRepo()
+
+			mConverter := &mocks.WorkLogConverter{}
+			if test.mockConverter != nil {
+				mConverter = test.mockConverter()
+			}
+
+			mTimeGen := &mocks.TimeGenerator{}
+			mTimeGen.EXPECT().Now().Return(updatedAt).Once()
+
+			wService := NewService(mRepo, nil, nil, nil, nil, mTimeGen, mConverter)
+			workLog, err := wService.UpdateWorkLogRecord(context.TODO(), todoId.String(), workLogId.String(), test.workLog)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, workLog)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedWorkLog, workLog)
+			}
+
+			mock.AssertExpectationsForObjects(t, mRepo, mConverter, mTimeGen)
+		})
+	}
+}
+
+func TestService_GetUserWorkLogReport(t *testing.T) {
+	eWorkLogs := []entities.WorkLog{*workLogEntity, *workLogEntity}
+	secondModel := &models.WorkLog{Id: workLogId.String(), Duration: 15}
+
+	tests := []struct {
+		testName       string
+		mockUserRepo   func() *mocks.UserRepo
+		mockRepo       func() *mocks.WorkLogRepo
+		mockConverter  func() *mocks.WorkLogConverter
+		expectedReport *models.WorkLogReport
+		err            error
+	}{
+		{
+			testName: "Successfully getting the report with the total of the logged work",
+			mockUserRepo: func() *mocks.UserRepo {
+				mRepo := &mocks.UserRepo{}
+				mRepo.EXPECT().GetUser(context.TODO(), userId.String()).Return(&entities.User{Id: userId}, nil).Once()
+
+				return mRepo
+			},
+			mockRepo: func() *mocks.WorkLogRepo {
+				mRepo := &mocks.WorkLogRepo{}
+				mRepo.EXPECT().GetUserWorkLogs(context.TODO(), userId.String(), &yesterday, &today).Return(eWorkLogs, nil).Once()
+
+				return mRepo
+			},
+			mockConverter: func() *mocks.WorkLogConverter {
+				mConverter := &mocks.WorkLogConverter{}
+				mConverter.EXPECT().ManyToModel(eWorkLogs).Return([]*models.WorkLog{workLogModel, secondModel}).Once()
+
+				return mConverter
+			},
+			expectedReport: &models.WorkLogReport{
+				UserId:    userId.String(),
+				From:      &yesterday,
+				To:        &today,
+				TimeSpent: 45,
+				WorkLogs:  []*models.WorkLog{workLogModel, secondModel},
+			},
+		},
+		{
+			testName: "Failed to get the report of user who does not exist",
+			mockUserRepo: func() *mocks.UserRepo {
+				mRepo := &mocks.UserRepo{}
+				mRepo.EXPECT().GetUser(context.TODO(), userId.String()).Return(nil, userNotFound).Once()
+
+				return mRepo
+			},
+			err: userNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			mUserRepo := test.mockUserRepo()
+
+			mRepo := &mocks.WorkLogRepo{}
+			if test.mockRepo != nil {
+				mRepo = test.mockRepo()
+			}
+
+			mConverter := &mocks.WorkLogConverter{}
+			if test.mockConverter != nil {
+				mConverter = test.mockConverter()
+			}
+
+			wService := NewService(mRepo, nil, nil, mUserRepo, nil, nil, mConverter)
+			report, err := wService.GetUserWorkLogReport(context.TODO(), userId.String(), &yesterday, &today)
+
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				require.Nil(t, report)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedReport, report)
+			}
+
+			mock.AssertExpectationsForObjects(t, mUserRepo, mRepo, mConverter)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package worklogs
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/handler_models"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const workLogColumns = `id, todo_id, user_id, duration, note, logged_on, created_at, last_updated`
+
+var getWorkLogQuery = `SELECT ` + workLogColumns + ` FROM work_logs WHERE id = $1 AND todo_id = $2`
+
+var getTodoWorkLogsQuery = `SELECT ` + workLogColumns + ` FROM work_logs WHERE todo_id = $1 ORDER BY logged_on, created_at, id`
+
+// getUserWorkLogsQuery returns the work logged by the user in the period, both ends of the period are optional and inclusive
+var getUserWorkLogsQuery = `SELECT ` + workLogColumns + ` FROM work_logs
+WHERE user_id = $1 AND (CAST($2 AS DATE) IS NULL OR logged_on >= CAST($2 AS DATE)) AND (CAST($3 AS DATE) IS NULL OR logged_on <= CAST($3 AS DATE))
+ORDER BY logged_on, created_at, id`
+
+var createWorkLogQuery = `INSERT INTO work_logs (` + workLogColumns + `)
+VALUES (:id, :todo_id, :user_id, :duration, :note, :logged_on, :created_at, :last_updated)`
+
+const deleteWorkLogQuery = `DELETE FROM work_logs WHERE id = $1 AND todo_id = $2`
+
+// todoTimeTotalsQuery returns no rows when the todo does not exist or is in the trash
+const todoTimeTotalsQuery = `SELECT COALESCE(todos.estimate, 0) AS estimate,
+COALESCE((SELECT SUM(duration) FROM work_logs WHERE work_logs.todo_id = todos.id), 0) AS time_spent
+FROM todos WHERE todos.id = $1 AND todos.deleted_at IS NULL`
+
+// listTimeTotalsQuery sums the estimates and the logged work of the todos of the list which are not in the trash
+const listTimeTotalsQuery = `SELECT COALESCE(SUM(todos.estimate), 0) AS estimate, COALESCE(SUM(logged.time_spent), 0) AS time_spent
+FROM todos
+LEFT JOIN (SELECT todo_id, SUM(duration) AS time_spent FROM work_logs GROUP BY todo_id) AS logged ON logged.todo_id = todos.id
+WHERE todos.list_id = $1 AND todos.deleted_at IS NULL`
+
+func parseSqlUpdateWorkLogQuery(sqlFields []string) string {
+	return fmt.Sprintf("UPDATE work_logs SET %s WHERE id = :id AND todo_id = :todo_id", strings.Join(sqlFields, ", "))
+}
+
+func determineSqlFieldsAndParamsWorkLog(workLog *handler_models.UpdateWorkLog, lastUpdated time.Time, sqlExecParams map[string]interface{}, sqlFields *[]string) {
+	if workLog.Duration != nil {
+		sqlExecParams["duration"] = *workLog.Duration
+		*sqlFields = append(*sqlFields, "duration = :duration")
+	}
+
+	if workLog.Note != nil {
+		sqlExecParams["note"] = *workLog.Note
+		*sqlFields = append(*sqlFields, "note = :note")
+	}
+
+	if workLog.LoggedOn != nil {
+		sqlExecParams["logged_on"] = truncateToDay(*workLog.LoggedOn)
+		*sqlFields = append(*sqlFields, "logged_on = :logged_on")
+	}
+
+	if len(*sqlFields) != 0 {
+		sqlExecParams["last_updated"] = lastUpdated
+		*sqlFields = append(*sqlFields, "last_updated = :last_updated")
+	}
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -1,32 +1,46 @@
 package application
 
 import (
+	"Todo-List/internProject/todo_app_service/internal/boards"
 	"Todo-List/internProject/todo_app_service/internal/checks"
+	"Todo-List/internProject/todo_app_service/internal/comments"
 	"Todo-List/internProject/todo_app_service/internal/converters"
 	"Todo-List/internProject/todo_app_service/internal/generators"
 	"Todo-List/internProject/todo_app_service/internal/generic"
 	"Todo-List/internProject/todo_app_service/internal/gitHub"
+	"Todo-List/internProject/todo_app_service/internal/history"
+	"Todo-List/internProject/todo_app_service/internal/invitations"
+	"Todo-List/internProject/todo_app_service/internal/labels"
 	"Todo-List/internProject/todo_app_service/internal/lists"
 	"Todo-List/internProject/todo_app_service/internal/middlewares"
 	"Todo-List/internProject/todo_app_service/internal/oauth"
+	"Todo-List/internProject/todo_app_service/internal/participants"
 	"Todo-List/internProject/todo_app_service/internal/persistence"
 	"Todo-List/internProject/todo_app_service/internal/random_activites"
 	"Todo-List/internProject/todo_app_service/internal/refresh"
 	"Todo-List/internProject/todo_app_service/internal/resource_identifier"
+	"Todo-List/internProject/todo_app_service/internal/revocation"
+	"Todo-List/internProject/todo_app_service/internal/roles"
+	"Todo-List/internProject/todo_app_service/internal/search"
 	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators"
 	"Todo-List/internProject/todo_app_service/internal/sql_query_decorators/filters"
+	"Todo-List/internProject/todo_app_service/internal/templates"
 	"Todo-List/internProject/todo_app_service/internal/todos"
+	"Todo-List/internProject/todo_app_service/internal/trash"
 	"Todo-List/internProject/todo_app_service/internal/user_info"
 	"Todo-List/internProject/todo_app_service/internal/users"
 	"Todo-List/internProject/todo_app_service/internal/validators"
+	"Todo-List/internProject/todo_app_service/internal/worklogs"
 	config "Todo-List/internProject/todo_app_service/pkg/configuration"
 	"Todo-List/internProject/todo_app_service/pkg/constants"
 	"Todo-List/internProject/todo_app_service/pkg/http_helpers"
 	"Todo-List/internProject/todo_app_service/pkg/jwt"
 	"Todo-List/internProject/todo_app_service/pkg/models"
+	"Todo-List/internProject/todo_app_service/pkg/oidc"
 	"context"
 	"fmt"
 	"github.com/gorilla/mux"
+	"golang.org/x/oauth2"
 	"log"
 	"net/http"
 )
@@ -44,6 +58,31 @@ type userService interface {
 type todoService interface {
 	GetTodoAssigneeToRecord(ctx context.Context, todoId string) (*models.User, error)
 	GetTodoRecord(ctx context.Context, todoId string) (*models.Todo, error)
+	IsTodoAssigneeRecord(ctx context.Context, todoId string, userId string) (bool, error)
+}
+
+type labelService interface {
+	GetLabelRecord(ctx context.Context, labelId string) (*models.Label, error)
+}
+
+type templateService interface {
+	GetTemplateRecord(ctx context.Context, templateId string) (*models.Template, error)
+}
+
+type commentService interface {
+	GetCommentRecord(ctx context.Context, todoId string, commentId string) (*models.Comment, error)
+}
+
+type workLogService interface {
+	GetWorkLogRecord(ctx context.Context, todoId string, workLogId string) (*models.WorkLog, error)
+}
+
+type revocationChecker interface {
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+type sessionChecker interface {
+	SessionExists(ctx context.Context, sessionId string) (bool, error)
 }
 
 type uuidGenerator interface {
@@ -51,20 +90,38 @@ type uuidGenerator interface {
 }
 
 type server struct {
-	listHandler     *lists.Handler
-	todoHandler     *todos.Handler
-	userHandler     *users.Handler
-	oauthHandler    *oauth.Handler
-	healthHandler   *checks.Handler
-	refreshHandler  *refresh.Handler
-	activityHandler *random_activites.Handler
-	configManger    *config.Config
-	jwtParser       *jwt.JwtParserService
-	listService     listService
-	userService     userService
-	todoService     todoService
-	generator       uuidGenerator
-	transact        persistence.Transactioner
+	listHandler        *lists.Handler
+	todoHandler        *todos.Handler
+	userHandler        *users.Handler
+	oauthHandler       *oauth.Handler
+	healthHandler      *checks.Handler
+	refreshHandler     *refresh.Handler
+	activityHandler    *random_activites.Handler
+	labelHandler       *labels.Handler
+	commentHandler     *comments.Handler
+	searchHandler      *search.Handler
+	historyHandler     *history.Handler
+	trashHandler       *trash.Handler
+	trashPurger        *trash.Purger
+	revocationPruner   *revocation.Pruner
+	invitationHandler  *invitations.Handler
+	templateHandler    *templates.Handler
+	boardHandler       *boards.Handler
+	participantHandler *participants.Handler
+	workLogHandler     *worklogs.Handler
+	configManger       *config.Config
+	jwtParser          *jwt.JwtParserService
+	listService        listService
+	userService        userService
+	todoService        todoService
+	labelService       labelService
+	commentService     commentService
+	workLogService     workLogService
+	templateService    templateService
+	revocationChecker  revocationChecker
+	sessionChecker     sessionChecker
+	generator          uuidGenerator
+	transact           persistence.Transactioner
 }
 
 func NewServer() *server {
@@ -83,6 +140,17 @@ func NewServer() *server {
 	lRepo := lists.NewRepo(gRepo, decoratorFactory)
 	uRepo := users.NewRepo(gRepo, decoratorFactory)
 	refreshRepo := refresh.NewRepo()
+	labelRepo := labels.NewRepo(gRepo, decoratorFactory)
+	commentRepo := comments.NewRepo(gRepo, decoratorFactory)
+	searchRepo := search.NewRepo()
+	historyRepo := history.NewRepo(gRepo, decoratorFactory)
+	trashRepo := trash.NewRepo()
+	invitationRepo := invitations.NewRepo()
+	templateRepo := templates.NewRepo()
+	columnRepo := boards.NewRepo()
+	participantRepo := participants.NewRepo(gRepo, decoratorFactory)
+	workLogRepo := worklogs.NewRepo()
+	revocationRepo := revocation.NewRepo()
 
 	uuidGen := generators.NewUuidGenerator()
 	timeGen := generators.NewTimeGenerator()
@@ -90,57 +158,139 @@ func NewServer() *server {
 	todoConverter := converters.NewTodoConverter()
 	userConverter := converters.NewUserConverter()
 	listConverter := converters.NewListConverter()
-	refreshConverter := converters.NewRefreshConverter()
+	sessionConverter := converters.NewSessionConverter()
+	labelConverter := converters.NewLabelConverter()
+	commentConverter := converters.NewCommentConverter()
+	historyConverter := converters.NewHistoryConverter()
+	invitationConverter := converters.NewInvitationConverter()
+	templateConverter := converters.NewTemplateConverter()
+	columnConverter := converters.NewColumnConverter()
+	workLogConverter := converters.NewWorkLogConverter()
 
 	httpRequester := http_helpers.NewHttpRequester()
 	httpService := http_helpers.NewService(client, nil, httpRequester)
 
-	uService := users.NewService(uRepo, userConverter, listConverter, todoConverter, uuidGen, rfAdapter)
-	lService := lists.NewService(lRepo, uuidGen, timeGen, listConverter, uRepo, tRepo, userConverter, rfAdapter)
-	tService := todos.NewService(tRepo, lRepo, uuidGen, timeGen, todoConverter, userConverter, rfAdapter)
-	refreshService := refresh.NewService(refreshRepo, uRepo, refreshConverter, userConverter)
+	historyService := history.NewService(historyRepo, tRepo, lRepo, historyConverter, rfAdapter)
+	uService := users.NewService(uRepo, userConverter, listConverter, todoConverter, uuidGen, rfAdapter, historyService)
+	lService := lists.NewService(lRepo, uuidGen, timeGen, listConverter, uRepo, tRepo, userConverter, rfAdapter, historyService)
+	tService := todos.NewService(tRepo, lRepo, columnRepo, uuidGen, timeGen, todoConverter, userConverter, rfAdapter, historyService)
+	revocationService := revocation.NewService(revocationRepo, timeGen)
+	refreshService := refresh.NewService(refreshRepo, sessionConverter, userConverter, revocationService, timeGen)
+	labelService := labels.NewService(labelRepo, tRepo, lRepo, uRepo, uuidGen, timeGen, labelConverter, rfAdapter)
+	commentService := comments.NewService(commentRepo, tRepo, uuidGen, timeGen, commentConverter, rfAdapter)
+	searchService := search.NewService(searchRepo, todoConverter, listConverter)
+	trashService := trash.NewService(trashRepo, tRepo, lRepo, todoConverter, listConverter, historyService, timeGen,
+		configManagerInstance.TrashConfig.Retention)
+	templateService := templates.NewService(templateRepo, lRepo, tRepo, lService, templateConverter, uuidGen, timeGen)
+	boardService := boards.NewService(columnRepo, lRepo, columnConverter, todoConverter, historyService, uuidGen, timeGen)
+	participantService := participants.NewService(participantRepo, tRepo, lRepo, uRepo, timeGen, userConverter, rfAdapter, historyService)
+	workLogService := worklogs.NewService(workLogRepo, tRepo, lRepo, uRepo, uuidGen, timeGen, workLogConverter)
 	activityService := random_activites.NewService(configManagerInstance.ActivityConfig.ApiUrl, httpService)
 
 	fValidator := validators.GetInstance()
 	tHandler := todos.NewHandler(tService, fValidator, sqlDB)
 	lHandler := lists.NewHandler(lService, fValidator, sqlDB)
 	uHandler := users.NewHandler(uService, sqlDB)
+	lblHandler := labels.NewHandler(labelService, fValidator, sqlDB)
+	cHandler := comments.NewHandler(commentService, fValidator, sqlDB)
+	sHandler := search.NewHandler(searchService, sqlDB)
+	hstHandler := history.NewHandler(historyService, sqlDB)
+	trHandler := trash.NewHandler(trashService, sqlDB)
 	activityHandler := random_activites.NewHandler(activityService)
+	tmplHandler := templates.NewHandler(templateService, fValidator, sqlDB)
+	bHandler := boards.NewHandler(boardService, fValidator, sqlDB)
+	pHandler := participants.NewHandler(participantService, fValidator, sqlDB)
+	wHandler := worklogs.NewHandler(workLogService, fValidator, sqlDB)
 
 	gitHubService := gitHub.NewService(httpService)
 
 	jwtManager := jwt.NewJwtManager()
 
+	invitationTokenService := jwt.NewInvitationTokenService(jwtManager, jwtManager, timeGen, configManagerInstance.JwtConfig.Secret)
+	invitationService := invitations.NewService(invitationRepo, lRepo, uRepo, invitationConverter, invitationTokenService,
+		historyService, uuidGen, timeGen, configManagerInstance.InvitationConfig.Expiry)
+	iHandler := invitations.NewHandler(invitationService, fValidator, sqlDB)
+
 	userInfoService := user_info.NewUserInfoService(gitHubService)
 	userInfoAggregator := user_info.NewAggregator(userInfoService)
 	stateGenerator := generators.NewStateGenerator()
-	jwtCreator := jwt.NewJwtService(uService, timeGen, jwtManager, configManagerInstance.JwtConfig.Secret)
+	jwtCreator := jwt.NewJwtService(uService, invitationService, timeGen, uuidGen, jwtManager, configManagerInstance.JwtConfig.Secret)
+
+	roleMapping := configManagerInstance.RoleMappingConfig
+	rolePolicy, err := roles.NewPolicy([]roles.Rule{
+		{Role: constants.Admin, Organizations: roleMapping.AdminOrganizations, Teams: roleMapping.AdminTeams},
+		{Role: constants.Writer, Organizations: roleMapping.WriterOrganizations, Teams: roleMapping.WriterTeams},
+		{Role: constants.Reader, Organizations: roleMapping.ReaderOrganizations, Teams: roleMapping.ReaderTeams},
+	}, roleMapping.EmailOverrides, roleMapping.DefaultRole)
+	if err != nil {
+		panic(err)
+	}
+	roleService := roles.NewService(roles.NewRepo(), rolePolicy, converters.NewMembershipConverter())
 
-	jwtIssuer := jwt.NewJwtIssuer(jwtCreator, userInfoAggregator, refreshService, uService)
+	tokenParser := jwt.NewJwtParseService(jwtManager)
+	jwtIssuer := jwt.NewJwtIssuer(jwtCreator, refreshService, uService, roleService, tokenParser, revocationService, uuidGen)
+
+	oauthService := oauth.NewService(stateGenerator)
+	oauthService.RegisterProvider(constants.GITHUB_PROVIDER, oauth.NewGitHubProvider(configManagerInstance.OauthConfig, userInfoAggregator))
+
+	if oidcConfig := configManagerInstance.OidcConfig; oidcConfig.Enabled() {
+		metadata, err := oidc.Discover(context.Background(), client, oidcConfig.IssuerUrl)
+		if err != nil {
+			panic(err)
+		}
+
+		oidcOauthConfig := &oauth2.Config{
+			ClientID:     oidcConfig.ClientId,
+			ClientSecret: oidcConfig.ClientSecret,
+			Scopes:       oidcConfig.Scopes,
+			Endpoint:     metadata.Endpoint(),
+			RedirectURL:  oidcConfig.CallbackUrl,
+		}
+		idTokenVerifier := oidc.NewVerifier(client, metadata, oidcConfig.ClientId)
+		oauthService.RegisterProvider(constants.OIDC_PROVIDER, oauth.NewOidcProvider(oidcOauthConfig, idTokenVerifier))
+	}
 
-	oauthService := oauth.NewService(stateGenerator, configManagerInstance.OauthConfig)
 	oHandler := oauth.NewHandler(oauthService, jwtIssuer, httpService, sqlDB, configManagerInstance.CorsConfig.FrontendUrl)
 
-	tokenParser := jwt.NewJwtParseService(jwtManager)
-
 	hHandler := checks.NewHandler(sqlDB)
-	rHandler := refresh.NewHandler(jwtIssuer, httpService, sqlDB)
+	rHandler := refresh.NewHandler(jwtIssuer, refreshService, httpService, sqlDB)
+
+	trashPurger := trash.NewPurger(trashService, sqlDB, configManagerInstance.TrashConfig.PurgeInterval)
+	revocationPruner := revocation.NewPruner(revocationService, sqlDB, configManagerInstance.JwtConfig.RevocationPruneInterval)
 
 	return &server{
-		listHandler:     lHandler,
-		todoHandler:     tHandler,
-		userHandler:     uHandler,
-		oauthHandler:    oHandler,
-		healthHandler:   hHandler,
-		refreshHandler:  rHandler,
-		activityHandler: activityHandler,
-		configManger:    configManagerInstance,
-		jwtParser:       tokenParser,
-		todoService:     tService,
-		listService:     lService,
-		userService:     uService,
-		generator:       uuidGen,
-		transact:        sqlDB,
+		listHandler:        lHandler,
+		todoHandler:        tHandler,
+		userHandler:        uHandler,
+		oauthHandler:       oHandler,
+		healthHandler:      hHandler,
+		refreshHandler:     rHandler,
+		activityHandler:    activityHandler,
+		labelHandler:       lblHandler,
+		commentHandler:     cHandler,
+		searchHandler:      sHandler,
+		historyHandler:     hstHandler,
+		trashHandler:       trHandler,
+		trashPurger:        trashPurger,
+		revocationPruner:   revocationPruner,
+		invitationHandler:  iHandler,
+		templateHandler:    tmplHandler,
+		boardHandler:       bHandler,
+		participantHandler: pHandler,
+		workLogHandler:     wHandler,
+		configManger:       configManagerInstance,
+		jwtParser:          tokenParser,
+		todoService:        tService,
+		listService:        lService,
+		userService:        uService,
+		labelService:       labelService,
+		commentService:     commentService,
+		workLogService:     workLogService,
+		templateService:    templateService,
+		revocationChecker:  revocationService,
+		sessionChecker:     refreshService,
+		generator:          uuidGen,
+		transact:           sqlDB,
 	}
 }
 
@@ -153,26 +303,100 @@ func (s *server) registerHealthPaths(router *mux.Router) {
 	router.HandleFunc("/api/healthz", s.healthHandler.HandleLiveness).Methods(http.MethodGet)
 }
 
-// only admins, list owners and collaborators can modify lists
+// only admins, list owners and the list managers can modify lists and add collaborators
 func (s *server) registerListIdAuthRoutes(router *mux.Router) {
 	router.HandleFunc("/collaborators", s.listHandler.HandleAddCollaborator).Methods(http.MethodPost)
 	router.HandleFunc("", s.listHandler.HandleUpdateListPartially).Methods(http.MethodPatch)
+	router.HandleFunc("/invitations", s.invitationHandler.HandleCreateInvitation).Methods(http.MethodPost)
+	router.HandleFunc("/columns", s.boardHandler.HandleCreateColumn).Methods(http.MethodPost)
 }
 
-// only admins and list owners can modify delete a certain collaborator
+// only admins, list owners and the list managers can modify and delete the columns of the board
+func (s *server) registerListColumnIdRoutes(router *mux.Router) {
+	router.HandleFunc("", s.boardHandler.HandleUpdateColumn).Methods(http.MethodPatch)
+	router.HandleFunc("", s.boardHandler.HandleDeleteColumn).Methods(http.MethodDelete)
+}
+
+// only admins, list owners and the list managers can delete a certain collaborator
 func (s *server) registerListUserIdRoutes(router *mux.Router) {
 	router.HandleFunc("", s.listHandler.HandleDeleteCollaborator).Methods(http.MethodDelete)
 }
 
+// only admins, list owners and the list managers can revoke an invitation to the list
+func (s *server) registerListInvitationIdRoutes(router *mux.Router) {
+	router.HandleFunc("", s.invitationHandler.HandleRevokeInvitation).Methods(http.MethodDelete)
+}
+
 // only admins and list owners can modify delete a certain collaborator
 func (s *server) registerListDeleteRoutes(router *mux.Router) {
 	router.HandleFunc("", s.listHandler.HandleDeleteList).Methods(http.MethodDelete)
 }
 
-// only admins, list the list owner and the list collaborators of the list where todo is located can update and delete todo
+// only admins and list owners can hand the list over to another user
+func (s *server) registerListOwnerRoutes(router *mux.Router) {
+	router.HandleFunc("", s.listHandler.HandleTransferListOwnership).Methods(http.MethodPost)
+}
+
+// only admins and writers who can access the list can copy it or save it as a template, the copy and the template belong to the caller
+func (s *server) registerListCopyRoutes(router *mux.Router) {
+	router.HandleFunc("/duplicate", s.listHandler.HandleDuplicateList).Methods(http.MethodPost)
+	router.HandleFunc("/templates", s.templateHandler.HandleCreateTemplate).Methods(http.MethodPost)
+}
+
+// only admins, the list owner, the assignee and the list editors of the list where todo is located can update and delete todo,
+// the list viewers can only read its comments
 func (s *server) registerTodoIdAuthRoutes(router *mux.Router) {
 	router.HandleFunc("", s.todoHandler.HandleDeleteTodo).Methods(http.MethodDelete)
 	router.HandleFunc("", s.todoHandler.HandleUpdateTodoRecord).Methods(http.MethodPatch)
+	router.HandleFunc("/labels", s.labelHandler.HandleAddLabelToTodo).Methods(http.MethodPost)
+	router.HandleFunc("/comments", s.commentHandler.HandleGetComments).Methods(http.MethodGet)
+	router.HandleFunc("/comments", s.commentHandler.HandleCreateComment).Methods(http.MethodPost)
+	router.HandleFunc("/blockers", s.todoHandler.HandleAddTodoBlocker).Methods(http.MethodPost)
+	router.HandleFunc("/move", s.todoHandler.HandleMoveTodo).Methods(http.MethodPost)
+	router.HandleFunc("/copy", s.todoHandler.HandleCopyTodo).Methods(http.MethodPost)
+	router.HandleFunc("/reorder", s.todoHandler.HandleReorderTodo).Methods(http.MethodPost)
+	router.HandleFunc("/assignees", s.participantHandler.HandleAddTodoAssignee).Methods(http.MethodPost)
+	router.HandleFunc("/watchers", s.participantHandler.HandleAddTodoWatcher).Methods(http.MethodPost)
+	router.HandleFunc("/worklogs", s.workLogHandler.HandleLogWork).Methods(http.MethodPost)
+}
+
+// only admins, list the list owner and the list collaborators of the list where todo is located can remove blockers from todo
+func (s *server) registerTodoBlockerIdAuthRoutes(router *mux.Router) {
+	router.HandleFunc("", s.todoHandler.HandleRemoveTodoBlocker).Methods(http.MethodDelete)
+}
+
+// only admins, the list owner and the list collaborators of the list where todo is located can unassign users from todo
+func (s *server) registerTodoAssigneeIdAuthRoutes(router *mux.Router) {
+	router.HandleFunc("", s.participantHandler.HandleRemoveTodoAssignee).Methods(http.MethodDelete)
+}
+
+// only admins, the list owner and the list collaborators of the list where todo is located can remove watchers from todo
+func (s *server) registerTodoWatcherIdAuthRoutes(router *mux.Router) {
+	router.HandleFunc("", s.participantHandler.HandleRemoveTodoWatcher).Methods(http.MethodDelete)
+}
+
+// only admins, list the list owner and the list collaborators of the list where todo is located can detach labels from todo
+func (s *server) registerTodoLabelIdAuthRoutes(router *mux.Router) {
+	router.HandleFunc("", s.labelHandler.HandleRemoveLabelFromTodo).Methods(http.MethodDelete)
+}
+
+// only admins and the comment author can modify and delete a comment
+func (s *server) registerTodoCommentIdAuthRoutes(router *mux.Router) {
+	router.HandleFunc("", s.commentHandler.HandleUpdateComment).Methods(http.MethodPatch)
+	router.HandleFunc("", s.commentHandler.HandleDeleteComment).Methods(http.MethodDelete)
+}
+
+// only admins and the user who logged the work can modify and delete a work log
+func (s *server) registerTodoWorkLogIdAuthRoutes(router *mux.Router) {
+	router.HandleFunc("", s.workLogHandler.HandleUpdateWorkLog).Methods(http.MethodPatch)
+	router.HandleFunc("", s.workLogHandler.HandleDeleteWorkLog).Methods(http.MethodDelete)
+}
+
+// only admins and the label owner can read, modify and delete a label
+func (s *server) registerLabelIdAuthRoutes(router *mux.Router) {
+	router.HandleFunc("", s.labelHandler.HandleGetLabel).Methods(http.MethodGet)
+	router.HandleFunc("", s.labelHandler.HandleUpdateLabel).Methods(http.MethodPatch)
+	router.HandleFunc("", s.labelHandler.HandleDeleteLabel).Methods(http.MethodDelete)
 }
 
 // all authorized users can read user specific things
@@ -180,25 +404,68 @@ func (s *server) registerReadUserIdRoutes(router *mux.Router) {
 	router.HandleFunc("", s.userHandler.HandleGetUser).Methods(http.MethodGet)
 	router.HandleFunc("/lists", s.userHandler.HandleGetUserLists).Methods(http.MethodGet)
 	router.HandleFunc("/todos", s.userHandler.HandleGetTodosAssignedToUser).Methods(http.MethodGet)
+	router.HandleFunc("/labels", s.labelHandler.HandleGetUserLabels).Methods(http.MethodGet)
 }
 
-// only admins can modify users
+// only admins and the user himself can modify the user and see his invitations, templates, work log report and sessions
 func (s *server) registerAuthUserIdRoutes(router *mux.Router) {
 	router.HandleFunc("", s.userHandler.HandleDeleteUser).Methods(http.MethodDelete)
+	router.HandleFunc("/invitations", s.invitationHandler.HandleGetUserInvitations).Methods(http.MethodGet)
+	router.HandleFunc("/templates", s.templateHandler.HandleGetUserTemplates).Methods(http.MethodGet)
+	router.HandleFunc("/worklog", s.workLogHandler.HandleGetUserWorkLogReport).Methods(http.MethodGet)
+	router.HandleFunc("/sessions", s.refreshHandler.HandleGetUserSessions).Methods(http.MethodGet)
+}
+
+// only admins can log a user out of every device
+func (s *server) registerAdminUserIdRoutes(router *mux.Router) {
+	router.HandleFunc("/sessions", s.refreshHandler.HandleRevokeUserSessions).Methods(http.MethodDelete)
+}
+
+// every user sees the devices he is logged in on and can log them out, admins can log out the sessions of every user
+func (s *server) registerSessionPaths(router *mux.Router) {
+	router.HandleFunc("", s.refreshHandler.HandleGetMySessions).Methods(http.MethodGet)
+
+	sessionIdRouter := router.PathPrefix(fmt.Sprintf("/{session_id:%s}", constants.UUID_REGEX)).Subrouter()
+	sessionIdRouter.Use(middlewares.ExtractionSessionIdMiddlewareFunc)
+	sessionIdRouter.HandleFunc("", s.refreshHandler.HandleRevokeSession).Methods(http.MethodDelete)
+}
+
+// only admins and the template owner can read and delete a template
+func (s *server) registerTemplateIdAuthRoutes(router *mux.Router) {
+	router.HandleFunc("", s.templateHandler.HandleGetTemplate).Methods(http.MethodGet)
+	router.HandleFunc("", s.templateHandler.HandleDeleteTemplate).Methods(http.MethodDelete)
+}
+
+// only admins and writers who own the template can create lists from it
+func (s *server) registerTemplateListCreationRoutes(router *mux.Router) {
+	router.HandleFunc("", s.templateHandler.HandleCreateListFromTemplate).Methods(http.MethodPost)
+}
+
+// the invited user answers an invitation with the token of the invitation
+func (s *server) registerInvitationPaths(router *mux.Router) {
+	router.HandleFunc("/accept", s.invitationHandler.HandleAcceptInvitation).Methods(http.MethodPost)
+	router.HandleFunc("/decline", s.invitationHandler.HandleDeclineInvitation).Methods(http.MethodPost)
 }
 
 // every one can access these endpoints, entry point of the API
 func (s *server) registerOauthPaths(router *mux.Router) {
-	// this will redirect you to github and will prompt you to type your credentials and you will be asked to grant my API
-	// with the scopes my API need so it can access your github information, this will be used to determine your role in the API
-	// and you will be issued with a JWT token that you can use in order to authorize in front of my API.
-	router.HandleFunc("/github/login", s.oauthHandler.HandleLogin).Methods(http.MethodGet)
+	// kept for the callback url already registered in the github oauth app, it is the github callback
+	legacyCallbackRouter := router.Path("/auth2/callback").Subrouter()
+	legacyCallbackRouter.Use(middlewares.FixedProviderMiddlewareFunc(constants.GITHUB_PROVIDER))
+	legacyCallbackRouter.HandleFunc("", s.oauthHandler.HandleCallback).Methods(http.MethodGet)
+
+	router.HandleFunc("/logout", s.oauthHandler.HandleLogout).Methods(http.MethodGet)
+
+	providerRouter := router.PathPrefix("/{provider}").Subrouter()
+	providerRouter.Use(middlewares.ExtractionProviderMiddlewareFunc)
+	// this will redirect you to the provider (e.g. /github/login or /oidc/login) and will prompt you to type your credentials,
+	// for github you will be asked to grant my API the scopes it needs so it can access your github information, this will be used
+	// to determine your role in the API and you will be issued with a JWT token that you can use in order to authorize in front of my API.
+	providerRouter.HandleFunc("/login", s.oauthHandler.HandleLogin).Methods(http.MethodGet)
 	// this will redirect you to a page where you will be granted with a JWT token and a Refresh token,
 	// then you should put the JWT in the Auth header in Postman and you will be able to call the API,
 	// keep you refresh token because you JWT token will expire after around 3 minutes.
-	router.HandleFunc("/auth2/callback", s.oauthHandler.HandleCallback).Methods(http.MethodGet)
-
-	router.HandleFunc("/logout", s.oauthHandler.HandleLogout).Methods(http.MethodGet)
+	providerRouter.HandleFunc("/callback", s.oauthHandler.HandleCallback).Methods(http.MethodGet)
 }
 
 func (s *server) registerRefreshPaths(router *mux.Router) {
@@ -208,23 +475,50 @@ func (s *server) registerRefreshPaths(router *mux.Router) {
 	router.HandleFunc("/tokens/refresh", s.refreshHandler.HandleRefresh).Methods(http.MethodPost)
 }
 
-// only admins and writers can create todos and lists
+// other services call it with an access token to find out whether the token has been revoked
+func (s *server) registerTokenVerificationPaths(router *mux.Router) {
+	router.HandleFunc("/tokens/verify", s.refreshHandler.HandleVerifyToken).Methods(http.MethodGet)
+}
+
+// only admins and writers can create todos, lists and labels, move or copy todos in bulk and send batches of todo operations,
+// the batch checks the permissions for every todo on its own
 func (s *server) registerPostPaths(router *mux.Router) {
 	router.HandleFunc("/lists", s.listHandler.HandleCreateList).Methods(http.MethodPost)
 	router.HandleFunc("/todos", s.todoHandler.HandleTodoCreation).Methods(http.MethodPost)
+	router.HandleFunc("/labels", s.labelHandler.HandleCreateLabel).Methods(http.MethodPost)
+	router.HandleFunc("/todos/move", s.todoHandler.HandleMoveTodos).Methods(http.MethodPost)
+	router.HandleFunc("/todos/copy", s.todoHandler.HandleCopyTodos).Methods(http.MethodPost)
+	router.HandleFunc("/todos/batch", s.todoHandler.HandleBatchTodos).Methods(http.MethodPost)
 }
 
-// all authorized users can read lists, todos and users
+// all authorized users can read lists, todos and users,
+// search only returns the todos and lists the caller can see, admins see everything
 func (s *server) registerReadAllRolesPaths(router *mux.Router) {
 	router.HandleFunc("/lists", s.listHandler.HandleGetLists).Methods(http.MethodGet)
 	router.HandleFunc("/todos", s.todoHandler.HandleGetTodos).Methods(http.MethodGet)
 	router.HandleFunc("/users", s.userHandler.HandleGetUsers).Methods(http.MethodGet)
+	router.HandleFunc("/search", s.searchHandler.HandleSearch).Methods(http.MethodGet)
+	router.HandleFunc("/trash", s.trashHandler.HandleGetTrash).Methods(http.MethodGet)
 }
 
 // all authorized users can read todo specific things
 func (s *server) registerReadTodoIdPaths(router *mux.Router) {
 	router.HandleFunc("", s.todoHandler.HandleGetTodo).Methods(http.MethodGet)
 	router.HandleFunc("/assignee", s.todoHandler.HandleGetTodoAssignee).Methods(http.MethodGet)
+	router.HandleFunc("/subtasks", s.todoHandler.HandleGetSubtasks).Methods(http.MethodGet)
+	router.HandleFunc("/labels", s.labelHandler.HandleGetTodoLabels).Methods(http.MethodGet)
+	router.HandleFunc("/blockers", s.todoHandler.HandleGetTodoBlockers).Methods(http.MethodGet)
+	router.HandleFunc("/blocks", s.todoHandler.HandleGetBlockedTodos).Methods(http.MethodGet)
+	router.HandleFunc("/history", s.historyHandler.HandleGetTodoHistory).Methods(http.MethodGet)
+	router.HandleFunc("/assignees", s.participantHandler.HandleGetTodoAssignees).Methods(http.MethodGet)
+	router.HandleFunc("/watchers", s.participantHandler.HandleGetTodoWatchers).Methods(http.MethodGet)
+	router.HandleFunc("/worklogs", s.workLogHandler.HandleGetTodoWorkLogs).Methods(http.MethodGet)
+	router.HandleFunc("/time", s.workLogHandler.HandleGetTodoTimeTotals).Methods(http.MethodGet)
+}
+
+// only admins and writers who can modify the parent todo can create subtasks in it
+func (s *server) registerSubtaskCreationPaths(router *mux.Router) {
+	router.HandleFunc("", s.todoHandler.HandleSubtaskCreation).Methods(http.MethodPost)
 }
 
 // all authorized users can read todos related to a certain list
@@ -242,9 +536,23 @@ func (s *server) registerReadListIdPaths(router *mux.Router) {
 	router.HandleFunc("/collaborators", s.listHandler.HandleGetCollaborators).Methods(http.MethodGet)
 	router.HandleFunc("", s.listHandler.HandleGetListRecord).Methods(http.MethodGet)
 	router.HandleFunc("/owner", s.listHandler.HandleGetListOwner).Methods(http.MethodGet)
+	router.HandleFunc("/history", s.historyHandler.HandleGetListHistory).Methods(http.MethodGet)
+	router.HandleFunc("/board", s.boardHandler.HandleGetBoard).Methods(http.MethodGet)
+	router.HandleFunc("/columns", s.boardHandler.HandleGetColumns).Methods(http.MethodGet)
+	router.HandleFunc("/time", s.workLogHandler.HandleGetListTimeTotals).Methods(http.MethodGet)
 	router.HandleFunc("", s.listHandler.HandleGetCollaborators).Methods(http.MethodGet)
 }
 
+// the list is in the trash so only the user who deleted it or an admin can restore it, the trash service checks that
+func (s *server) registerRestoreListIdPaths(router *mux.Router) {
+	router.HandleFunc("/restore", s.trashHandler.HandleRestoreList).Methods(http.MethodPost)
+}
+
+// the todo is in the trash so only the user who deleted it or an admin can restore it, the trash service checks that
+func (s *server) registerRestoreTodoIdPaths(router *mux.Router) {
+	router.HandleFunc("/restore", s.trashHandler.HandleRestoreTodo).Methods(http.MethodPost)
+}
+
 // only admins can delete all entities from a certain type!
 func (s *server) registerAdminPaths(router *mux.Router) {
 	router.HandleFunc("/lists", s.listHandler.HandleDeleteLists).Methods(http.MethodDelete)
@@ -268,7 +576,7 @@ func (s *server) Start() {
 	s.registerRefreshPaths(refreshRouter)
 
 	authRouter := router.PathPrefix("").Subrouter()
-	authRouter.Use(middlewares.AuthorisationMiddlewareFunc(s.userService, s.jwtParser, s.transact))
+	authRouter.Use(middlewares.AuthorisationMiddlewareFunc(s.userService, s.jwtParser, s.revocationChecker, s.sessionChecker, s.transact))
 
 	randomActivityRouter := router.PathPrefix("/activities").Subrouter()
 	s.registerRandomActivityPaths(randomActivityRouter)
@@ -277,6 +585,9 @@ func (s *server) Start() {
 	postRouter.Use(middlewares.ObjectCreationMiddlewareFunc)
 	s.registerPostPaths(postRouter)
 
+	tokenVerificationRouter := authRouter.PathPrefix("").Subrouter()
+	s.registerTokenVerificationPaths(tokenVerificationRouter)
+
 	globalReaderRouter := authRouter.PathPrefix("").Subrouter()
 	s.registerReadAllRolesPaths(globalReaderRouter)
 
@@ -287,17 +598,40 @@ func (s *server) Start() {
 	listRouter := authRouter.PathPrefix("/lists").Subrouter()
 	listIdAuthRouter := listRouter.PathPrefix(fmt.Sprintf("/{list_id:%s}", constants.UUID_REGEX)).Subrouter()
 	listIdAuthRouter.Use(middlewares.ExtractionListIdMiddlewareFunc, middlewares.ListAccessPermissionMiddlewareFunc(s.listService, s.transact))
-	s.registerListIdAuthRoutes(listIdAuthRouter)
+
+	listCopyRouter := listIdAuthRouter.PathPrefix("").Subrouter()
+	listCopyRouter.Use(middlewares.ObjectCreationMiddlewareFunc)
+	s.registerListCopyRoutes(listCopyRouter)
+
+	listManageRouter := listIdAuthRouter.PathPrefix("").Subrouter()
+	listManageRouter.Use(middlewares.ListRoleMiddlewareFunc(constants.Manager))
+	s.registerListIdAuthRoutes(listManageRouter)
 
 	listIdReadRouter := listRouter.PathPrefix(fmt.Sprintf("/{list_id:%s}", constants.UUID_REGEX)).Subrouter()
 	listIdReadRouter.Use(middlewares.ExtractionListIdMiddlewareFunc)
 	s.registerReadListIdPaths(listIdReadRouter)
 
+	listIdRestoreRouter := listRouter.PathPrefix(fmt.Sprintf("/{list_id:%s}", constants.UUID_REGEX)).Subrouter()
+	listIdRestoreRouter.Use(middlewares.ExtractionListIdMiddlewareFunc)
+	s.registerRestoreListIdPaths(listIdRestoreRouter)
+
 	listDeletionRouter := listIdAuthRouter.Methods(http.MethodDelete).Subrouter()
 	listDeletionRouter.Use(middlewares.ListDeletionMiddlewareFunc)
 	s.registerListDeleteRoutes(listDeletionRouter)
 
-	listUserIdRouter := listDeletionRouter.PathPrefix(fmt.Sprintf("/collaborators/{user_id:%s}", constants.UUID_REGEX)).Subrouter()
+	listOwnerRouter := listIdAuthRouter.PathPrefix("/owner").Subrouter()
+	listOwnerRouter.Use(middlewares.ListDeletionMiddlewareFunc)
+	s.registerListOwnerRoutes(listOwnerRouter)
+
+	listInvitationIdRouter := listManageRouter.PathPrefix(fmt.Sprintf("/invitations/{invitation_id:%s}", constants.UUID_REGEX)).Subrouter()
+	listInvitationIdRouter.Use(middlewares.ExtractionInvitationIdMiddlewareFunc)
+	s.registerListInvitationIdRoutes(listInvitationIdRouter)
+
+	listColumnIdRouter := listManageRouter.PathPrefix(fmt.Sprintf("/columns/{column_id:%s}", constants.UUID_REGEX)).Subrouter()
+	listColumnIdRouter.Use(middlewares.ExtractionColumnIdMiddlewareFunc)
+	s.registerListColumnIdRoutes(listColumnIdRouter)
+
+	listUserIdRouter := listManageRouter.PathPrefix(fmt.Sprintf("/collaborators/{user_id:%s}", constants.UUID_REGEX)).Subrouter()
 	listUserIdRouter.Use(middlewares.ExtractionUserIdMiddlewareFunc)
 	s.registerListUserIdRoutes(listUserIdRouter)
 
@@ -306,16 +640,69 @@ func (s *server) Start() {
 	todoIdReaderRouter.Use(middlewares.ExtractionTodoIdMiddlewareFunc)
 	s.registerReadTodoIdPaths(todoIdReaderRouter)
 
+	todoIdRestoreRouter := todoRouter.PathPrefix(fmt.Sprintf("/{todo_id:%s}", constants.UUID_REGEX)).Subrouter()
+	todoIdRestoreRouter.Use(middlewares.ExtractionTodoIdMiddlewareFunc)
+	s.registerRestoreTodoIdPaths(todoIdRestoreRouter)
+
 	todoIdAuthRouter := todoRouter.PathPrefix(fmt.Sprintf("/{todo_id:%s}", constants.UUID_REGEX)).Subrouter()
 	todoIdAuthRouter.Use(middlewares.ExtractionTodoIdMiddlewareFunc, middlewares.NewTodoModifyMiddlewareFunc(s.todoService, s.listService, s.transact))
 	s.registerTodoIdAuthRoutes(todoIdAuthRouter)
 
+	subtaskCreationRouter := todoIdAuthRouter.PathPrefix("/subtasks").Subrouter()
+	subtaskCreationRouter.Use(middlewares.ObjectCreationMiddlewareFunc)
+	s.registerSubtaskCreationPaths(subtaskCreationRouter)
+
+	todoLabelIdAuthRouter := todoIdAuthRouter.PathPrefix(fmt.Sprintf("/labels/{label_id:%s}", constants.UUID_REGEX)).Subrouter()
+	todoLabelIdAuthRouter.Use(middlewares.ExtractionLabelIdMiddlewareFunc)
+	s.registerTodoLabelIdAuthRoutes(todoLabelIdAuthRouter)
+
+	todoBlockerIdAuthRouter := todoIdAuthRouter.PathPrefix(fmt.Sprintf("/blockers/{blocker_id:%s}", constants.UUID_REGEX)).Subrouter()
+	todoBlockerIdAuthRouter.Use(middlewares.ExtractionBlockerIdMiddlewareFunc)
+	s.registerTodoBlockerIdAuthRoutes(todoBlockerIdAuthRouter)
+
+	todoAssigneeIdAuthRouter := todoIdAuthRouter.PathPrefix(fmt.Sprintf("/assignees/{user_id:%s}", constants.UUID_REGEX)).Subrouter()
+	todoAssigneeIdAuthRouter.Use(middlewares.ExtractionUserIdMiddlewareFunc)
+	s.registerTodoAssigneeIdAuthRoutes(todoAssigneeIdAuthRouter)
+
+	todoWatcherIdAuthRouter := todoIdAuthRouter.PathPrefix(fmt.Sprintf("/watchers/{user_id:%s}", constants.UUID_REGEX)).Subrouter()
+	todoWatcherIdAuthRouter.Use(middlewares.ExtractionUserIdMiddlewareFunc)
+	s.registerTodoWatcherIdAuthRoutes(todoWatcherIdAuthRouter)
+
+	todoCommentIdAuthRouter := todoIdAuthRouter.PathPrefix(fmt.Sprintf("/comments/{comment_id:%s}", constants.UUID_REGEX)).Subrouter()
+	todoCommentIdAuthRouter.Use(middlewares.ExtractionCommentIdMiddlewareFunc, middlewares.CommentModifyMiddlewareFunc(s.commentService, s.transact))
+	s.registerTodoCommentIdAuthRoutes(todoCommentIdAuthRouter)
+
+	todoWorkLogIdAuthRouter := todoIdAuthRouter.PathPrefix(fmt.Sprintf("/worklogs/{work_log_id:%s}", constants.UUID_REGEX)).Subrouter()
+	todoWorkLogIdAuthRouter.Use(middlewares.ExtractionWorkLogIdMiddlewareFunc, middlewares.WorkLogModifyMiddlewareFunc(s.workLogService, s.transact))
+	s.registerTodoWorkLogIdAuthRoutes(todoWorkLogIdAuthRouter)
+
+	labelRouter := authRouter.PathPrefix("/labels").Subrouter()
+	labelIdAuthRouter := labelRouter.PathPrefix(fmt.Sprintf("/{label_id:%s}", constants.UUID_REGEX)).Subrouter()
+	labelIdAuthRouter.Use(middlewares.ExtractionLabelIdMiddlewareFunc, middlewares.LabelAccessMiddlewareFunc(s.labelService, s.transact))
+	s.registerLabelIdAuthRoutes(labelIdAuthRouter)
+
 	todoListReaderRouter := listIdReadRouter.PathPrefix("/todos").Subrouter()
 	s.registerReadTodoListPaths(todoListReaderRouter)
 
 	todoListAuthRouter := listIdAuthRouter.PathPrefix("/todos").Subrouter()
+	todoListAuthRouter.Use(middlewares.ListRoleMiddlewareFunc(constants.Editor))
 	s.registerAuthTodoListPaths(todoListAuthRouter)
 
+	templateRouter := authRouter.PathPrefix("/templates").Subrouter()
+	templateIdAuthRouter := templateRouter.PathPrefix(fmt.Sprintf("/{template_id:%s}", constants.UUID_REGEX)).Subrouter()
+	templateIdAuthRouter.Use(middlewares.ExtractionTemplateIdMiddlewareFunc, middlewares.TemplateAccessMiddlewareFunc(s.templateService, s.transact))
+	s.registerTemplateIdAuthRoutes(templateIdAuthRouter)
+
+	templateListCreationRouter := templateIdAuthRouter.PathPrefix("/lists").Subrouter()
+	templateListCreationRouter.Use(middlewares.ObjectCreationMiddlewareFunc)
+	s.registerTemplateListCreationRoutes(templateListCreationRouter)
+
+	invitationRouter := authRouter.PathPrefix("/invitations").Subrouter()
+	s.registerInvitationPaths(invitationRouter)
+
+	sessionRouter := authRouter.PathPrefix("/sessions").Subrouter()
+	s.registerSessionPaths(sessionRouter)
+
 	userRouter := authRouter.PathPrefix("/users").Subrouter()
 
 	userIdReadRouter := userRouter.PathPrefix(fmt.Sprintf("/{user_id:%s}", constants.UUID_REGEX)).Subrouter()
@@ -326,6 +713,13 @@ func (s *server) Start() {
 	userIdAuthRouter.Use(middlewares.ExtractionUserIdMiddlewareFunc, middlewares.UserAccessMiddlewareFunc)
 	s.registerAuthUserIdRoutes(userIdAuthRouter)
 
+	userIdAdminRouter := userRouter.PathPrefix(fmt.Sprintf("/{user_id:%s}", constants.UUID_REGEX)).Subrouter()
+	userIdAdminRouter.Use(middlewares.ExtractionUserIdMiddlewareFunc, middlewares.GlobalAccessMiddlewareFunc)
+	s.registerAdminUserIdRoutes(userIdAdminRouter)
+
+	go s.trashPurger.Run(context.Background())
+	go s.revocationPruner.Run(context.Background())
+
 	port := fmt.Sprintf(":%s", s.configManger.RestConfig.Port)
 	log.Fatal(http.ListenAndServe(port, router))
 }
@@ -13,14 +13,18 @@ import (
 // config is implemented with singleton design pattern so we can share state!
 
 type Config struct {
-	DbConfig       databaseConfig
-	LogConfig      logConfig
-	RestConfig     restConfig
-	GraphConfig    graphQlServerConfig
-	OauthConfig    *oauth2.Config
-	JwtConfig      jwtConfig
-	ActivityConfig activityConfig
-	CorsConfig     corsConfig
+	DbConfig          databaseConfig
+	LogConfig         logConfig
+	RestConfig        restConfig
+	GraphConfig       graphQlServerConfig
+	OauthConfig       *oauth2.Config
+	OidcConfig        oidcConfig
+	RoleMappingConfig roleMappingConfig
+	JwtConfig         jwtConfig
+	ActivityConfig    activityConfig
+	CorsConfig        corsConfig
+	TrashConfig       trashConfig
+	InvitationConfig  invitationConfig
 }
 
 var (
@@ -72,6 +76,14 @@ func init() {
 		RedirectURL:  os.Getenv("CALLBACK_URL"),
 	}
 
+	if err = envconfig.Process("", &config.OidcConfig); err != nil {
+		panic(err)
+	}
+
+	if err = envconfig.Process("", &config.RoleMappingConfig); err != nil {
+		panic(err)
+	}
+
 	if err = envconfig.Process("", &config.JwtConfig); err != nil {
 		panic(err)
 	}
@@ -83,4 +95,12 @@ func init() {
 	if err = envconfig.Process("", &config.CorsConfig); err != nil {
 		panic(err)
 	}
+
+	if err = envconfig.Process("", &config.TrashConfig); err != nil {
+		panic(err)
+	}
+
+	if err = envconfig.Process("", &config.InvitationConfig); err != nil {
+		panic(err)
+	}
 }
@@ -0,0 +1,7 @@
+package configuration
+
+import "time"
+
+type invitationConfig struct {
+	Expiry time.Duration `envconfig:"INVITATION_EXPIRY" default:"168h"`
+}
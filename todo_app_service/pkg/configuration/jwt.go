@@ -1,5 +1,8 @@
 package configuration
 
+import "time"
+
 type jwtConfig struct {
-	Secret []byte `envconfig:"JWT_KEY"`
+	Secret                  []byte        `envconfig:"JWT_KEY"`
+	RevocationPruneInterval time.Duration `envconfig:"JWT_REVOCATION_PRUNE_INTERVAL" default:"1h"`
 }
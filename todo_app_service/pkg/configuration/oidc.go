@@ -0,0 +1,14 @@
+package configuration
+
+// oidcConfig configures the generic OpenID Connect login, it is disabled unless an issuer is set
+type oidcConfig struct {
+	IssuerUrl    string   `envconfig:"OIDC_ISSUER_URL"`
+	ClientId     string   `envconfig:"OIDC_CLIENT_ID"`
+	ClientSecret string   `envconfig:"OIDC_CLIENT_SECRET"`
+	CallbackUrl  string   `envconfig:"OIDC_CALLBACK_URL"`
+	Scopes       []string `envconfig:"OIDC_SCOPES" default:"openid,email"`
+}
+
+func (o oidcConfig) Enabled() bool {
+	return len(o.IssuerUrl) != 0
+}
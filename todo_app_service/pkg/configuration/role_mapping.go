@@ -0,0 +1,14 @@
+package configuration
+
+// roleMappingConfig maps github organizations, teams ("org/team-slug") and emails to application roles,
+// users matching nothing get the default role
+type roleMappingConfig struct {
+	AdminOrganizations  []string          `envconfig:"ROLE_ADMIN_ORGANIZATIONS"`
+	WriterOrganizations []string          `envconfig:"ROLE_WRITER_ORGANIZATIONS"`
+	ReaderOrganizations []string          `envconfig:"ROLE_READER_ORGANIZATIONS"`
+	AdminTeams          []string          `envconfig:"ROLE_ADMIN_TEAMS"`
+	WriterTeams         []string          `envconfig:"ROLE_WRITER_TEAMS"`
+	ReaderTeams         []string          `envconfig:"ROLE_READER_TEAMS"`
+	EmailOverrides      map[string]string `envconfig:"ROLE_EMAIL_OVERRIDES"`
+	DefaultRole         string            `envconfig:"ROLE_DEFAULT" default:"reader"`
+}
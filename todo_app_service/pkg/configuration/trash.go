@@ -0,0 +1,8 @@
+package configuration
+
+import "time"
+
+type trashConfig struct {
+	Retention     time.Duration `envconfig:"TRASH_RETENTION" default:"720h"`
+	PurgeInterval time.Duration `envconfig:"TRASH_PURGE_INTERVAL" default:"1h"`
+}
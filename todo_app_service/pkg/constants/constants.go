@@ -3,14 +3,23 @@ package constants
 type TodoStatus string
 type Priority string
 type UserRole string
+type CollaboratorRole string
+type InvitationStatus string
+type RecurrenceFrequency string
 
 const (
-	ListIdentifier       = "lists"
-	TodosIdentifier      = "todos"
-	UsersIdentifier      = "users"
-	ListsUsersIdentifier = "lists users"
-	UsersTodosIdentifier = "user todos"
-	UsersListsIdentifier = "user lists"
+	ListIdentifier           = "lists"
+	TodosIdentifier          = "todos"
+	UsersIdentifier          = "users"
+	ListsUsersIdentifier     = "lists users"
+	UsersTodosIdentifier     = "user todos"
+	UsersListsIdentifier     = "user lists"
+	LabelsIdentifier         = "labels"
+	TodosLabelsIdentifier    = "todo labels"
+	CommentsIdentifier       = "comments"
+	HistoryIdentifier        = "history"
+	TodosAssigneesIdentifier = "todo assignees"
+	TodosWatchersIdentifier  = "todo watchers"
 )
 
 // adapted
@@ -21,6 +30,12 @@ const (
 	ListsCollaboratorsTableName = "lists_collaborators"
 	UserListsTableName          = "lists_and_users"
 	UserTodosTableName          = "user_todos"
+	LabelsSQLTableName          = "labels"
+	TodosLabelsViewName         = "todos_labels"
+	CommentsSQLTableName        = "comments"
+	HistorySQLTableName         = "history"
+	TodosAssigneesViewName      = "todos_assignees"
+	TodosWatchersViewName       = "todos_watchers"
 )
 
 const (
@@ -29,6 +44,41 @@ const (
 	Reader UserRole = "reader"
 )
 
+const (
+	Viewer  CollaboratorRole = "viewer"
+	Editor  CollaboratorRole = "editor"
+	Manager CollaboratorRole = "manager"
+)
+
+const (
+	Pending  InvitationStatus = "pending"
+	Accepted InvitationStatus = "accepted"
+	Declined InvitationStatus = "declined"
+	Revoked  InvitationStatus = "revoked"
+)
+
+const (
+	Open       TodoStatus = "open"
+	InProgress TodoStatus = "in progress"
+	Done       TodoStatus = "done"
+)
+
+const (
+	VeryLow  Priority = "very low"
+	Low      Priority = "low"
+	Medium   Priority = "medium"
+	High     Priority = "high"
+	VeryHigh Priority = "very high"
+)
+
+const (
+	Daily   RecurrenceFrequency = "daily"
+	Weekly  RecurrenceFrequency = "weekly"
+	Monthly RecurrenceFrequency = "monthly"
+)
+
+const DEFAULT_RECURRENCE_INTERVAL = 1
+
 const CONTENT_TYPE = "application/json"
 
 const INVALID_REQUEST_BODY = "invalid request body"
@@ -37,8 +87,22 @@ const CONTEXT_NOT_CONTAINING_VALID_LIST_ID = "internal error: request context do
 const CONTEXT_NOT_CONTAINING_VALID_TODO_ID = "internal error: request context does not contain a valid todo ID"
 const CONTEXT_NOT_CONTAINING_VALID_USER = "internal error: request context does not contain a valid user"
 const CONTEXT_NOT_CONTAINING_VALID_USER_ID = "internal error: request context does not contain a valid user ID"
+const CONTEXT_NOT_CONTAINING_VALID_LABEL_ID = "internal error: request context does not contain a valid label ID"
+const CONTEXT_NOT_CONTAINING_VALID_COMMENT_ID = "internal error: request context does not contain a valid comment ID"
+const CONTEXT_NOT_CONTAINING_VALID_BLOCKER_ID = "internal error: request context does not contain a valid blocker ID"
+const CONTEXT_NOT_CONTAINING_VALID_INVITATION_ID = "internal error: request context does not contain a valid invitation ID"
+const CONTEXT_NOT_CONTAINING_VALID_TEMPLATE_ID = "internal error: request context does not contain a valid template ID"
+const CONTEXT_NOT_CONTAINING_VALID_COLUMN_ID = "internal error: request context does not contain a valid column ID"
+const CONTEXT_NOT_CONTAINING_VALID_WORK_LOG_ID = "internal error: request context does not contain a valid work log ID"
+const CONTEXT_NOT_CONTAINING_VALID_PROVIDER = "internal error: request context does not contain a valid oauth provider"
+const CONTEXT_NOT_CONTAINING_VALID_SESSION_ID = "internal error: request context does not contain a valid session ID"
 
 const MISSING_USER_ID = "internal error: missing user_id"
+const MISSING_SEARCH_QUERY = "missing search query"
+const INVALID_REASSIGN_LISTS_TO = "reassign_lists_to must be a valid user ID"
+const ONLY_ADMINS_CAN_REASSIGN_LISTS = "only admins can reassign the lists of a user"
+const INVALID_FIRST_VALUE = "first must be a positive number"
+const INVALID_WORK_LOG_PERIOD = "from and to must be dates in the format YYYY-MM-DD and from can't be after to"
 
 const STATUS = "status"
 const PRIORITY = "priority"
@@ -50,12 +114,32 @@ const ID = "id"
 const LAST = "last"
 const BEFORE = "before"
 const ORDER_BY = "order by"
+const SORT = "sort"
+const CREATED_AT = "created_at"
+const LAST_UPDATED = "last_updated"
+const DUE_DATE = "due_date"
+const POSITION = "position"
 const LIMIT = "limit"
+const FROM = "from"
+const TO = "to"
 
 const LIST_TARGET = "list"
 const USER_TARGET = "user"
 const TODO_TARGET = "todo"
 const REFRESH_TARGET = "refresh token"
+const LABEL_TARGET = "label"
+const COMMENT_TARGET = "comment"
+const DEPENDENCY_TARGET = "todo dependency"
+const INVITATION_TARGET = "invitation"
+const COLLABORATOR_TARGET = "collaborator"
+const TEMPLATE_TARGET = "template"
+const COLUMN_TARGET = "column"
+const ASSIGNEE_TARGET = "assignee"
+const WATCHER_TARGET = "watcher"
+const WORK_LOG_TARGET = "work log"
+const OAUTH_PROVIDER_TARGET = "oauth provider"
+const MEMBERSHIPS_TARGET = "memberships"
+const SESSION_TARGET = "session"
 
 const JSON_FORMAT = "json"
 const TEXT_FORMAT = "text"
@@ -64,10 +148,6 @@ const REQUEST_ID = "request_id"
 
 const DEFAULT_LIMIT_VALUE = "100"
 
-const ADMIN_ORG = "Admins"
-const READER_ORG = "Readers"
-const WRITER_ORG = "Writers"
-
 const UUID_REGEX = "[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}"
 
 const GOROUTINES_COUNT = 2
@@ -83,12 +163,39 @@ const ORDER_BY_PRIORITY = 18
 const ASC_ORDER = "ASC"
 const DESC_ORDER = "DESC"
 
+const SORT_DIRECTION_SEPARATOR = ":"
+const CURSOR_TIME_LAYOUT = "2006-01-02T15:04:05.999999"
+const INFINITY_TIMESTAMP = "infinity"
+const DATE_LAYOUT = "2006-01-02"
+
 const OWNER_ROLE = "owner"
 const PARTICIPANT_ROLE = "participant"
 
 const ROLE = "role"
 
 const OVERDUE = "overdue"
+const LABEL = "label"
+
+const SEARCH_QUERY = "q"
+const TODO_SEARCH_RESULT = "todo"
+const LIST_SEARCH_RESULT = "list"
+
+const TODO_HISTORY_ENTITY = "todo"
+const LIST_HISTORY_ENTITY = "list"
+
+const TODO_TRASH_ITEM = "todo"
+const LIST_TRASH_ITEM = "list"
+
+const EXCLUDE_SUBTASKS = "exclude_subtasks"
+const CASCADE = "cascade"
+const REASSIGN_LISTS_TO = "reassign_lists_to"
+
+const BATCH_CREATE_OPERATION = "create"
+const BATCH_UPDATE_OPERATION = "update"
+const BATCH_DELETE_OPERATION = "delete"
+
+const ALL_OR_NOTHING_BATCH_MODE = "all_or_nothing"
+const PER_ITEM_BATCH_MODE = "per_item"
 
 const TRUE_VALUE = "true"
 const FALSE_VALUE = "false"
@@ -100,3 +207,22 @@ const READY_STATUS = "ready"
 const OK_STATUS = "ok"
 
 const HTTP_COOKIES_MAX_AGE = 15552000
+
+const GITHUB_PROVIDER = "github"
+const OIDC_PROVIDER = "oidc"
+
+const DEVICE_LABEL = "device"
+const MAX_DEVICE_LABEL_LENGTH = 100
+const OAUTH_DEVICE_COOKIE = "oauth_device"
+const OAUTH_STATE_COOKIE = "oauth_state"
+const OAUTH_NONCE_COOKIE = "oauth_nonce"
+const OAUTH_NONCE_PARAM = "nonce"
+
+const REFRESH_TOKEN_LIFETIME_IN_HOURS = 150
+
+const SECURITY_EVENT = "security_event"
+const REFRESH_TOKEN_REUSE_EVENT = "refresh_token_reuse"
+
+const REVOKED_ACCESS_TOKEN = "token has been revoked"
+const ACCESS_TOKEN_COOKIE = "access-token"
+const REFRESH_TOKEN_COOKIE = "refresh-token"
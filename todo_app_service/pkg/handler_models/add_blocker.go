@@ -0,0 +1,5 @@
+package handler_models
+
+type AddBlocker struct {
+	BlockerId string `json:"blocker_id" validate:"required,uuid"`
+}
@@ -1,5 +1,8 @@
 package handler_models
 
+import "Todo-List/internProject/todo_app_service/pkg/constants"
+
 type AddCollaborator struct {
-	Email string `json:"email" validate:"required"`
+	Email string                      `json:"email" validate:"required"`
+	Role  *constants.CollaboratorRole `json:"role,omitempty" validate:"omitempty,collaborator_role"`
 }
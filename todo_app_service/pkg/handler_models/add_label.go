@@ -0,0 +1,5 @@
+package handler_models
+
+type AddLabel struct {
+	LabelId string `json:"label_id" validate:"required,uuid"`
+}
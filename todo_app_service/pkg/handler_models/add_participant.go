@@ -0,0 +1,5 @@
+package handler_models
+
+type AddParticipant struct {
+	UserId string `json:"user_id" validate:"required,uuid"`
+}
@@ -0,0 +1,13 @@
+package handler_models
+
+type BatchTodoOperation struct {
+	Op     string      `json:"op" validate:"required,oneof=create update delete"`
+	TodoId string      `json:"todo_id,omitempty" validate:"required_unless=Op create,omitempty,uuid"`
+	Create *CreateTodo `json:"create,omitempty" validate:"required_if=Op create"`
+	Update *UpdateTodo `json:"update,omitempty" validate:"required_if=Op update"`
+}
+
+type BatchTodos struct {
+	Mode       string               `json:"mode,omitempty" validate:"omitempty,oneof=all_or_nothing per_item"`
+	Operations []BatchTodoOperation `json:"operations" validate:"required,min=1,max=100,dive"`
+}
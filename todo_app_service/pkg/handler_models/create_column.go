@@ -0,0 +1,9 @@
+package handler_models
+
+import "Todo-List/internProject/todo_app_service/pkg/constants"
+
+type CreateColumn struct {
+	Name     string               `json:"name" validate:"required,max=100"`
+	Status   constants.TodoStatus `json:"status" validate:"required,oneof=open 'in progress' done"`
+	WipLimit *int                 `json:"wip_limit,omitempty" validate:"omitempty,min=1"`
+}
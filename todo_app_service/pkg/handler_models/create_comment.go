@@ -0,0 +1,5 @@
+package handler_models
+
+type CreateComment struct {
+	Content string `json:"content" validate:"required"`
+}
@@ -0,0 +1,8 @@
+package handler_models
+
+import "Todo-List/internProject/todo_app_service/pkg/constants"
+
+type CreateInvitation struct {
+	Email string                      `json:"email" validate:"required,email"`
+	Role  *constants.CollaboratorRole `json:"role,omitempty" validate:"omitempty,collaborator_role"`
+}
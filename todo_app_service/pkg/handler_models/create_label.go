@@ -0,0 +1,5 @@
+package handler_models
+
+type CreateLabel struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
@@ -0,0 +1,9 @@
+package handler_models
+
+import "time"
+
+type CreateListFromTemplate struct {
+	Name        string     `json:"name" validate:"required"`
+	Description *string    `json:"description,omitempty" validate:"omitempty,min=1"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+}
@@ -0,0 +1,6 @@
+package handler_models
+
+type CreateTemplate struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,min=1"`
+	Description *string `json:"description,omitempty" validate:"omitempty,min=1"`
+}
@@ -11,5 +11,7 @@ type CreateTodo struct {
 	ListId      string             `json:"list_id" validate:"required"`
 	Priority    constants.Priority `json:"priority" validate:"required"`
 	AssignedTo  *string            `json:"assigned_to,omitempty" validate:"omitempty,min=1"`
-	DueDate     *time.Time         `json:"due_date,omitempty" validate:"omitempty,gte"`
+	DueDate     *time.Time         `json:"due_date,omitempty" validate:"required_with=Recurrence,omitempty,gte"`
+	Recurrence  *Recurrence        `json:"recurrence,omitempty" validate:"omitempty"`
+	Estimate    *int               `json:"estimate,omitempty" validate:"omitempty,min=1"`
 }
@@ -0,0 +1,10 @@
+package handler_models
+
+import "time"
+
+// CreateWorkLog logs work in minutes, work logged without a date is logged on the current day
+type CreateWorkLog struct {
+	Duration int        `json:"duration" validate:"required,min=1"`
+	Note     string     `json:"note" validate:"max=1000"`
+	LoggedOn *time.Time `json:"logged_on,omitempty" validate:"omitempty,lte"`
+}
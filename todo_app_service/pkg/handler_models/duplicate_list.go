@@ -0,0 +1,10 @@
+package handler_models
+
+import "time"
+
+type DuplicateList struct {
+	Name                 string     `json:"name" validate:"required"`
+	Description          *string    `json:"description,omitempty" validate:"omitempty,min=1"`
+	IncludeCollaborators bool       `json:"include_collaborators"`
+	StartDate            *time.Time `json:"start_date,omitempty"`
+}
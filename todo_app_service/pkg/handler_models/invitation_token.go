@@ -0,0 +1,5 @@
+package handler_models
+
+type InvitationToken struct {
+	Token string `json:"token" validate:"required"`
+}
@@ -0,0 +1,13 @@
+package handler_models
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"time"
+)
+
+type Recurrence struct {
+	Frequency constants.RecurrenceFrequency `json:"frequency" validate:"required,recurrence_frequency"`
+	Interval  int                           `json:"interval,omitempty" validate:"omitempty,min=1"`
+	Until     *time.Time                    `json:"until,omitempty" validate:"omitempty,gte"`
+	Count     *int                          `json:"count,omitempty" validate:"omitempty,min=1,excluded_with=Until"`
+}
@@ -0,0 +1,7 @@
+package handler_models
+
+// ReorderTodo places the todo right before or right after another todo of the same list, exactly one of them has to be set
+type ReorderTodo struct {
+	Before *string `json:"before,omitempty" validate:"required_without=After,excluded_with=After,omitempty,uuid"`
+	After  *string `json:"after,omitempty" validate:"required_without=Before,omitempty,uuid"`
+}
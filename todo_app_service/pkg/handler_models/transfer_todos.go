@@ -0,0 +1,10 @@
+package handler_models
+
+type TransferTodo struct {
+	ListId string `json:"list_id" validate:"required,uuid"`
+}
+
+type TransferTodos struct {
+	TodoIds []string `json:"todo_ids" validate:"required,min=1,dive,uuid"`
+	ListId  string   `json:"list_id" validate:"required,uuid"`
+}
@@ -0,0 +1,9 @@
+package handler_models
+
+// UpdateColumn changes the column, a wip limit of zero removes the limit of the column,
+// the status of a column can't be changed because it is the status of all the todos in it
+type UpdateColumn struct {
+	Name     *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	WipLimit *int    `json:"wip_limit,omitempty" validate:"omitempty,min=0"`
+	Position *int    `json:"position,omitempty" validate:"omitempty,min=1"`
+}
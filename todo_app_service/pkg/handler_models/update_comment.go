@@ -0,0 +1,5 @@
+package handler_models
+
+type UpdateComment struct {
+	Content *string `json:"content,omitempty" validate:"omitempty,min=1"`
+}
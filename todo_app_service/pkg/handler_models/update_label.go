@@ -0,0 +1,5 @@
+package handler_models
+
+type UpdateLabel struct {
+	Name *string `json:"name,omitempty" validate:"omitempty,min=1,max=50"`
+}
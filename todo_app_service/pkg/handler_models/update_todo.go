@@ -5,6 +5,7 @@ import (
 	"time"
 )
 
+// UpdateTodo changes the todo, an estimate of zero removes the estimate of the todo
 type UpdateTodo struct {
 	Name        *string               `json:"name" validate:"omitempty,min=1"`
 	Description *string               `json:"description,omitempty" validate:"omitempty,min=1"`
@@ -12,4 +13,7 @@ type UpdateTodo struct {
 	Priority    *constants.Priority   `json:"priority,omitempty" validate:"omitempty,min=1"`
 	AssignedTo  *string               `json:"assigned_to,omitempty" validate:"omitempty,min=1"`
 	DueDate     *time.Time            `json:"due_date,omitempty" validate:"omitempty,gte"`
+	Recurrence  *Recurrence           `json:"recurrence,omitempty" validate:"omitempty"`
+	ColumnId    *string               `json:"column_id,omitempty" validate:"omitempty,uuid"`
+	Estimate    *int                  `json:"estimate,omitempty" validate:"omitempty,min=0"`
 }
@@ -0,0 +1,9 @@
+package handler_models
+
+import "time"
+
+type UpdateWorkLog struct {
+	Duration *int       `json:"duration,omitempty" validate:"omitempty,min=1"`
+	Note     *string    `json:"note,omitempty" validate:"omitempty,max=1000"`
+	LoggedOn *time.Time `json:"logged_on,omitempty" validate:"omitempty,lte"`
+}
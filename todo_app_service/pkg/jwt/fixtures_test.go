@@ -2,7 +2,7 @@ package jwt_test
 
 import (
 	"Todo-List/internProject/todo_app_service/pkg/constants"
-	"Todo-List/internProject/todo_app_service/pkg/tokens"
+	tokens "Todo-List/internProject/todo_app_service/pkg/jwt"
 	"errors"
 	"github.com/gofrs/uuid"
 	"github.com/golang-jwt/jwt/v5"
@@ -12,6 +12,8 @@ import (
 const (
 	SIGNED_JWT_STRING = "signed jwt string"
 	EMAIL             = "email@email.com"
+	REFRESH_TOKEN     = "refresh token"
+	ACCESS_TOKEN_ID   = "access token id"
 )
 
 var (
@@ -22,10 +24,13 @@ var (
 	errInvalidSigningMethod = errors.New("unexpected signing method")
 	errInvalidToken         = errors.New("token is no longer valid")
 	errTokenExpired         = errors.New("token expired")
+	errWhenRevokingToken    = errors.New("error when revoking access token")
+	errWhenRevokingSession  = errors.New("error when revoking session")
+	sessionId               = uuid.FromBytesOrNil([]byte("valid session id"))
 )
 
-func initClaims() *jwt.Claims {
-	return &jwt.Claims{
+func initClaims() *tokens.Claims {
+	return &tokens.Claims{
 		UserId: validUserId.String(),
 		Email:  EMAIL,
 		Role:   string(constants.Admin),
@@ -36,7 +41,15 @@ func initClaims() *jwt.Claims {
 	}
 }
 
-func initJWT(claims *jwt.Claims, method jwt.SigningMethod, valid bool) *jwt.Token {
+func initSessionClaims() *tokens.Claims {
+	claims := initClaims()
+	claims.ID = ACCESS_TOKEN_ID
+	claims.SessionId = sessionId.String()
+
+	return claims
+}
+
+func initJWT(claims *tokens.Claims, method jwt.SigningMethod, valid bool) *jwt.Token {
 	return &jwt.Token{
 		Claims: claims,
 		Method: method,
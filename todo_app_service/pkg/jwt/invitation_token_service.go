@@ -0,0 +1,73 @@
+package jwt
+
+import (
+	"Todo-List/internProject/todo_app_service/internal/application_errors"
+	"Todo-List/internProject/todo_app_service/internal/utils"
+	log "Todo-List/internProject/todo_app_service/pkg/configuration"
+	"context"
+	"github.com/golang-jwt/jwt/v5"
+	"time"
+)
+
+type invitationParser interface {
+	ParseInvitationWithClaims(string, *InvitationClaims) (*jwt.Token, *InvitationClaims, error)
+}
+
+// invitationTokenService signs the tokens that are handed out with the collaborator invitations,
+// so that an invitation can't be forged or used after it has expired
+type invitationTokenService struct {
+	getter    jwtGetter
+	parser    invitationParser
+	timeGen   timeGenerator
+	jwtSecret []byte
+}
+
+func NewInvitationTokenService(getter jwtGetter, parser invitationParser, timeGen timeGenerator, jwtSecret []byte) *invitationTokenService {
+	return &invitationTokenService{
+		getter:    getter,
+		parser:    parser,
+		timeGen:   timeGen,
+		jwtSecret: jwtSecret,
+	}
+}
+
+func (i *invitationTokenService) GenerateInvitationToken(ctx context.Context, invitationId string, expiresAt time.Time) (string, error) {
+	log.C(ctx).Infof("generating token for invitation with id %s", invitationId)
+
+	claims := &InvitationClaims{
+		InvitationId: invitationId,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(i.timeGen.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	invitationToken := i.getter.GetJWTWithClaims(jwt.SigningMethodHS256, claims)
+
+	signedToken, err := i.getter.GetSignedJWT(invitationToken, i.jwtSecret)
+	if err != nil {
+		log.C(ctx).Errorf("failed to sign invitation token, error %s", err.Error())
+		return "", utils.DetermineErrorWhenSigningJWT(err)
+	}
+
+	return signedToken, nil
+}
+
+// ParseInvitationToken returns the claims of a valid invitation token, every malformed, forged or expired
+// token is reported as an invalid invitation
+func (i *invitationTokenService) ParseInvitationToken(ctx context.Context, tokenString string) (*InvitationClaims, error) {
+	log.C(ctx).Info("parsing invitation token")
+
+	token, claims, err := i.parser.ParseInvitationWithClaims(tokenString, &InvitationClaims{})
+	if err != nil {
+		log.C(ctx).Errorf("failed to parse invitation token, error %s", err.Error())
+		return nil, application_errors.InvalidInvitationError
+	}
+
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || !token.Valid {
+		log.C(ctx).Error("the parsed invitation token is not valid")
+		return nil, application_errors.InvalidInvitationError
+	}
+
+	return claims, nil
+}
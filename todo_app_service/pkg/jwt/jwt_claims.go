@@ -3,12 +3,18 @@ package jwt
 import "github.com/golang-jwt/jwt/v5"
 
 type Claims struct {
-	UserId string `json:"id"`
-	Email  string `json:"email"`
-	Role   string `json:"role"`
+	UserId    string `json:"id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	SessionId string `json:"sid"`
 	jwt.RegisteredClaims
 }
 
 type RefreshClaims struct {
 	jwt.RegisteredClaims
 }
+
+type InvitationClaims struct {
+	InvitationId string `json:"invitation_id"`
+	jwt.RegisteredClaims
+}
@@ -19,10 +19,18 @@ type userService interface {
 	UpdateUserRecordPartially(context.Context, string, *handler_models.UpdateUser) (*models.User, error)
 }
 
+type invitationAcceptor interface {
+	AcceptPendingInvitations(ctx context.Context, user *models.User) error
+}
+
 type timeGenerator interface {
 	Now() time.Time
 }
 
+type uuidGenerator interface {
+	Generate() string
+}
+
 //go:generate mockery --name=jwtGetter --exported --output=./mocks --outpkg=mocks --filename=jwt_getter.go --with-expecter=true
 type jwtGetter interface {
 	GetJWTWithClaims(method jwt.SigningMethod, claims jwt.Claims) *jwt.Token
@@ -31,21 +39,26 @@ type jwtGetter interface {
 
 type jwtCreationService struct {
 	uService  userService
+	iAcceptor invitationAcceptor
 	timeGen   timeGenerator
+	uuidGen   uuidGenerator
 	getter    jwtGetter
 	jwtSecret []byte
 }
 
-func NewJwtService(uService userService, timeGen timeGenerator, getter jwtGetter, jwtSecret []byte) *jwtCreationService {
+func NewJwtService(uService userService, iAcceptor invitationAcceptor, timeGen timeGenerator, uuidGen uuidGenerator, getter jwtGetter, jwtSecret []byte) *jwtCreationService {
 	return &jwtCreationService{
 		uService:  uService,
+		iAcceptor: iAcceptor,
 		timeGen:   timeGen,
+		uuidGen:   uuidGen,
 		getter:    getter,
 		jwtSecret: jwtSecret,
 	}
 }
 
-func (j *jwtCreationService) GenerateJWT(ctx context.Context, email string, role string) (string, error) {
+// GenerateJWT returns the signed access token together with its claims, the id of the token is used to revoke it
+func (j *jwtCreationService) GenerateJWT(ctx context.Context, email string, role string, sessionId string) (string, *Claims, error) {
 	log.C(ctx).Info("generating jwt token in jwt service")
 
 	user, err := j.uService.GetUserRecordByEmail(ctx, email)
@@ -59,10 +72,16 @@ func (j *jwtCreationService) GenerateJWT(ctx context.Context, email string, role
 
 			if err != nil {
 				log.C(ctx).Errorf("failed to generate JWT, error %s when trying to create unregistred user", err.Error())
-				return "", err
+				return "", nil, err
+			}
+
+			// the user could have been invited to lists before logging in for the first time
+			if err = j.iAcceptor.AcceptPendingInvitations(ctx, user); err != nil {
+				log.C(ctx).Errorf("failed to generate JWT, error %s when trying to accept the invitations of the new user", err.Error())
+				return "", nil, err
 			}
 		} else {
-			return "", err
+			return "", nil, err
 		}
 	}
 
@@ -71,17 +90,19 @@ func (j *jwtCreationService) GenerateJWT(ctx context.Context, email string, role
 			Role: &role,
 		}); err != nil {
 			log.C(ctx).Errorf("failed to update user role, error %s", err.Error())
-			return "", err
+			return "", nil, err
 		}
 	}
 
 	expirationTime := determineTokenExpirationTimeBasedOnUserRole(j.timeGen, user)
 
 	claims := &Claims{
-		UserId: user.Id,
-		Email:  email,
-		Role:   role,
+		UserId:    user.Id,
+		Email:     email,
+		Role:      role,
+		SessionId: sessionId,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        j.uuidGen.Generate(),
 			IssuedAt:  jwt.NewNumericDate(j.timeGen.Now()),
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
@@ -92,19 +113,21 @@ func (j *jwtCreationService) GenerateJWT(ctx context.Context, email string, role
 	stringToken, err := j.getter.GetSignedJWT(jwtToken, j.jwtSecret)
 	if err != nil {
 		log.C(ctx).Errorf("failed to sign jwt, error %s", err.Error())
-		return "", err
+		return "", nil, err
 	}
 
-	return stringToken, nil
+	return stringToken, claims, nil
 }
 
 func (j *jwtCreationService) GenerateRefreshToken(ctx context.Context) (string, error) {
 	log.C(ctx).Info("generating refresh token")
 
-	expirationTime := j.timeGen.Now().Add(150 * time.Hour)
+	expirationTime := j.timeGen.Now().Add(constants.REFRESH_TOKEN_LIFETIME_IN_HOURS * time.Hour)
 
+	// the random id keeps refresh tokens issued in the same second apart, only their digests are stored
 	claims := &RefreshClaims{
 		jwt.RegisteredClaims{
+			ID:        j.uuidGen.Generate(),
 			IssuedAt:  jwt.NewNumericDate(j.timeGen.Now()),
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
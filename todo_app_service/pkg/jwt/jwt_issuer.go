@@ -5,53 +5,77 @@ import (
 	"Todo-List/internProject/todo_app_service/pkg/handler_models"
 	"Todo-List/internProject/todo_app_service/pkg/models"
 	"context"
+	"time"
 )
 
 type jwtBuilder interface {
-	GenerateJWT(context.Context, string, string) (string, error)
+	GenerateJWT(context.Context, string, string, string) (string, *Claims, error)
 	GenerateRefreshToken(context.Context) (string, error)
 }
 
-type refreshTokenService interface {
-	UpsertRefreshToken(ctx context.Context, refresh *models.Refresh, userEmail string) error
-	UpdateRefreshToken(ctx context.Context, refreshToken string, userId string) (*models.Refresh, error)
+//go:generate mockery --name=sessionService --exported --output=./mocks --outpkg=mocks --filename=session_service.go --with-expecter=true
+type sessionService interface {
+	CreateSession(ctx context.Context, session *models.Session, refreshToken string) (*models.Session, error)
+	GetSession(ctx context.Context, refreshToken string) (*models.Session, error)
+	RotateSessionToken(ctx context.Context, session *models.Session, oldRefreshToken string, newRefreshToken string, device *models.SessionDevice) error
 	GetTokenOwner(context.Context, string) (*models.User, error)
+	RevokeSession(ctx context.Context, sessionId string) error
+	RevokeRefreshTokenSession(ctx context.Context, refreshToken string) error
+}
+
+//go:generate mockery --name=accessTokenParser --exported --output=./mocks --outpkg=mocks --filename=access_token_parser.go --with-expecter=true
+type accessTokenParser interface {
+	ParseJWT(context.Context, string) (*Claims, error)
+}
+
+//go:generate mockery --name=accessTokenRevoker --exported --output=./mocks --outpkg=mocks --filename=access_token_revoker.go --with-expecter=true
+type accessTokenRevoker interface {
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
 }
 
 type emailSearcher interface {
 	GetUserRecordByEmail(context.Context, string) (*models.User, error)
 }
 
-type userInfoAggregator interface {
-	AggregateUserInfo(context.Context, string) (string, string, error)
+type roleResolver interface {
+	DetermineUserRole(ctx context.Context, identity *models.UserIdentity) string
+	SaveUserMemberships(ctx context.Context, userId string, memberships *models.Memberships) error
+	ReevaluateUserRole(ctx context.Context, user *models.User) (string, error)
 }
 
 type jwtIssuer struct {
 	builder      jwtBuilder
-	aggregator   userInfoAggregator
-	service      refreshTokenService
+	service      sessionService
 	userSearcher emailSearcher
+	roles        roleResolver
+	parser       accessTokenParser
+	revoker      accessTokenRevoker
+	uuidGen      uuidGenerator
 }
 
-func NewJwtIssuer(builder jwtBuilder, aggregator userInfoAggregator, service refreshTokenService, userSearcher emailSearcher) *jwtIssuer {
+func NewJwtIssuer(builder jwtBuilder, service sessionService, userSearcher emailSearcher, roles roleResolver, parser accessTokenParser,
+	revoker accessTokenRevoker, uuidGen uuidGenerator) *jwtIssuer {
 	return &jwtIssuer{
 		builder:      builder,
-		aggregator:   aggregator,
 		service:      service,
 		userSearcher: userSearcher,
+		roles:        roles,
+		parser:       parser,
+		revoker:      revoker,
+		uuidGen:      uuidGen,
 	}
 }
 
-func (j *jwtIssuer) GetTokens(ctx context.Context, accessToken string) (*models.CallbackResponse, error) {
+// GetTokens opens a new session for the device, the sessions of the user on other devices stay valid
+func (j *jwtIssuer) GetTokens(ctx context.Context, identity *models.UserIdentity, device *models.SessionDevice) (*models.CallbackResponse, error) {
 	log.C(ctx).Info("getting jwt token in oauth service")
 
-	email, role, err := j.aggregator.AggregateUserInfo(ctx, accessToken)
-	if err != nil {
-		log.C(ctx).Errorf("failed to aggregate user info in jwt issuer, error %s", err.Error())
-		return nil, err
-	}
+	role := j.roles.DetermineUserRole(ctx, identity)
+
+	// the id of the session is part of the access token, so it is known before the session is stored
+	sessionId := j.uuidGen.Generate()
 
-	jwtToken, err := j.builder.GenerateJWT(ctx, email, role)
+	jwtToken, claims, err := j.builder.GenerateJWT(ctx, identity.Email, role, sessionId)
 	if err != nil {
 		log.C(ctx).Errorf("failed to create jwt token, error %s when generating...", err.Error())
 		return nil, err
@@ -63,17 +87,26 @@ func (j *jwtIssuer) GetTokens(ctx context.Context, accessToken string) (*models.
 		return nil, err
 	}
 
-	user, err := j.userSearcher.GetUserRecordByEmail(ctx, email)
+	user, err := j.userSearcher.GetUserRecordByEmail(ctx, identity.Email)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get user record by email when trying to get api tokens, error %s", err.Error())
 		return nil, err
 	}
 
-	if err = j.service.UpsertRefreshToken(ctx, &models.Refresh{
-		RefreshToken: refreshToken,
-		UserId:       user.Id,
-	}, user.Email); err != nil {
-		log.C(ctx).Errorf("failed to upsert refresh token im jwt issuer, error %s", err.Error())
+	if err = j.roles.SaveUserMemberships(ctx, user.Id, &identity.Memberships); err != nil {
+		log.C(ctx).Errorf("failed to save memberships of user in jwt issuer, error %s", err.Error())
+		return nil, err
+	}
+
+	if _, err = j.service.CreateSession(ctx, &models.Session{
+		Id:          sessionId,
+		UserId:      user.Id,
+		DeviceLabel: device.Label,
+		UserAgent:   device.UserAgent,
+		IpAddress:   device.IpAddress,
+		AccessToken: accessTokenInfo(claims),
+	}, refreshToken); err != nil {
+		log.C(ctx).Errorf("failed to create session in jwt issuer, error %s", err.Error())
 		return nil, err
 	}
 
@@ -84,16 +117,31 @@ func (j *jwtIssuer) GetTokens(ctx context.Context, accessToken string) (*models.
 
 }
 
-func (j *jwtIssuer) GetRenewedTokens(ctx context.Context, refresh *handler_models.Refresh) (*models.CallbackResponse, error) {
+// GetRenewedTokens rotates the refresh token of the session it belongs to, other sessions of the user are not affected.
+// Presenting a refresh token which has already been rotated revokes its session.
+func (j *jwtIssuer) GetRenewedTokens(ctx context.Context, refresh *handler_models.Refresh, device *models.SessionDevice) (*models.CallbackResponse, error) {
 	log.C(ctx).Info("renewing refresh and jwt in oauth service")
 
+	session, err := j.service.GetSession(ctx, refresh.RefreshToken)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get renewed tokens, error %s when trying to get the session of the refresh token", err.Error())
+		return nil, err
+	}
+
 	tokenOwner, err := j.service.GetTokenOwner(ctx, refresh.RefreshToken)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get renewed tokens, error %s when trying to get token owner", err.Error())
 		return nil, err
 	}
 
-	refreshedJwtToken, err := j.builder.GenerateJWT(ctx, tokenOwner.Email, string(tokenOwner.Role))
+	// changes of the role mapping take effect on renewal, not only on the next interactive login
+	role, err := j.roles.ReevaluateUserRole(ctx, tokenOwner)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get renewed tokens, error %s when trying to reevaluate user role", err.Error())
+		return nil, err
+	}
+
+	refreshedJwtToken, claims, err := j.builder.GenerateJWT(ctx, tokenOwner.Email, role, session.Id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to get renewed tokens, error %s when trying to generate new jwt token", err.Error())
 		return nil, err
@@ -105,8 +153,9 @@ func (j *jwtIssuer) GetRenewedTokens(ctx context.Context, refresh *handler_model
 		return nil, err
 	}
 
-	if _, err = j.service.UpdateRefreshToken(ctx, newRefreshToken, tokenOwner.Id); err != nil {
-		log.C(ctx).Errorf("failed to get renewed token, error %s when trying to update refresh token", err.Error())
+	session.AccessToken = accessTokenInfo(claims)
+	if err = j.service.RotateSessionToken(ctx, session, refresh.RefreshToken, newRefreshToken, device); err != nil {
+		log.C(ctx).Errorf("failed to get renewed token, error %s when trying to rotate the refresh token of the session", err.Error())
 		return nil, err
 	}
 
@@ -115,3 +164,46 @@ func (j *jwtIssuer) GetRenewedTokens(ctx context.Context, refresh *handler_model
 		RefreshToken: newRefreshToken,
 	}, nil
 }
+
+// RevokeTokens logs out the session of the tokens, the access token is rejected until it expires and the session
+// can no longer be renewed. Tokens which are invalid or expired have nothing left to revoke and are skipped.
+func (j *jwtIssuer) RevokeTokens(ctx context.Context, accessToken string, refreshToken string) error {
+	log.C(ctx).Info("revoking tokens in jwt issuer")
+
+	if accessToken != "" {
+		claims, err := j.parser.ParseJWT(ctx, accessToken)
+		if err != nil {
+			log.C(ctx).Infof("access token is not valid anymore, error %s, skipping its revocation", err.Error())
+		} else {
+			if claims.ExpiresAt != nil {
+				if err = j.revoker.RevokeAccessToken(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+					log.C(ctx).Errorf("failed to revoke access token, error %s", err.Error())
+					return err
+				}
+			}
+
+			if claims.SessionId != "" {
+				if err = j.service.RevokeSession(ctx, claims.SessionId); err != nil {
+					log.C(ctx).Errorf("failed to revoke session of access token, error %s", err.Error())
+					return err
+				}
+			}
+		}
+	}
+
+	if refreshToken != "" {
+		if err := j.service.RevokeRefreshTokenSession(ctx, refreshToken); err != nil {
+			log.C(ctx).Errorf("failed to revoke session of refresh token, error %s", err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+func accessTokenInfo(claims *Claims) *models.AccessTokenInfo {
+	return &models.AccessTokenInfo{
+		Id:        claims.ID,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}
+}
@@ -0,0 +1,176 @@
+package jwt_test
+
+import (
+	tokens "Todo-List/internProject/todo_app_service/pkg/jwt"
+	"Todo-List/internProject/todo_app_service/pkg/jwt/mocks"
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestJwtIssuer_RevokeTokens(t *testing.T) {
+	tests := []struct {
+		testName     string
+		accessToken  string
+		refreshToken string
+		parserMock   func() *mocks.AccessTokenParser
+		revokerMock  func() *mocks.AccessTokenRevoker
+		serviceMock  func() *mocks.SessionService
+		err          error
+	}{
+		{
+			testName: "Successfully revoking the access token and its session",
+
+			accessToken: SIGNED_JWT_STRING,
+
+			parserMock: func() *mocks.AccessTokenParser {
+				mck := &mocks.AccessTokenParser{}
+
+				mck.EXPECT().
+					ParseJWT(context.TODO(), SIGNED_JWT_STRING).
+					Return(initSessionClaims(), nil).
+					Once()
+
+				return mck
+			},
+
+			revokerMock: func() *mocks.AccessTokenRevoker {
+				mck := &mocks.AccessTokenRevoker{}
+
+				mck.EXPECT().
+					RevokeAccessToken(context.TODO(), ACCESS_TOKEN_ID, initSessionClaims().ExpiresAt.Time).
+					Return(nil).
+					Once()
+
+				return mck
+			},
+
+			serviceMock: func() *mocks.SessionService {
+				mck := &mocks.SessionService{}
+
+				mck.EXPECT().
+					RevokeSession(context.TODO(), sessionId.String()).
+					Return(nil).
+					Once()
+
+				return mck
+			},
+		},
+
+		{
+			testName: "Successfully revoking the session of the refresh token when the access token is no longer valid",
+
+			accessToken: SIGNED_JWT_STRING,
+
+			refreshToken: REFRESH_TOKEN,
+
+			parserMock: func() *mocks.AccessTokenParser {
+				mck := &mocks.AccessTokenParser{}
+
+				mck.EXPECT().
+					ParseJWT(context.TODO(), SIGNED_JWT_STRING).
+					Return(nil, errTokenExpired).
+					Once()
+
+				return mck
+			},
+
+			serviceMock: func() *mocks.SessionService {
+				mck := &mocks.SessionService{}
+
+				mck.EXPECT().
+					RevokeRefreshTokenSession(context.TODO(), REFRESH_TOKEN).
+					Return(nil).
+					Once()
+
+				return mck
+			},
+		},
+
+		{
+			testName: "Successfully doing nothing when there are no tokens to revoke",
+		},
+
+		{
+			testName: "Failed to revoke tokens, error when revoking the access token",
+
+			accessToken: SIGNED_JWT_STRING,
+
+			refreshToken: REFRESH_TOKEN,
+
+			parserMock: func() *mocks.AccessTokenParser {
+				mck := &mocks.AccessTokenParser{}
+
+				mck.EXPECT().
+					ParseJWT(context.TODO(), SIGNED_JWT_STRING).
+					Return(initSessionClaims(), nil).
+					Once()
+
+				return mck
+			},
+
+			revokerMock: func() *mocks.AccessTokenRevoker {
+				mck := &mocks.AccessTokenRevoker{}
+
+				mck.EXPECT().
+					RevokeAccessToken(context.TODO(), ACCESS_TOKEN_ID, initSessionClaims().ExpiresAt.Time).
+					Return(errWhenRevokingToken).
+					Once()
+
+				return mck
+			},
+
+			err: errWhenRevokingToken,
+		},
+
+		{
+			testName: "Failed to revoke tokens, error when revoking the session of the refresh token",
+
+			refreshToken: REFRESH_TOKEN,
+
+			serviceMock: func() *mocks.SessionService {
+				mck := &mocks.SessionService{}
+
+				mck.EXPECT().
+					RevokeRefreshTokenSession(context.TODO(), REFRESH_TOKEN).
+					Return(errWhenRevokingSession).
+					Once()
+
+				return mck
+			},
+
+			err: errWhenRevokingSession,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			parserMock := &mocks.AccessTokenParser{}
+			if test.parserMock != nil {
+				parserMock = test.parserMock()
+			}
+
+			revokerMock := &mocks.AccessTokenRevoker{}
+			if test.revokerMock != nil {
+				revokerMock = test.revokerMock()
+			}
+
+			serviceMock := &mocks.SessionService{}
+			if test.serviceMock != nil {
+				serviceMock = test.serviceMock()
+			}
+
+			issuer := tokens.NewJwtIssuer(nil, serviceMock, nil, nil, parserMock, revokerMock, nil)
+
+			err := issuer.RevokeTokens(context.TODO(), test.accessToken, test.refreshToken)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, parserMock, revokerMock, serviceMock)
+		})
+	}
+}
@@ -33,3 +33,15 @@ func (j *jwtManager) ParseWithClaims(tokenString string, claims *Claims) (*jwt.T
 
 	return token, claims, nil
 }
+
+func (j *jwtManager) ParseInvitationWithClaims(tokenString string, claims *InvitationClaims) (*jwt.Token, *InvitationClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return j.configManager.JwtConfig.Secret, nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return token, claims, nil
+}
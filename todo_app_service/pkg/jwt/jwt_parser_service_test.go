@@ -1,8 +1,8 @@
 package jwt_test
 
 import (
-	"Todo-List/internProject/todo_app_service/pkg/tokens"
-	"Todo-List/internProject/todo_app_service/pkg/tokens/mocks"
+	tokens "Todo-List/internProject/todo_app_service/pkg/jwt"
+	"Todo-List/internProject/todo_app_service/pkg/jwt/mocks"
 	"context"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/mock"
@@ -15,7 +15,7 @@ func TestJwtParserService_ParseJWT(t *testing.T) {
 		testName       string
 		tokenString    string
 		mockParser     func() *mocks.Parser
-		expectedOutput *jwt.Claims
+		expectedOutput *tokens.Claims
 		err            error
 	}{
 		{
@@ -30,7 +30,7 @@ func TestJwtParserService_ParseJWT(t *testing.T) {
 				token := initJWT(claims, &jwt.SigningMethodHMAC{}, true)
 
 				mParser.EXPECT().
-					ParseWithClaims(SIGNED_JWT_STRING, &jwt.Claims{}).
+					ParseWithClaims(SIGNED_JWT_STRING, &tokens.Claims{}).
 					Return(token, claims, nil).Once()
 
 				return mParser
@@ -45,7 +45,7 @@ func TestJwtParserService_ParseJWT(t *testing.T) {
 				mParser := &mocks.Parser{}
 
 				mParser.EXPECT().
-					ParseWithClaims("", &jwt.Claims{}).
+					ParseWithClaims("", &tokens.Claims{}).
 					Return(nil, nil, jwt.ErrTokenMalformed).Once()
 
 				return mParser
@@ -65,7 +65,7 @@ func TestJwtParserService_ParseJWT(t *testing.T) {
 				token := initJWT(claims, &jwt.SigningMethodECDSA{}, true)
 
 				mParser.EXPECT().
-					ParseWithClaims(SIGNED_JWT_STRING, &jwt.Claims{}).
+					ParseWithClaims(SIGNED_JWT_STRING, &tokens.Claims{}).
 					Return(token, claims, nil).Once()
 
 				return mParser
@@ -85,7 +85,7 @@ func TestJwtParserService_ParseJWT(t *testing.T) {
 				token := initJWT(claims, &jwt.SigningMethodHMAC{}, false)
 
 				mParser.EXPECT().
-					ParseWithClaims(SIGNED_JWT_STRING, &jwt.Claims{}).
+					ParseWithClaims(SIGNED_JWT_STRING, &tokens.Claims{}).
 					Return(token, claims, nil).Once()
 
 				return mParser
@@ -100,7 +100,7 @@ func TestJwtParserService_ParseJWT(t *testing.T) {
 				mParser := &mocks.Parser{}
 
 				mParser.EXPECT().
-					ParseWithClaims("", &jwt.Claims{}).
+					ParseWithClaims("", &tokens.Claims{}).
 					Return(nil, nil, jwt.ErrTokenExpired).Once()
 
 				return mParser
@@ -118,7 +118,7 @@ func TestJwtParserService_ParseJWT(t *testing.T) {
 				mParser = test.mockParser()
 			}
 
-			parserService := jwt.NewJwtParseService(mParser)
+			parserService := tokens.NewJwtParseService(mParser)
 
 			gotOutput, err := parserService.ParseJWT(context.TODO(), test.tokenString)
 			if test.err != nil {
@@ -6,7 +6,7 @@ import (
 	jwt "github.com/golang-jwt/jwt/v5"
 	mock "github.com/stretchr/testify/mock"
 
-	tokens "Todo-List/internProject/todo_app_service/pkg/tokens"
+	tokens "Todo-List/internProject/todo_app_service/pkg/jwt"
 )
 
 // Parser is an autogenerated mock type for the parser type
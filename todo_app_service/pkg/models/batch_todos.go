@@ -0,0 +1,16 @@
+package models
+
+// BatchTodoResult is the outcome of a single operation of a batch, the status is the http status code
+// the operation would have had as a standalone request
+type BatchTodoResult struct {
+	Index  int    `json:"index"`
+	Op     string `json:"op"`
+	Status int    `json:"status"`
+	Todo   *Todo  `json:"todo,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+type BatchTodosResult struct {
+	Committed bool               `json:"committed"`
+	Results   []*BatchTodoResult `json:"results"`
+}
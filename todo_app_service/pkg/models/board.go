@@ -0,0 +1,31 @@
+package models
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"time"
+)
+
+type Column struct {
+	Id        string               `json:"id"`
+	ListId    string               `json:"list_id"`
+	Name      string               `json:"name"`
+	Status    constants.TodoStatus `json:"status"`
+	WipLimit  *int                 `json:"wip_limit,omitempty"`
+	Position  int                  `json:"position"`
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+// BoardColumn is a column of the board together with its todos, the default column of a status
+// that has no custom column has no id
+type BoardColumn struct {
+	Id       *string              `json:"id,omitempty"`
+	Name     string               `json:"name"`
+	Status   constants.TodoStatus `json:"status"`
+	WipLimit *int                 `json:"wip_limit,omitempty"`
+	Todos    []*Todo              `json:"todos"`
+}
+
+type Board struct {
+	ListId  string         `json:"list_id"`
+	Columns []*BoardColumn `json:"columns"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"time"
+)
+
+type Comment struct {
+	Id          string    `json:"id"`
+	TodoId      string    `json:"todo_id" validate:"required"`
+	Author      string    `json:"author" validate:"required"`
+	Content     string    `json:"content" validate:"required"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+type CommentPage struct {
+	Data       []*Comment       `json:"data"`
+	PageInfo   *pagination.Page `json:"page_info"`
+	TotalCount int              `json:"total_count"`
+}
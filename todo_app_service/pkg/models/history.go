@@ -0,0 +1,25 @@
+package models
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"time"
+)
+
+// HistoryEntry is a single field change of a todo or a list, the actor is missing for changes made outside of a request
+type HistoryEntry struct {
+	Id         string    `json:"id"`
+	EntityType string    `json:"entity_type"`
+	EntityId   string    `json:"entity_id"`
+	Action     string    `json:"action"`
+	Field      string    `json:"field"`
+	OldValue   *string   `json:"old_value"`
+	NewValue   *string   `json:"new_value"`
+	Actor      *string   `json:"actor"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type HistoryPage struct {
+	Data       []*HistoryEntry  `json:"data"`
+	PageInfo   *pagination.Page `json:"page_info"`
+	TotalCount int              `json:"total_count"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"time"
+)
+
+type Invitation struct {
+	Id        string                     `json:"id"`
+	ListId    string                     `json:"list_id"`
+	Email     string                     `json:"email"`
+	Role      constants.CollaboratorRole `json:"role"`
+	InvitedBy string                     `json:"invited_by,omitempty"`
+	Token     string                     `json:"token"`
+	Status    constants.InvitationStatus `json:"status"`
+	CreatedAt time.Time                  `json:"created_at"`
+	ExpiresAt time.Time                  `json:"expires_at"`
+}
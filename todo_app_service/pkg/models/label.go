@@ -0,0 +1,19 @@
+package models
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/pagination"
+	"time"
+)
+
+type Label struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name" validate:"required"`
+	Owner     string    `json:"owner" validate:"required"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type LabelPage struct {
+	Data       []*Label         `json:"data"`
+	PageInfo   *pagination.Page `json:"page_info"`
+	TotalCount int              `json:"total_count"`
+}
@@ -0,0 +1,8 @@
+package models
+
+// Memberships are the organizations and teams of a user the role mapping is evaluated against,
+// teams are written as "org/team-slug"
+type Memberships struct {
+	Organizations []string
+	Teams         []string
+}
@@ -0,0 +1,8 @@
+package models
+
+// OauthLogin is where the user is sent to log in together with the values the callback has to be checked against
+type OauthLogin struct {
+	Url   string
+	State string
+	Nonce string
+}
@@ -0,0 +1,14 @@
+package models
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"time"
+)
+
+type Recurrence struct {
+	Frequency constants.RecurrenceFrequency `json:"frequency"`
+	Interval  int                           `json:"interval"`
+	Until     *time.Time                    `json:"until,omitempty"`
+	Count     *int                          `json:"count,omitempty"`
+	AnchorDay int                           `json:"-"`
+}
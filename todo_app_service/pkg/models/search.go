@@ -0,0 +1,16 @@
+package models
+
+import "Todo-List/internProject/todo_app_service/pkg/pagination"
+
+type SearchResult struct {
+	Type string  `json:"type"`
+	Rank float64 `json:"rank"`
+	Todo *Todo   `json:"todo,omitempty"`
+	List *List   `json:"list,omitempty"`
+}
+
+type SearchResultPage struct {
+	Data       []*SearchResult  `json:"data"`
+	PageInfo   *pagination.Page `json:"page_info"`
+	TotalCount int              `json:"total_count"`
+}
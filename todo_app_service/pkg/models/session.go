@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// Session is a login of a user on one device, the refresh tokens issued for it form a token family
+type Session struct {
+	Id          string    `json:"id"`
+	UserId      string    `json:"user_id"`
+	DeviceLabel string    `json:"device_label"`
+	UserAgent   string    `json:"user_agent"`
+	IpAddress   string    `json:"ip_address"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastUsedAt  time.Time `json:"last_used_at"`
+	Current     bool      `json:"current"`
+	// the last access token issued for the session, it is revoked together with the session
+	AccessToken *AccessTokenInfo `json:"-"`
+}
+
+// AccessTokenInfo identifies an issued access token so that it can be revoked before it expires
+type AccessTokenInfo struct {
+	Id        string
+	ExpiresAt time.Time
+}
+
+// SessionDevice describes the client a session is created or renewed from
+type SessionDevice struct {
+	Label     string
+	UserAgent string
+	IpAddress string
+}
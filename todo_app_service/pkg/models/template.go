@@ -0,0 +1,26 @@
+package models
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/constants"
+	"time"
+)
+
+type Template struct {
+	Id          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Owner       string          `json:"owner"`
+	CreatedAt   time.Time       `json:"created_at"`
+	Todos       []*TemplateTodo `json:"todos,omitempty"`
+}
+
+// TemplateTodo is a todo of a template, its due date is the number of seconds after the start date of the created list
+type TemplateTodo struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Priority    constants.Priority `json:"priority"`
+	DueOffset   *int64             `json:"due_offset,omitempty"`
+	ParentId    *string            `json:"parent_id,omitempty"`
+	Recurrence  *Recurrence        `json:"recurrence,omitempty"`
+}
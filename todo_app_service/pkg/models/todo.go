@@ -17,6 +17,11 @@ type Todo struct {
 	Priority    constants.Priority   `json:"priority"`
 	AssignedTo  *string              `json:"assigned_to,omitempty"`
 	DueDate     *time.Time           `json:"due_date,omitempty"`
+	ParentId    *string              `json:"parent_id,omitempty"`
+	Recurrence  *Recurrence          `json:"recurrence,omitempty"`
+	Position    string               `json:"position"`
+	ColumnId    *string              `json:"column_id,omitempty"`
+	Estimate    *int                 `json:"estimate,omitempty"`
 }
 
 type TodoPage struct {
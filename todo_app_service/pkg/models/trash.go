@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// TrashItem is a deleted todo or list that can be restored until it is purged
+type TrashItem struct {
+	Type      string    `json:"type"`
+	DeletedAt time.Time `json:"deleted_at"`
+	Todo      *Todo     `json:"todo,omitempty"`
+	List      *List     `json:"list,omitempty"`
+}
+
+type Trash struct {
+	Data       []*TrashItem `json:"data"`
+	TotalCount int          `json:"total_count"`
+}
@@ -6,9 +6,10 @@ import (
 )
 
 type User struct {
-	Id    string             `json:"id"`
-	Email string             `json:"email" validate:"required"`
-	Role  constants.UserRole `json:"role" validate:"required"`
+	Id       string                     `json:"id"`
+	Email    string                     `json:"email" validate:"required"`
+	Role     constants.UserRole         `json:"role" validate:"required"`
+	ListRole constants.CollaboratorRole `json:"list_role,omitempty"`
 }
 
 type UserPage struct {
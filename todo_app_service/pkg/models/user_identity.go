@@ -0,0 +1,7 @@
+package models
+
+// UserIdentity is the identity an oauth provider vouches for after a successful login
+type UserIdentity struct {
+	Email       string
+	Memberships Memberships
+}
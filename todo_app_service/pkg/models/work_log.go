@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// WorkLog is time logged by a user on a todo, the duration is in minutes
+type WorkLog struct {
+	Id          string    `json:"id"`
+	TodoId      string    `json:"todo_id"`
+	UserId      string    `json:"user_id"`
+	Duration    int       `json:"duration"`
+	Note        string    `json:"note"`
+	LoggedOn    time.Time `json:"logged_on"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+// TimeTotals sums the estimates and the logged work of a todo or a list in minutes, todos without estimate count as zero
+type TimeTotals struct {
+	Estimate  int `json:"estimate"`
+	TimeSpent int `json:"time_spent"`
+}
+
+type WorkLogReport struct {
+	UserId    string     `json:"user_id"`
+	From      *time.Time `json:"from,omitempty"`
+	To        *time.Time `json:"to,omitempty"`
+	TimeSpent int        `json:"time_spent"`
+	WorkLogs  []*WorkLog `json:"work_logs"`
+}
@@ -0,0 +1,71 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"golang.org/x/oauth2"
+	"net/http"
+	"strings"
+)
+
+const discoveryPath = "/.well-known/openid-configuration"
+
+type httpClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// ProviderMetadata is the part of the OpenID Connect discovery document the API relies on
+type ProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+func (m *ProviderMetadata) Endpoint() oauth2.Endpoint {
+	return oauth2.Endpoint{
+		AuthURL:  m.AuthorizationEndpoint,
+		TokenURL: m.TokenEndpoint,
+	}
+}
+
+// Discover fetches the discovery document of the issuer and checks that it was published by the same issuer
+func Discover(ctx context.Context, client httpClient, issuerUrl string) (*ProviderMetadata, error) {
+	var metadata ProviderMetadata
+	if err := getJson(ctx, client, strings.TrimSuffix(issuerUrl, "/")+discoveryPath, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to fetch discovery document: %w", err)
+	}
+
+	if strings.TrimSuffix(metadata.Issuer, "/") != strings.TrimSuffix(issuerUrl, "/") {
+		return nil, fmt.Errorf("discovery document issuer %q does not match expected issuer %q", metadata.Issuer, issuerUrl)
+	}
+
+	if len(metadata.AuthorizationEndpoint) == 0 || len(metadata.TokenEndpoint) == 0 || len(metadata.JwksUri) == 0 {
+		return nil, errors.New("discovery document is missing a required endpoint")
+	}
+
+	return &metadata, nil
+}
+
+func getJson(ctx context.Context, client httpClient, url string, target interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
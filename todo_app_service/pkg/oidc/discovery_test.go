@@ -0,0 +1,60 @@
+package oidc
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDiscover(t *testing.T) {
+	server := setUpStandInServer(t)
+
+	tests := []struct {
+		testName  string
+		issuerUrl string
+		expectErr bool
+	}{
+		{
+			testName:  "Successfully discovering provider metadata",
+			issuerUrl: server.URL,
+		},
+
+		{
+			testName:  "Successfully discovering provider metadata when issuer has trailing slash",
+			issuerUrl: server.URL + "/",
+		},
+
+		{
+			testName:  "Failed to discover provider metadata because issuer does not publish a discovery document",
+			issuerUrl: server.URL + "/tenant",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			metadata, err := Discover(context.TODO(), http.DefaultClient, test.issuerUrl)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, server.URL, metadata.Issuer)
+			require.Equal(t, server.URL+"/token", metadata.Endpoint().TokenURL)
+			require.Equal(t, server.URL+"/keys", metadata.JwksUri)
+		})
+	}
+}
+
+func TestDiscover_MismatchedIssuer(t *testing.T) {
+	impostor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"issuer":"https://accounts.example.com","authorization_endpoint":"a","token_endpoint":"t","jwks_uri":"k"}`))
+	}))
+	defer impostor.Close()
+
+	_, err := Discover(context.TODO(), http.DefaultClient, impostor.URL)
+	require.Error(t, err)
+}
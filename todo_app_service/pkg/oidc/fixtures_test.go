@@ -0,0 +1,49 @@
+package oidc
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/oidc/oidctest"
+	"context"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const clientId = "todo-app"
+
+func setUpStandInServer(t *testing.T) *oidctest.Server {
+	t.Helper()
+
+	server, err := oidctest.NewServer(clientId)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func setUpVerifier(t *testing.T, server *oidctest.Server) *verifier {
+	t.Helper()
+
+	metadata, err := Discover(context.TODO(), http.DefaultClient, server.URL)
+	require.NoError(t, err)
+
+	return NewVerifier(http.DefaultClient, metadata, clientId)
+}
+
+func signIdToken(t *testing.T, server *oidctest.Server, modify func(claims map[string]interface{})) string {
+	t.Helper()
+
+	claims := server.DefaultClaims(oidctest.DefaultEmail)
+	if modify != nil {
+		modify(claims)
+	}
+
+	token, err := server.SignIdToken(claims)
+	require.NoError(t, err)
+
+	return token
+}
+
+func expiredAt() int64 {
+	return time.Now().Add(-time.Hour).Unix()
+}
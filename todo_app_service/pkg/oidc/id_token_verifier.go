@@ -0,0 +1,148 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"sync"
+)
+
+// Claims are the ID token claims, email_verified is a pointer so a missing claim can be told apart from false
+type Claims struct {
+	Email         string `json:"email"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+	Nonce         string `json:"nonce,omitempty"`
+	jwt.RegisteredClaims
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type verifier struct {
+	client   httpClient
+	metadata *ProviderMetadata
+	clientId string
+
+	mu   sync.RWMutex
+	keys map[string]*rsa.PublicKey
+}
+
+func NewVerifier(client httpClient, metadata *ProviderMetadata, clientId string) *verifier {
+	return &verifier{
+		client:   client,
+		metadata: metadata,
+		clientId: clientId,
+		keys:     make(map[string]*rsa.PublicKey),
+	}
+}
+
+// Verify checks the signature of the ID token against the provider keys as well as its issuer, audience and expiry
+func (v *verifier) Verify(ctx context.Context, rawIdToken string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(rawIdToken, claims, func(token *jwt.Token) (interface{}, error) {
+		return v.signingKey(ctx, token)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodRS384.Alg(), jwt.SigningMethodRS512.Alg()}),
+		jwt.WithIssuer(v.metadata.Issuer),
+		jwt.WithAudience(v.clientId),
+		jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+
+	return claims, nil
+}
+
+func (v *verifier) signingKey(ctx context.Context, token *jwt.Token) (*rsa.PublicKey, error) {
+	keyId, _ := token.Header["kid"].(string)
+	if key, ok := v.cachedKey(keyId); ok {
+		return key, nil
+	}
+
+	// the provider may have rotated its keys since they were last fetched
+	if err := v.fetchKeys(ctx); err != nil {
+		return nil, fmt.Errorf("failed to fetch provider keys: %w", err)
+	}
+
+	if key, ok := v.cachedKey(keyId); ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("no provider key with id %q", keyId)
+}
+
+func (v *verifier) cachedKey(keyId string) (*rsa.PublicKey, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	// tokens without a key id are only accepted when the provider publishes a single key
+	if len(keyId) == 0 && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, true
+		}
+	}
+
+	key, ok := v.keys[keyId]
+	return key, ok
+}
+
+func (v *verifier) fetchKeys(ctx context.Context) error {
+	var keySet jsonWebKeySet
+	if err := getJson(ctx, v.client, v.metadata.JwksUri, &keySet); err != nil {
+		return err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.Kty != "RSA" || (len(jwk.Use) != 0 && jwk.Use != "sig") {
+			continue
+		}
+
+		key, err := jwk.rsaPublicKey()
+		if err != nil {
+			return err
+		}
+		keys[jwk.Kid] = key
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.mu.Unlock()
+
+	return nil
+}
+
+func (k jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	modulus, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus of key %q: %w", k.Kid, err)
+	}
+
+	exponent, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent of key %q: %w", k.Kid, err)
+	}
+
+	e := new(big.Int).SetBytes(exponent)
+	if len(modulus) == 0 || !e.IsInt64() || e.Int64() < 3 {
+		return nil, errors.New("invalid rsa public key " + k.Kid)
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulus),
+		E: int(e.Int64()),
+	}, nil
+}
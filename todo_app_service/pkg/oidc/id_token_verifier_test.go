@@ -0,0 +1,112 @@
+package oidc
+
+import (
+	"Todo-List/internProject/todo_app_service/pkg/oidc/oidctest"
+	"context"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	server := setUpStandInServer(t)
+
+	tests := []struct {
+		testName      string
+		modify        func(claims map[string]interface{})
+		expectedEmail string
+		expectErr     bool
+	}{
+		{
+			testName:      "Successfully verifying id token",
+			expectedEmail: oidctest.DefaultEmail,
+		},
+
+		{
+			testName: "Failed to verify id token issued for another client",
+			modify: func(claims map[string]interface{}) {
+				claims["aud"] = "another-client"
+			},
+			expectErr: true,
+		},
+
+		{
+			testName: "Failed to verify id token issued by another issuer",
+			modify: func(claims map[string]interface{}) {
+				claims["iss"] = "https://accounts.example.com"
+			},
+			expectErr: true,
+		},
+
+		{
+			testName: "Failed to verify expired id token",
+			modify: func(claims map[string]interface{}) {
+				claims["exp"] = expiredAt()
+			},
+			expectErr: true,
+		},
+
+		{
+			testName: "Failed to verify id token without expiry",
+			modify: func(claims map[string]interface{}) {
+				delete(claims, "exp")
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			v := setUpVerifier(t, server)
+
+			claims, err := v.Verify(context.TODO(), signIdToken(t, server, test.modify))
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expectedEmail, claims.Email)
+			require.NotNil(t, claims.EmailVerified)
+			require.True(t, *claims.EmailVerified)
+		})
+	}
+}
+
+func TestVerifier_VerifyAfterKeyRotation(t *testing.T) {
+	server := setUpStandInServer(t)
+	v := setUpVerifier(t, server)
+
+	_, err := v.Verify(context.TODO(), signIdToken(t, server, nil))
+	require.NoError(t, err)
+
+	require.NoError(t, server.RotateKey())
+
+	_, err = v.Verify(context.TODO(), signIdToken(t, server, nil))
+	require.NoError(t, err)
+}
+
+func TestVerifier_VerifyRejectsForeignSignature(t *testing.T) {
+	server := setUpStandInServer(t)
+	impostor := setUpStandInServer(t)
+	v := setUpVerifier(t, server)
+
+	claims := server.DefaultClaims(oidctest.DefaultEmail)
+	token, err := impostor.SignIdToken(claims)
+	require.NoError(t, err)
+
+	_, err = v.Verify(context.TODO(), token)
+	require.Error(t, err)
+}
+
+func TestVerifier_VerifyRejectsUnsignedToken(t *testing.T) {
+	server := setUpStandInServer(t)
+	v := setUpVerifier(t, server)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodNone, server.DefaultClaims(oidctest.DefaultEmail)).
+		SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+
+	_, err = v.Verify(context.TODO(), token)
+	require.Error(t, err)
+}
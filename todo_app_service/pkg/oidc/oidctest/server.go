@@ -0,0 +1,163 @@
+// Package oidctest provides a stand-in OpenID Connect provider for tests.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultEmail = "oidc.user@example.com"
+	DefaultNonce = "stand-in nonce"
+	AccessToken  = "stand-in access token"
+	keyBits      = 2048
+)
+
+// Server serves a discovery document, a key set and a token endpoint which issues ID tokens
+// for any authorization code, the claims of the issued tokens can be replaced by the tests
+type Server struct {
+	*httptest.Server
+	ClientId string
+
+	mu     sync.Mutex
+	keyId  int
+	key    *rsa.PrivateKey
+	claims jwt.MapClaims
+}
+
+func NewServer(clientId string) (*Server, error) {
+	s := &Server{ClientId: clientId}
+	if err := s.RotateKey(); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", s.handleDiscovery)
+	mux.HandleFunc("/keys", s.handleKeys)
+	mux.HandleFunc("/token", s.handleToken)
+	s.Server = httptest.NewServer(mux)
+
+	return s, nil
+}
+
+// RotateKey replaces the signing key, the old one is no longer published
+func (s *Server) RotateKey() error {
+	key, err := rsa.GenerateKey(rand.Reader, keyBits)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.key = key
+	s.keyId++
+
+	return nil
+}
+
+// SetIdTokenClaims sets the claims of the ID tokens issued by the token endpoint, nil restores the defaults
+func (s *Server) SetIdTokenClaims(claims jwt.MapClaims) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.claims = claims
+}
+
+// DefaultClaims returns claims of a valid ID token for the given email, issued for a login started with DefaultNonce
+func (s *Server) DefaultClaims(email string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":            s.URL,
+		"aud":            s.ClientId,
+		"sub":            email,
+		"email":          email,
+		"email_verified": true,
+		"nonce":          DefaultNonce,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+	}
+}
+
+// SignIdToken signs the claims with the currently published key
+func (s *Server) SignIdToken(claims jwt.MapClaims) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = s.currentKeyId()
+
+	return token.SignedString(s.key)
+}
+
+func (s *Server) currentKeyId() string {
+	return "key-" + strconv.Itoa(s.keyId)
+}
+
+func (s *Server) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJson(w, map[string]string{
+		"issuer":                 s.URL,
+		"authorization_endpoint": s.URL + "/authorize",
+		"token_endpoint":         s.URL + "/token",
+		"jwks_uri":               s.URL + "/keys",
+		"userinfo_endpoint":      s.URL + "/userinfo",
+	})
+}
+
+func (s *Server) handleKeys(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJson(w, map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"use": "sig",
+				"alg": jwt.SigningMethodRS256.Alg(),
+				"kid": s.currentKeyId(),
+				"n":   base64.RawURLEncoding.EncodeToString(s.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.key.E)).Bytes()),
+			},
+		},
+	})
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || len(r.FormValue("code")) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJson(w, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	s.mu.Lock()
+	claims := s.claims
+	s.mu.Unlock()
+	if claims == nil {
+		claims = s.DefaultClaims(DefaultEmail)
+	}
+
+	idToken, err := s.SignIdToken(claims)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJson(w, map[string]interface{}{
+		"access_token": AccessToken,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func writeJson(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,41 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Cursor points at a record in a sorted page, records sorted by id only need the id to be located.
+// Cursors are handed out as opaque base64 tokens so clients never depend on (or tamper with) their layout
+type Cursor struct {
+	SortValue string `json:"v,omitempty"`
+	Id        string `json:"id"`
+}
+
+func NewCursor(sortValue string, id string) *Cursor {
+	return &Cursor{SortValue: sortValue, Id: id}
+}
+
+func (c *Cursor) HasSortValue() bool {
+	return len(c.SortValue) != 0
+}
+
+func (c *Cursor) String() string {
+	payload, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func ParseCursor(cursor string) (*Cursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedCursor := &Cursor{}
+	if err = json.Unmarshal(payload, parsedCursor); err != nil {
+		return nil, err
+	}
+
+	return parsedCursor, nil
+}